@@ -1,10 +1,13 @@
 package middleware
 
 import (
+	"charum/business/permissions"
+	"charum/business/refresh_tokens"
 	"charum/business/users"
 	"charum/helper"
 	"charum/util"
 	"net/http"
+	"time"
 
 	"github.com/labstack/echo/v4"
 )
@@ -15,7 +18,8 @@ type RoleMiddleware struct {
 	Func echo.HandlerFunc
 }
 
-func Check(roles []string, UserRepository users.Repository) echo.MiddlewareFunc {
+// Check authenticates the request and asks the permission policy whether the role of the user is granted any of the allowed permissions
+func Check(allowed []string, UserRepository users.Repository, RefreshTokenRepository refresh_tokens.Repository, PermissionRepository permissions.Repository) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
 			uid, err := util.GetUIDFromToken(c)
@@ -27,6 +31,37 @@ func Check(roles []string, UserRepository users.Repository) echo.MiddlewareFunc
 				})
 			}
 
+			claims, err := util.GetClaimsFromToken(c)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, helper.BaseResponse{
+					Status:  http.StatusBadRequest,
+					Message: "invalid token",
+					Data:    nil,
+				})
+			}
+
+			tokenData, err := RefreshTokenRepository.GetByAccessTokenID(claims.ID)
+			if err != nil || tokenData.UserID != uid {
+				return echo.NewHTTPError(http.StatusUnauthorized, helper.BaseResponse{
+					Status:  http.StatusUnauthorized,
+					Message: "invalid token",
+					Data:    nil,
+				})
+			}
+
+			if tokenData.IsRevoked {
+				return echo.NewHTTPError(http.StatusUnauthorized, helper.BaseResponse{
+					Status:  http.StatusUnauthorized,
+					Message: "token has been revoked",
+					Data:    nil,
+				})
+			}
+
+			// last seen is only refreshed once per minute to avoid a write on every request
+			if time.Since(tokenData.LastSeenAt.Time()) > time.Minute {
+				_ = RefreshTokenRepository.UpdateLastSeen(tokenData.Id)
+			}
+
 			user, err := UserRepository.GetByID(uid)
 			if err != nil {
 				return echo.NewHTTPError(http.StatusBadRequest, helper.BaseResponse{
@@ -44,9 +79,12 @@ func Check(roles []string, UserRepository users.Repository) echo.MiddlewareFunc
 				})
 			}
 
-			for _, role := range roles {
-				if user.Role == role {
-					return next(c)
+			policy, err := PermissionRepository.GetByRole(user.Role)
+			if err == nil {
+				for _, permission := range allowed {
+					if policy.Allows(permission) {
+						return next(c)
+					}
 				}
 			}
 
@@ -58,3 +96,71 @@ func Check(roles []string, UserRepository users.Repository) echo.MiddlewareFunc
 		}
 	}
 }
+
+func CheckEmailVerified(UserRepository users.Repository) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			uid, err := util.GetUIDFromToken(c)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, helper.BaseResponse{
+					Status:  http.StatusBadRequest,
+					Message: "invalid token",
+					Data:    nil,
+				})
+			}
+
+			user, err := UserRepository.GetByID(uid)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, helper.BaseResponse{
+					Status:  http.StatusBadRequest,
+					Message: err.Error(),
+					Data:    nil,
+				})
+			}
+
+			if !user.EmailVerified && user.Role != "admin" {
+				return c.JSON(http.StatusForbidden, helper.BaseResponse{
+					Status:  http.StatusForbidden,
+					Message: "email is not verified",
+					Data:    nil,
+				})
+			}
+
+			return next(c)
+		}
+	}
+}
+
+func CheckTwoFactorEnabled(UserRepository users.Repository) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			uid, err := util.GetUIDFromToken(c)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, helper.BaseResponse{
+					Status:  http.StatusBadRequest,
+					Message: "invalid token",
+					Data:    nil,
+				})
+			}
+
+			user, err := UserRepository.GetByID(uid)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, helper.BaseResponse{
+					Status:  http.StatusBadRequest,
+					Message: err.Error(),
+					Data:    nil,
+				})
+			}
+
+			if !user.TwoFactorEnabled {
+				return c.JSON(http.StatusForbidden, helper.BaseResponse{
+					Status:  http.StatusForbidden,
+					Message: "two-factor authentication is required",
+					Data:    nil,
+				})
+			}
+
+			return next(c)
+		}
+	}
+}
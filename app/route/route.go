@@ -2,15 +2,24 @@ package route
 
 import (
 	_middleware "charum/app/middleware"
+	_permissionDomain "charum/business/permissions"
+	_refreshTokenDomain "charum/business/refresh_tokens"
 	_usersDomain "charum/business/users"
+	"charum/controller/appeals"
+	auditLogs "charum/controller/audit_logs"
 	_bookmarkController "charum/controller/bookmarks"
 	"charum/controller/comments"
+	dataExports "charum/controller/data_exports"
 	followThreads "charum/controller/follow_threads"
+	followUsers "charum/controller/follow_users"
 	"charum/controller/forgot_password"
+	"charum/controller/permissions"
 	"charum/controller/reports"
 	"charum/controller/threads"
 	"charum/controller/topics"
+	userBlocks "charum/controller/user_blocks"
 	"charum/controller/users"
+	"charum/controller/warnings"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
@@ -19,14 +28,24 @@ import (
 type ControllerList struct {
 	LoggerMiddleware         echo.MiddlewareFunc
 	UserRepository           _usersDomain.Repository
+	RefreshTokenRepository   _refreshTokenDomain.Repository
+	PermissionRepository     _permissionDomain.Repository
+	AdminTwoFactorRequired   bool
 	UserController           *users.UserController
 	TopicController          *topics.TopicController
 	ThreadController         *threads.ThreadController
 	CommentController        *comments.CommentController
 	FollowThreadController   *followThreads.FollowThreadController
+	FollowUserController     *followUsers.FollowUserController
+	UserBlockController      *userBlocks.UserBlockController
 	BookmarkController       *_bookmarkController.BookmarkController
 	ForgotPasswordController *forgot_password.ForgotPasswordController
 	ReportController         *reports.ReportController
+	PermissionController     *permissions.PermissionController
+	DataExportController     *dataExports.DataExportController
+	WarningController        *warnings.WarningController
+	AppealController         *appeals.AppealController
+	AuditLogController       *auditLogs.AuditLogController
 }
 
 func (cl *ControllerList) Init(e *echo.Echo) {
@@ -43,78 +62,187 @@ func (cl *ControllerList) Init(e *echo.Echo) {
 	user := apiV1.Group("/user")
 	user.POST("/register", cl.UserController.Register)
 	user.POST("/login", cl.UserController.Login)
-	user.GET("/profile", cl.UserController.GetProfile, _middleware.Check([]string{"user", "admin"}, cl.UserRepository))
-	user.PUT("/profile", cl.UserController.UserUpdate, _middleware.Check([]string{"user", "admin"}, cl.UserRepository))
-	user.POST("/report/:user-id", cl.ReportController.ReportUser, _middleware.Check([]string{"user", "admin"}, cl.UserRepository))
-	user.PUT("/change-password", cl.UserController.UpdatePassword, _middleware.Check([]string{"user", "admin"}, cl.UserRepository))
+	user.POST("/refresh", cl.UserController.RefreshToken)
+	user.POST("/appeal", cl.AppealController.Create)
+	user.GET("/oidc/login", cl.UserController.OIDCLogin)
+	user.GET("/oidc/callback", cl.UserController.OIDCCallback)
+	user.POST("/2fa/verify", cl.UserController.VerifyTwoFactor)
+	user.POST("/2fa/enroll", cl.UserController.EnrollTwoFactor, _middleware.Check([]string{_permissionDomain.AccountManageOwn}, cl.UserRepository, cl.RefreshTokenRepository, cl.PermissionRepository))
+	user.POST("/2fa/confirm", cl.UserController.ConfirmTwoFactor, _middleware.Check([]string{_permissionDomain.AccountManageOwn}, cl.UserRepository, cl.RefreshTokenRepository, cl.PermissionRepository))
+	user.POST("/2fa/disable", cl.UserController.DisableTwoFactor, _middleware.Check([]string{_permissionDomain.AccountManageOwn}, cl.UserRepository, cl.RefreshTokenRepository, cl.PermissionRepository))
+	user.POST("/logout", cl.UserController.Logout, _middleware.Check([]string{_permissionDomain.AccountManageOwn}, cl.UserRepository, cl.RefreshTokenRepository, cl.PermissionRepository))
+	user.GET("/profile", cl.UserController.GetProfile, _middleware.Check([]string{_permissionDomain.AccountManageOwn}, cl.UserRepository, cl.RefreshTokenRepository, cl.PermissionRepository))
+	user.PUT("/profile", cl.UserController.UserUpdate, _middleware.Check([]string{_permissionDomain.AccountManageOwn}, cl.UserRepository, cl.RefreshTokenRepository, cl.PermissionRepository))
+	user.DELETE("/profile", cl.UserController.RequestDeletion, _middleware.Check([]string{_permissionDomain.AccountManageOwn}, cl.UserRepository, cl.RefreshTokenRepository, cl.PermissionRepository))
+	user.POST("/report/:user-id", cl.ReportController.ReportUser, _middleware.Check([]string{_permissionDomain.ReportCreate}, cl.UserRepository, cl.RefreshTokenRepository, cl.PermissionRepository), _middleware.CheckEmailVerified(cl.UserRepository))
+	user.POST("/follow/:user-id", cl.FollowUserController.Create, _middleware.Check([]string{_permissionDomain.AccountManageOwn}, cl.UserRepository, cl.RefreshTokenRepository, cl.PermissionRepository))
+	user.DELETE("/follow/:user-id", cl.FollowUserController.Delete, _middleware.Check([]string{_permissionDomain.AccountManageOwn}, cl.UserRepository, cl.RefreshTokenRepository, cl.PermissionRepository))
+	user.GET("/u/:username", cl.UserController.GetPublicProfile)
+	user.GET("/u/:username/threads/:page", cl.UserController.GetPublicThreads)
+	user.GET("/u/:username/comments/:page", cl.UserController.GetPublicComments)
+	user.GET("/followers/:user-id", cl.FollowUserController.GetFollowers)
+	user.GET("/following/:user-id", cl.FollowUserController.GetFollowing)
+	user.GET("/block", cl.UserBlockController.GetBlocked, _middleware.Check([]string{_permissionDomain.AccountManageOwn}, cl.UserRepository, cl.RefreshTokenRepository, cl.PermissionRepository))
+	user.POST("/block/:user-id", cl.UserBlockController.Block, _middleware.Check([]string{_permissionDomain.AccountManageOwn}, cl.UserRepository, cl.RefreshTokenRepository, cl.PermissionRepository))
+	user.DELETE("/block/:user-id", cl.UserBlockController.Unblock, _middleware.Check([]string{_permissionDomain.AccountManageOwn}, cl.UserRepository, cl.RefreshTokenRepository, cl.PermissionRepository))
+	user.GET("/mute", cl.UserBlockController.GetMuted, _middleware.Check([]string{_permissionDomain.AccountManageOwn}, cl.UserRepository, cl.RefreshTokenRepository, cl.PermissionRepository))
+	user.POST("/mute/:user-id", cl.UserBlockController.Mute, _middleware.Check([]string{_permissionDomain.AccountManageOwn}, cl.UserRepository, cl.RefreshTokenRepository, cl.PermissionRepository))
+	user.DELETE("/mute/:user-id", cl.UserBlockController.Unmute, _middleware.Check([]string{_permissionDomain.AccountManageOwn}, cl.UserRepository, cl.RefreshTokenRepository, cl.PermissionRepository))
+	user.PUT("/change-password", cl.UserController.UpdatePassword, _middleware.Check([]string{_permissionDomain.AccountManageOwn}, cl.UserRepository, cl.RefreshTokenRepository, cl.PermissionRepository))
+	user.GET("/verify-email/:token", cl.UserController.VerifyEmail)
+	user.POST("/verify-email", cl.UserController.ResendVerificationEmail, _middleware.Check([]string{_permissionDomain.AccountManageOwn}, cl.UserRepository, cl.RefreshTokenRepository, cl.PermissionRepository))
+	user.POST("/export", cl.DataExportController.Request, _middleware.Check([]string{_permissionDomain.AccountManageOwn}, cl.UserRepository, cl.RefreshTokenRepository, cl.PermissionRepository))
+	user.GET("/export", cl.DataExportController.GetLatest, _middleware.Check([]string{_permissionDomain.AccountManageOwn}, cl.UserRepository, cl.RefreshTokenRepository, cl.PermissionRepository))
+	user.GET("/export/:token", cl.DataExportController.Download)
+	user.GET("/sessions", cl.UserController.GetSessions, _middleware.Check([]string{_permissionDomain.AccountManageOwn}, cl.UserRepository, cl.RefreshTokenRepository, cl.PermissionRepository))
+	user.DELETE("/sessions", cl.UserController.RevokeAllSessions, _middleware.Check([]string{_permissionDomain.AccountManageOwn}, cl.UserRepository, cl.RefreshTokenRepository, cl.PermissionRepository))
+	user.DELETE("/sessions/:session-id", cl.UserController.RevokeSession, _middleware.Check([]string{_permissionDomain.AccountManageOwn}, cl.UserRepository, cl.RefreshTokenRepository, cl.PermissionRepository))
 	user.POST("/forgot-password", cl.ForgotPasswordController.Generate)
 	user.GET("/forgot-password/:token", cl.ForgotPasswordController.ValidateToken)
 	user.POST("/forgot-password/:token", cl.ForgotPasswordController.Update)
+	user.POST("/magic-link", cl.ForgotPasswordController.GenerateMagicLink)
+	user.POST("/magic-link/:token", cl.ForgotPasswordController.MagicLinkLogin)
 
 	topic := apiV1.Group("/topic")
 	topic.GET("/:page", cl.TopicController.GetManyWithPagination)
 	topic.GET("/id/:topic-id", cl.TopicController.GetByID)
 
 	thread := apiV1.Group("/thread")
-	thread.GET("", cl.ThreadController.GetManyByToken, _middleware.Check([]string{"user", "admin"}, cl.UserRepository))
-	thread.POST("", cl.ThreadController.Create, _middleware.Check([]string{"user", "admin"}, cl.UserRepository))
+	thread.GET("", cl.ThreadController.GetManyByToken, _middleware.Check([]string{_permissionDomain.AccountManageOwn}, cl.UserRepository, cl.RefreshTokenRepository, cl.PermissionRepository))
+	thread.POST("", cl.ThreadController.Create, _middleware.Check([]string{_permissionDomain.ThreadCreate}, cl.UserRepository, cl.RefreshTokenRepository, cl.PermissionRepository), _middleware.CheckEmailVerified(cl.UserRepository))
 	thread.GET("/:page", cl.ThreadController.GetManyWithPagination)
+	thread.GET("/tag", cl.ThreadController.GetTags)
+	thread.GET("/following/:page", cl.ThreadController.GetFeed, _middleware.Check([]string{_permissionDomain.AccountManageOwn}, cl.UserRepository, cl.RefreshTokenRepository, cl.PermissionRepository))
 	threadID := thread.Group("/id")
 	threadID.GET("/:thread-id", cl.ThreadController.GetByID)
-	threadID.PUT("/:thread-id", cl.ThreadController.UserUpdate, _middleware.Check([]string{"user", "admin"}, cl.UserRepository))
-	threadID.DELETE("/:thread-id", cl.ThreadController.UserDelete, _middleware.Check([]string{"user", "admin"}, cl.UserRepository))
+	threadID.PUT("/:thread-id", cl.ThreadController.UserUpdate, _middleware.Check([]string{_permissionDomain.ThreadUpdateOwn, _permissionDomain.ThreadUpdateAny}, cl.UserRepository, cl.RefreshTokenRepository, cl.PermissionRepository))
+	threadID.DELETE("/:thread-id", cl.ThreadController.UserDelete, _middleware.Check([]string{_permissionDomain.ThreadDeleteOwn, _permissionDomain.ThreadDeleteAny}, cl.UserRepository, cl.RefreshTokenRepository, cl.PermissionRepository))
+	threadDraft := thread.Group("/draft", _middleware.Check([]string{_permissionDomain.ThreadCreate}, cl.UserRepository, cl.RefreshTokenRepository, cl.PermissionRepository))
+	threadDraft.GET("", cl.ThreadController.GetDrafts)
+	threadDraft.POST("", cl.ThreadController.CreateDraft)
+	threadDraft.GET("/:thread-id", cl.ThreadController.GetDraftByID)
+	threadDraft.PUT("/:thread-id", cl.ThreadController.UpdateDraft)
+	threadDraft.PUT("/:thread-id/publish", cl.ThreadController.PublishDraft, _middleware.CheckEmailVerified(cl.UserRepository))
+	threadDraft.DELETE("/:thread-id", cl.ThreadController.DeleteDraft)
+	threadRevision := thread.Group("/revision")
+	threadRevision.GET("/:thread-id", cl.ThreadController.GetRevisions, _middleware.Check([]string{_permissionDomain.AccountManageOwn}, cl.UserRepository, cl.RefreshTokenRepository, cl.PermissionRepository))
+	threadRevision.GET("/:thread-id/diff", cl.ThreadController.CompareRevisions, _middleware.Check([]string{_permissionDomain.AccountManageOwn}, cl.UserRepository, cl.RefreshTokenRepository, cl.PermissionRepository))
 	threadFollow := thread.Group("/follow")
-	threadFollow.GET("", cl.FollowThreadController.GetFollowedThreadByToken, _middleware.Check([]string{"user", "admin"}, cl.UserRepository))
+	threadFollow.GET("", cl.FollowThreadController.GetFollowedThreadByToken, _middleware.Check([]string{_permissionDomain.AccountManageOwn}, cl.UserRepository, cl.RefreshTokenRepository, cl.PermissionRepository))
 	threadFollow.GET("/:user-id", cl.FollowThreadController.GetFollowedThreadByUserID)
-	threadFollow.POST("/:thread-id", cl.FollowThreadController.Create, _middleware.Check([]string{"user", "admin"}, cl.UserRepository))
-	threadFollow.DELETE("/:thread-id", cl.FollowThreadController.Delete, _middleware.Check([]string{"user", "admin"}, cl.UserRepository))
+	threadFollow.POST("/:thread-id", cl.FollowThreadController.Create, _middleware.Check([]string{_permissionDomain.ThreadInteract}, cl.UserRepository, cl.RefreshTokenRepository, cl.PermissionRepository))
+	threadFollow.DELETE("/:thread-id", cl.FollowThreadController.Delete, _middleware.Check([]string{_permissionDomain.ThreadInteract}, cl.UserRepository, cl.RefreshTokenRepository, cl.PermissionRepository))
 	threadComment := thread.Group("/comment")
-	threadComment.POST("/:thread-id", cl.CommentController.Create, _middleware.Check([]string{"user", "admin"}, cl.UserRepository))
-	threadComment.PUT("/:comment-id", cl.CommentController.Update, _middleware.Check([]string{"user", "admin"}, cl.UserRepository))
-	threadComment.DELETE("/:comment-id", cl.CommentController.Delete, _middleware.Check([]string{"user", "admin"}, cl.UserRepository))
+	threadComment.POST("/:thread-id", cl.CommentController.Create, _middleware.Check([]string{_permissionDomain.CommentCreate}, cl.UserRepository, cl.RefreshTokenRepository, cl.PermissionRepository), _middleware.CheckEmailVerified(cl.UserRepository))
+	threadComment.PUT("/:comment-id", cl.CommentController.Update, _middleware.Check([]string{_permissionDomain.CommentUpdateOwn, _permissionDomain.CommentUpdateAny}, cl.UserRepository, cl.RefreshTokenRepository, cl.PermissionRepository))
+	threadComment.DELETE("/:comment-id", cl.CommentController.Delete, _middleware.Check([]string{_permissionDomain.CommentDeleteOwn, _permissionDomain.CommentDeleteAny}, cl.UserRepository, cl.RefreshTokenRepository, cl.PermissionRepository))
 	threadLike := thread.Group("/like")
-	threadLike.GET("", cl.ThreadController.GetLikedThreadByToken, _middleware.Check([]string{"user", "admin"}, cl.UserRepository))
+	threadLike.GET("", cl.ThreadController.GetLikedThreadByToken, _middleware.Check([]string{_permissionDomain.AccountManageOwn}, cl.UserRepository, cl.RefreshTokenRepository, cl.PermissionRepository))
 	threadLike.GET("/:user-id", cl.ThreadController.GetLikedThreadByUserID)
-	threadLike.POST("/id/:thread-id", cl.ThreadController.Like, _middleware.Check([]string{"user", "admin"}, cl.UserRepository))
-	threadLike.DELETE("/id/:thread-id", cl.ThreadController.Unlike, _middleware.Check([]string{"user", "admin"}, cl.UserRepository))
+	threadLike.POST("/id/:thread-id", cl.ThreadController.Like, _middleware.Check([]string{_permissionDomain.ThreadInteract}, cl.UserRepository, cl.RefreshTokenRepository, cl.PermissionRepository))
+	threadLike.DELETE("/id/:thread-id", cl.ThreadController.Unlike, _middleware.Check([]string{_permissionDomain.ThreadInteract}, cl.UserRepository, cl.RefreshTokenRepository, cl.PermissionRepository))
 	threadBookmark := thread.Group("/bookmark")
-	threadBookmark.GET("", cl.BookmarkController.GetAllByToken, _middleware.Check([]string{"user", "admin"}, cl.UserRepository))
-	threadBookmark.POST("/:thread-id", cl.BookmarkController.Create, _middleware.Check([]string{"user", "admin"}, cl.UserRepository))
-	threadBookmark.DELETE("/:thread-id", cl.BookmarkController.Delete, _middleware.Check([]string{"user", "admin"}, cl.UserRepository))
+	threadBookmark.GET("", cl.BookmarkController.GetAllByToken, _middleware.Check([]string{_permissionDomain.AccountManageOwn}, cl.UserRepository, cl.RefreshTokenRepository, cl.PermissionRepository))
+	threadBookmark.POST("/:thread-id", cl.BookmarkController.Create, _middleware.Check([]string{_permissionDomain.ThreadInteract}, cl.UserRepository, cl.RefreshTokenRepository, cl.PermissionRepository))
+	threadBookmark.DELETE("/:thread-id", cl.BookmarkController.Delete, _middleware.Check([]string{_permissionDomain.ThreadInteract}, cl.UserRepository, cl.RefreshTokenRepository, cl.PermissionRepository))
 	threadReport := thread.Group("/report")
-	threadReport.POST("/:thread-id", cl.ReportController.ReportThread, _middleware.Check([]string{"user", "admin"}, cl.UserRepository))
+	threadReport.POST("/:thread-id", cl.ReportController.ReportThread, _middleware.Check([]string{_permissionDomain.ReportCreate}, cl.UserRepository, cl.RefreshTokenRepository, cl.PermissionRepository), _middleware.CheckEmailVerified(cl.UserRepository))
 
-	// Admin
-	admin := apiV1.Group("/admin", _middleware.Check([]string{"admin"}, cl.UserRepository))
-	admin.GET("/statistics", cl.ReportController.CountAllData)
+	// Admin, every route also requires two-factor authentication when configured
+	adminCheck := func(allowed ...string) []echo.MiddlewareFunc {
+		middlewares := []echo.MiddlewareFunc{_middleware.Check(allowed, cl.UserRepository, cl.RefreshTokenRepository, cl.PermissionRepository)}
+		if cl.AdminTwoFactorRequired {
+			middlewares = append(middlewares, _middleware.CheckTwoFactorEnabled(cl.UserRepository))
+		}
+		return middlewares
+	}
+
+	admin := apiV1.Group("/admin")
+	admin.GET("/statistics", cl.ReportController.CountAllData, adminCheck(_permissionDomain.StatisticsRead)...)
+	admin.GET("/audit/:page", cl.AuditLogController.GetManyWithPagination, adminCheck(_permissionDomain.UserManage)...)
+
+	adminAppeal := admin.Group("/appeal")
+	adminAppeal.GET("/:page", cl.AppealController.GetManyWithPagination, adminCheck(_permissionDomain.UserManage)...)
+	adminAppeal.GET("/id/:appeal-id", cl.AppealController.GetByID, adminCheck(_permissionDomain.UserManage)...)
+	adminAppeal.PUT("/accept/:appeal-id", cl.AppealController.Accept, adminCheck(_permissionDomain.UserManage)...)
+	adminAppeal.PUT("/reject/:appeal-id", cl.AppealController.Reject, adminCheck(_permissionDomain.UserManage)...)
 
 	adminUser := admin.Group("/user")
-	adminUser.GET("/:page", cl.UserController.GetManyWithPagination)
-	adminUser.PUT("/suspend/:user-id", cl.UserController.Suspend)
-	adminUser.PUT("/unsuspend/:user-id", cl.UserController.Unsuspend)
-	adminUser.GET("/report", cl.ReportController.GetAllReportedUsers)
-	adminUser.GET("/report/:user-id", cl.ReportController.GetUserReportedID)
+	adminUser.GET("/:page", cl.UserController.GetManyWithPagination, adminCheck(_permissionDomain.UserManage)...)
+	adminUser.PUT("/suspend/:user-id", cl.UserController.Suspend, adminCheck(_permissionDomain.UserManage)...)
+	adminUser.PUT("/unsuspend/:user-id", cl.UserController.Unsuspend, adminCheck(_permissionDomain.UserManage)...)
+	adminUser.GET("/suspension/:user-id", cl.UserController.GetSuspensions, adminCheck(_permissionDomain.UserManage)...)
+	adminUser.POST("/warning/:user-id", cl.WarningController.Create, adminCheck(_permissionDomain.UserManage)...)
+	adminUser.GET("/warning/:user-id", cl.WarningController.GetAllByUserID, adminCheck(_permissionDomain.UserManage)...)
+	adminUser.DELETE("/warning/id/:warning-id", cl.WarningController.Delete, adminCheck(_permissionDomain.UserManage)...)
+	adminUser.GET("/report", cl.ReportController.GetAllReportedUsers, adminCheck(_permissionDomain.ReportReview)...)
+	adminUser.GET("/lockout", cl.UserController.GetLockouts, adminCheck(_permissionDomain.UserManage)...)
+	adminUser.DELETE("/lockout/:lockout-id", cl.UserController.ClearLockout, adminCheck(_permissionDomain.UserManage)...)
+	adminUser.GET("/report/:user-id", cl.ReportController.GetUserReportedID, adminCheck(_permissionDomain.ReportReview)...)
 	adminUserID := adminUser.Group("/id")
-	adminUserID.GET("/:user-id", cl.UserController.GetByID)
-	adminUserID.PUT("/:user-id", cl.UserController.AdminUpdate)
-	adminUserID.DELETE("/:user-id", cl.UserController.Delete)
+	adminUserID.GET("/:user-id", cl.UserController.GetByID, adminCheck(_permissionDomain.UserManage)...)
+	adminUserID.PUT("/:user-id", cl.UserController.AdminUpdate, adminCheck(_permissionDomain.UserManage)...)
+	adminUserID.DELETE("/:user-id", cl.UserController.Delete, adminCheck(_permissionDomain.UserManage)...)
 
 	adminReport := admin.Group("/report")
-	adminReport.GET("", cl.ReportController.GetAll)
+	adminReport.GET("", cl.ReportController.GetAll, adminCheck(_permissionDomain.ReportReview)...)
 
 	adminTopic := admin.Group("/topic")
-	adminTopic.POST("", cl.TopicController.Create)
-	adminTopic.GET("/:page", cl.TopicController.GetManyWithPagination)
-	adminTopic.GET("/id/:topic-id", cl.TopicController.GetByID)
-	adminTopic.PUT("/id/:topic-id", cl.TopicController.Update)
-	adminTopic.DELETE("/id/:topic-id", cl.TopicController.Delete)
+	adminTopic.POST("", cl.TopicController.Create, adminCheck(_permissionDomain.TopicManage)...)
+	adminTopic.GET("/:page", cl.TopicController.GetManyWithPagination, adminCheck(_permissionDomain.TopicManage)...)
+	adminTopic.GET("/id/:topic-id", cl.TopicController.GetByID, adminCheck(_permissionDomain.TopicManage)...)
+	adminTopic.PUT("/id/:topic-id", cl.TopicController.Update, adminCheck(_permissionDomain.TopicManage)...)
+	adminTopic.DELETE("/id/:topic-id", cl.TopicController.Delete, adminCheck(_permissionDomain.TopicManage)...)
+	adminTopic.POST("/id/:topic-id/moderator/:user-id", cl.TopicController.AssignModerator, adminCheck(_permissionDomain.TopicManage)...)
+	adminTopic.DELETE("/id/:topic-id/moderator/:user-id", cl.TopicController.RemoveModerator, adminCheck(_permissionDomain.TopicManage)...)
 
 	adminThread := admin.Group("/thread")
-	adminThread.GET("/:page", cl.ThreadController.GetManyWithPagination)
-	adminThread.GET("/report", cl.ReportController.GetAllReportedThreads)
-	adminThread.GET("/report/:thread-id", cl.ReportController.GetThreadReportedID)
-	adminThread.GET("/id/:thread-id", cl.ThreadController.GetByID)
-	adminThread.PUT("/id/:thread-id", cl.ThreadController.AdminUpdate)
-	adminThread.DELETE("/id/:thread-id", cl.ThreadController.AdminDelete)
+	adminThread.GET("/:page", cl.ThreadController.GetManyWithPagination, adminCheck(_permissionDomain.ContentModerateAny)...)
+	adminThread.GET("/report", cl.ReportController.GetAllReportedThreads, adminCheck(_permissionDomain.ReportReview)...)
+	adminThread.GET("/report/:thread-id", cl.ReportController.GetThreadReportedID, adminCheck(_permissionDomain.ReportReview)...)
+	adminThread.GET("/id/:thread-id", cl.ThreadController.GetByID, adminCheck(_permissionDomain.ContentModerateAny)...)
+	adminThread.PUT("/id/:thread-id", cl.ThreadController.AdminUpdate, adminCheck(_permissionDomain.ThreadUpdateAny)...)
+	adminThread.DELETE("/id/:thread-id", cl.ThreadController.AdminDelete, adminCheck(_permissionDomain.ThreadDeleteAny)...)
+	adminThread.GET("/trash/:page", cl.ThreadController.GetTrash, adminCheck(_permissionDomain.ThreadDeleteAny)...)
+	adminThread.PUT("/restore/:thread-id", cl.ThreadController.Restore, adminCheck(_permissionDomain.ThreadDeleteAny)...)
+	adminThread.PUT("/pin/:thread-id", cl.ThreadController.Pin, adminCheck(_permissionDomain.ContentModerateAny)...)
+	adminThread.PUT("/unpin/:thread-id", cl.ThreadController.Unpin, adminCheck(_permissionDomain.ContentModerateAny)...)
+	adminThread.PUT("/lock/:thread-id", cl.ThreadController.Lock, adminCheck(_permissionDomain.ContentModerateAny)...)
+	adminThread.PUT("/unlock/:thread-id", cl.ThreadController.Unlock, adminCheck(_permissionDomain.ContentModerateAny)...)
+
+	adminTag := admin.Group("/tag")
+	adminTag.GET("/banned", cl.ThreadController.GetBannedTags, adminCheck(_permissionDomain.TopicManage)...)
+	adminTag.PUT("/rename", cl.ThreadController.RenameTag, adminCheck(_permissionDomain.TopicManage)...)
+	adminTag.PUT("/merge", cl.ThreadController.MergeTag, adminCheck(_permissionDomain.TopicManage)...)
+	adminTag.POST("/banned", cl.ThreadController.BanTag, adminCheck(_permissionDomain.TopicManage)...)
+	adminTag.DELETE("/banned/:name", cl.ThreadController.UnbanTag, adminCheck(_permissionDomain.TopicManage)...)
+
+	adminPermission := admin.Group("/permission")
+	adminPermission.GET("", cl.PermissionController.GetAll, adminCheck(_permissionDomain.PermissionManage)...)
+	adminPermission.GET("/:role", cl.PermissionController.GetByRole, adminCheck(_permissionDomain.PermissionManage)...)
+	adminPermission.PUT("/:role", cl.PermissionController.Update, adminCheck(_permissionDomain.PermissionManage)...)
+
+	// Moderator, scoped to the topics assigned to the moderator
+	moderator := apiV1.Group("/moderator", _middleware.Check([]string{_permissionDomain.ContentModerateAssigned, _permissionDomain.ContentModerateAny}, cl.UserRepository, cl.RefreshTokenRepository, cl.PermissionRepository))
+	moderator.GET("/topic", cl.TopicController.GetModeratedTopics)
+
+	moderatorThread := moderator.Group("/thread")
+	moderatorThread.GET("/report", cl.ReportController.ModeratorGetAllReportedThreads)
+	moderatorThread.GET("/report/:thread-id", cl.ReportController.ModeratorGetThreadReportedID)
+	moderatorThread.PUT("/suspend/:thread-id", cl.ThreadController.ModeratorSuspend)
+	moderatorThread.PUT("/unsuspend/:thread-id", cl.ThreadController.ModeratorUnsuspend)
+	moderatorThread.PUT("/pin/:thread-id", cl.ThreadController.Pin)
+	moderatorThread.PUT("/unpin/:thread-id", cl.ThreadController.Unpin)
+	moderatorThread.PUT("/lock/:thread-id", cl.ThreadController.Lock)
+	moderatorThread.PUT("/unlock/:thread-id", cl.ThreadController.Unlock)
+	moderatorThread.DELETE("/id/:thread-id", cl.ThreadController.ModeratorDelete)
+
+	moderatorComment := moderator.Group("/comment")
+	moderatorComment.PUT("/suspend/:comment-id", cl.CommentController.ModeratorSuspend)
+	moderatorComment.PUT("/unsuspend/:comment-id", cl.CommentController.ModeratorUnsuspend)
+	moderatorComment.DELETE("/:comment-id", cl.CommentController.ModeratorDelete)
+
+	moderatorWarning := moderator.Group("/warning")
+	moderatorWarning.POST("/:user-id", cl.WarningController.Create)
 
 }
@@ -0,0 +1,50 @@
+package appeals
+
+import (
+	dtoPagination "charum/dto/pagination"
+	dtoQuery "charum/dto/query"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	StatusPending  = "pending"
+	StatusAccepted = "accepted"
+	StatusRejected = "rejected"
+)
+
+// Domain is a suspended user's appeal against one suspension, every suspension can only be appealed once
+type Domain struct {
+	Id           primitive.ObjectID `json:"_id" bson:"_id"`
+	UserID       primitive.ObjectID `json:"userID" bson:"userID"`
+	SuspensionID primitive.ObjectID `json:"suspensionID" bson:"suspensionID"`
+	Message      string             `json:"message" bson:"message"`
+	Status       string             `json:"status" bson:"status"`
+	Reply        string             `json:"reply,omitempty" bson:"reply,omitempty"`
+	ReviewerID   primitive.ObjectID `json:"reviewerID,omitempty" bson:"reviewerID,omitempty"`
+	ReviewedAt   primitive.DateTime `json:"reviewedAt,omitempty" bson:"reviewedAt,omitempty"`
+	CreatedAt    primitive.DateTime `json:"createdAt" bson:"createdAt"`
+	UpdatedAt    primitive.DateTime `json:"updatedAt" bson:"updatedAt"`
+}
+
+type Repository interface {
+	// Create
+	Create(domain *Domain) (Domain, error)
+	// Read
+	GetByID(id primitive.ObjectID) (Domain, error)
+	GetBySuspensionID(suspensionID primitive.ObjectID) (Domain, error)
+	GetManyWithPagination(query dtoQuery.Request, domain *Domain) ([]Domain, int, error)
+	// Update
+	Update(domain *Domain) (Domain, error)
+}
+
+type UseCase interface {
+	// Create
+	Create(appealToken string, message string) (Domain, error)
+	// Read
+	GetByID(id primitive.ObjectID) (Domain, error)
+	GetManyWithPagination(pagination dtoPagination.Request, domain *Domain) ([]Domain, int, int, error)
+	// Update
+	Accept(id primitive.ObjectID, reviewerID primitive.ObjectID, reply string) (Domain, error)
+	Reject(id primitive.ObjectID, reviewerID primitive.ObjectID, reply string) (Domain, error)
+}
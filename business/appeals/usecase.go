@@ -0,0 +1,189 @@
+package appeals
+
+import (
+	"charum/business/suspensions"
+	"charum/business/threads"
+	"charum/business/users"
+	dtoPagination "charum/dto/pagination"
+	dtoQuery "charum/dto/query"
+	_mailgun "charum/helper/mailgun"
+	"charum/util"
+	"errors"
+	"math"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type AppealUseCase struct {
+	appealRepository     Repository
+	userRepository       users.Repository
+	suspensionRepository suspensions.Repository
+	userUseCase          users.UseCase
+	threadUseCase        threads.UseCase
+	mailgun              _mailgun.Function
+}
+
+func NewAppealUseCase(ar Repository, ur users.Repository, sr suspensions.Repository, uu users.UseCase, tu threads.UseCase, mg _mailgun.Function) UseCase {
+	return &AppealUseCase{
+		appealRepository:     ar,
+		userRepository:       ur,
+		suspensionRepository: sr,
+		userUseCase:          uu,
+		threadUseCase:        tu,
+		mailgun:              mg,
+	}
+}
+
+/*
+Create
+*/
+
+// Create files an appeal against the user's active suspension, the user is identified by the appeal token handed out on login
+func (au *AppealUseCase) Create(appealToken string, message string) (Domain, error) {
+	claims, err := util.GetAppealPayload(appealToken)
+	if err != nil {
+		return Domain{}, errors.New("invalid or expired appeal token")
+	}
+
+	userID, err := primitive.ObjectIDFromHex(claims.UID)
+	if err != nil {
+		return Domain{}, errors.New("invalid or expired appeal token")
+	}
+
+	user, err := au.userRepository.GetByID(userID)
+	if err != nil {
+		return Domain{}, errors.New("failed to get user")
+	}
+
+	if user.IsActive {
+		return Domain{}, errors.New("user is not suspended")
+	}
+
+	suspension, err := au.suspensionRepository.GetActiveByUserID(userID)
+	if err != nil {
+		return Domain{}, errors.New("failed to get suspension")
+	}
+
+	_, err = au.appealRepository.GetBySuspensionID(suspension.Id)
+	if err == nil {
+		return Domain{}, errors.New("suspension has already been appealed")
+	}
+
+	result, err := au.appealRepository.Create(&Domain{
+		Id:           primitive.NewObjectID(),
+		UserID:       userID,
+		SuspensionID: suspension.Id,
+		Message:      message,
+		Status:       StatusPending,
+		CreatedAt:    primitive.NewDateTimeFromTime(time.Now()),
+		UpdatedAt:    primitive.NewDateTimeFromTime(time.Now()),
+	})
+	if err != nil {
+		return Domain{}, errors.New("failed to create appeal")
+	}
+
+	return result, nil
+}
+
+/*
+Read
+*/
+
+func (au *AppealUseCase) GetByID(id primitive.ObjectID) (Domain, error) {
+	result, err := au.appealRepository.GetByID(id)
+	if err != nil {
+		return Domain{}, errors.New("failed to get appeal")
+	}
+
+	return result, nil
+}
+
+func (au *AppealUseCase) GetManyWithPagination(pagination dtoPagination.Request, domain *Domain) ([]Domain, int, int, error) {
+	skip := pagination.Limit * (pagination.Page - 1)
+	var orderInMongo int
+
+	if pagination.Order == "asc" {
+		orderInMongo = 1
+	} else {
+		orderInMongo = -1
+	}
+
+	query := dtoQuery.Request{
+		Skip:  skip,
+		Limit: pagination.Limit,
+		Order: orderInMongo,
+		Sort:  pagination.Sort,
+	}
+
+	appeals, totalData, err := au.appealRepository.GetManyWithPagination(query, domain)
+	if err != nil {
+		return []Domain{}, 0, 0, errors.New("failed to get appeals")
+	}
+
+	totalPage := math.Ceil(float64(totalData) / float64(pagination.Limit))
+	return appeals, int(totalPage), totalData, nil
+}
+
+/*
+Update
+*/
+
+func (au *AppealUseCase) Accept(id primitive.ObjectID, reviewerID primitive.ObjectID, reply string) (Domain, error) {
+	return au.review(id, reviewerID, reply, StatusAccepted)
+}
+
+func (au *AppealUseCase) Reject(id primitive.ObjectID, reviewerID primitive.ObjectID, reply string) (Domain, error) {
+	return au.review(id, reviewerID, reply, StatusRejected)
+}
+
+// review settles a pending appeal and emails the outcome, an accepted appeal lifts the suspension unless it is already over
+func (au *AppealUseCase) review(id primitive.ObjectID, reviewerID primitive.ObjectID, reply string, status string) (Domain, error) {
+	appeal, err := au.appealRepository.GetByID(id)
+	if err != nil {
+		return Domain{}, errors.New("failed to get appeal")
+	}
+
+	if appeal.Status != StatusPending {
+		return Domain{}, errors.New("appeal has already been reviewed")
+	}
+
+	user, err := au.userRepository.GetByID(appeal.UserID)
+	if err != nil {
+		return Domain{}, errors.New("failed to get user")
+	}
+
+	suspension, err := au.suspensionRepository.GetByID(appeal.SuspensionID)
+	if err != nil {
+		return Domain{}, errors.New("failed to get suspension")
+	}
+
+	appeal.Status = status
+	appeal.Reply = reply
+	appeal.ReviewerID = reviewerID
+	appeal.ReviewedAt = primitive.NewDateTimeFromTime(time.Now())
+	appeal.UpdatedAt = primitive.NewDateTimeFromTime(time.Now())
+
+	result, err := au.appealRepository.Update(&appeal)
+	if err != nil {
+		return Domain{}, errors.New("failed to update appeal")
+	}
+
+	// the appeal is saved first so a failed save never lifts a suspension without a reviewed appeal behind it
+	if status == StatusAccepted && suspension.LiftedAt == 0 {
+		_, err = au.userUseCase.Unsuspend(appeal.UserID, reviewerID)
+		if err != nil {
+			return Domain{}, err
+		}
+
+		err = au.threadUseCase.UnsuspendByUserID(appeal.UserID)
+		if err != nil {
+			return Domain{}, err
+		}
+	}
+
+	// the review already took effect, a failed mail must not report it as failed
+	_, _ = au.mailgun.SendAppealResultMail(user.Email, status, reply)
+
+	return result, nil
+}
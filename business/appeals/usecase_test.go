@@ -0,0 +1,412 @@
+package appeals_test
+
+import (
+	"charum/business/appeals"
+	_appealMock "charum/business/appeals/mocks"
+	"charum/business/suspensions"
+	_suspensionMock "charum/business/suspensions/mocks"
+	_threadMock "charum/business/threads/mocks"
+	"charum/business/users"
+	_userMock "charum/business/users/mocks"
+	dtoPagination "charum/dto/pagination"
+	dtoQuery "charum/dto/query"
+	_mailgunMock "charum/helper/mailgun/mocks"
+	"charum/util"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var (
+	appealRepository     _appealMock.Repository
+	userRepository       _userMock.Repository
+	suspensionRepository _suspensionMock.Repository
+	userUseCase          _userMock.UseCase
+	threadUseCase        _threadMock.UseCase
+	mailgun              _mailgunMock.Function
+	appealUseCase        appeals.UseCase
+	appealDomain         appeals.Domain
+	suspensionDomain     suspensions.Domain
+	userDomain           users.Domain
+	adminDomain          users.Domain
+)
+
+func TestMain(m *testing.M) {
+	appealUseCase = appeals.NewAppealUseCase(&appealRepository, &userRepository, &suspensionRepository, &userUseCase, &threadUseCase, &mailgun)
+
+	userDomain = users.Domain{
+		Id:        primitive.NewObjectID(),
+		Email:     "user@charum.com",
+		UserName:  "user",
+		Role:      "user",
+		IsActive:  false,
+		CreatedAt: primitive.NewDateTimeFromTime(time.Now()),
+		UpdatedAt: primitive.NewDateTimeFromTime(time.Now()),
+	}
+
+	adminDomain = users.Domain{
+		Id:        primitive.NewObjectID(),
+		Email:     "admin@charum.com",
+		UserName:  "admin",
+		Role:      "admin",
+		IsActive:  true,
+		CreatedAt: primitive.NewDateTimeFromTime(time.Now()),
+		UpdatedAt: primitive.NewDateTimeFromTime(time.Now()),
+	}
+
+	suspensionDomain = suspensions.Domain{
+		Id:        primitive.NewObjectID(),
+		UserID:    userDomain.Id,
+		AdminID:   adminDomain.Id,
+		Reason:    suspensions.ReasonSpam,
+		CreatedAt: primitive.NewDateTimeFromTime(time.Now()),
+		UpdatedAt: primitive.NewDateTimeFromTime(time.Now()),
+	}
+
+	appealDomain = appeals.Domain{
+		Id:           primitive.NewObjectID(),
+		UserID:       userDomain.Id,
+		SuspensionID: suspensionDomain.Id,
+		Message:      "I did not spam",
+		Status:       appeals.StatusPending,
+		CreatedAt:    primitive.NewDateTimeFromTime(time.Now()),
+		UpdatedAt:    primitive.NewDateTimeFromTime(time.Now()),
+	}
+
+	m.Run()
+}
+
+func TestCreate(t *testing.T) {
+	t.Run("Test case 1 | Valid create appeal", func(t *testing.T) {
+		token := util.GenerateAppealToken(userDomain.Id.Hex())
+
+		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
+		suspensionRepository.On("GetActiveByUserID", userDomain.Id).Return(suspensionDomain, nil).Once()
+		appealRepository.On("GetBySuspensionID", suspensionDomain.Id).Return(appeals.Domain{}, errors.New("not found")).Once()
+		appealRepository.On("Create", mock.Anything).Return(appealDomain, nil).Once()
+
+		result, err := appealUseCase.Create(token, appealDomain.Message)
+
+		assert.Equal(t, appealDomain, result)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Invalid create appeal | Invalid appeal token", func(t *testing.T) {
+		expectedErr := errors.New("invalid or expired appeal token")
+
+		result, err := appealUseCase.Create("invalid token", appealDomain.Message)
+
+		assert.Equal(t, appeals.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 3 | Invalid create appeal | Access token is not an appeal token", func(t *testing.T) {
+		expectedErr := errors.New("invalid or expired appeal token")
+		token := util.GenerateToken(userDomain.Id.Hex(), userDomain.Role, primitive.NewObjectID().Hex())
+
+		result, err := appealUseCase.Create(token, appealDomain.Message)
+
+		assert.Equal(t, appeals.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 4 | Invalid create appeal | User is not suspended", func(t *testing.T) {
+		expectedErr := errors.New("user is not suspended")
+		token := util.GenerateAppealToken(adminDomain.Id.Hex())
+
+		userRepository.On("GetByID", adminDomain.Id).Return(adminDomain, nil).Once()
+
+		result, err := appealUseCase.Create(token, appealDomain.Message)
+
+		assert.Equal(t, appeals.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 5 | Invalid create appeal | Error when getting suspension", func(t *testing.T) {
+		expectedErr := errors.New("failed to get suspension")
+		token := util.GenerateAppealToken(userDomain.Id.Hex())
+
+		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
+		suspensionRepository.On("GetActiveByUserID", userDomain.Id).Return(suspensions.Domain{}, errors.New("unexpected error")).Once()
+
+		result, err := appealUseCase.Create(token, appealDomain.Message)
+
+		assert.Equal(t, appeals.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 6 | Invalid create appeal | Suspension has already been appealed", func(t *testing.T) {
+		expectedErr := errors.New("suspension has already been appealed")
+		token := util.GenerateAppealToken(userDomain.Id.Hex())
+
+		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
+		suspensionRepository.On("GetActiveByUserID", userDomain.Id).Return(suspensionDomain, nil).Once()
+		appealRepository.On("GetBySuspensionID", suspensionDomain.Id).Return(appealDomain, nil).Once()
+
+		result, err := appealUseCase.Create(token, appealDomain.Message)
+
+		assert.Equal(t, appeals.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 7 | Invalid create appeal | Error when creating appeal", func(t *testing.T) {
+		expectedErr := errors.New("failed to create appeal")
+		token := util.GenerateAppealToken(userDomain.Id.Hex())
+
+		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
+		suspensionRepository.On("GetActiveByUserID", userDomain.Id).Return(suspensionDomain, nil).Once()
+		appealRepository.On("GetBySuspensionID", suspensionDomain.Id).Return(appeals.Domain{}, errors.New("not found")).Once()
+		appealRepository.On("Create", mock.Anything).Return(appeals.Domain{}, errors.New("unexpected error")).Once()
+
+		result, err := appealUseCase.Create(token, appealDomain.Message)
+
+		assert.Equal(t, appeals.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestGetByID(t *testing.T) {
+	t.Run("Test case 1 | Valid get appeal by id", func(t *testing.T) {
+		appealRepository.On("GetByID", appealDomain.Id).Return(appealDomain, nil).Once()
+
+		result, err := appealUseCase.GetByID(appealDomain.Id)
+
+		assert.Equal(t, appealDomain, result)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Invalid get appeal by id | Error when getting appeal", func(t *testing.T) {
+		expectedErr := errors.New("failed to get appeal")
+		appealRepository.On("GetByID", appealDomain.Id).Return(appeals.Domain{}, errors.New("unexpected error")).Once()
+
+		result, err := appealUseCase.GetByID(appealDomain.Id)
+
+		assert.Equal(t, appeals.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestGetManyWithPagination(t *testing.T) {
+	t.Run("Test case 1 | Valid get appeals", func(t *testing.T) {
+		query := dtoQuery.Request{
+			Skip:  0,
+			Limit: 1,
+			Sort:  "createdAt",
+			Order: 1,
+		}
+		filter := appeals.Domain{Status: appeals.StatusPending}
+		appealRepository.On("GetManyWithPagination", query, &filter).Return([]appeals.Domain{appealDomain}, 1, nil).Once()
+
+		pagination := dtoPagination.Request{
+			Page:  1,
+			Limit: 1,
+			Sort:  "createdAt",
+			Order: "asc",
+		}
+		result, totalPage, totalData, err := appealUseCase.GetManyWithPagination(pagination, &filter)
+
+		assert.Equal(t, []appeals.Domain{appealDomain}, result)
+		assert.Equal(t, 1, totalPage)
+		assert.Equal(t, 1, totalData)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Invalid get appeals | Error when getting appeals", func(t *testing.T) {
+		expectedErr := errors.New("failed to get appeals")
+		query := dtoQuery.Request{
+			Skip:  0,
+			Limit: 1,
+			Sort:  "createdAt",
+			Order: -1,
+		}
+		filter := appeals.Domain{}
+		appealRepository.On("GetManyWithPagination", query, &filter).Return([]appeals.Domain{}, 0, errors.New("unexpected error")).Once()
+
+		pagination := dtoPagination.Request{
+			Page:  1,
+			Limit: 1,
+			Sort:  "createdAt",
+			Order: "desc",
+		}
+		result, totalPage, totalData, err := appealUseCase.GetManyWithPagination(pagination, &filter)
+
+		assert.Equal(t, []appeals.Domain{}, result)
+		assert.Zero(t, totalPage)
+		assert.Zero(t, totalData)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestAccept(t *testing.T) {
+	t.Run("Test case 1 | Valid accept appeal", func(t *testing.T) {
+		accepted := appealDomain
+		accepted.Status = appeals.StatusAccepted
+
+		appealRepository.On("GetByID", appealDomain.Id).Return(appealDomain, nil).Once()
+		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
+		suspensionRepository.On("GetByID", suspensionDomain.Id).Return(suspensionDomain, nil).Once()
+		appealRepository.On("Update", mock.Anything).Return(accepted, nil).Once()
+		userUseCase.On("Unsuspend", userDomain.Id, adminDomain.Id).Return(userDomain, nil).Once()
+		threadUseCase.On("UnsuspendByUserID", userDomain.Id).Return(nil).Once()
+		mailgun.On("SendAppealResultMail", userDomain.Email, appeals.StatusAccepted, "welcome back").Return("", nil).Once()
+
+		result, err := appealUseCase.Accept(appealDomain.Id, adminDomain.Id, "welcome back")
+
+		assert.Equal(t, accepted, result)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Valid accept appeal | Suspension already lifted is not lifted again", func(t *testing.T) {
+		accepted := appealDomain
+		accepted.Status = appeals.StatusAccepted
+		lifted := suspensionDomain
+		lifted.LiftedAt = primitive.NewDateTimeFromTime(time.Now())
+
+		appealRepository.On("GetByID", appealDomain.Id).Return(appealDomain, nil).Once()
+		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
+		suspensionRepository.On("GetByID", suspensionDomain.Id).Return(lifted, nil).Once()
+		appealRepository.On("Update", mock.Anything).Return(accepted, nil).Once()
+		mailgun.On("SendAppealResultMail", userDomain.Email, appeals.StatusAccepted, "welcome back").Return("", nil).Once()
+
+		result, err := appealUseCase.Accept(appealDomain.Id, adminDomain.Id, "welcome back")
+
+		assert.Equal(t, accepted, result)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 3 | Invalid accept appeal | Appeal has already been reviewed", func(t *testing.T) {
+		expectedErr := errors.New("appeal has already been reviewed")
+		rejected := appealDomain
+		rejected.Status = appeals.StatusRejected
+
+		appealRepository.On("GetByID", appealDomain.Id).Return(rejected, nil).Once()
+
+		result, err := appealUseCase.Accept(appealDomain.Id, adminDomain.Id, "welcome back")
+
+		assert.Equal(t, appeals.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 4 | Invalid accept appeal | Error when unsuspending user", func(t *testing.T) {
+		expectedErr := errors.New("failed to unsuspend user")
+
+		appealRepository.On("GetByID", appealDomain.Id).Return(appealDomain, nil).Once()
+		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
+		suspensionRepository.On("GetByID", suspensionDomain.Id).Return(suspensionDomain, nil).Once()
+		appealRepository.On("Update", mock.Anything).Return(appealDomain, nil).Once()
+		userUseCase.On("Unsuspend", userDomain.Id, adminDomain.Id).Return(users.Domain{}, expectedErr).Once()
+
+		result, err := appealUseCase.Accept(appealDomain.Id, adminDomain.Id, "welcome back")
+
+		assert.Equal(t, appeals.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 5 | Invalid accept appeal | Error when getting suspension", func(t *testing.T) {
+		expectedErr := errors.New("failed to get suspension")
+
+		appealRepository.On("GetByID", appealDomain.Id).Return(appealDomain, nil).Once()
+		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
+		suspensionRepository.On("GetByID", suspensionDomain.Id).Return(suspensions.Domain{}, errors.New("unexpected error")).Once()
+
+		result, err := appealUseCase.Accept(appealDomain.Id, adminDomain.Id, "welcome back")
+
+		assert.Equal(t, appeals.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 6 | Invalid accept appeal | Suspension is not lifted when updating appeal fails", func(t *testing.T) {
+		expectedErr := errors.New("failed to update appeal")
+
+		appealRepository.On("GetByID", appealDomain.Id).Return(appealDomain, nil).Once()
+		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
+		suspensionRepository.On("GetByID", suspensionDomain.Id).Return(suspensionDomain, nil).Once()
+		appealRepository.On("Update", mock.Anything).Return(appeals.Domain{}, errors.New("unexpected error")).Once()
+
+		result, err := appealUseCase.Accept(appealDomain.Id, adminDomain.Id, "welcome back")
+
+		assert.Equal(t, appeals.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 7 | Valid accept appeal | Failed appeal result mail does not fail the review", func(t *testing.T) {
+		accepted := appealDomain
+		accepted.Status = appeals.StatusAccepted
+
+		appealRepository.On("GetByID", appealDomain.Id).Return(appealDomain, nil).Once()
+		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
+		suspensionRepository.On("GetByID", suspensionDomain.Id).Return(suspensionDomain, nil).Once()
+		appealRepository.On("Update", mock.Anything).Return(accepted, nil).Once()
+		userUseCase.On("Unsuspend", userDomain.Id, adminDomain.Id).Return(userDomain, nil).Once()
+		threadUseCase.On("UnsuspendByUserID", userDomain.Id).Return(nil).Once()
+		mailgun.On("SendAppealResultMail", userDomain.Email, appeals.StatusAccepted, "welcome back").Return("", errors.New("unexpected error")).Once()
+
+		result, err := appealUseCase.Accept(appealDomain.Id, adminDomain.Id, "welcome back")
+
+		assert.Equal(t, accepted, result)
+		assert.Nil(t, err)
+	})
+}
+
+func TestReject(t *testing.T) {
+	t.Run("Test case 1 | Valid reject appeal", func(t *testing.T) {
+		rejected := appealDomain
+		rejected.Status = appeals.StatusRejected
+
+		appealRepository.On("GetByID", appealDomain.Id).Return(appealDomain, nil).Once()
+		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
+		suspensionRepository.On("GetByID", suspensionDomain.Id).Return(suspensionDomain, nil).Once()
+		appealRepository.On("Update", mock.Anything).Return(rejected, nil).Once()
+		mailgun.On("SendAppealResultMail", userDomain.Email, appeals.StatusRejected, "spam confirmed").Return("", nil).Once()
+
+		result, err := appealUseCase.Reject(appealDomain.Id, adminDomain.Id, "spam confirmed")
+
+		assert.Equal(t, rejected, result)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Invalid reject appeal | Error when getting appeal", func(t *testing.T) {
+		expectedErr := errors.New("failed to get appeal")
+
+		appealRepository.On("GetByID", appealDomain.Id).Return(appeals.Domain{}, errors.New("unexpected error")).Once()
+
+		result, err := appealUseCase.Reject(appealDomain.Id, adminDomain.Id, "spam confirmed")
+
+		assert.Equal(t, appeals.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 3 | Invalid reject appeal | Error when updating appeal", func(t *testing.T) {
+		expectedErr := errors.New("failed to update appeal")
+
+		appealRepository.On("GetByID", appealDomain.Id).Return(appealDomain, nil).Once()
+		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
+		suspensionRepository.On("GetByID", suspensionDomain.Id).Return(suspensionDomain, nil).Once()
+		appealRepository.On("Update", mock.Anything).Return(appeals.Domain{}, errors.New("unexpected error")).Once()
+
+		result, err := appealUseCase.Reject(appealDomain.Id, adminDomain.Id, "spam confirmed")
+
+		assert.Equal(t, appeals.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 4 | Valid reject appeal | Failed appeal result mail does not fail the review", func(t *testing.T) {
+		rejected := appealDomain
+		rejected.Status = appeals.StatusRejected
+
+		appealRepository.On("GetByID", appealDomain.Id).Return(appealDomain, nil).Once()
+		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
+		suspensionRepository.On("GetByID", suspensionDomain.Id).Return(suspensionDomain, nil).Once()
+		appealRepository.On("Update", mock.Anything).Return(rejected, nil).Once()
+		mailgun.On("SendAppealResultMail", userDomain.Email, appeals.StatusRejected, "spam confirmed").Return("", errors.New("unexpected error")).Once()
+
+		result, err := appealUseCase.Reject(appealDomain.Id, adminDomain.Id, "spam confirmed")
+
+		assert.Equal(t, rejected, result)
+		assert.Nil(t, err)
+	})
+}
@@ -0,0 +1,65 @@
+package audit_logs
+
+import (
+	dtoPagination "charum/dto/pagination"
+	dtoQuery "charum/dto/query"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	ActionUserSuspend          = "user.suspend"
+	ActionUserUnsuspend        = "user.unsuspend"
+	ActionUserUpdate           = "user.update"
+	ActionUserDelete           = "user.delete"
+	ActionThreadUpdate         = "thread.update"
+	ActionThreadDelete         = "thread.delete"
+	ActionThreadRestore        = "thread.restore"
+	ActionTopicUpdate          = "topic.update"
+	ActionTopicDelete          = "topic.delete"
+	ActionTopicModeratorAssign = "topic.moderator.assign"
+	ActionTopicModeratorRemove = "topic.moderator.remove"
+	ActionTagRename            = "tag.rename"
+	ActionTagMerge             = "tag.merge"
+	ActionTagBan               = "tag.ban"
+	ActionTagUnban             = "tag.unban"
+	ActionAppealAccept         = "appeal.accept"
+	ActionAppealReject         = "appeal.reject"
+	ActionPermissionUpdate     = "permission.update"
+)
+
+const (
+	TargetUser       = "user"
+	TargetThread     = "thread"
+	TargetTopic      = "topic"
+	TargetTag        = "tag"
+	TargetAppeal     = "appeal"
+	TargetPermission = "permission"
+)
+
+// Domain is one administrative action, the log is append-only so entries are never updated or deleted
+type Domain struct {
+	Id         primitive.ObjectID `json:"_id" bson:"_id"`
+	ActorID    primitive.ObjectID `json:"actorID" bson:"actorID"`
+	Action     string             `json:"action" bson:"action"`
+	TargetType string             `json:"targetType" bson:"targetType"`
+	TargetID   primitive.ObjectID `json:"targetID" bson:"targetID"`
+	Before     interface{}        `json:"before,omitempty" bson:"before,omitempty"`
+	After      interface{}        `json:"after,omitempty" bson:"after,omitempty"`
+	IP         string             `json:"ip" bson:"ip"`
+	CreatedAt  primitive.DateTime `json:"createdAt" bson:"createdAt"`
+}
+
+type Repository interface {
+	// Create
+	Create(domain *Domain) (Domain, error)
+	// Read
+	GetManyWithPagination(query dtoQuery.Request, domain *Domain) ([]Domain, int, error)
+}
+
+type UseCase interface {
+	// Create
+	Create(domain *Domain) (Domain, error)
+	// Read
+	GetManyWithPagination(pagination dtoPagination.Request, domain *Domain) ([]Domain, int, int, error)
+}
@@ -0,0 +1,71 @@
+package audit_logs
+
+import (
+	dtoPagination "charum/dto/pagination"
+	dtoQuery "charum/dto/query"
+	"errors"
+	"math"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type AuditLogUseCase struct {
+	auditLogRepository Repository
+}
+
+func NewAuditLogUseCase(alr Repository) UseCase {
+	return &AuditLogUseCase{
+		auditLogRepository: alr,
+	}
+}
+
+/*
+Create
+*/
+
+func (alu *AuditLogUseCase) Create(domain *Domain) (Domain, error) {
+	if domain.Action == "" || domain.TargetType == "" {
+		return Domain{}, errors.New("audit log must have an action and a target type")
+	}
+
+	domain.Id = primitive.NewObjectID()
+	domain.CreatedAt = primitive.NewDateTimeFromTime(time.Now())
+
+	result, err := alu.auditLogRepository.Create(domain)
+	if err != nil {
+		return Domain{}, errors.New("failed to create audit log")
+	}
+
+	return result, nil
+}
+
+/*
+Read
+*/
+
+func (alu *AuditLogUseCase) GetManyWithPagination(pagination dtoPagination.Request, domain *Domain) ([]Domain, int, int, error) {
+	skip := pagination.Limit * (pagination.Page - 1)
+	var orderInMongo int
+
+	if pagination.Order == "asc" {
+		orderInMongo = 1
+	} else {
+		orderInMongo = -1
+	}
+
+	query := dtoQuery.Request{
+		Skip:  skip,
+		Limit: pagination.Limit,
+		Order: orderInMongo,
+		Sort:  pagination.Sort,
+	}
+
+	auditLogs, totalData, err := alu.auditLogRepository.GetManyWithPagination(query, domain)
+	if err != nil {
+		return []Domain{}, 0, 0, errors.New("failed to get audit logs")
+	}
+
+	totalPage := math.Ceil(float64(totalData) / float64(pagination.Limit))
+	return auditLogs, int(totalPage), totalData, nil
+}
@@ -0,0 +1,133 @@
+package audit_logs_test
+
+import (
+	"charum/business/audit_logs"
+	_auditLogMock "charum/business/audit_logs/mocks"
+	dtoPagination "charum/dto/pagination"
+	dtoQuery "charum/dto/query"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var (
+	auditLogRepository _auditLogMock.Repository
+	auditLogUseCase    audit_logs.UseCase
+	auditLogDomain     audit_logs.Domain
+)
+
+func TestMain(m *testing.M) {
+	auditLogUseCase = audit_logs.NewAuditLogUseCase(&auditLogRepository)
+
+	auditLogDomain = audit_logs.Domain{
+		Id:         primitive.NewObjectID(),
+		ActorID:    primitive.NewObjectID(),
+		Action:     audit_logs.ActionUserSuspend,
+		TargetType: audit_logs.TargetUser,
+		TargetID:   primitive.NewObjectID(),
+		Before:     map[string]interface{}{"isActive": true},
+		After:      map[string]interface{}{"isActive": false},
+		IP:         "127.0.0.1",
+		CreatedAt:  primitive.NewDateTimeFromTime(time.Now()),
+	}
+
+	m.Run()
+}
+
+func TestCreate(t *testing.T) {
+	t.Run("Test case 1 | Valid create audit log", func(t *testing.T) {
+		input := audit_logs.Domain{
+			ActorID:    auditLogDomain.ActorID,
+			Action:     auditLogDomain.Action,
+			TargetType: auditLogDomain.TargetType,
+			TargetID:   auditLogDomain.TargetID,
+			Before:     auditLogDomain.Before,
+			After:      auditLogDomain.After,
+			IP:         auditLogDomain.IP,
+		}
+
+		auditLogRepository.On("Create", mock.AnythingOfType("*audit_logs.Domain")).Return(auditLogDomain, nil).Once()
+
+		result, err := auditLogUseCase.Create(&input)
+
+		assert.NotEqual(t, primitive.NilObjectID, input.Id)
+		assert.NotZero(t, input.CreatedAt)
+		assert.Equal(t, auditLogDomain, result)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Invalid create audit log | Missing action", func(t *testing.T) {
+		expectedErr := errors.New("audit log must have an action and a target type")
+
+		result, err := auditLogUseCase.Create(&audit_logs.Domain{ActorID: auditLogDomain.ActorID, TargetType: audit_logs.TargetUser})
+
+		assert.Equal(t, audit_logs.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 3 | Invalid create audit log | Error when creating audit log", func(t *testing.T) {
+		expectedErr := errors.New("failed to create audit log")
+
+		auditLogRepository.On("Create", mock.AnythingOfType("*audit_logs.Domain")).Return(audit_logs.Domain{}, errors.New("unexpected error")).Once()
+
+		result, err := auditLogUseCase.Create(&audit_logs.Domain{ActorID: auditLogDomain.ActorID, Action: audit_logs.ActionTopicDelete, TargetType: audit_logs.TargetTopic})
+
+		assert.Equal(t, audit_logs.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestGetManyWithPagination(t *testing.T) {
+	t.Run("Test case 1 | Valid get audit logs", func(t *testing.T) {
+		query := dtoQuery.Request{
+			Skip:  0,
+			Limit: 1,
+			Sort:  "createdAt",
+			Order: -1,
+		}
+		filter := audit_logs.Domain{ActorID: auditLogDomain.ActorID}
+		auditLogRepository.On("GetManyWithPagination", query, &filter).Return([]audit_logs.Domain{auditLogDomain}, 2, nil).Once()
+
+		pagination := dtoPagination.Request{
+			Page:  1,
+			Limit: 1,
+			Sort:  "createdAt",
+			Order: "desc",
+		}
+		result, totalPage, totalData, err := auditLogUseCase.GetManyWithPagination(pagination, &filter)
+
+		assert.Equal(t, []audit_logs.Domain{auditLogDomain}, result)
+		assert.Equal(t, 2, totalPage)
+		assert.Equal(t, 2, totalData)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Invalid get audit logs | Error when getting audit logs", func(t *testing.T) {
+		expectedErr := errors.New("failed to get audit logs")
+		query := dtoQuery.Request{
+			Skip:  0,
+			Limit: 1,
+			Sort:  "createdAt",
+			Order: 1,
+		}
+		filter := audit_logs.Domain{}
+		auditLogRepository.On("GetManyWithPagination", query, &filter).Return([]audit_logs.Domain{}, 0, errors.New("unexpected error")).Once()
+
+		pagination := dtoPagination.Request{
+			Page:  1,
+			Limit: 1,
+			Sort:  "createdAt",
+			Order: "asc",
+		}
+		result, totalPage, totalData, err := auditLogUseCase.GetManyWithPagination(pagination, &filter)
+
+		assert.Equal(t, []audit_logs.Domain{}, result)
+		assert.Zero(t, totalPage)
+		assert.Zero(t, totalData)
+		assert.Equal(t, expectedErr, err)
+	})
+}
@@ -0,0 +1,23 @@
+package banned_tags
+
+import "go.mongodb.org/mongo-driver/bson/primitive"
+
+// Domain is a tag that admins have banned, a banned tag is removed from every thread and can no longer be added to one
+type Domain struct {
+	Id        primitive.ObjectID `json:"_id" bson:"_id"`
+	Name      string             `json:"name" bson:"name"`
+	BannedBy  primitive.ObjectID `json:"bannedBy" bson:"bannedBy"`
+	CreatedAt primitive.DateTime `json:"createdAt" bson:"createdAt"`
+}
+
+type Repository interface {
+	// Create
+	Create(domain *Domain) (Domain, error)
+	// Read
+	GetByID(id primitive.ObjectID) (Domain, error)
+	GetByName(name string) (Domain, error)
+	GetByNames(names []string) ([]Domain, error)
+	GetAll() ([]Domain, error)
+	// Delete
+	Delete(id primitive.ObjectID) error
+}
@@ -21,6 +21,9 @@ type Repository interface {
 	GetByUserIDAndThreadID(UserID primitive.ObjectID, threadID primitive.ObjectID) (Domain, error)
 	GetAllByUserID(UserID primitive.ObjectID) ([]Domain, error)
 	CountByThreadID(threadID primitive.ObjectID) (int, error)
+	// Update
+	SoftDeleteAllByThreadID(threadID primitive.ObjectID, deletedAt primitive.DateTime) error
+	RestoreAllByThreadID(threadID primitive.ObjectID) error
 	// Delete
 	Delete(domain *Domain) error
 	DeleteAllByUserID(userID primitive.ObjectID) error
@@ -36,6 +39,8 @@ type UseCase interface {
 	CheckBookmarkedThread(userID primitive.ObjectID, threadID primitive.ObjectID) (bool, error)
 	DomainToResponse(domain Domain, userID primitive.ObjectID) (bookmarks.Response, error)
 	DomainsToResponseArray(domains []Domain, userID primitive.ObjectID) ([]bookmarks.Response, error)
+	// Update
+	RestoreAllByThreadID(threadID primitive.ObjectID) error
 	// Delete
 	Delete(domain *Domain) (Domain, error)
 	DeleteAllByUserID(userID primitive.ObjectID) error
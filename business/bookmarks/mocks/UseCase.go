@@ -16,6 +16,27 @@ type UseCase struct {
 	mock.Mock
 }
 
+// CheckBookmarkedThread provides a mock function with given fields: userID, threadID
+func (_m *UseCase) CheckBookmarkedThread(userID primitive.ObjectID, threadID primitive.ObjectID) (bool, error) {
+	ret := _m.Called(userID, threadID)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID, primitive.ObjectID) bool); ok {
+		r0 = rf(userID, threadID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(primitive.ObjectID, primitive.ObjectID) error); ok {
+		r1 = rf(userID, threadID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CountByThreadID provides a mock function with given fields: threadID
 func (_m *UseCase) CountByThreadID(threadID primitive.ObjectID) (int, error) {
 	ret := _m.Called(threadID)
@@ -107,20 +128,20 @@ func (_m *UseCase) DeleteAllByUserID(userID primitive.ObjectID) error {
 	return r0
 }
 
-// DomainToResponse provides a mock function with given fields: domain
-func (_m *UseCase) DomainToResponse(domain bookmarks.Domain) (dtobookmarks.Response, error) {
-	ret := _m.Called(domain)
+// DomainToResponse provides a mock function with given fields: domain, userID
+func (_m *UseCase) DomainToResponse(domain bookmarks.Domain, userID primitive.ObjectID) (dtobookmarks.Response, error) {
+	ret := _m.Called(domain, userID)
 
 	var r0 dtobookmarks.Response
-	if rf, ok := ret.Get(0).(func(bookmarks.Domain) dtobookmarks.Response); ok {
-		r0 = rf(domain)
+	if rf, ok := ret.Get(0).(func(bookmarks.Domain, primitive.ObjectID) dtobookmarks.Response); ok {
+		r0 = rf(domain, userID)
 	} else {
 		r0 = ret.Get(0).(dtobookmarks.Response)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(bookmarks.Domain) error); ok {
-		r1 = rf(domain)
+	if rf, ok := ret.Get(1).(func(bookmarks.Domain, primitive.ObjectID) error); ok {
+		r1 = rf(domain, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -128,13 +149,13 @@ func (_m *UseCase) DomainToResponse(domain bookmarks.Domain) (dtobookmarks.Respo
 	return r0, r1
 }
 
-// DomainsToResponseArray provides a mock function with given fields: domains
-func (_m *UseCase) DomainsToResponseArray(domains []bookmarks.Domain) ([]dtobookmarks.Response, error) {
-	ret := _m.Called(domains)
+// DomainsToResponseArray provides a mock function with given fields: domains, userID
+func (_m *UseCase) DomainsToResponseArray(domains []bookmarks.Domain, userID primitive.ObjectID) ([]dtobookmarks.Response, error) {
+	ret := _m.Called(domains, userID)
 
 	var r0 []dtobookmarks.Response
-	if rf, ok := ret.Get(0).(func([]bookmarks.Domain) []dtobookmarks.Response); ok {
-		r0 = rf(domains)
+	if rf, ok := ret.Get(0).(func([]bookmarks.Domain, primitive.ObjectID) []dtobookmarks.Response); ok {
+		r0 = rf(domains, userID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]dtobookmarks.Response)
@@ -142,8 +163,8 @@ func (_m *UseCase) DomainsToResponseArray(domains []bookmarks.Domain) ([]dtobook
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func([]bookmarks.Domain) error); ok {
-		r1 = rf(domains)
+	if rf, ok := ret.Get(1).(func([]bookmarks.Domain, primitive.ObjectID) error); ok {
+		r1 = rf(domains, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -174,6 +195,20 @@ func (_m *UseCase) GetAllByUserID(userID primitive.ObjectID) ([]bookmarks.Domain
 	return r0, r1
 }
 
+// RestoreAllByThreadID provides a mock function with given fields: threadID
+func (_m *UseCase) RestoreAllByThreadID(threadID primitive.ObjectID) error {
+	ret := _m.Called(threadID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID) error); ok {
+		r0 = rf(threadID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewUseCase interface {
 	mock.TestingT
 	Cleanup(func())
@@ -130,6 +130,19 @@ func (bu *BookmarkUseCase) DomainsToResponseArray(domains []Domain, userID primi
 	return responses, nil
 }
 
+/*
+Update
+*/
+
+func (bu *BookmarkUseCase) RestoreAllByThreadID(threadID primitive.ObjectID) error {
+	result := bu.bookmarkRepository.RestoreAllByThreadID(threadID)
+	if result != nil {
+		return errors.New("failed to restore bookmark")
+	}
+
+	return nil
+}
+
 /*
 Delete
 */
@@ -10,6 +10,7 @@ import (
 	"charum/business/users"
 	_userMock "charum/business/users/mocks"
 	dtoThread "charum/dto/threads"
+	dtoUser "charum/dto/users"
 	"errors"
 	"testing"
 	"time"
@@ -81,7 +82,7 @@ func TestMain(m *testing.M) {
 	threadResponse = dtoThread.Response{
 		Id:            threadDomain.Id,
 		Topic:         topicDomain,
-		Creator:       userDomain,
+		Creator:       dtoUser.FromDomain(userDomain),
 		Title:         threadDomain.Title,
 		Description:   threadDomain.Description,
 		Likes:         []dtoThread.Like{},
@@ -314,3 +315,19 @@ func TestDeleteAllByThreadID(t *testing.T) {
 		assert.NotNil(t, err)
 	})
 }
+
+func TestRestoreAllByThreadID(t *testing.T) {
+	t.Run("Test Case 1 | Valid Restore All By Thread ID", func(t *testing.T) {
+		bookmarkRepository.On("RestoreAllByThreadID", bookmarkDomain.ThreadID).Return(nil).Once()
+
+		err := BookmarkUseCase.RestoreAllByThreadID(bookmarkDomain.ThreadID)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test Case 2 | Invalid Restore All By Thread ID | Repository Error", func(t *testing.T) {
+		bookmarkRepository.On("RestoreAllByThreadID", bookmarkDomain.ThreadID).Return(errors.New("unexpected error")).Once()
+
+		err := BookmarkUseCase.RestoreAllByThreadID(bookmarkDomain.ThreadID)
+		assert.NotNil(t, err)
+	})
+}
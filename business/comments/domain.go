@@ -2,20 +2,24 @@ package comments
 
 import (
 	dtoComment "charum/dto/comments"
+	dtoPagination "charum/dto/pagination"
+	dtoQuery "charum/dto/query"
 	"mime/multipart"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 type Domain struct {
-	Id        primitive.ObjectID `json:"_id" bson:"_id"`
-	ThreadID  primitive.ObjectID `json:"threadID" bson:"threadID"`
-	UserID    primitive.ObjectID `json:"userID" bson:"userID"`
-	ParentID  primitive.ObjectID `json:"parentID,omitempty" bson:"parentID,omitempty"`
-	ImageURL  string             `json:"imageURL,omitempty" bson:"imageURL,omitempty"`
-	Comment   string             `json:"comment" bson:"commment"`
-	CreatedAt primitive.DateTime `json:"createdAt" bson:"createdAt"`
-	UpdatedAt primitive.DateTime `json:"updatedAt" bson:"updatedAt"`
+	Id            primitive.ObjectID `json:"_id" bson:"_id"`
+	ThreadID      primitive.ObjectID `json:"threadID" bson:"threadID"`
+	UserID        primitive.ObjectID `json:"userID" bson:"userID"`
+	ParentID      primitive.ObjectID `json:"parentID,omitempty" bson:"parentID,omitempty"`
+	ImageURL      string             `json:"imageURL,omitempty" bson:"imageURL,omitempty"`
+	Comment       string             `json:"comment" bson:"commment"`
+	SuspendStatus string             `json:"suspendStatus,omitempty" bson:"suspendStatus"`
+	SuspendDetail string             `json:"suspendDetail,omitempty" bson:"suspendDetail"`
+	CreatedAt     primitive.DateTime `json:"createdAt" bson:"createdAt"`
+	UpdatedAt     primitive.DateTime `json:"updatedAt" bson:"updatedAt"`
 }
 
 type Repository interface {
@@ -27,8 +31,14 @@ type Repository interface {
 	CountByThreadID(threadID primitive.ObjectID) (int, error)
 	GetByIDAndThreadID(id primitive.ObjectID, threadID primitive.ObjectID) (Domain, error)
 	GetAllByUserID(userID primitive.ObjectID) ([]Domain, error)
+	GetManyByUserID(query dtoQuery.Request, userID primitive.ObjectID) ([]Domain, int, error)
+	CountByUserID(userID primitive.ObjectID) (int, error)
+	GetTrashedByThreadID(threadID primitive.ObjectID) ([]Domain, error)
 	// Update
 	Update(domain *Domain) (Domain, error)
+	UpdateSuspendStatus(domain *Domain) error
+	SoftDeleteAllByThreadID(threadID primitive.ObjectID, deletedAt primitive.DateTime) error
+	RestoreAllByThreadID(threadID primitive.ObjectID) error
 	// Delete
 	Delete(id primitive.ObjectID) error
 	DeleteAllByUserID(userID primitive.ObjectID) error
@@ -43,10 +53,17 @@ type UseCase interface {
 	DomainToResponse(comment Domain) (dtoComment.Response, error)
 	DomainToResponseArray(comments []Domain) ([]dtoComment.Response, error)
 	CountByThreadID(threadID primitive.ObjectID) (int, error)
+	GetManyByUserID(pagination dtoPagination.Request, userID primitive.ObjectID) ([]Domain, int, int, error)
+	CountByUserID(userID primitive.ObjectID) (int, error)
 	// Update
 	Update(domain *Domain, image *multipart.FileHeader) (Domain, error)
+	ModeratorSuspend(moderatorID primitive.ObjectID, commentID primitive.ObjectID, detail string) (Domain, error)
+	ModeratorUnsuspend(moderatorID primitive.ObjectID, commentID primitive.ObjectID) (Domain, error)
+	RestoreAllByThreadID(threadID primitive.ObjectID) error
 	// Delete
 	Delete(id primitive.ObjectID, userID primitive.ObjectID) (Domain, error)
+	ModeratorDelete(moderatorID primitive.ObjectID, commentID primitive.ObjectID) (Domain, error)
 	DeleteAllByUserID(userID primitive.ObjectID) error
 	DeleteAllByThreadID(threadID primitive.ObjectID) error
+	PurgeAllByThreadID(threadID primitive.ObjectID) error
 }
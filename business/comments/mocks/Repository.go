@@ -8,6 +8,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+
+	query "charum/dto/query"
 )
 
 // Repository is an autogenerated mock type for the Repository type
@@ -36,6 +38,27 @@ func (_m *Repository) CountByThreadID(threadID primitive.ObjectID) (int, error)
 	return r0, r1
 }
 
+// CountByUserID provides a mock function with given fields: userID
+func (_m *Repository) CountByUserID(userID primitive.ObjectID) (int, error) {
+	ret := _m.Called(userID)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID) int); ok {
+		r0 = rf(userID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(primitive.ObjectID) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Create provides a mock function with given fields: domain
 func (_m *Repository) Create(domain *comments.Domain) (comments.Domain, error) {
 	ret := _m.Called(domain)
@@ -187,6 +210,87 @@ func (_m *Repository) GetByThreadID(threadID primitive.ObjectID) ([]comments.Dom
 	return r0, r1
 }
 
+// GetManyByUserID provides a mock function with given fields: _a0, userID
+func (_m *Repository) GetManyByUserID(_a0 query.Request, userID primitive.ObjectID) ([]comments.Domain, int, error) {
+	ret := _m.Called(_a0, userID)
+
+	var r0 []comments.Domain
+	if rf, ok := ret.Get(0).(func(query.Request, primitive.ObjectID) []comments.Domain); ok {
+		r0 = rf(_a0, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]comments.Domain)
+		}
+	}
+
+	var r1 int
+	if rf, ok := ret.Get(1).(func(query.Request, primitive.ObjectID) int); ok {
+		r1 = rf(_a0, userID)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(query.Request, primitive.ObjectID) error); ok {
+		r2 = rf(_a0, userID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetTrashedByThreadID provides a mock function with given fields: threadID
+func (_m *Repository) GetTrashedByThreadID(threadID primitive.ObjectID) ([]comments.Domain, error) {
+	ret := _m.Called(threadID)
+
+	var r0 []comments.Domain
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID) []comments.Domain); ok {
+		r0 = rf(threadID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]comments.Domain)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(primitive.ObjectID) error); ok {
+		r1 = rf(threadID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestoreAllByThreadID provides a mock function with given fields: threadID
+func (_m *Repository) RestoreAllByThreadID(threadID primitive.ObjectID) error {
+	ret := _m.Called(threadID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID) error); ok {
+		r0 = rf(threadID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SoftDeleteAllByThreadID provides a mock function with given fields: threadID, deletedAt
+func (_m *Repository) SoftDeleteAllByThreadID(threadID primitive.ObjectID, deletedAt primitive.DateTime) error {
+	ret := _m.Called(threadID, deletedAt)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID, primitive.DateTime) error); ok {
+		r0 = rf(threadID, deletedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields: domain
 func (_m *Repository) Update(domain *comments.Domain) (comments.Domain, error) {
 	ret := _m.Called(domain)
@@ -208,6 +312,20 @@ func (_m *Repository) Update(domain *comments.Domain) (comments.Domain, error) {
 	return r0, r1
 }
 
+// UpdateSuspendStatus provides a mock function with given fields: domain
+func (_m *Repository) UpdateSuspendStatus(domain *comments.Domain) error {
+	ret := _m.Called(domain)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*comments.Domain) error); ok {
+		r0 = rf(domain)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewRepository interface {
 	mock.TestingT
 	Cleanup(func())
@@ -11,6 +11,8 @@ import (
 	multipart "mime/multipart"
 
 	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+
+	pagination "charum/dto/pagination"
 )
 
 // UseCase is an autogenerated mock type for the UseCase type
@@ -39,6 +41,27 @@ func (_m *UseCase) CountByThreadID(threadID primitive.ObjectID) (int, error) {
 	return r0, r1
 }
 
+// CountByUserID provides a mock function with given fields: userID
+func (_m *UseCase) CountByUserID(userID primitive.ObjectID) (int, error) {
+	ret := _m.Called(userID)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID) int); ok {
+		r0 = rf(userID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(primitive.ObjectID) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Create provides a mock function with given fields: domain, image
 func (_m *UseCase) Create(domain *comments.Domain, image *multipart.FileHeader) (comments.Domain, error) {
 	ret := _m.Called(domain, image)
@@ -176,6 +199,134 @@ func (_m *UseCase) GetByThreadID(threadID primitive.ObjectID) ([]comments.Domain
 	return r0, r1
 }
 
+// GetManyByUserID provides a mock function with given fields: _a0, userID
+func (_m *UseCase) GetManyByUserID(_a0 pagination.Request, userID primitive.ObjectID) ([]comments.Domain, int, int, error) {
+	ret := _m.Called(_a0, userID)
+
+	var r0 []comments.Domain
+	if rf, ok := ret.Get(0).(func(pagination.Request, primitive.ObjectID) []comments.Domain); ok {
+		r0 = rf(_a0, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]comments.Domain)
+		}
+	}
+
+	var r1 int
+	if rf, ok := ret.Get(1).(func(pagination.Request, primitive.ObjectID) int); ok {
+		r1 = rf(_a0, userID)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 int
+	if rf, ok := ret.Get(2).(func(pagination.Request, primitive.ObjectID) int); ok {
+		r2 = rf(_a0, userID)
+	} else {
+		r2 = ret.Get(2).(int)
+	}
+
+	var r3 error
+	if rf, ok := ret.Get(3).(func(pagination.Request, primitive.ObjectID) error); ok {
+		r3 = rf(_a0, userID)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
+// ModeratorDelete provides a mock function with given fields: moderatorID, commentID
+func (_m *UseCase) ModeratorDelete(moderatorID primitive.ObjectID, commentID primitive.ObjectID) (comments.Domain, error) {
+	ret := _m.Called(moderatorID, commentID)
+
+	var r0 comments.Domain
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID, primitive.ObjectID) comments.Domain); ok {
+		r0 = rf(moderatorID, commentID)
+	} else {
+		r0 = ret.Get(0).(comments.Domain)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(primitive.ObjectID, primitive.ObjectID) error); ok {
+		r1 = rf(moderatorID, commentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModeratorSuspend provides a mock function with given fields: moderatorID, commentID, detail
+func (_m *UseCase) ModeratorSuspend(moderatorID primitive.ObjectID, commentID primitive.ObjectID, detail string) (comments.Domain, error) {
+	ret := _m.Called(moderatorID, commentID, detail)
+
+	var r0 comments.Domain
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID, primitive.ObjectID, string) comments.Domain); ok {
+		r0 = rf(moderatorID, commentID, detail)
+	} else {
+		r0 = ret.Get(0).(comments.Domain)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(primitive.ObjectID, primitive.ObjectID, string) error); ok {
+		r1 = rf(moderatorID, commentID, detail)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModeratorUnsuspend provides a mock function with given fields: moderatorID, commentID
+func (_m *UseCase) ModeratorUnsuspend(moderatorID primitive.ObjectID, commentID primitive.ObjectID) (comments.Domain, error) {
+	ret := _m.Called(moderatorID, commentID)
+
+	var r0 comments.Domain
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID, primitive.ObjectID) comments.Domain); ok {
+		r0 = rf(moderatorID, commentID)
+	} else {
+		r0 = ret.Get(0).(comments.Domain)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(primitive.ObjectID, primitive.ObjectID) error); ok {
+		r1 = rf(moderatorID, commentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PurgeAllByThreadID provides a mock function with given fields: threadID
+func (_m *UseCase) PurgeAllByThreadID(threadID primitive.ObjectID) error {
+	ret := _m.Called(threadID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID) error); ok {
+		r0 = rf(threadID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RestoreAllByThreadID provides a mock function with given fields: threadID
+func (_m *UseCase) RestoreAllByThreadID(threadID primitive.ObjectID) error {
+	ret := _m.Called(threadID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID) error); ok {
+		r0 = rf(threadID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields: domain, image
 func (_m *UseCase) Update(domain *comments.Domain, image *multipart.FileHeader) (comments.Domain, error) {
 	ret := _m.Called(domain, image)
@@ -1,12 +1,19 @@
 package comments
 
 import (
+	"charum/business/permissions"
 	"charum/business/threads"
+	"charum/business/topics"
+	userBlocks "charum/business/user_blocks"
 	"charum/business/users"
 	dtoComment "charum/dto/comments"
+	dtoPagination "charum/dto/pagination"
+	dtoQuery "charum/dto/query"
+	dtoUser "charum/dto/users"
 	"charum/helper/cloudinary"
 	"charum/util"
 	"errors"
+	"math"
 	"mime/multipart"
 	"time"
 
@@ -14,18 +21,24 @@ import (
 )
 
 type CommentUseCase struct {
-	commentRepository Repository
-	threadRepository  threads.Repository
-	userRepository    users.Repository
-	cloudinary        cloudinary.Function
+	commentRepository    Repository
+	threadRepository     threads.Repository
+	topicRepository      topics.Repository
+	userRepository       users.Repository
+	userBlockRepository  userBlocks.Repository
+	permissionRepository permissions.Repository
+	cloudinary           cloudinary.Function
 }
 
-func NewCommentUseCase(cr Repository, tr threads.Repository, ur users.Repository, c cloudinary.Function) UseCase {
+func NewCommentUseCase(cr Repository, tr threads.Repository, tor topics.Repository, ur users.Repository, ubr userBlocks.Repository, pr permissions.Repository, c cloudinary.Function) UseCase {
 	return &CommentUseCase{
-		commentRepository: cr,
-		threadRepository:  tr,
-		userRepository:    ur,
-		cloudinary:        c,
+		commentRepository:    cr,
+		threadRepository:     tr,
+		topicRepository:      tor,
+		userRepository:       ur,
+		userBlockRepository:  ubr,
+		permissionRepository: pr,
+		cloudinary:           c,
 	}
 }
 
@@ -42,11 +55,20 @@ func (cu *CommentUseCase) Create(domain *Domain, image *multipart.FileHeader) (D
 		}
 	}
 
-	_, err = cu.threadRepository.GetByID(domain.ThreadID)
+	thread, err := cu.threadRepository.GetByID(domain.ThreadID)
 	if err != nil {
 		return Domain{}, errors.New("failed to get thread")
 	}
 
+	if thread.IsLocked {
+		return Domain{}, errors.New("thread is locked, new comments are not allowed")
+	}
+
+	_, err = cu.userBlockRepository.GetByUserIDTargetIDAndType(thread.CreatorID, domain.UserID, userBlocks.TypeBlock)
+	if err == nil {
+		return Domain{}, errors.New("user is blocked by the thread creator")
+	}
+
 	if image != nil {
 		cloudinaryURL, err := cu.cloudinary.Upload("comment", image, util.GenerateUUID())
 		if err != nil {
@@ -104,9 +126,11 @@ func (cu *CommentUseCase) DomainToResponse(comment Domain) (dtoComment.Response,
 	responseComment.Id = comment.Id
 	responseComment.ThreadID = comment.ThreadID
 	responseComment.ParentID = comment.ParentID
-	responseComment.User = user
+	responseComment.User = dtoUser.FromDomain(user)
 	responseComment.Comment = comment.Comment
 	responseComment.ImageURL = comment.ImageURL
+	responseComment.SuspendStatus = comment.SuspendStatus
+	responseComment.SuspendDetail = comment.SuspendDetail
 	responseComment.CreatedAt = comment.CreatedAt
 	responseComment.UpdatedAt = comment.UpdatedAt
 
@@ -142,6 +166,40 @@ func (cu *CommentUseCase) CountByThreadID(threadID primitive.ObjectID) (int, err
 	return count, nil
 }
 
+func (cu *CommentUseCase) GetManyByUserID(pagination dtoPagination.Request, userID primitive.ObjectID) ([]Domain, int, int, error) {
+	var orderInMongo int
+	if pagination.Order == "asc" {
+		orderInMongo = 1
+	} else {
+		orderInMongo = -1
+	}
+
+	query := dtoQuery.Request{
+		Skip:  pagination.Limit * (pagination.Page - 1),
+		Limit: pagination.Limit,
+		Order: orderInMongo,
+		Sort:  pagination.Sort,
+	}
+
+	comments, totalData, err := cu.commentRepository.GetManyByUserID(query, userID)
+	if err != nil {
+		return []Domain{}, 0, 0, errors.New("failed to get comments")
+	}
+
+	totalPage := math.Ceil(float64(totalData) / float64(pagination.Limit))
+
+	return comments, int(totalPage), totalData, nil
+}
+
+func (cu *CommentUseCase) CountByUserID(userID primitive.ObjectID) (int, error) {
+	count, err := cu.commentRepository.CountByUserID(userID)
+	if err != nil {
+		return 0, errors.New("failed to count comments")
+	}
+
+	return count, nil
+}
+
 /*
 Update
 */
@@ -152,7 +210,12 @@ func (cu *CommentUseCase) Update(domain *Domain, image *multipart.FileHeader) (D
 		return Domain{}, errors.New("failed to get comment")
 	}
 
-	if comment.UserID != domain.UserID {
+	policy, err := cu.getPolicy(domain.UserID)
+	if err != nil {
+		return Domain{}, err
+	}
+
+	if !policy.AllowsOn(permissions.CommentUpdateOwn, permissions.CommentUpdateAny, comment.UserID == domain.UserID) {
 		return Domain{}, errors.New("user are not the owner of this comment")
 	}
 
@@ -188,6 +251,116 @@ func (cu *CommentUseCase) Update(domain *Domain, image *multipart.FileHeader) (D
 	return comment, nil
 }
 
+func (cu *CommentUseCase) ModeratorSuspend(moderatorID primitive.ObjectID, commentID primitive.ObjectID, detail string) (Domain, error) {
+	comment, err := cu.commentRepository.GetByID(commentID)
+	if err != nil {
+		return Domain{}, errors.New("failed to get comment")
+	}
+
+	err = cu.checkModerator(moderatorID, comment.ThreadID)
+	if err != nil {
+		return Domain{}, err
+	}
+
+	if comment.SuspendStatus != "" {
+		return Domain{}, errors.New("comment is already suspended")
+	}
+
+	if detail == "" {
+		detail = "comment is violate the rules"
+	}
+
+	comment.SuspendStatus = "moderator suspend"
+	comment.SuspendDetail = detail
+	comment.UpdatedAt = primitive.NewDateTimeFromTime(time.Now())
+
+	err = cu.commentRepository.UpdateSuspendStatus(&comment)
+	if err != nil {
+		return Domain{}, errors.New("failed to suspend comment")
+	}
+
+	return comment, nil
+}
+
+func (cu *CommentUseCase) ModeratorUnsuspend(moderatorID primitive.ObjectID, commentID primitive.ObjectID) (Domain, error) {
+	comment, err := cu.commentRepository.GetByID(commentID)
+	if err != nil {
+		return Domain{}, errors.New("failed to get comment")
+	}
+
+	err = cu.checkModerator(moderatorID, comment.ThreadID)
+	if err != nil {
+		return Domain{}, err
+	}
+
+	if comment.SuspendStatus == "" {
+		return Domain{}, errors.New("comment is not suspended")
+	}
+
+	comment.SuspendStatus = ""
+	comment.SuspendDetail = ""
+	comment.UpdatedAt = primitive.NewDateTimeFromTime(time.Now())
+
+	err = cu.commentRepository.UpdateSuspendStatus(&comment)
+	if err != nil {
+		return Domain{}, errors.New("failed to unsuspend comment")
+	}
+
+	return comment, nil
+}
+
+// checkModerator allows admins everywhere and moderators only within the topic of the comment's thread
+func (cu *CommentUseCase) checkModerator(moderatorID primitive.ObjectID, threadID primitive.ObjectID) error {
+	policy, err := cu.getPolicy(moderatorID)
+	if err != nil {
+		return err
+	}
+
+	if policy.Allows(permissions.ContentModerateAny) {
+		return nil
+	}
+
+	thread, err := cu.threadRepository.GetByID(threadID)
+	if err != nil {
+		return errors.New("failed to get thread")
+	}
+
+	topic, err := cu.topicRepository.GetByID(thread.TopicID)
+	if err != nil {
+		return errors.New("failed to get topic")
+	}
+
+	if !policy.Allows(permissions.ContentModerateAssigned) || !topic.IsModeratedBy(moderatorID) {
+		return errors.New("user is not a moderator of this topic")
+	}
+
+	return nil
+}
+
+// getPolicy returns the permissions granted to the role of the user
+func (cu *CommentUseCase) getPolicy(userID primitive.ObjectID) (permissions.Domain, error) {
+	user, err := cu.userRepository.GetByID(userID)
+	if err != nil {
+		return permissions.Domain{}, errors.New("failed to get user")
+	}
+
+	policy, err := cu.permissionRepository.GetByRole(user.Role)
+	if err != nil {
+		return permissions.Domain{}, errors.New("failed to get permissions")
+	}
+
+	return policy, nil
+}
+
+func (cu *CommentUseCase) RestoreAllByThreadID(threadID primitive.ObjectID) error {
+	err := cu.commentRepository.RestoreAllByThreadID(threadID)
+	if err != nil {
+		return errors.New("failed to restore thread's comments")
+	}
+
+	return nil
+}
+
 /*
 Delete
 */
@@ -198,7 +371,12 @@ func (cu *CommentUseCase) Delete(id primitive.ObjectID, userID primitive.ObjectI
 		return Domain{}, errors.New("failed to get comment")
 	}
 
-	if comment.UserID != userID {
+	policy, err := cu.getPolicy(userID)
+	if err != nil {
+		return Domain{}, err
+	}
+
+	if !policy.AllowsOn(permissions.CommentDeleteOwn, permissions.CommentDeleteAny, comment.UserID == userID) {
 		return Domain{}, errors.New("user are not the owner of this comment")
 	}
 
@@ -222,6 +400,32 @@ func (cu *CommentUseCase) Delete(id primitive.ObjectID, userID primitive.ObjectI
 	return comment, nil
 }
 
+func (cu *CommentUseCase) ModeratorDelete(moderatorID primitive.ObjectID, commentID primitive.ObjectID) (Domain, error) {
+	comment, err := cu.commentRepository.GetByID(commentID)
+	if err != nil {
+		return Domain{}, errors.New("failed to get comment")
+	}
+
+	err = cu.checkModerator(moderatorID, comment.ThreadID)
+	if err != nil {
+		return Domain{}, err
+	}
+
+	if comment.ImageURL != "" {
+		err := cu.cloudinary.Delete("comment", util.GetFilenameWithoutExtension(comment.ImageURL))
+		if err != nil {
+			return Domain{}, errors.New("failed to delete image")
+		}
+	}
+
+	err = cu.commentRepository.Delete(commentID)
+	if err != nil {
+		return Domain{}, errors.New("failed to delete comment")
+	}
+
+	return comment, nil
+}
+
 func (cu *CommentUseCase) DeleteAllByUserID(userID primitive.ObjectID) error {
 	comments, err := cu.commentRepository.GetAllByUserID(userID)
 	if err != nil {
@@ -267,3 +471,27 @@ func (cu *CommentUseCase) DeleteAllByThreadID(threadID primitive.ObjectID) error
 
 	return nil
 }
+
+// PurgeAllByThreadID permanently deletes the trashed comments of a thread together with their images
+func (cu *CommentUseCase) PurgeAllByThreadID(threadID primitive.ObjectID) error {
+	comments, err := cu.commentRepository.GetTrashedByThreadID(threadID)
+	if err != nil {
+		return errors.New("failed to get thread's comments")
+	}
+
+	for _, comment := range comments {
+		if comment.ImageURL != "" {
+			err := cu.cloudinary.Delete("comment", util.GetFilenameWithoutExtension(comment.ImageURL))
+			if err != nil {
+				return errors.New("failed to delete image")
+			}
+		}
+	}
+
+	err = cu.commentRepository.DeleteAllByThreadID(threadID)
+	if err != nil {
+		return errors.New("failed to delete thread's comments")
+	}
+
+	return nil
+}
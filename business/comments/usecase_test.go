@@ -3,10 +3,19 @@ package comments_test
 import (
 	"charum/business/comments"
 	_commentMock "charum/business/comments/mocks"
+	"charum/business/permissions"
+	_permissionMock "charum/business/permissions/mocks"
 	"charum/business/threads"
 	_threadMock "charum/business/threads/mocks"
+	"charum/business/topics"
+	_topicMock "charum/business/topics/mocks"
+	userBlocks "charum/business/user_blocks"
+	_userBlockMock "charum/business/user_blocks/mocks"
 	"charum/business/users"
 	_userMock "charum/business/users/mocks"
+	dtoPagination "charum/dto/pagination"
+	dtoQuery "charum/dto/query"
+	dtoUser "charum/dto/users"
 	_cloudinaryMock "charum/helper/cloudinary/mocks"
 	"errors"
 	"mime/multipart"
@@ -21,17 +30,23 @@ import (
 var (
 	commentRepository    _commentMock.Repository
 	threadRepository     _threadMock.Repository
+	topicRepository      _topicMock.Repository
 	userRepository       _userMock.Repository
+	userBlockRepository  _userBlockMock.Repository
+	permissionRepository _permissionMock.Repository
 	cloudinaryRepository _cloudinaryMock.Function
 	commentUseCase       comments.UseCase
 	commentDomain        comments.Domain
 	threadDomain         threads.Domain
 	userDomain           users.Domain
+	userPermission       permissions.Domain
+	moderatorPermission  permissions.Domain
+	adminPermission      permissions.Domain
 	image                *multipart.FileHeader
 )
 
 func TestMain(m *testing.M) {
-	commentUseCase = comments.NewCommentUseCase(&commentRepository, &threadRepository, &userRepository, &cloudinaryRepository)
+	commentUseCase = comments.NewCommentUseCase(&commentRepository, &threadRepository, &topicRepository, &userRepository, &userBlockRepository, &permissionRepository, &cloudinaryRepository)
 
 	userDomain = users.Domain{
 		Id:          primitive.NewObjectID(),
@@ -68,6 +83,24 @@ func TestMain(m *testing.M) {
 		UpdatedAt: primitive.NewDateTimeFromTime(time.Now()),
 	}
 
+	userPermission = permissions.Domain{
+		Id:          primitive.NewObjectID(),
+		Role:        "user",
+		Permissions: permissions.Defaults["user"],
+	}
+
+	moderatorPermission = permissions.Domain{
+		Id:          primitive.NewObjectID(),
+		Role:        "moderator",
+		Permissions: permissions.Defaults["moderator"],
+	}
+
+	adminPermission = permissions.Domain{
+		Id:          primitive.NewObjectID(),
+		Role:        "admin",
+		Permissions: permissions.Defaults["admin"],
+	}
+
 	image = &multipart.FileHeader{}
 
 	m.Run()
@@ -77,6 +110,7 @@ func TestCreate(t *testing.T) {
 	t.Run("Test case 1 | Valid create", func(t *testing.T) {
 		commentRepository.On("GetByIDAndThreadID", mock.Anything, mock.Anything).Return(commentDomain, nil).Once()
 		threadRepository.On("GetByID", commentDomain.ThreadID).Return(threadDomain, nil).Once()
+		userBlockRepository.On("GetByUserIDTargetIDAndType", threadDomain.CreatorID, commentDomain.UserID, userBlocks.TypeBlock).Return(userBlocks.Domain{}, errors.New("not found")).Once()
 		cloudinaryRepository.On("Upload", mock.Anything, mock.Anything, mock.Anything).Return("test", nil).Once()
 		commentRepository.On("Create", mock.Anything).Return(commentDomain, nil).Once()
 
@@ -109,6 +143,7 @@ func TestCreate(t *testing.T) {
 		expectedErr := errors.New("failed to upload image")
 		commentRepository.On("GetByIDAndThreadID", mock.Anything, mock.Anything).Return(commentDomain, nil).Once()
 		threadRepository.On("GetByID", commentDomain.ThreadID).Return(threadDomain, nil).Once()
+		userBlockRepository.On("GetByUserIDTargetIDAndType", threadDomain.CreatorID, commentDomain.UserID, userBlocks.TypeBlock).Return(userBlocks.Domain{}, errors.New("not found")).Once()
 		cloudinaryRepository.On("Upload", mock.Anything, mock.Anything, mock.Anything).Return("", expectedErr).Once()
 
 		actualComment, err := commentUseCase.Create(&commentDomain, image)
@@ -120,6 +155,7 @@ func TestCreate(t *testing.T) {
 		expectedErr := errors.New("failed to create comment")
 		commentRepository.On("GetByIDAndThreadID", mock.Anything, mock.Anything).Return(commentDomain, nil).Once()
 		threadRepository.On("GetByID", commentDomain.ThreadID).Return(threadDomain, nil).Once()
+		userBlockRepository.On("GetByUserIDTargetIDAndType", threadDomain.CreatorID, commentDomain.UserID, userBlocks.TypeBlock).Return(userBlocks.Domain{}, errors.New("not found")).Once()
 		cloudinaryRepository.On("Upload", mock.Anything, mock.Anything, mock.Anything).Return("test", nil).Once()
 		commentRepository.On("Create", mock.Anything).Return(comments.Domain{}, expectedErr).Once()
 		cloudinaryRepository.On("Delete", mock.Anything, mock.Anything).Return(nil).Once()
@@ -133,6 +169,7 @@ func TestCreate(t *testing.T) {
 		expectedErr := errors.New("failed to delete image")
 		commentRepository.On("GetByIDAndThreadID", mock.Anything, mock.Anything).Return(commentDomain, nil).Once()
 		threadRepository.On("GetByID", commentDomain.ThreadID).Return(threadDomain, nil).Once()
+		userBlockRepository.On("GetByUserIDTargetIDAndType", threadDomain.CreatorID, commentDomain.UserID, userBlocks.TypeBlock).Return(userBlocks.Domain{}, errors.New("not found")).Once()
 		cloudinaryRepository.On("Upload", mock.Anything, mock.Anything, mock.Anything).Return("test", nil).Once()
 		commentRepository.On("Create", mock.Anything).Return(commentDomain, expectedErr).Once()
 		cloudinaryRepository.On("Delete", mock.Anything, mock.Anything).Return(expectedErr).Once()
@@ -141,6 +178,29 @@ func TestCreate(t *testing.T) {
 		assert.NotNil(t, err)
 		assert.Empty(t, actualComment)
 	})
+
+	t.Run("Test case 7 | Invalid create | User Is Blocked By Thread Creator", func(t *testing.T) {
+		expectedErr := errors.New("user is blocked by the thread creator")
+		commentRepository.On("GetByIDAndThreadID", mock.Anything, mock.Anything).Return(commentDomain, nil).Once()
+		threadRepository.On("GetByID", commentDomain.ThreadID).Return(threadDomain, nil).Once()
+		userBlockRepository.On("GetByUserIDTargetIDAndType", threadDomain.CreatorID, commentDomain.UserID, userBlocks.TypeBlock).Return(userBlocks.Domain{Id: primitive.NewObjectID()}, nil).Once()
+
+		actualComment, err := commentUseCase.Create(&commentDomain, image)
+		assert.Equal(t, expectedErr, err)
+		assert.Empty(t, actualComment)
+	})
+
+	t.Run("Test case 8 | Invalid create | Thread Is Locked", func(t *testing.T) {
+		expectedErr := errors.New("thread is locked, new comments are not allowed")
+		lockedThread := threadDomain
+		lockedThread.IsLocked = true
+		commentRepository.On("GetByIDAndThreadID", mock.Anything, mock.Anything).Return(commentDomain, nil).Once()
+		threadRepository.On("GetByID", commentDomain.ThreadID).Return(lockedThread, nil).Once()
+
+		actualComment, err := commentUseCase.Create(&commentDomain, image)
+		assert.Equal(t, expectedErr, err)
+		assert.Empty(t, actualComment)
+	})
 }
 
 func TestGetByThreadID(t *testing.T) {
@@ -180,6 +240,7 @@ func TestDomainToResponse(t *testing.T) {
 		actualComment, err := commentUseCase.DomainToResponse(commentDomain)
 
 		assert.NotEmpty(t, actualComment)
+		assert.Equal(t, dtoUser.FromDomain(userDomain), actualComment.User)
 		assert.Nil(t, err)
 	})
 
@@ -214,6 +275,8 @@ func TestDomainToResponseArray(t *testing.T) {
 func TestUpdate(t *testing.T) {
 	t.Run("Test case 1 | Valid update", func(t *testing.T) {
 		commentRepository.On("GetByID", commentDomain.Id).Return(commentDomain, nil).Once()
+		userRepository.On("GetByID", commentDomain.UserID).Return(userDomain, nil).Once()
+		permissionRepository.On("GetByRole", "user").Return(userPermission, nil).Once()
 		threadRepository.On("GetByID", commentDomain.ThreadID).Return(threadDomain, nil).Once()
 		cloudinaryRepository.On("Delete", mock.Anything, mock.Anything).Return(nil).Once()
 		cloudinaryRepository.On("Upload", mock.Anything, mock.Anything, mock.Anything).Return("test", nil).Once()
@@ -236,6 +299,8 @@ func TestUpdate(t *testing.T) {
 	t.Run("Test case 3 | Invalid update | Failed To Get Thread", func(t *testing.T) {
 		expectedErr := errors.New("failed to get thread")
 		commentRepository.On("GetByID", commentDomain.Id).Return(commentDomain, nil).Once()
+		userRepository.On("GetByID", commentDomain.UserID).Return(userDomain, nil).Once()
+		permissionRepository.On("GetByRole", "user").Return(userPermission, nil).Once()
 		threadRepository.On("GetByID", commentDomain.ThreadID).Return(threads.Domain{}, expectedErr).Once()
 
 		_, err := commentUseCase.Update(&commentDomain, image)
@@ -245,6 +310,8 @@ func TestUpdate(t *testing.T) {
 	t.Run("Test case 4 | Invalid update | Failed To Update Comment", func(t *testing.T) {
 		expectedErr := errors.New("failed to update comment")
 		commentRepository.On("GetByID", commentDomain.Id).Return(commentDomain, nil).Once()
+		userRepository.On("GetByID", commentDomain.UserID).Return(userDomain, nil).Once()
+		permissionRepository.On("GetByRole", "user").Return(userPermission, nil).Once()
 		threadRepository.On("GetByID", commentDomain.ThreadID).Return(threadDomain, nil).Once()
 		cloudinaryRepository.On("Delete", mock.Anything, mock.Anything).Return(nil).Once()
 		cloudinaryRepository.On("Upload", mock.Anything, mock.Anything, mock.Anything).Return("test", nil).Once()
@@ -259,6 +326,8 @@ func TestUpdate(t *testing.T) {
 		copyDomain.UserID = primitive.NewObjectID()
 
 		commentRepository.On("GetByID", commentDomain.Id).Return(commentDomain, nil).Once()
+		userRepository.On("GetByID", copyDomain.UserID).Return(userDomain, nil).Once()
+		permissionRepository.On("GetByRole", "user").Return(userPermission, nil).Once()
 
 		_, err := commentUseCase.Update(&copyDomain, image)
 		assert.NotNil(t, err)
@@ -267,6 +336,8 @@ func TestUpdate(t *testing.T) {
 	t.Run("Test case 6 | Invalid update | Failed To Delete Image", func(t *testing.T) {
 		expectedErr := errors.New("failed to delete image")
 		commentRepository.On("GetByID", commentDomain.Id).Return(commentDomain, nil).Once()
+		userRepository.On("GetByID", commentDomain.UserID).Return(userDomain, nil).Once()
+		permissionRepository.On("GetByRole", "user").Return(userPermission, nil).Once()
 		threadRepository.On("GetByID", commentDomain.ThreadID).Return(threadDomain, nil).Once()
 		cloudinaryRepository.On("Delete", mock.Anything, mock.Anything).Return(expectedErr).Once()
 
@@ -277,6 +348,8 @@ func TestUpdate(t *testing.T) {
 	t.Run("Test case 7 | Invalid update | Failed To Upload Image", func(t *testing.T) {
 		expectedErr := errors.New("failed to upload image")
 		commentRepository.On("GetByID", commentDomain.Id).Return(commentDomain, nil).Once()
+		userRepository.On("GetByID", commentDomain.UserID).Return(userDomain, nil).Once()
+		permissionRepository.On("GetByRole", "user").Return(userPermission, nil).Once()
 		threadRepository.On("GetByID", commentDomain.ThreadID).Return(threadDomain, nil).Once()
 		cloudinaryRepository.On("Delete", mock.Anything, mock.Anything).Return(nil).Once()
 		cloudinaryRepository.On("Upload", mock.Anything, mock.Anything, mock.Anything).Return("", expectedErr).Once()
@@ -284,6 +357,40 @@ func TestUpdate(t *testing.T) {
 		_, err := commentUseCase.Update(&commentDomain, image)
 		assert.NotNil(t, err)
 	})
+
+	t.Run("Test case 8 | Valid update | Role may update any comment", func(t *testing.T) {
+		admin := userDomain
+		admin.Id = primitive.NewObjectID()
+		admin.Role = "admin"
+		copyDomain := commentDomain
+		copyDomain.UserID = admin.Id
+
+		commentRepository.On("GetByID", commentDomain.Id).Return(commentDomain, nil).Once()
+		userRepository.On("GetByID", admin.Id).Return(admin, nil).Once()
+		permissionRepository.On("GetByRole", "admin").Return(adminPermission, nil).Once()
+		threadRepository.On("GetByID", commentDomain.ThreadID).Return(threadDomain, nil).Once()
+		commentRepository.On("Update", mock.Anything).Return(commentDomain, nil).Once()
+
+		actualComment, err := commentUseCase.Update(&copyDomain, nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, commentDomain, actualComment)
+	})
+
+	t.Run("Test case 9 | Invalid update | Failed To Get Permissions", func(t *testing.T) {
+		unknown := userDomain
+		unknown.Id = primitive.NewObjectID()
+		unknown.Role = "unknown"
+		copyDomain := commentDomain
+		copyDomain.UserID = unknown.Id
+
+		commentRepository.On("GetByID", commentDomain.Id).Return(commentDomain, nil).Once()
+		userRepository.On("GetByID", unknown.Id).Return(unknown, nil).Once()
+		permissionRepository.On("GetByRole", "unknown").Return(permissions.Domain{}, errors.New("not found")).Once()
+
+		_, err := commentUseCase.Update(&copyDomain, nil)
+		assert.Equal(t, errors.New("failed to get permissions"), err)
+	})
 }
 
 func TestCountByThreadID(t *testing.T) {
@@ -316,9 +423,71 @@ func TestCountByThreadID(t *testing.T) {
 	})
 }
 
+func TestGetManyByUserID(t *testing.T) {
+	pagination := dtoPagination.Request{
+		Page:  1,
+		Limit: 10,
+		Sort:  "createdAt",
+		Order: "desc",
+	}
+
+	query := dtoQuery.Request{
+		Skip:  0,
+		Limit: 10,
+		Sort:  "createdAt",
+		Order: -1,
+	}
+
+	t.Run("Test case 1 | Valid get many comment by user id", func(t *testing.T) {
+		commentRepository.On("GetManyByUserID", query, commentDomain.UserID).Return([]comments.Domain{commentDomain}, 1, nil).Once()
+
+		result, totalPage, totalData, err := commentUseCase.GetManyByUserID(pagination, commentDomain.UserID)
+
+		assert.Equal(t, []comments.Domain{commentDomain}, result)
+		assert.Equal(t, 1, totalPage)
+		assert.Equal(t, 1, totalData)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Invalid get many comment by user id | Failed To Get Comments", func(t *testing.T) {
+		expectedErr := errors.New("failed to get comments")
+		commentRepository.On("GetManyByUserID", query, commentDomain.UserID).Return([]comments.Domain{}, 0, errors.New("unexpected error")).Once()
+
+		result, totalPage, totalData, err := commentUseCase.GetManyByUserID(pagination, commentDomain.UserID)
+
+		assert.Equal(t, []comments.Domain{}, result)
+		assert.Zero(t, totalPage)
+		assert.Zero(t, totalData)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestCountByUserID(t *testing.T) {
+	t.Run("Test case 1 | Valid count by user id", func(t *testing.T) {
+		commentRepository.On("CountByUserID", commentDomain.UserID).Return(4, nil).Once()
+
+		result, err := commentUseCase.CountByUserID(commentDomain.UserID)
+
+		assert.Equal(t, 4, result)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Invalid count by user id | Failed To Count Comments", func(t *testing.T) {
+		expectedErr := errors.New("failed to count comments")
+		commentRepository.On("CountByUserID", commentDomain.UserID).Return(0, errors.New("unexpected error")).Once()
+
+		result, err := commentUseCase.CountByUserID(commentDomain.UserID)
+
+		assert.Zero(t, result)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
 func TestDelete(t *testing.T) {
 	t.Run("Test case 1 | Valid delete", func(t *testing.T) {
 		commentRepository.On("GetByID", commentDomain.Id).Return(commentDomain, nil).Once()
+		userRepository.On("GetByID", commentDomain.UserID).Return(userDomain, nil).Once()
+		permissionRepository.On("GetByRole", "user").Return(userPermission, nil).Once()
 		threadRepository.On("GetByID", commentDomain.ThreadID).Return(threadDomain, nil).Once()
 		cloudinaryRepository.On("Delete", mock.Anything, mock.Anything).Return(nil).Once()
 		commentRepository.On("Delete", commentDomain.Id).Return(nil).Once()
@@ -341,6 +510,8 @@ func TestDelete(t *testing.T) {
 	t.Run("Test case 3 | Invalid delete | Failed To Get Thread", func(t *testing.T) {
 		expectedErr := errors.New("failed to get thread")
 		commentRepository.On("GetByID", commentDomain.Id).Return(commentDomain, nil).Once()
+		userRepository.On("GetByID", commentDomain.UserID).Return(userDomain, nil).Once()
+		permissionRepository.On("GetByRole", "user").Return(userPermission, nil).Once()
 		threadRepository.On("GetByID", commentDomain.ThreadID).Return(threads.Domain{}, expectedErr).Once()
 
 		_, err := commentUseCase.Delete(commentDomain.Id, commentDomain.UserID)
@@ -350,6 +521,8 @@ func TestDelete(t *testing.T) {
 	t.Run("Test case 4 | Invalid delete | Failed To Delete Comment", func(t *testing.T) {
 		expectedErr := errors.New("failed to delete comment")
 		commentRepository.On("GetByID", commentDomain.Id).Return(commentDomain, nil).Once()
+		userRepository.On("GetByID", commentDomain.UserID).Return(userDomain, nil).Once()
+		permissionRepository.On("GetByRole", "user").Return(userPermission, nil).Once()
 		threadRepository.On("GetByID", commentDomain.ThreadID).Return(threadDomain, nil).Once()
 		cloudinaryRepository.On("Delete", mock.Anything, mock.Anything).Return(nil).Once()
 		commentRepository.On("Delete", commentDomain.Id).Return(expectedErr).Once()
@@ -362,6 +535,8 @@ func TestDelete(t *testing.T) {
 		copyComment := commentDomain
 		copyComment.UserID = primitive.NewObjectID()
 		commentRepository.On("GetByID", commentDomain.Id).Return(commentDomain, nil).Once()
+		userRepository.On("GetByID", copyComment.UserID).Return(userDomain, nil).Once()
+		permissionRepository.On("GetByRole", "user").Return(userPermission, nil).Once()
 
 		_, err := commentUseCase.Delete(commentDomain.Id, copyComment.UserID)
 		assert.NotNil(t, err)
@@ -370,12 +545,34 @@ func TestDelete(t *testing.T) {
 	t.Run("Test case 6 | Invalid delete | Failed To Delete Image", func(t *testing.T) {
 		expectedErr := errors.New("failed to delete image")
 		commentRepository.On("GetByID", commentDomain.Id).Return(commentDomain, nil).Once()
+		userRepository.On("GetByID", commentDomain.UserID).Return(userDomain, nil).Once()
+		permissionRepository.On("GetByRole", "user").Return(userPermission, nil).Once()
 		threadRepository.On("GetByID", commentDomain.ThreadID).Return(threadDomain, nil).Once()
 		cloudinaryRepository.On("Delete", mock.Anything, mock.Anything).Return(expectedErr).Once()
 
 		_, err := commentUseCase.Delete(commentDomain.Id, commentDomain.UserID)
 		assert.NotNil(t, err)
 	})
+
+	t.Run("Test case 7 | Valid delete | Role may delete any comment", func(t *testing.T) {
+		admin := userDomain
+		admin.Id = primitive.NewObjectID()
+		admin.Role = "admin"
+		copyComment := commentDomain
+		copyComment.Id = primitive.NewObjectID()
+		copyComment.ImageURL = ""
+
+		commentRepository.On("GetByID", copyComment.Id).Return(copyComment, nil).Once()
+		userRepository.On("GetByID", admin.Id).Return(admin, nil).Once()
+		permissionRepository.On("GetByRole", "admin").Return(adminPermission, nil).Once()
+		threadRepository.On("GetByID", copyComment.ThreadID).Return(threadDomain, nil).Once()
+		commentRepository.On("Delete", copyComment.Id).Return(nil).Once()
+
+		actualComment, err := commentUseCase.Delete(copyComment.Id, admin.Id)
+
+		assert.Nil(t, err)
+		assert.Equal(t, copyComment, actualComment)
+	})
 }
 
 func TestDeleteAllByUserID(t *testing.T) {
@@ -455,3 +652,217 @@ func TestDeleteALlByThreadID(t *testing.T) {
 		assert.NotNil(t, err)
 	})
 }
+
+func TestModeratorSuspend(t *testing.T) {
+	moderatorDomain := userDomain
+	moderatorDomain.Id = primitive.NewObjectID()
+	moderatorDomain.Role = "moderator"
+
+	t.Run("Test case 1 | Valid moderator suspend", func(t *testing.T) {
+		copyComment := commentDomain
+		topicDomain := topics.Domain{Id: threadDomain.TopicID, ModeratorIDs: []primitive.ObjectID{moderatorDomain.Id}}
+		commentRepository.On("GetByID", copyComment.Id).Return(copyComment, nil).Once()
+		userRepository.On("GetByID", moderatorDomain.Id).Return(moderatorDomain, nil).Once()
+		permissionRepository.On("GetByRole", "moderator").Return(moderatorPermission, nil).Once()
+		threadRepository.On("GetByID", copyComment.ThreadID).Return(threadDomain, nil).Once()
+		topicRepository.On("GetByID", threadDomain.TopicID).Return(topicDomain, nil).Once()
+		commentRepository.On("UpdateSuspendStatus", mock.Anything).Return(nil).Once()
+
+		result, err := commentUseCase.ModeratorSuspend(moderatorDomain.Id, copyComment.Id, "spam")
+
+		assert.Equal(t, "moderator suspend", result.SuspendStatus)
+		assert.Equal(t, "spam", result.SuspendDetail)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Valid moderator suspend | Admin is not limited to assigned topics", func(t *testing.T) {
+		adminDomain := moderatorDomain
+		adminDomain.Role = "admin"
+		copyComment := commentDomain
+		commentRepository.On("GetByID", copyComment.Id).Return(copyComment, nil).Once()
+		userRepository.On("GetByID", adminDomain.Id).Return(adminDomain, nil).Once()
+		permissionRepository.On("GetByRole", "admin").Return(adminPermission, nil).Once()
+		commentRepository.On("UpdateSuspendStatus", mock.Anything).Return(nil).Once()
+
+		result, err := commentUseCase.ModeratorSuspend(adminDomain.Id, copyComment.Id, "")
+
+		assert.Equal(t, "comment is violate the rules", result.SuspendDetail)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 3 | Invalid moderator suspend | Comment outside of the moderated topics", func(t *testing.T) {
+		copyComment := commentDomain
+		topicDomain := topics.Domain{Id: threadDomain.TopicID, ModeratorIDs: []primitive.ObjectID{primitive.NewObjectID()}}
+		commentRepository.On("GetByID", copyComment.Id).Return(copyComment, nil).Once()
+		userRepository.On("GetByID", moderatorDomain.Id).Return(moderatorDomain, nil).Once()
+		permissionRepository.On("GetByRole", "moderator").Return(moderatorPermission, nil).Once()
+		threadRepository.On("GetByID", copyComment.ThreadID).Return(threadDomain, nil).Once()
+		topicRepository.On("GetByID", threadDomain.TopicID).Return(topicDomain, nil).Once()
+
+		result, err := commentUseCase.ModeratorSuspend(moderatorDomain.Id, copyComment.Id, "spam")
+
+		assert.Equal(t, comments.Domain{}, result)
+		assert.Equal(t, errors.New("user is not a moderator of this topic"), err)
+	})
+
+	t.Run("Test case 4 | Invalid moderator suspend | Comment is already suspended", func(t *testing.T) {
+		copyComment := commentDomain
+		copyComment.SuspendStatus = "moderator suspend"
+		topicDomain := topics.Domain{Id: threadDomain.TopicID, ModeratorIDs: []primitive.ObjectID{moderatorDomain.Id}}
+		commentRepository.On("GetByID", copyComment.Id).Return(copyComment, nil).Once()
+		userRepository.On("GetByID", moderatorDomain.Id).Return(moderatorDomain, nil).Once()
+		permissionRepository.On("GetByRole", "moderator").Return(moderatorPermission, nil).Once()
+		threadRepository.On("GetByID", copyComment.ThreadID).Return(threadDomain, nil).Once()
+		topicRepository.On("GetByID", threadDomain.TopicID).Return(topicDomain, nil).Once()
+
+		result, err := commentUseCase.ModeratorSuspend(moderatorDomain.Id, copyComment.Id, "spam")
+
+		assert.Equal(t, comments.Domain{}, result)
+		assert.Equal(t, errors.New("comment is already suspended"), err)
+	})
+}
+
+func TestModeratorUnsuspend(t *testing.T) {
+	moderatorDomain := userDomain
+	moderatorDomain.Id = primitive.NewObjectID()
+	moderatorDomain.Role = "moderator"
+
+	t.Run("Test case 1 | Valid moderator unsuspend", func(t *testing.T) {
+		copyComment := commentDomain
+		copyComment.SuspendStatus = "moderator suspend"
+		copyComment.SuspendDetail = "spam"
+		topicDomain := topics.Domain{Id: threadDomain.TopicID, ModeratorIDs: []primitive.ObjectID{moderatorDomain.Id}}
+		commentRepository.On("GetByID", copyComment.Id).Return(copyComment, nil).Once()
+		userRepository.On("GetByID", moderatorDomain.Id).Return(moderatorDomain, nil).Once()
+		permissionRepository.On("GetByRole", "moderator").Return(moderatorPermission, nil).Once()
+		threadRepository.On("GetByID", copyComment.ThreadID).Return(threadDomain, nil).Once()
+		topicRepository.On("GetByID", threadDomain.TopicID).Return(topicDomain, nil).Once()
+		commentRepository.On("UpdateSuspendStatus", mock.Anything).Return(nil).Once()
+
+		result, err := commentUseCase.ModeratorUnsuspend(moderatorDomain.Id, copyComment.Id)
+
+		assert.Empty(t, result.SuspendStatus)
+		assert.Empty(t, result.SuspendDetail)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Invalid moderator unsuspend | Comment is not suspended", func(t *testing.T) {
+		copyComment := commentDomain
+		topicDomain := topics.Domain{Id: threadDomain.TopicID, ModeratorIDs: []primitive.ObjectID{moderatorDomain.Id}}
+		commentRepository.On("GetByID", copyComment.Id).Return(copyComment, nil).Once()
+		userRepository.On("GetByID", moderatorDomain.Id).Return(moderatorDomain, nil).Once()
+		permissionRepository.On("GetByRole", "moderator").Return(moderatorPermission, nil).Once()
+		threadRepository.On("GetByID", copyComment.ThreadID).Return(threadDomain, nil).Once()
+		topicRepository.On("GetByID", threadDomain.TopicID).Return(topicDomain, nil).Once()
+
+		result, err := commentUseCase.ModeratorUnsuspend(moderatorDomain.Id, copyComment.Id)
+
+		assert.Equal(t, comments.Domain{}, result)
+		assert.Equal(t, errors.New("comment is not suspended"), err)
+	})
+}
+
+func TestModeratorDelete(t *testing.T) {
+	moderatorDomain := userDomain
+	moderatorDomain.Id = primitive.NewObjectID()
+	moderatorDomain.Role = "moderator"
+
+	t.Run("Test case 1 | Valid moderator delete", func(t *testing.T) {
+		copyComment := commentDomain
+		copyComment.ImageURL = ""
+		topicDomain := topics.Domain{Id: threadDomain.TopicID, ModeratorIDs: []primitive.ObjectID{moderatorDomain.Id}}
+		commentRepository.On("GetByID", copyComment.Id).Return(copyComment, nil).Once()
+		userRepository.On("GetByID", moderatorDomain.Id).Return(moderatorDomain, nil).Once()
+		permissionRepository.On("GetByRole", "moderator").Return(moderatorPermission, nil).Once()
+		threadRepository.On("GetByID", copyComment.ThreadID).Return(threadDomain, nil).Once()
+		topicRepository.On("GetByID", threadDomain.TopicID).Return(topicDomain, nil).Once()
+		commentRepository.On("Delete", copyComment.Id).Return(nil).Once()
+
+		result, err := commentUseCase.ModeratorDelete(moderatorDomain.Id, copyComment.Id)
+
+		assert.Equal(t, copyComment, result)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Invalid moderator delete | Regular user", func(t *testing.T) {
+		regularUser := moderatorDomain
+		regularUser.Role = "user"
+		topicDomain := topics.Domain{Id: threadDomain.TopicID, ModeratorIDs: []primitive.ObjectID{regularUser.Id}}
+		commentRepository.On("GetByID", commentDomain.Id).Return(commentDomain, nil).Once()
+		userRepository.On("GetByID", regularUser.Id).Return(regularUser, nil).Once()
+		permissionRepository.On("GetByRole", "user").Return(userPermission, nil).Once()
+		threadRepository.On("GetByID", commentDomain.ThreadID).Return(threadDomain, nil).Once()
+		topicRepository.On("GetByID", threadDomain.TopicID).Return(topicDomain, nil).Once()
+
+		result, err := commentUseCase.ModeratorDelete(regularUser.Id, commentDomain.Id)
+
+		assert.Equal(t, comments.Domain{}, result)
+		assert.Equal(t, errors.New("user is not a moderator of this topic"), err)
+	})
+
+	t.Run("Test case 3 | Invalid moderator delete | Comment not found", func(t *testing.T) {
+		commentRepository.On("GetByID", commentDomain.Id).Return(comments.Domain{}, errors.New("not found")).Once()
+
+		result, err := commentUseCase.ModeratorDelete(moderatorDomain.Id, commentDomain.Id)
+
+		assert.Equal(t, comments.Domain{}, result)
+		assert.Equal(t, errors.New("failed to get comment"), err)
+	})
+}
+
+func TestRestoreAllByThreadID(t *testing.T) {
+	t.Run("Test case 1 | Valid restore all by thread id", func(t *testing.T) {
+		commentRepository.On("RestoreAllByThreadID", commentDomain.ThreadID).Return(nil).Once()
+
+		err := commentUseCase.RestoreAllByThreadID(commentDomain.ThreadID)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Invalid restore all by thread id | Repository error", func(t *testing.T) {
+		commentRepository.On("RestoreAllByThreadID", commentDomain.ThreadID).Return(errors.New("unexpected error")).Once()
+
+		err := commentUseCase.RestoreAllByThreadID(commentDomain.ThreadID)
+
+		assert.Equal(t, errors.New("failed to restore thread's comments"), err)
+	})
+}
+
+func TestPurgeAllByThreadID(t *testing.T) {
+	t.Run("Test case 1 | Valid purge all by thread id", func(t *testing.T) {
+		commentRepository.On("GetTrashedByThreadID", commentDomain.ThreadID).Return([]comments.Domain{commentDomain}, nil).Once()
+		cloudinaryRepository.On("Delete", "comment", mock.Anything).Return(nil).Once()
+		commentRepository.On("DeleteAllByThreadID", commentDomain.ThreadID).Return(nil).Once()
+
+		err := commentUseCase.PurgeAllByThreadID(commentDomain.ThreadID)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Invalid purge all by thread id | Failed to get trashed comments", func(t *testing.T) {
+		commentRepository.On("GetTrashedByThreadID", commentDomain.ThreadID).Return([]comments.Domain{}, errors.New("unexpected error")).Once()
+
+		err := commentUseCase.PurgeAllByThreadID(commentDomain.ThreadID)
+
+		assert.Equal(t, errors.New("failed to get thread's comments"), err)
+	})
+
+	t.Run("Test case 3 | Invalid purge all by thread id | Failed to delete image", func(t *testing.T) {
+		commentRepository.On("GetTrashedByThreadID", commentDomain.ThreadID).Return([]comments.Domain{commentDomain}, nil).Once()
+		cloudinaryRepository.On("Delete", "comment", mock.Anything).Return(errors.New("unexpected error")).Once()
+
+		err := commentUseCase.PurgeAllByThreadID(commentDomain.ThreadID)
+
+		assert.Equal(t, errors.New("failed to delete image"), err)
+	})
+
+	t.Run("Test case 4 | Invalid purge all by thread id | Failed to delete comments", func(t *testing.T) {
+		commentRepository.On("GetTrashedByThreadID", commentDomain.ThreadID).Return([]comments.Domain{commentDomain}, nil).Once()
+		cloudinaryRepository.On("Delete", "comment", mock.Anything).Return(nil).Once()
+		commentRepository.On("DeleteAllByThreadID", commentDomain.ThreadID).Return(errors.New("unexpected error")).Once()
+
+		err := commentUseCase.PurgeAllByThreadID(commentDomain.ThreadID)
+
+		assert.Equal(t, errors.New("failed to delete thread's comments"), err)
+	})
+}
@@ -0,0 +1,47 @@
+package data_exports
+
+import "go.mongodb.org/mongo-driver/bson/primitive"
+
+const (
+	StatusPending = "pending"
+	StatusReady   = "ready"
+	StatusFailed  = "failed"
+)
+
+type Domain struct {
+	Id        primitive.ObjectID `json:"_id" bson:"_id"`
+	UserID    primitive.ObjectID `json:"userID" bson:"userID"`
+	Status    string             `json:"status" bson:"status"`
+	Token     string             `json:"-" bson:"token"`
+	Archive   []byte             `json:"-" bson:"-"`
+	ExpiredAt primitive.DateTime `json:"expiredAt" bson:"expiredAt"`
+	CreatedAt primitive.DateTime `json:"createdAt" bson:"createdAt"`
+	UpdatedAt primitive.DateTime `json:"updatedAt" bson:"updatedAt"`
+}
+
+type Repository interface {
+	// Create
+	Create(domain *Domain) (Domain, error)
+	// Read
+	GetByID(id primitive.ObjectID) (Domain, error)
+	GetByToken(token string) (Domain, error)
+	GetLatestByUserID(userID primitive.ObjectID) (Domain, error)
+	GetAllByStatus(status string) ([]Domain, error)
+	// Update
+	Update(domain *Domain) (Domain, error)
+	// Delete
+	DeleteExpired(now primitive.DateTime) error
+	DeleteAllByUserID(userID primitive.ObjectID) error
+}
+
+type UseCase interface {
+	// Create
+	Request(userID primitive.ObjectID) (Domain, error)
+	// Read
+	GetLatestByUserID(userID primitive.ObjectID) (Domain, error)
+	Download(token string) (Domain, error)
+	// Update
+	ProcessPending() error
+	// Delete
+	DeleteAllByUserID(userID primitive.ObjectID) error
+}
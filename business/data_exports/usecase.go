@@ -0,0 +1,313 @@
+package data_exports
+
+import (
+	"archive/zip"
+	"bytes"
+	"charum/business/bookmarks"
+	"charum/business/comments"
+	"charum/business/follow_threads"
+	"charum/business/reports"
+	"charum/business/threads"
+	"charum/business/users"
+	_mailgun "charum/helper/mailgun"
+	"charum/util"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	tokenLength     = 80
+	linkDuration    = 48 * time.Hour
+	requestCooldown = 24 * time.Hour
+)
+
+type DataExportUseCase struct {
+	dataExportRepository   Repository
+	userRepository         users.Repository
+	threadRepository       threads.Repository
+	commentRepository      comments.Repository
+	bookmarkRepository     bookmarks.Repository
+	followThreadRepository follow_threads.Repository
+	reportRepository       reports.Repository
+	mailgun                _mailgun.Function
+}
+
+func NewDataExportUseCase(der Repository, ur users.Repository, tr threads.Repository, cr comments.Repository, br bookmarks.Repository, ftr follow_threads.Repository, rr reports.Repository, mg _mailgun.Function) UseCase {
+	return &DataExportUseCase{
+		dataExportRepository:   der,
+		userRepository:         ur,
+		threadRepository:       tr,
+		commentRepository:      cr,
+		bookmarkRepository:     br,
+		followThreadRepository: ftr,
+		reportRepository:       rr,
+		mailgun:                mg,
+	}
+}
+
+type like struct {
+	ThreadID primitive.ObjectID `json:"threadID"`
+	Title    string             `json:"title"`
+	LikedAt  primitive.DateTime `json:"likedAt"`
+}
+
+type archiveFile struct {
+	name string
+	data interface{}
+}
+
+/*
+Create
+*/
+
+// Request queues an export of the user's data, the archive itself is built in the background by ProcessPending
+func (deu *DataExportUseCase) Request(userID primitive.ObjectID) (Domain, error) {
+	_, err := deu.userRepository.GetByID(userID)
+	if err != nil {
+		return Domain{}, errors.New("failed to get user")
+	}
+
+	latest, err := deu.dataExportRepository.GetLatestByUserID(userID)
+	if err == nil {
+		if latest.Status == StatusPending {
+			return Domain{}, errors.New("data export is already in progress")
+		}
+
+		if latest.Status == StatusReady && latest.CreatedAt.Time().Add(requestCooldown).After(time.Now()) {
+			return Domain{}, errors.New("please wait before requesting another data export")
+		}
+	}
+
+	result, err := deu.dataExportRepository.Create(&Domain{
+		Id:        primitive.NewObjectID(),
+		UserID:    userID,
+		Status:    StatusPending,
+		CreatedAt: primitive.NewDateTimeFromTime(time.Now()),
+		UpdatedAt: primitive.NewDateTimeFromTime(time.Now()),
+	})
+	if err != nil {
+		return Domain{}, errors.New("failed to request data export")
+	}
+
+	return result, nil
+}
+
+/*
+Read
+*/
+
+func (deu *DataExportUseCase) GetLatestByUserID(userID primitive.ObjectID) (Domain, error) {
+	result, err := deu.dataExportRepository.GetLatestByUserID(userID)
+	if err != nil {
+		return Domain{}, errors.New("data export not found")
+	}
+
+	return result, nil
+}
+
+func (deu *DataExportUseCase) Download(token string) (Domain, error) {
+	result, err := deu.dataExportRepository.GetByToken(util.HashToken(token))
+	if err != nil || result.Status != StatusReady {
+		return Domain{}, errors.New("invalid download link")
+	}
+
+	if result.ExpiredAt.Time().Before(time.Now()) {
+		return Domain{}, errors.New("download link is expired")
+	}
+
+	return result, nil
+}
+
+/*
+Update
+*/
+
+// ProcessPending builds the archive of every queued export and emails its download link, expired archives are removed along the way
+func (deu *DataExportUseCase) ProcessPending() error {
+	err := deu.dataExportRepository.DeleteExpired(primitive.NewDateTimeFromTime(time.Now()))
+	if err != nil {
+		return errors.New("failed to delete expired data exports")
+	}
+
+	pending, err := deu.dataExportRepository.GetAllByStatus(StatusPending)
+	if err != nil {
+		return errors.New("failed to get data exports")
+	}
+
+	failed := false
+	for _, export := range pending {
+		err = deu.process(export)
+		if err != nil {
+			failed = true
+		}
+	}
+
+	if failed {
+		return errors.New("failed to process data exports")
+	}
+
+	return nil
+}
+
+func (deu *DataExportUseCase) process(export Domain) error {
+	user, err := deu.userRepository.GetByID(export.UserID)
+	if err != nil {
+		return deu.markAsFailed(export)
+	}
+
+	archive, err := deu.buildArchive(user)
+	if err != nil {
+		return deu.markAsFailed(export)
+	}
+
+	// only the hash of the token is stored, the token itself is only sent to the user's email
+	token, err := util.GenerateSecureRandomString(tokenLength)
+	if err != nil {
+		return deu.markAsFailed(export)
+	}
+
+	export.Status = StatusReady
+	export.Token = util.HashToken(token)
+	export.Archive = archive
+	export.ExpiredAt = primitive.NewDateTimeFromTime(time.Now().Add(linkDuration))
+	export.UpdatedAt = primitive.NewDateTimeFromTime(time.Now())
+	export, err = deu.dataExportRepository.Update(&export)
+	if err != nil {
+		return errors.New("failed to update data export")
+	}
+
+	_, err = deu.mailgun.SendDataExportMail(user.Email, token)
+	if err != nil {
+		return deu.markAsFailed(export)
+	}
+
+	return nil
+}
+
+// markAsFailed drops whatever was built so far, the user can request a new export afterwards
+func (deu *DataExportUseCase) markAsFailed(export Domain) error {
+	export.Status = StatusFailed
+	export.Token = ""
+	export.Archive = nil
+	export.UpdatedAt = primitive.NewDateTimeFromTime(time.Now())
+	_, err := deu.dataExportRepository.Update(&export)
+	if err != nil {
+		return errors.New("failed to update data export")
+	}
+
+	return errors.New("failed to build data export")
+}
+
+/*
+Delete
+*/
+
+// DeleteAllByUserID removes the user's exports with their archives, a deleted user's data must not stay downloadable
+func (deu *DataExportUseCase) DeleteAllByUserID(userID primitive.ObjectID) error {
+	err := deu.dataExportRepository.DeleteAllByUserID(userID)
+	if err != nil {
+		return errors.New("failed to delete data exports")
+	}
+
+	return nil
+}
+
+func (deu *DataExportUseCase) buildArchive(user users.Domain) ([]byte, error) {
+	createdThreads, err := deu.threadRepository.GetAllByUserID(user.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	createdComments, err := deu.commentRepository.GetAllByUserID(user.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	likedThreads, err := deu.threadRepository.GetLikedByUserID(user.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	userBookmarks, err := deu.bookmarkRepository.GetAllByUserID(user.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	followedThreads, err := deu.followThreadRepository.GetAllByUserID(user.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	filedReports, err := deu.reportRepository.GetAllByUserID(user.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	likes := []like{}
+	for _, thread := range likedThreads {
+		for _, threadLike := range thread.Likes {
+			if threadLike.UserID == user.Id {
+				likes = append(likes, like{
+					ThreadID: thread.Id,
+					Title:    thread.Title,
+					LikedAt:  threadLike.Timestamp,
+				})
+			}
+		}
+	}
+
+	images := []string{}
+	if user.ProfilePictureURL != "" {
+		images = append(images, user.ProfilePictureURL)
+	}
+	for _, thread := range createdThreads {
+		if thread.ImageURL != "" {
+			images = append(images, thread.ImageURL)
+		}
+	}
+	for _, comment := range createdComments {
+		if comment.ImageURL != "" {
+			images = append(images, comment.ImageURL)
+		}
+	}
+
+	files := []archiveFile{
+		{name: "profile.json", data: user},
+		{name: "threads.json", data: createdThreads},
+		{name: "comments.json", data: createdComments},
+		{name: "likes.json", data: likes},
+		{name: "bookmarks.json", data: userBookmarks},
+		{name: "followed_threads.json", data: followedThreads},
+		{name: "reports.json", data: filedReports},
+		{name: "images.json", data: images},
+	}
+
+	buffer := new(bytes.Buffer)
+	writer := zip.NewWriter(buffer)
+	for _, file := range files {
+		content, err := json.MarshalIndent(file.data, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+
+		entry, err := writer.Create(file.name)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = entry.Write(content)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = writer.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
@@ -0,0 +1,375 @@
+package data_exports_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"charum/business/bookmarks"
+	_bookmarkMock "charum/business/bookmarks/mocks"
+	"charum/business/comments"
+	_commentMock "charum/business/comments/mocks"
+	"charum/business/data_exports"
+	_dataExportMock "charum/business/data_exports/mocks"
+	"charum/business/follow_threads"
+	_followThreadMock "charum/business/follow_threads/mocks"
+	"charum/business/reports"
+	_reportMock "charum/business/reports/mocks"
+	"charum/business/threads"
+	_threadMock "charum/business/threads/mocks"
+	"charum/business/users"
+	_userMock "charum/business/users/mocks"
+	_mailgunMock "charum/helper/mailgun/mocks"
+	"charum/util"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var (
+	dataExportRepository   _dataExportMock.Repository
+	userRepository         _userMock.Repository
+	threadRepository       _threadMock.Repository
+	commentRepository      _commentMock.Repository
+	bookmarkRepository     _bookmarkMock.Repository
+	followThreadRepository _followThreadMock.Repository
+	reportRepository       _reportMock.Repository
+	mailgun                _mailgunMock.Function
+	dataExportUseCase      data_exports.UseCase
+	dataExportDomain       data_exports.Domain
+	userDomain             users.Domain
+	threadDomain           threads.Domain
+)
+
+func TestMain(m *testing.M) {
+	dataExportUseCase = data_exports.NewDataExportUseCase(&dataExportRepository, &userRepository, &threadRepository, &commentRepository, &bookmarkRepository, &followThreadRepository, &reportRepository, &mailgun)
+
+	userDomain = users.Domain{
+		Id:                primitive.NewObjectID(),
+		Email:             "test@charum.com",
+		UserName:          "tester",
+		Password:          "hashed password",
+		ProfilePictureURL: "profile-picture",
+		Role:              "user",
+		IsActive:          true,
+		CreatedAt:         primitive.NewDateTimeFromTime(time.Now()),
+		UpdatedAt:         primitive.NewDateTimeFromTime(time.Now()),
+	}
+
+	threadDomain = threads.Domain{
+		Id:        primitive.NewObjectID(),
+		TopicID:   primitive.NewObjectID(),
+		CreatorID: userDomain.Id,
+		Title:     "Test Thread",
+		Likes: []threads.Like{
+			{
+				UserID:    userDomain.Id,
+				Timestamp: primitive.NewDateTimeFromTime(time.Now()),
+			},
+		},
+		ImageURL:  "thread-image",
+		CreatedAt: primitive.NewDateTimeFromTime(time.Now()),
+		UpdatedAt: primitive.NewDateTimeFromTime(time.Now()),
+	}
+
+	dataExportDomain = data_exports.Domain{
+		Id:        primitive.NewObjectID(),
+		UserID:    userDomain.Id,
+		Status:    data_exports.StatusPending,
+		CreatedAt: primitive.NewDateTimeFromTime(time.Now()),
+		UpdatedAt: primitive.NewDateTimeFromTime(time.Now()),
+	}
+
+	m.Run()
+}
+
+func readArchive(archive []byte) map[string]string {
+	files := map[string]string{}
+	reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		return files
+	}
+
+	for _, file := range reader.File {
+		content, err := file.Open()
+		if err != nil {
+			continue
+		}
+
+		data, _ := io.ReadAll(content)
+		content.Close()
+		files[file.Name] = string(data)
+	}
+
+	return files
+}
+
+func TestRequest(t *testing.T) {
+	t.Run("Test case 1 | Valid request", func(t *testing.T) {
+		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
+		dataExportRepository.On("GetLatestByUserID", userDomain.Id).Return(data_exports.Domain{}, errors.New("not found")).Once()
+		dataExportRepository.On("Create", mock.MatchedBy(func(domain *data_exports.Domain) bool {
+			return domain.UserID == userDomain.Id && domain.Status == data_exports.StatusPending
+		})).Return(dataExportDomain, nil).Once()
+
+		result, err := dataExportUseCase.Request(userDomain.Id)
+
+		assert.Equal(t, dataExportDomain, result)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Valid request | Previous export has failed", func(t *testing.T) {
+		failedExport := dataExportDomain
+		failedExport.Status = data_exports.StatusFailed
+		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
+		dataExportRepository.On("GetLatestByUserID", userDomain.Id).Return(failedExport, nil).Once()
+		dataExportRepository.On("Create", mock.Anything).Return(dataExportDomain, nil).Once()
+
+		result, err := dataExportUseCase.Request(userDomain.Id)
+
+		assert.Equal(t, dataExportDomain, result)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 3 | Invalid request | Failed to get user", func(t *testing.T) {
+		expectedErr := errors.New("failed to get user")
+		userRepository.On("GetByID", userDomain.Id).Return(users.Domain{}, errors.New("not found")).Once()
+
+		result, err := dataExportUseCase.Request(userDomain.Id)
+
+		assert.Equal(t, data_exports.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 4 | Invalid request | Export is already in progress", func(t *testing.T) {
+		expectedErr := errors.New("data export is already in progress")
+		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
+		dataExportRepository.On("GetLatestByUserID", userDomain.Id).Return(dataExportDomain, nil).Once()
+
+		result, err := dataExportUseCase.Request(userDomain.Id)
+
+		assert.Equal(t, data_exports.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 5 | Invalid request | Previous export is too recent", func(t *testing.T) {
+		expectedErr := errors.New("please wait before requesting another data export")
+		readyExport := dataExportDomain
+		readyExport.Status = data_exports.StatusReady
+		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
+		dataExportRepository.On("GetLatestByUserID", userDomain.Id).Return(readyExport, nil).Once()
+
+		result, err := dataExportUseCase.Request(userDomain.Id)
+
+		assert.Equal(t, data_exports.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 6 | Invalid request | Failed to create export", func(t *testing.T) {
+		expectedErr := errors.New("failed to request data export")
+		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
+		dataExportRepository.On("GetLatestByUserID", userDomain.Id).Return(data_exports.Domain{}, errors.New("not found")).Once()
+		dataExportRepository.On("Create", mock.Anything).Return(data_exports.Domain{}, errors.New("unexpected error")).Once()
+
+		result, err := dataExportUseCase.Request(userDomain.Id)
+
+		assert.Equal(t, data_exports.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestGetLatestByUserID(t *testing.T) {
+	t.Run("Test case 1 | Valid get latest export", func(t *testing.T) {
+		dataExportRepository.On("GetLatestByUserID", userDomain.Id).Return(dataExportDomain, nil).Once()
+
+		result, err := dataExportUseCase.GetLatestByUserID(userDomain.Id)
+
+		assert.Equal(t, dataExportDomain, result)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Invalid get latest export | Export not found", func(t *testing.T) {
+		expectedErr := errors.New("data export not found")
+		dataExportRepository.On("GetLatestByUserID", userDomain.Id).Return(data_exports.Domain{}, errors.New("not found")).Once()
+
+		result, err := dataExportUseCase.GetLatestByUserID(userDomain.Id)
+
+		assert.Equal(t, data_exports.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestDownload(t *testing.T) {
+	readyExport := dataExportDomain
+	readyExport.Status = data_exports.StatusReady
+	readyExport.Token = util.HashToken("token")
+	readyExport.Archive = []byte("archive")
+	readyExport.ExpiredAt = primitive.NewDateTimeFromTime(time.Now().Add(time.Hour))
+
+	t.Run("Test case 1 | Valid download", func(t *testing.T) {
+		dataExportRepository.On("GetByToken", util.HashToken("token")).Return(readyExport, nil).Once()
+
+		result, err := dataExportUseCase.Download("token")
+
+		assert.Equal(t, readyExport, result)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Invalid download | Unknown token", func(t *testing.T) {
+		expectedErr := errors.New("invalid download link")
+		dataExportRepository.On("GetByToken", util.HashToken("unknown")).Return(data_exports.Domain{}, errors.New("not found")).Once()
+
+		result, err := dataExportUseCase.Download("unknown")
+
+		assert.Equal(t, data_exports.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 3 | Invalid download | Export has failed", func(t *testing.T) {
+		expectedErr := errors.New("invalid download link")
+		failedExport := readyExport
+		failedExport.Status = data_exports.StatusFailed
+		dataExportRepository.On("GetByToken", util.HashToken("token")).Return(failedExport, nil).Once()
+
+		result, err := dataExportUseCase.Download("token")
+
+		assert.Equal(t, data_exports.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 4 | Invalid download | Link is expired", func(t *testing.T) {
+		expectedErr := errors.New("download link is expired")
+		expiredExport := readyExport
+		expiredExport.ExpiredAt = primitive.NewDateTimeFromTime(time.Now().Add(-time.Hour))
+		dataExportRepository.On("GetByToken", util.HashToken("token")).Return(expiredExport, nil).Once()
+
+		result, err := dataExportUseCase.Download("token")
+
+		assert.Equal(t, data_exports.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestProcessPending(t *testing.T) {
+	commentDomain := comments.Domain{
+		Id:       primitive.NewObjectID(),
+		ThreadID: threadDomain.Id,
+		UserID:   userDomain.Id,
+		Comment:  "Test Comment",
+		ImageURL: "comment-image",
+	}
+	bookmarkDomain := bookmarks.Domain{Id: primitive.NewObjectID(), UserID: userDomain.Id, ThreadID: threadDomain.Id}
+	followThreadDomain := follow_threads.Domain{Id: primitive.NewObjectID(), UserID: userDomain.Id, ThreadID: threadDomain.Id}
+	reportDomain := reports.Domain{Id: primitive.NewObjectID(), UserID: userDomain.Id, ReportedID: threadDomain.Id, ReportedType: "thread"}
+
+	expectUserData := func() {
+		threadRepository.On("GetAllByUserID", userDomain.Id).Return([]threads.Domain{threadDomain}, nil).Once()
+		commentRepository.On("GetAllByUserID", userDomain.Id).Return([]comments.Domain{commentDomain}, nil).Once()
+		threadRepository.On("GetLikedByUserID", userDomain.Id).Return([]threads.Domain{threadDomain}, nil).Once()
+		bookmarkRepository.On("GetAllByUserID", userDomain.Id).Return([]bookmarks.Domain{bookmarkDomain}, nil).Once()
+		followThreadRepository.On("GetAllByUserID", userDomain.Id).Return([]follow_threads.Domain{followThreadDomain}, nil).Once()
+		reportRepository.On("GetAllByUserID", userDomain.Id).Return([]reports.Domain{reportDomain}, nil).Once()
+	}
+
+	t.Run("Test case 1 | Valid process pending | Archive is built and emailed", func(t *testing.T) {
+		var archive []byte
+		dataExportRepository.On("DeleteExpired", mock.Anything).Return(nil).Once()
+		dataExportRepository.On("GetAllByStatus", data_exports.StatusPending).Return([]data_exports.Domain{dataExportDomain}, nil).Once()
+		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
+		expectUserData()
+		dataExportRepository.On("Update", mock.MatchedBy(func(domain *data_exports.Domain) bool {
+			archive = domain.Archive
+			return domain.Status == data_exports.StatusReady && domain.Token != "" && domain.ExpiredAt.Time().After(time.Now())
+		})).Return(dataExportDomain, nil).Once()
+		mailgun.On("SendDataExportMail", userDomain.Email, mock.Anything).Return("", nil).Once()
+
+		err := dataExportUseCase.ProcessPending()
+
+		assert.Nil(t, err)
+		files := readArchive(archive)
+		assert.Len(t, files, 8)
+		assert.Contains(t, files["profile.json"], userDomain.Email)
+		assert.NotContains(t, files["profile.json"], userDomain.Password)
+		assert.Contains(t, files["likes.json"], threadDomain.Id.Hex())
+		assert.Contains(t, files["reports.json"], reportDomain.Id.Hex())
+		for _, image := range []string{"profile-picture", "thread-image", "comment-image"} {
+			assert.True(t, strings.Contains(files["images.json"], image))
+		}
+	})
+
+	t.Run("Test case 2 | Invalid process pending | Failed to delete expired exports", func(t *testing.T) {
+		expectedErr := errors.New("failed to delete expired data exports")
+		dataExportRepository.On("DeleteExpired", mock.Anything).Return(errors.New("unexpected error")).Once()
+
+		err := dataExportUseCase.ProcessPending()
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 3 | Invalid process pending | Failed to get pending exports", func(t *testing.T) {
+		expectedErr := errors.New("failed to get data exports")
+		dataExportRepository.On("DeleteExpired", mock.Anything).Return(nil).Once()
+		dataExportRepository.On("GetAllByStatus", data_exports.StatusPending).Return([]data_exports.Domain{}, errors.New("unexpected error")).Once()
+
+		err := dataExportUseCase.ProcessPending()
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 4 | Invalid process pending | Export is marked as failed when the data cannot be read", func(t *testing.T) {
+		expectedErr := errors.New("failed to process data exports")
+		dataExportRepository.On("DeleteExpired", mock.Anything).Return(nil).Once()
+		dataExportRepository.On("GetAllByStatus", data_exports.StatusPending).Return([]data_exports.Domain{dataExportDomain}, nil).Once()
+		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
+		threadRepository.On("GetAllByUserID", userDomain.Id).Return([]threads.Domain{}, errors.New("unexpected error")).Once()
+		dataExportRepository.On("Update", mock.MatchedBy(func(domain *data_exports.Domain) bool {
+			return domain.Status == data_exports.StatusFailed && domain.Archive == nil
+		})).Return(dataExportDomain, nil).Once()
+
+		err := dataExportUseCase.ProcessPending()
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 5 | Invalid process pending | Export is marked as failed when the email cannot be sent", func(t *testing.T) {
+		expectedErr := errors.New("failed to process data exports")
+		dataExportRepository.On("DeleteExpired", mock.Anything).Return(nil).Once()
+		dataExportRepository.On("GetAllByStatus", data_exports.StatusPending).Return([]data_exports.Domain{dataExportDomain}, nil).Once()
+		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
+		expectUserData()
+		dataExportRepository.On("Update", mock.MatchedBy(func(domain *data_exports.Domain) bool {
+			return domain.Status == data_exports.StatusReady
+		})).Return(dataExportDomain, nil).Once()
+		mailgun.On("SendDataExportMail", userDomain.Email, mock.Anything).Return("", errors.New("unexpected error")).Once()
+		dataExportRepository.On("Update", mock.MatchedBy(func(domain *data_exports.Domain) bool {
+			return domain.Status == data_exports.StatusFailed && domain.Token == ""
+		})).Return(dataExportDomain, nil).Once()
+
+		err := dataExportUseCase.ProcessPending()
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestDeleteAllByUserID(t *testing.T) {
+	t.Run("Test case 1 | Valid delete all by user id", func(t *testing.T) {
+		dataExportRepository.On("DeleteAllByUserID", userDomain.Id).Return(nil).Once()
+
+		err := dataExportUseCase.DeleteAllByUserID(userDomain.Id)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Test case 2 | Invalid delete all by user id | Error when deleting data exports", func(t *testing.T) {
+		expectedErr := errors.New("failed to delete data exports")
+		dataExportRepository.On("DeleteAllByUserID", userDomain.Id).Return(errors.New("unexpected error")).Once()
+
+		err := dataExportUseCase.DeleteAllByUserID(userDomain.Id)
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
@@ -26,6 +26,8 @@ type Repository interface {
 	// Update
 	AddOneNotification(threadID primitive.ObjectID) error
 	ResetNotification(threadID primitive.ObjectID, userID primitive.ObjectID) error
+	SoftDeleteAllByThreadID(threadID primitive.ObjectID, deletedAt primitive.DateTime) error
+	RestoreAllByThreadID(threadID primitive.ObjectID) error
 	// Delete
 	Delete(id primitive.ObjectID) error
 	DeleteAllByUserID(id primitive.ObjectID) error
@@ -44,6 +46,7 @@ type UseCase interface {
 	// Update
 	UpdateNotification(threadID primitive.ObjectID) error
 	ResetNotification(threadID primitive.ObjectID, userID primitive.ObjectID) error
+	RestoreAllByThreadID(threadID primitive.ObjectID) error
 	// Delete
 	Delete(domain *Domain) (Domain, error)
 	DeleteAllByUserID(id primitive.ObjectID) error
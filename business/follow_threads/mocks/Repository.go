@@ -192,6 +192,34 @@ func (_m *Repository) ResetNotification(threadID primitive.ObjectID, userID prim
 	return r0
 }
 
+// RestoreAllByThreadID provides a mock function with given fields: threadID
+func (_m *Repository) RestoreAllByThreadID(threadID primitive.ObjectID) error {
+	ret := _m.Called(threadID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID) error); ok {
+		r0 = rf(threadID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SoftDeleteAllByThreadID provides a mock function with given fields: threadID, deletedAt
+func (_m *Repository) SoftDeleteAllByThreadID(threadID primitive.ObjectID, deletedAt primitive.DateTime) error {
+	ret := _m.Called(threadID, deletedAt)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID, primitive.DateTime) error); ok {
+		r0 = rf(threadID, deletedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewRepository interface {
 	mock.TestingT
 	Cleanup(func())
@@ -16,6 +16,27 @@ type UseCase struct {
 	mock.Mock
 }
 
+// CheckFollowedThread provides a mock function with given fields: userID, threadID
+func (_m *UseCase) CheckFollowedThread(userID primitive.ObjectID, threadID primitive.ObjectID) (bool, error) {
+	ret := _m.Called(userID, threadID)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID, primitive.ObjectID) bool); ok {
+		r0 = rf(userID, threadID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(primitive.ObjectID, primitive.ObjectID) error); ok {
+		r1 = rf(userID, threadID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CountByThreadID provides a mock function with given fields: threadID
 func (_m *UseCase) CountByThreadID(threadID primitive.ObjectID) (int, error) {
 	ret := _m.Called(threadID)
@@ -107,20 +128,20 @@ func (_m *UseCase) DeleteAllByUserID(id primitive.ObjectID) error {
 	return r0
 }
 
-// DomainToResponse provides a mock function with given fields: domain
-func (_m *UseCase) DomainToResponse(domain follow_threads.Domain) (dtofollow_threads.Response, error) {
-	ret := _m.Called(domain)
+// DomainToResponse provides a mock function with given fields: domain, userID
+func (_m *UseCase) DomainToResponse(domain follow_threads.Domain, userID primitive.ObjectID) (dtofollow_threads.Response, error) {
+	ret := _m.Called(domain, userID)
 
 	var r0 dtofollow_threads.Response
-	if rf, ok := ret.Get(0).(func(follow_threads.Domain) dtofollow_threads.Response); ok {
-		r0 = rf(domain)
+	if rf, ok := ret.Get(0).(func(follow_threads.Domain, primitive.ObjectID) dtofollow_threads.Response); ok {
+		r0 = rf(domain, userID)
 	} else {
 		r0 = ret.Get(0).(dtofollow_threads.Response)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(follow_threads.Domain) error); ok {
-		r1 = rf(domain)
+	if rf, ok := ret.Get(1).(func(follow_threads.Domain, primitive.ObjectID) error); ok {
+		r1 = rf(domain, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -128,13 +149,13 @@ func (_m *UseCase) DomainToResponse(domain follow_threads.Domain) (dtofollow_thr
 	return r0, r1
 }
 
-// DomainToResponseArray provides a mock function with given fields: domain
-func (_m *UseCase) DomainToResponseArray(domain []follow_threads.Domain) ([]dtofollow_threads.Response, error) {
-	ret := _m.Called(domain)
+// DomainToResponseArray provides a mock function with given fields: domains, userID
+func (_m *UseCase) DomainToResponseArray(domains []follow_threads.Domain, userID primitive.ObjectID) ([]dtofollow_threads.Response, error) {
+	ret := _m.Called(domains, userID)
 
 	var r0 []dtofollow_threads.Response
-	if rf, ok := ret.Get(0).(func([]follow_threads.Domain) []dtofollow_threads.Response); ok {
-		r0 = rf(domain)
+	if rf, ok := ret.Get(0).(func([]follow_threads.Domain, primitive.ObjectID) []dtofollow_threads.Response); ok {
+		r0 = rf(domains, userID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]dtofollow_threads.Response)
@@ -142,8 +163,8 @@ func (_m *UseCase) DomainToResponseArray(domain []follow_threads.Domain) ([]dtof
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func([]follow_threads.Domain) error); ok {
-		r1 = rf(domain)
+	if rf, ok := ret.Get(1).(func([]follow_threads.Domain, primitive.ObjectID) error); ok {
+		r1 = rf(domains, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -188,6 +209,20 @@ func (_m *UseCase) ResetNotification(threadID primitive.ObjectID, userID primiti
 	return r0
 }
 
+// RestoreAllByThreadID provides a mock function with given fields: threadID
+func (_m *UseCase) RestoreAllByThreadID(threadID primitive.ObjectID) error {
+	ret := _m.Called(threadID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID) error); ok {
+		r0 = rf(threadID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateNotification provides a mock function with given fields: threadID
 func (_m *UseCase) UpdateNotification(threadID primitive.ObjectID) error {
 	ret := _m.Called(threadID)
@@ -5,6 +5,7 @@ import (
 	"charum/business/threads"
 	"charum/business/users"
 	dtoFollowThread "charum/dto/follow_threads"
+	dtoUser "charum/dto/users"
 	"errors"
 	"time"
 
@@ -118,7 +119,7 @@ func (ftu *FollowThreadUseCase) DomainToResponse(domain Domain, userID primitive
 
 	response := dtoFollowThread.Response{
 		Id:           domain.Id,
-		User:         user,
+		User:         dtoUser.FromDomain(user),
 		Thread:       responseThread,
 		Notification: domain.Notification,
 		CreatedAt:    domain.CreatedAt.Time(),
@@ -165,6 +166,15 @@ func (ftu *FollowThreadUseCase) ResetNotification(threadID primitive.ObjectID, u
 	return nil
 }
 
+func (ftu *FollowThreadUseCase) RestoreAllByThreadID(threadID primitive.ObjectID) error {
+	err := ftu.followThreadRepository.RestoreAllByThreadID(threadID)
+	if err != nil {
+		return errors.New("failed to restore all follow thread")
+	}
+
+	return nil
+}
+
 /*
 Delete
 */
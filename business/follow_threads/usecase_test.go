@@ -12,6 +12,7 @@ import (
 	_userMock "charum/business/users/mocks"
 	dtoFollowThread "charum/dto/follow_threads"
 	dtoThreads "charum/dto/threads"
+	dtoUser "charum/dto/users"
 	"errors"
 	"testing"
 	"time"
@@ -91,7 +92,7 @@ func TestMain(m *testing.M) {
 	responseThread = dtoThreads.Response{
 		Id:            threadDomain.Id,
 		Topic:         topicDomain,
-		Creator:       userDomain,
+		Creator:       dtoUser.FromDomain(userDomain),
 		Title:         threadDomain.Title,
 		Description:   threadDomain.Description,
 		Likes:         []dtoThreads.Like{},
@@ -452,3 +453,21 @@ func TestDeleteAllByThreadID(t *testing.T) {
 		assert.NotNil(t, err)
 	})
 }
+
+func TestRestoreAllByThreadID(t *testing.T) {
+	t.Run("Test Case 1 | Valid Restore All By Thread ID", func(t *testing.T) {
+		followThreadRepositoryMock.On("RestoreAllByThreadID", followThreadDomain.ThreadID).Return(nil).Once()
+
+		err := followThreadUseCase.RestoreAllByThreadID(followThreadDomain.ThreadID)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test Case 2 | Invalid Restore All By Thread ID", func(t *testing.T) {
+		followThreadRepositoryMock.On("RestoreAllByThreadID", followThreadDomain.ThreadID).Return(errors.New("unexpected error")).Once()
+
+		err := followThreadUseCase.RestoreAllByThreadID(followThreadDomain.ThreadID)
+
+		assert.NotNil(t, err)
+	})
+}
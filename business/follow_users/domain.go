@@ -0,0 +1,49 @@
+package follow_users
+
+import (
+	"charum/business/threads"
+	"charum/business/users"
+	dtoPagination "charum/dto/pagination"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type Domain struct {
+	Id          primitive.ObjectID `json:"_id" bson:"_id"`
+	FollowerID  primitive.ObjectID `json:"followerID" bson:"followerID"`
+	FollowingID primitive.ObjectID `json:"followingID" bson:"followingID"`
+	CreatedAt   primitive.DateTime `json:"createdAt" bson:"createdAt"`
+	UpdatedAt   primitive.DateTime `json:"updatedAt" bson:"updatedAt"`
+}
+
+type Repository interface {
+	// Create
+	Create(domain *Domain) (Domain, error)
+	// Read
+	GetByID(id primitive.ObjectID) (Domain, error)
+	GetByFollowerIDAndFollowingID(followerID primitive.ObjectID, followingID primitive.ObjectID) (Domain, error)
+	GetAllByFollowerID(followerID primitive.ObjectID) ([]Domain, error)
+	GetAllByFollowingID(followingID primitive.ObjectID) ([]Domain, error)
+	CountByFollowerID(followerID primitive.ObjectID) (int, error)
+	CountByFollowingID(followingID primitive.ObjectID) (int, error)
+	// Delete
+	Delete(id primitive.ObjectID) error
+	DeleteBetweenUsers(userID primitive.ObjectID, otherUserID primitive.ObjectID) error
+	DeleteAllByUserID(userID primitive.ObjectID) error
+}
+
+type UseCase interface {
+	// Create
+	Create(domain *Domain) (Domain, error)
+	// Read
+	GetFollowers(userID primitive.ObjectID) ([]users.Domain, error)
+	GetFollowing(userID primitive.ObjectID) ([]users.Domain, error)
+	CountFollowers(userID primitive.ObjectID) (int, error)
+	CountFollowing(userID primitive.ObjectID) (int, error)
+	CheckFollowedUser(followerID primitive.ObjectID, followingID primitive.ObjectID) (bool, error)
+	GetFeed(pagination dtoPagination.Request, userID primitive.ObjectID) ([]threads.Domain, int, int, error)
+	// Delete
+	Delete(domain *Domain) (Domain, error)
+	DeleteBetweenUsers(userID primitive.ObjectID, otherUserID primitive.ObjectID) error
+	DeleteAllByUserID(userID primitive.ObjectID) error
+}
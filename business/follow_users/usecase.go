@@ -0,0 +1,234 @@
+package follow_users
+
+import (
+	"charum/business/threads"
+	userBlocks "charum/business/user_blocks"
+	"charum/business/users"
+	dtoPagination "charum/dto/pagination"
+	dtoQuery "charum/dto/query"
+	"errors"
+	"math"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type FollowUserUseCase struct {
+	followUserRepository Repository
+	userRepository       users.Repository
+	userBlockRepository  userBlocks.Repository
+	threadRepository     threads.Repository
+}
+
+func NewFollowUserUseCase(fur Repository, ur users.Repository, ubr userBlocks.Repository, tr threads.Repository) UseCase {
+	return &FollowUserUseCase{
+		followUserRepository: fur,
+		userRepository:       ur,
+		userBlockRepository:  ubr,
+		threadRepository:     tr,
+	}
+}
+
+/*
+Create
+*/
+
+func (fuu *FollowUserUseCase) Create(domain *Domain) (Domain, error) {
+	if domain.FollowerID == domain.FollowingID {
+		return Domain{}, errors.New("user cannot follow themselves")
+	}
+
+	_, err := fuu.followUserRepository.GetByFollowerIDAndFollowingID(domain.FollowerID, domain.FollowingID)
+	if err == nil {
+		return Domain{}, errors.New("user already follow this user")
+	}
+
+	_, err = fuu.userRepository.GetByID(domain.FollowerID)
+	if err != nil {
+		return Domain{}, errors.New("failed to get user")
+	}
+
+	_, err = fuu.userRepository.GetByID(domain.FollowingID)
+	if err != nil {
+		return Domain{}, errors.New("failed to get followed user")
+	}
+
+	_, err = fuu.userBlockRepository.GetByUserIDTargetIDAndType(domain.FollowingID, domain.FollowerID, userBlocks.TypeBlock)
+	if err == nil {
+		return Domain{}, errors.New("user is blocked by this user")
+	}
+
+	domain.Id = primitive.NewObjectID()
+	domain.CreatedAt = primitive.NewDateTimeFromTime(time.Now())
+	domain.UpdatedAt = primitive.NewDateTimeFromTime(time.Now())
+
+	result, err := fuu.followUserRepository.Create(domain)
+	if err != nil {
+		return Domain{}, errors.New("failed to follow user")
+	}
+
+	return result, nil
+}
+
+/*
+Read
+*/
+
+func (fuu *FollowUserUseCase) GetFollowers(userID primitive.ObjectID) ([]users.Domain, error) {
+	follows, err := fuu.followUserRepository.GetAllByFollowingID(userID)
+	if err != nil {
+		return []users.Domain{}, errors.New("failed to get followers")
+	}
+
+	followers := []users.Domain{}
+	for _, follow := range follows {
+		follower, err := fuu.userRepository.GetByID(follow.FollowerID)
+		if err != nil {
+			return []users.Domain{}, errors.New("failed to get user")
+		}
+
+		followers = append(followers, follower)
+	}
+
+	return followers, nil
+}
+
+func (fuu *FollowUserUseCase) GetFollowing(userID primitive.ObjectID) ([]users.Domain, error) {
+	follows, err := fuu.followUserRepository.GetAllByFollowerID(userID)
+	if err != nil {
+		return []users.Domain{}, errors.New("failed to get following")
+	}
+
+	following := []users.Domain{}
+	for _, follow := range follows {
+		user, err := fuu.userRepository.GetByID(follow.FollowingID)
+		if err != nil {
+			return []users.Domain{}, errors.New("failed to get user")
+		}
+
+		following = append(following, user)
+	}
+
+	return following, nil
+}
+
+func (fuu *FollowUserUseCase) CountFollowers(userID primitive.ObjectID) (int, error) {
+	result, err := fuu.followUserRepository.CountByFollowingID(userID)
+	if err != nil {
+		return 0, errors.New("failed to count followers")
+	}
+
+	return result, nil
+}
+
+func (fuu *FollowUserUseCase) CountFollowing(userID primitive.ObjectID) (int, error) {
+	result, err := fuu.followUserRepository.CountByFollowerID(userID)
+	if err != nil {
+		return 0, errors.New("failed to count following")
+	}
+
+	return result, nil
+}
+
+func (fuu *FollowUserUseCase) CheckFollowedUser(followerID primitive.ObjectID, followingID primitive.ObjectID) (bool, error) {
+	_, err := fuu.followUserRepository.GetByFollowerIDAndFollowingID(followerID, followingID)
+	if err == nil {
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// GetFeed returns the threads created by the users the user follows
+func (fuu *FollowUserUseCase) GetFeed(pagination dtoPagination.Request, userID primitive.ObjectID) ([]threads.Domain, int, int, error) {
+	follows, err := fuu.followUserRepository.GetAllByFollowerID(userID)
+	if err != nil {
+		return []threads.Domain{}, 0, 0, errors.New("failed to get following")
+	}
+
+	if len(follows) == 0 {
+		return []threads.Domain{}, 0, 0, nil
+	}
+
+	// a followed user can still be muted, their threads stay out of the feed like the ones of blocked users
+	blocks, err := fuu.userBlockRepository.GetAllByUserID(userID)
+	if err != nil {
+		return []threads.Domain{}, 0, 0, errors.New("failed to get hidden users")
+	}
+
+	hidden := map[primitive.ObjectID]bool{}
+	for _, block := range blocks {
+		hidden[block.TargetID] = true
+	}
+
+	creatorIDs := []primitive.ObjectID{}
+	for _, follow := range follows {
+		if !hidden[follow.FollowingID] {
+			creatorIDs = append(creatorIDs, follow.FollowingID)
+		}
+	}
+
+	if len(creatorIDs) == 0 {
+		return []threads.Domain{}, 0, 0, nil
+	}
+
+	var orderInMongo int
+	if pagination.Order == "asc" {
+		orderInMongo = 1
+	} else {
+		orderInMongo = -1
+	}
+
+	query := dtoQuery.Request{
+		Skip:  pagination.Limit * (pagination.Page - 1),
+		Limit: pagination.Limit,
+		Order: orderInMongo,
+		Sort:  pagination.Sort,
+	}
+
+	feed, totalData, err := fuu.threadRepository.GetManyByCreatorIDs(query, creatorIDs)
+	if err != nil {
+		return []threads.Domain{}, 0, 0, errors.New("failed to get threads")
+	}
+
+	totalPage := math.Ceil(float64(totalData) / float64(pagination.Limit))
+
+	return feed, int(totalPage), totalData, nil
+}
+
+/*
+Delete
+*/
+
+func (fuu *FollowUserUseCase) Delete(domain *Domain) (Domain, error) {
+	result, err := fuu.followUserRepository.GetByFollowerIDAndFollowingID(domain.FollowerID, domain.FollowingID)
+	if err != nil {
+		return Domain{}, errors.New("user does not follow this user")
+	}
+
+	err = fuu.followUserRepository.Delete(result.Id)
+	if err != nil {
+		return Domain{}, errors.New("failed to unfollow user")
+	}
+
+	return result, nil
+}
+
+// DeleteBetweenUsers removes the follows between both users regardless of who follows who
+func (fuu *FollowUserUseCase) DeleteBetweenUsers(userID primitive.ObjectID, otherUserID primitive.ObjectID) error {
+	err := fuu.followUserRepository.DeleteBetweenUsers(userID, otherUserID)
+	if err != nil {
+		return errors.New("failed to delete follow user")
+	}
+
+	return nil
+}
+
+func (fuu *FollowUserUseCase) DeleteAllByUserID(userID primitive.ObjectID) error {
+	err := fuu.followUserRepository.DeleteAllByUserID(userID)
+	if err != nil {
+		return errors.New("failed to delete all follow user")
+	}
+
+	return nil
+}
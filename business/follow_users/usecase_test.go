@@ -0,0 +1,416 @@
+package follow_users_test
+
+import (
+	followUsers "charum/business/follow_users"
+	_followUserMock "charum/business/follow_users/mocks"
+	"charum/business/threads"
+	_threadMock "charum/business/threads/mocks"
+	userBlocks "charum/business/user_blocks"
+	_userBlockMock "charum/business/user_blocks/mocks"
+	"charum/business/users"
+	_userMock "charum/business/users/mocks"
+	dtoPagination "charum/dto/pagination"
+	dtoQuery "charum/dto/query"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var (
+	followUserRepository _followUserMock.Repository
+	userRepository       _userMock.Repository
+	userBlockRepository  _userBlockMock.Repository
+	threadRepository     _threadMock.Repository
+	followUserUseCase    followUsers.UseCase
+	followUserDomain     followUsers.Domain
+	followerDomain       users.Domain
+	followingDomain      users.Domain
+	threadDomain         threads.Domain
+)
+
+func TestMain(m *testing.M) {
+	followUserUseCase = followUsers.NewFollowUserUseCase(&followUserRepository, &userRepository, &userBlockRepository, &threadRepository)
+
+	followerDomain = users.Domain{
+		Id:        primitive.NewObjectID(),
+		Email:     "follower@charum.com",
+		UserName:  "follower",
+		Role:      "user",
+		IsActive:  true,
+		CreatedAt: primitive.NewDateTimeFromTime(time.Now()),
+		UpdatedAt: primitive.NewDateTimeFromTime(time.Now()),
+	}
+
+	followingDomain = users.Domain{
+		Id:        primitive.NewObjectID(),
+		Email:     "following@charum.com",
+		UserName:  "following",
+		Role:      "user",
+		IsActive:  true,
+		CreatedAt: primitive.NewDateTimeFromTime(time.Now()),
+		UpdatedAt: primitive.NewDateTimeFromTime(time.Now()),
+	}
+
+	followUserDomain = followUsers.Domain{
+		Id:          primitive.NewObjectID(),
+		FollowerID:  followerDomain.Id,
+		FollowingID: followingDomain.Id,
+		CreatedAt:   primitive.NewDateTimeFromTime(time.Now()),
+		UpdatedAt:   primitive.NewDateTimeFromTime(time.Now()),
+	}
+
+	threadDomain = threads.Domain{
+		Id:        primitive.NewObjectID(),
+		TopicID:   primitive.NewObjectID(),
+		CreatorID: followingDomain.Id,
+		Title:     "Test Thread",
+		CreatedAt: primitive.NewDateTimeFromTime(time.Now()),
+		UpdatedAt: primitive.NewDateTimeFromTime(time.Now()),
+	}
+
+	m.Run()
+}
+
+func TestCreate(t *testing.T) {
+	t.Run("Test case 1 | Valid follow user", func(t *testing.T) {
+		followUserRepository.On("GetByFollowerIDAndFollowingID", followerDomain.Id, followingDomain.Id).Return(followUsers.Domain{}, errors.New("not found")).Once()
+		userRepository.On("GetByID", followerDomain.Id).Return(followerDomain, nil).Once()
+		userRepository.On("GetByID", followingDomain.Id).Return(followingDomain, nil).Once()
+		userBlockRepository.On("GetByUserIDTargetIDAndType", followingDomain.Id, followerDomain.Id, userBlocks.TypeBlock).Return(userBlocks.Domain{}, errors.New("not found")).Once()
+		followUserRepository.On("Create", mock.Anything).Return(followUserDomain, nil).Once()
+
+		result, err := followUserUseCase.Create(&followUsers.Domain{FollowerID: followerDomain.Id, FollowingID: followingDomain.Id})
+
+		assert.Equal(t, followUserDomain, result)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Invalid follow user | User follows themselves", func(t *testing.T) {
+		expectedErr := errors.New("user cannot follow themselves")
+
+		result, err := followUserUseCase.Create(&followUsers.Domain{FollowerID: followerDomain.Id, FollowingID: followerDomain.Id})
+
+		assert.Equal(t, followUsers.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 3 | Invalid follow user | User is already followed", func(t *testing.T) {
+		expectedErr := errors.New("user already follow this user")
+		followUserRepository.On("GetByFollowerIDAndFollowingID", followerDomain.Id, followingDomain.Id).Return(followUserDomain, nil).Once()
+
+		result, err := followUserUseCase.Create(&followUsers.Domain{FollowerID: followerDomain.Id, FollowingID: followingDomain.Id})
+
+		assert.Equal(t, followUsers.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 4 | Invalid follow user | Failed to get user", func(t *testing.T) {
+		expectedErr := errors.New("failed to get user")
+		followUserRepository.On("GetByFollowerIDAndFollowingID", followerDomain.Id, followingDomain.Id).Return(followUsers.Domain{}, errors.New("not found")).Once()
+		userRepository.On("GetByID", followerDomain.Id).Return(users.Domain{}, errors.New("not found")).Once()
+
+		result, err := followUserUseCase.Create(&followUsers.Domain{FollowerID: followerDomain.Id, FollowingID: followingDomain.Id})
+
+		assert.Equal(t, followUsers.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 5 | Invalid follow user | Failed to get followed user", func(t *testing.T) {
+		expectedErr := errors.New("failed to get followed user")
+		followUserRepository.On("GetByFollowerIDAndFollowingID", followerDomain.Id, followingDomain.Id).Return(followUsers.Domain{}, errors.New("not found")).Once()
+		userRepository.On("GetByID", followerDomain.Id).Return(followerDomain, nil).Once()
+		userRepository.On("GetByID", followingDomain.Id).Return(users.Domain{}, errors.New("not found")).Once()
+
+		result, err := followUserUseCase.Create(&followUsers.Domain{FollowerID: followerDomain.Id, FollowingID: followingDomain.Id})
+
+		assert.Equal(t, followUsers.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 6 | Invalid follow user | Failed to create follow", func(t *testing.T) {
+		expectedErr := errors.New("failed to follow user")
+		followUserRepository.On("GetByFollowerIDAndFollowingID", followerDomain.Id, followingDomain.Id).Return(followUsers.Domain{}, errors.New("not found")).Once()
+		userRepository.On("GetByID", followerDomain.Id).Return(followerDomain, nil).Once()
+		userRepository.On("GetByID", followingDomain.Id).Return(followingDomain, nil).Once()
+		userBlockRepository.On("GetByUserIDTargetIDAndType", followingDomain.Id, followerDomain.Id, userBlocks.TypeBlock).Return(userBlocks.Domain{}, errors.New("not found")).Once()
+		followUserRepository.On("Create", mock.Anything).Return(followUsers.Domain{}, errors.New("unexpected error")).Once()
+
+		result, err := followUserUseCase.Create(&followUsers.Domain{FollowerID: followerDomain.Id, FollowingID: followingDomain.Id})
+
+		assert.Equal(t, followUsers.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("Test case 7 | Invalid follow user | User is blocked by the followed user", func(t *testing.T) {
+		expectedErr := errors.New("user is blocked by this user")
+		followUserRepository.On("GetByFollowerIDAndFollowingID", followerDomain.Id, followingDomain.Id).Return(followUsers.Domain{}, errors.New("not found")).Once()
+		userRepository.On("GetByID", followerDomain.Id).Return(followerDomain, nil).Once()
+		userRepository.On("GetByID", followingDomain.Id).Return(followingDomain, nil).Once()
+		userBlockRepository.On("GetByUserIDTargetIDAndType", followingDomain.Id, followerDomain.Id, userBlocks.TypeBlock).Return(userBlocks.Domain{Id: primitive.NewObjectID()}, nil).Once()
+
+		result, err := followUserUseCase.Create(&followUsers.Domain{FollowerID: followerDomain.Id, FollowingID: followingDomain.Id})
+
+		assert.Equal(t, followUsers.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestGetFollowers(t *testing.T) {
+	t.Run("Test case 1 | Valid get followers", func(t *testing.T) {
+		followUserRepository.On("GetAllByFollowingID", followingDomain.Id).Return([]followUsers.Domain{followUserDomain}, nil).Once()
+		userRepository.On("GetByID", followerDomain.Id).Return(followerDomain, nil).Once()
+
+		result, err := followUserUseCase.GetFollowers(followingDomain.Id)
+
+		assert.Equal(t, []users.Domain{followerDomain}, result)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Invalid get followers | Failed to get followers", func(t *testing.T) {
+		expectedErr := errors.New("failed to get followers")
+		followUserRepository.On("GetAllByFollowingID", followingDomain.Id).Return([]followUsers.Domain{}, errors.New("unexpected error")).Once()
+
+		result, err := followUserUseCase.GetFollowers(followingDomain.Id)
+
+		assert.Equal(t, []users.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 3 | Invalid get followers | Failed to get user", func(t *testing.T) {
+		expectedErr := errors.New("failed to get user")
+		followUserRepository.On("GetAllByFollowingID", followingDomain.Id).Return([]followUsers.Domain{followUserDomain}, nil).Once()
+		userRepository.On("GetByID", followerDomain.Id).Return(users.Domain{}, errors.New("not found")).Once()
+
+		result, err := followUserUseCase.GetFollowers(followingDomain.Id)
+
+		assert.Equal(t, []users.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestGetFollowing(t *testing.T) {
+	t.Run("Test case 1 | Valid get following", func(t *testing.T) {
+		followUserRepository.On("GetAllByFollowerID", followerDomain.Id).Return([]followUsers.Domain{followUserDomain}, nil).Once()
+		userRepository.On("GetByID", followingDomain.Id).Return(followingDomain, nil).Once()
+
+		result, err := followUserUseCase.GetFollowing(followerDomain.Id)
+
+		assert.Equal(t, []users.Domain{followingDomain}, result)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Invalid get following | Failed to get following", func(t *testing.T) {
+		expectedErr := errors.New("failed to get following")
+		followUserRepository.On("GetAllByFollowerID", followerDomain.Id).Return([]followUsers.Domain{}, errors.New("unexpected error")).Once()
+
+		result, err := followUserUseCase.GetFollowing(followerDomain.Id)
+
+		assert.Equal(t, []users.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestCount(t *testing.T) {
+	t.Run("Test case 1 | Valid count followers and following", func(t *testing.T) {
+		followUserRepository.On("CountByFollowingID", followingDomain.Id).Return(3, nil).Once()
+		followUserRepository.On("CountByFollowerID", followingDomain.Id).Return(2, nil).Once()
+
+		totalFollowers, followersErr := followUserUseCase.CountFollowers(followingDomain.Id)
+		totalFollowing, followingErr := followUserUseCase.CountFollowing(followingDomain.Id)
+
+		assert.Equal(t, 3, totalFollowers)
+		assert.Equal(t, 2, totalFollowing)
+		assert.Nil(t, followersErr)
+		assert.Nil(t, followingErr)
+	})
+
+	t.Run("Test case 2 | Invalid count followers | Failed to count followers", func(t *testing.T) {
+		expectedErr := errors.New("failed to count followers")
+		followUserRepository.On("CountByFollowingID", followingDomain.Id).Return(0, errors.New("unexpected error")).Once()
+
+		result, err := followUserUseCase.CountFollowers(followingDomain.Id)
+
+		assert.Equal(t, 0, result)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestCheckFollowedUser(t *testing.T) {
+	t.Run("Test case 1 | User is followed", func(t *testing.T) {
+		followUserRepository.On("GetByFollowerIDAndFollowingID", followerDomain.Id, followingDomain.Id).Return(followUserDomain, nil).Once()
+
+		result, err := followUserUseCase.CheckFollowedUser(followerDomain.Id, followingDomain.Id)
+
+		assert.True(t, result)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | User is not followed", func(t *testing.T) {
+		followUserRepository.On("GetByFollowerIDAndFollowingID", followerDomain.Id, followingDomain.Id).Return(followUsers.Domain{}, errors.New("not found")).Once()
+
+		result, err := followUserUseCase.CheckFollowedUser(followerDomain.Id, followingDomain.Id)
+
+		assert.False(t, result)
+		assert.Nil(t, err)
+	})
+}
+
+func TestGetFeed(t *testing.T) {
+	pagination := dtoPagination.Request{
+		Page:  1,
+		Limit: 10,
+		Sort:  "createdAt",
+		Order: "desc",
+	}
+
+	t.Run("Test case 1 | Valid get feed", func(t *testing.T) {
+		followUserRepository.On("GetAllByFollowerID", followerDomain.Id).Return([]followUsers.Domain{followUserDomain}, nil).Once()
+		userBlockRepository.On("GetAllByUserID", followerDomain.Id).Return([]userBlocks.Domain{}, nil).Once()
+		query := dtoQuery.Request{Skip: 0, Limit: 10, Sort: "createdAt", Order: -1}
+		threadRepository.On("GetManyByCreatorIDs", query, []primitive.ObjectID{followingDomain.Id}).Return([]threads.Domain{threadDomain}, 1, nil).Once()
+
+		result, totalPage, totalData, err := followUserUseCase.GetFeed(pagination, followerDomain.Id)
+
+		assert.Equal(t, []threads.Domain{threadDomain}, result)
+		assert.Equal(t, 1, totalPage)
+		assert.Equal(t, 1, totalData)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Valid get feed | User does not follow anyone", func(t *testing.T) {
+		followUserRepository.On("GetAllByFollowerID", followingDomain.Id).Return([]followUsers.Domain{}, nil).Once()
+
+		result, totalPage, totalData, err := followUserUseCase.GetFeed(pagination, followingDomain.Id)
+
+		assert.Equal(t, []threads.Domain{}, result)
+		assert.Equal(t, 0, totalPage)
+		assert.Equal(t, 0, totalData)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 3 | Invalid get feed | Failed to get following", func(t *testing.T) {
+		expectedErr := errors.New("failed to get following")
+		followUserRepository.On("GetAllByFollowerID", followerDomain.Id).Return([]followUsers.Domain{}, errors.New("unexpected error")).Once()
+
+		result, totalPage, totalData, err := followUserUseCase.GetFeed(pagination, followerDomain.Id)
+
+		assert.Equal(t, []threads.Domain{}, result)
+		assert.Equal(t, 0, totalPage)
+		assert.Equal(t, 0, totalData)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 4 | Invalid get feed | Failed to get threads", func(t *testing.T) {
+		expectedErr := errors.New("failed to get threads")
+		followUserRepository.On("GetAllByFollowerID", followerDomain.Id).Return([]followUsers.Domain{followUserDomain}, nil).Once()
+		userBlockRepository.On("GetAllByUserID", followerDomain.Id).Return([]userBlocks.Domain{}, nil).Once()
+		threadRepository.On("GetManyByCreatorIDs", mock.Anything, mock.Anything).Return([]threads.Domain{}, 0, errors.New("unexpected error")).Once()
+
+		result, totalPage, totalData, err := followUserUseCase.GetFeed(pagination, followerDomain.Id)
+
+		assert.Equal(t, []threads.Domain{}, result)
+		assert.Equal(t, 0, totalPage)
+		assert.Equal(t, 0, totalData)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 5 | Valid get feed | Threads of muted users are left out", func(t *testing.T) {
+		mute := userBlocks.Domain{UserID: followerDomain.Id, TargetID: followingDomain.Id, Type: userBlocks.TypeMute}
+		followUserRepository.On("GetAllByFollowerID", followerDomain.Id).Return([]followUsers.Domain{followUserDomain}, nil).Once()
+		userBlockRepository.On("GetAllByUserID", followerDomain.Id).Return([]userBlocks.Domain{mute}, nil).Once()
+
+		result, totalPage, totalData, err := followUserUseCase.GetFeed(pagination, followerDomain.Id)
+
+		assert.Equal(t, []threads.Domain{}, result)
+		assert.Equal(t, 0, totalPage)
+		assert.Equal(t, 0, totalData)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 6 | Invalid get feed | Failed to get hidden users", func(t *testing.T) {
+		expectedErr := errors.New("failed to get hidden users")
+		followUserRepository.On("GetAllByFollowerID", followerDomain.Id).Return([]followUsers.Domain{followUserDomain}, nil).Once()
+		userBlockRepository.On("GetAllByUserID", followerDomain.Id).Return([]userBlocks.Domain{}, errors.New("unexpected error")).Once()
+
+		result, totalPage, totalData, err := followUserUseCase.GetFeed(pagination, followerDomain.Id)
+
+		assert.Equal(t, []threads.Domain{}, result)
+		assert.Equal(t, 0, totalPage)
+		assert.Equal(t, 0, totalData)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestDelete(t *testing.T) {
+	t.Run("Test case 1 | Valid unfollow user", func(t *testing.T) {
+		followUserRepository.On("GetByFollowerIDAndFollowingID", followerDomain.Id, followingDomain.Id).Return(followUserDomain, nil).Once()
+		followUserRepository.On("Delete", followUserDomain.Id).Return(nil).Once()
+
+		result, err := followUserUseCase.Delete(&followUsers.Domain{FollowerID: followerDomain.Id, FollowingID: followingDomain.Id})
+
+		assert.Equal(t, followUserDomain, result)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Invalid unfollow user | User is not followed", func(t *testing.T) {
+		expectedErr := errors.New("user does not follow this user")
+		followUserRepository.On("GetByFollowerIDAndFollowingID", followerDomain.Id, followingDomain.Id).Return(followUsers.Domain{}, errors.New("not found")).Once()
+
+		result, err := followUserUseCase.Delete(&followUsers.Domain{FollowerID: followerDomain.Id, FollowingID: followingDomain.Id})
+
+		assert.Equal(t, followUsers.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 3 | Invalid unfollow user | Failed to delete follow", func(t *testing.T) {
+		expectedErr := errors.New("failed to unfollow user")
+		followUserRepository.On("GetByFollowerIDAndFollowingID", followerDomain.Id, followingDomain.Id).Return(followUserDomain, nil).Once()
+		followUserRepository.On("Delete", followUserDomain.Id).Return(errors.New("unexpected error")).Once()
+
+		result, err := followUserUseCase.Delete(&followUsers.Domain{FollowerID: followerDomain.Id, FollowingID: followingDomain.Id})
+
+		assert.Equal(t, followUsers.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestDeleteBetweenUsers(t *testing.T) {
+	t.Run("Test case 1 | Valid delete follows between users", func(t *testing.T) {
+		followUserRepository.On("DeleteBetweenUsers", followerDomain.Id, followingDomain.Id).Return(nil).Once()
+
+		err := followUserUseCase.DeleteBetweenUsers(followerDomain.Id, followingDomain.Id)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Invalid delete follows between users | Failed to delete follows", func(t *testing.T) {
+		expectedErr := errors.New("failed to delete follow user")
+		followUserRepository.On("DeleteBetweenUsers", followerDomain.Id, followingDomain.Id).Return(errors.New("unexpected error")).Once()
+
+		err := followUserUseCase.DeleteBetweenUsers(followerDomain.Id, followingDomain.Id)
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestDeleteAllByUserID(t *testing.T) {
+	t.Run("Test case 1 | Valid delete all by user id", func(t *testing.T) {
+		followUserRepository.On("DeleteAllByUserID", followerDomain.Id).Return(nil).Once()
+
+		err := followUserUseCase.DeleteAllByUserID(followerDomain.Id)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Invalid delete all by user id | Failed to delete", func(t *testing.T) {
+		expectedErr := errors.New("failed to delete all follow user")
+		followUserRepository.On("DeleteAllByUserID", followerDomain.Id).Return(errors.New("unexpected error")).Once()
+
+		err := followUserUseCase.DeleteAllByUserID(followerDomain.Id)
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
@@ -1,12 +1,22 @@
 package forgot_password
 
-import "go.mongodb.org/mongo-driver/bson/primitive"
+import (
+	"charum/business/users"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	PurposeResetPassword = "reset-password"
+	PurposeMagicLink     = "magic-link"
+)
 
 type Domain struct {
 	Id        primitive.ObjectID `bson:"_id" json:"id"`
 	Email     string             `json:"email"`
-	Token     string             `json:"token"`
+	Token     string             `json:"-"`
 	Password  string             `json:"-"`
+	Purpose   string             `json:"purpose"`
 	CreatedAt primitive.DateTime `json:"createdAt"`
 	UpdatedAt primitive.DateTime `json:"updatedAt"`
 	ExpiredAt primitive.DateTime `json:"expiredAt"`
@@ -19,8 +29,11 @@ type Repository interface {
 	// Read
 	GetByID(id primitive.ObjectID) (Domain, error)
 	GetByToken(token string) (Domain, error)
+	GetLatestByEmail(email string) (Domain, error)
 	// Update
 	Update(domain *Domain) (Domain, error)
+	MarkAsUsed(id primitive.ObjectID) error
+	InvalidateAllByEmail(email string) error
 	// Delete
 	Delete(id primitive.ObjectID) error
 }
@@ -28,10 +41,13 @@ type Repository interface {
 type UseCase interface {
 	// Create
 	Generate(domain *Domain) (Domain, error)
+	GenerateMagicLink(domain *Domain) (Domain, error)
 	// Read
 	GetByID(id primitive.ObjectID) (Domain, error)
 	GetByToken(token string) (Domain, error)
 	ValidateToken(token string) (Domain, error)
 	// Update
 	UpdatePassword(domain *Domain) (Domain, error)
+	RedeemMagicLink(token string) (users.Domain, error)
+	InvalidateTokens(email string) error
 }
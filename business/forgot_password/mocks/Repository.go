@@ -92,6 +92,55 @@ func (_m *Repository) GetByToken(token string) (forgot_password.Domain, error) {
 	return r0, r1
 }
 
+// GetLatestByEmail provides a mock function with given fields: email
+func (_m *Repository) GetLatestByEmail(email string) (forgot_password.Domain, error) {
+	ret := _m.Called(email)
+
+	var r0 forgot_password.Domain
+	if rf, ok := ret.Get(0).(func(string) forgot_password.Domain); ok {
+		r0 = rf(email)
+	} else {
+		r0 = ret.Get(0).(forgot_password.Domain)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InvalidateAllByEmail provides a mock function with given fields: email
+func (_m *Repository) InvalidateAllByEmail(email string) error {
+	ret := _m.Called(email)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(email)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MarkAsUsed provides a mock function with given fields: id
+func (_m *Repository) MarkAsUsed(id primitive.ObjectID) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields: domain
 func (_m *Repository) Update(domain *forgot_password.Domain) (forgot_password.Domain, error) {
 	ret := _m.Called(domain)
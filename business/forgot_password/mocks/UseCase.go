@@ -8,6 +8,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+
+	users "charum/business/users"
 )
 
 // UseCase is an autogenerated mock type for the UseCase type
@@ -36,6 +38,27 @@ func (_m *UseCase) Generate(domain *forgot_password.Domain) (forgot_password.Dom
 	return r0, r1
 }
 
+// GenerateMagicLink provides a mock function with given fields: domain
+func (_m *UseCase) GenerateMagicLink(domain *forgot_password.Domain) (forgot_password.Domain, error) {
+	ret := _m.Called(domain)
+
+	var r0 forgot_password.Domain
+	if rf, ok := ret.Get(0).(func(*forgot_password.Domain) forgot_password.Domain); ok {
+		r0 = rf(domain)
+	} else {
+		r0 = ret.Get(0).(forgot_password.Domain)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*forgot_password.Domain) error); ok {
+		r1 = rf(domain)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetByID provides a mock function with given fields: id
 func (_m *UseCase) GetByID(id primitive.ObjectID) (forgot_password.Domain, error) {
 	ret := _m.Called(id)
@@ -78,6 +101,41 @@ func (_m *UseCase) GetByToken(token string) (forgot_password.Domain, error) {
 	return r0, r1
 }
 
+// InvalidateTokens provides a mock function with given fields: email
+func (_m *UseCase) InvalidateTokens(email string) error {
+	ret := _m.Called(email)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(email)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RedeemMagicLink provides a mock function with given fields: token
+func (_m *UseCase) RedeemMagicLink(token string) (users.Domain, error) {
+	ret := _m.Called(token)
+
+	var r0 users.Domain
+	if rf, ok := ret.Get(0).(func(string) users.Domain); ok {
+		r0 = rf(token)
+	} else {
+		r0 = ret.Get(0).(users.Domain)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdatePassword provides a mock function with given fields: domain
 func (_m *UseCase) UpdatePassword(domain *forgot_password.Domain) (forgot_password.Domain, error) {
 	ret := _m.Called(domain)
@@ -1,6 +1,7 @@
 package forgot_password
 
 import (
+	"charum/business/refresh_tokens"
 	"charum/business/users"
 	_mailgun "charum/helper/mailgun"
 	"charum/util"
@@ -11,17 +12,26 @@ import (
 	"golang.org/x/crypto/bcrypt"
 )
 
+const (
+	tokenLength       = 80
+	tokenDuration     = 30 * time.Minute
+	magicLinkDuration = 15 * time.Minute
+	generateCooldown  = time.Minute
+)
+
 type ForgotPasswordUseCase struct {
-	forgotPassword Repository
-	userRepository users.Repository
-	mailgun        _mailgun.Function
+	forgotPassword         Repository
+	userRepository         users.Repository
+	refreshTokenRepository refresh_tokens.Repository
+	mailgun                _mailgun.Function
 }
 
-func NewForgotPasswordUseCase(fp Repository, ur users.Repository, mg _mailgun.Function) UseCase {
+func NewForgotPasswordUseCase(fp Repository, ur users.Repository, rtr refresh_tokens.Repository, mg _mailgun.Function) UseCase {
 	return &ForgotPasswordUseCase{
-		forgotPassword: fp,
-		userRepository: ur,
-		mailgun:        mg,
+		forgotPassword:         fp,
+		userRepository:         ur,
+		refreshTokenRepository: rtr,
+		mailgun:                mg,
 	}
 }
 
@@ -35,20 +45,16 @@ func (fpu *ForgotPasswordUseCase) Generate(domain *Domain) (Domain, error) {
 		return Domain{}, errors.New("email is not registered")
 	}
 
-	token := util.GenerateRandomString(80)
-	domain.Id = primitive.NewObjectID()
-	domain.Token = token
-	domain.CreatedAt = primitive.NewDateTimeFromTime(time.Now())
-	domain.UpdatedAt = primitive.NewDateTimeFromTime(time.Now())
-	domain.ExpiredAt = primitive.NewDateTimeFromTime(time.Now().Add(30 * time.Minute))
-	domain.IsUsed = false
+	if fpu.inCooldown(domain.Email) {
+		return Domain{}, errors.New("please wait before requesting another reset token")
+	}
 
-	forgotPassword, err := fpu.forgotPassword.Generate(domain)
+	forgotPassword, token, err := fpu.issueToken(domain, PurposeResetPassword, tokenDuration)
 	if err != nil {
-		return Domain{}, errors.New("failed to reset password")
+		return Domain{}, err
 	}
 
-	_, err = fpu.mailgun.SendMail(domain.Email, domain.Token)
+	_, err = fpu.mailgun.SendMail(domain.Email, token)
 	if err != nil {
 		delErr := fpu.forgotPassword.Delete(domain.Id)
 		if delErr != nil {
@@ -61,6 +67,71 @@ func (fpu *ForgotPasswordUseCase) Generate(domain *Domain) (Domain, error) {
 	return forgotPassword, nil
 }
 
+func (fpu *ForgotPasswordUseCase) GenerateMagicLink(domain *Domain) (Domain, error) {
+	_, err := fpu.userRepository.GetByEmail(domain.Email)
+	if err != nil {
+		return Domain{}, errors.New("email is not registered")
+	}
+
+	if fpu.inCooldown(domain.Email) {
+		return Domain{}, errors.New("please wait before requesting another login link")
+	}
+
+	magicLink, token, err := fpu.issueToken(domain, PurposeMagicLink, magicLinkDuration)
+	if err != nil {
+		return Domain{}, err
+	}
+
+	_, err = fpu.mailgun.SendMagicLinkMail(domain.Email, token)
+	if err != nil {
+		delErr := fpu.forgotPassword.Delete(domain.Id)
+		if delErr != nil {
+			return Domain{}, errors.New("failed to generate login link")
+		}
+
+		return Domain{}, err
+	}
+
+	return magicLink, nil
+}
+
+func (fpu *ForgotPasswordUseCase) inCooldown(email string) bool {
+	latest, err := fpu.forgotPassword.GetLatestByEmail(email)
+	return err == nil && latest.CreatedAt.Time().Add(generateCooldown).After(time.Now())
+}
+
+// issueToken invalidates the outstanding tokens of the email and stores a new one, returning the token that is sent to the user
+func (fpu *ForgotPasswordUseCase) issueToken(domain *Domain, purpose string, duration time.Duration) (Domain, string, error) {
+	err := fpu.forgotPassword.InvalidateAllByEmail(domain.Email)
+	if err != nil {
+		return Domain{}, "", errors.New("failed to invalidate tokens")
+	}
+
+	// only the hash of the token is stored, the token itself is only sent to the user's email
+	token, err := util.GenerateSecureRandomString(tokenLength)
+	if err != nil {
+		return Domain{}, "", errors.New("failed to generate token")
+	}
+
+	domain.Id = primitive.NewObjectID()
+	domain.Token = util.HashToken(token)
+	domain.Purpose = purpose
+	domain.CreatedAt = primitive.NewDateTimeFromTime(time.Now())
+	domain.UpdatedAt = primitive.NewDateTimeFromTime(time.Now())
+	domain.ExpiredAt = primitive.NewDateTimeFromTime(time.Now().Add(duration))
+	domain.IsUsed = false
+
+	result, err := fpu.forgotPassword.Generate(domain)
+	if err != nil {
+		if purpose == PurposeMagicLink {
+			return Domain{}, "", errors.New("failed to generate login link")
+		}
+		return Domain{}, "", errors.New("failed to reset password")
+	}
+
+	return result, token, nil
+}
+
 /*
 Read
 */
@@ -75,7 +146,7 @@ func (fpu *ForgotPasswordUseCase) GetByID(id primitive.ObjectID) (Domain, error)
 }
 
 func (fpu *ForgotPasswordUseCase) GetByToken(token string) (Domain, error) {
-	forgotPassword, err := fpu.forgotPassword.GetByToken(token)
+	forgotPassword, err := fpu.forgotPassword.GetByToken(util.HashToken(token))
 	if err != nil {
 		return Domain{}, errors.New("failed to get token")
 	}
@@ -84,8 +155,12 @@ func (fpu *ForgotPasswordUseCase) GetByToken(token string) (Domain, error) {
 }
 
 func (fpu *ForgotPasswordUseCase) ValidateToken(token string) (Domain, error) {
-	tokenData, err := fpu.forgotPassword.GetByToken(token)
-	if err != nil {
+	return fpu.validateToken(token, PurposeResetPassword)
+}
+
+func (fpu *ForgotPasswordUseCase) validateToken(token string, purpose string) (Domain, error) {
+	tokenData, err := fpu.forgotPassword.GetByToken(util.HashToken(token))
+	if err != nil || tokenData.Purpose != purpose {
 		return Domain{}, errors.New("failed to get token")
 	}
 
@@ -135,5 +210,44 @@ func (fpu *ForgotPasswordUseCase) UpdatePassword(domain *Domain) (Domain, error)
 		return Domain{}, errors.New("failed to update token")
 	}
 
+	err = fpu.forgotPassword.InvalidateAllByEmail(tokenData.Email)
+	if err != nil {
+		return Domain{}, errors.New("failed to invalidate tokens")
+	}
+
+	err = fpu.refreshTokenRepository.RevokeAllByUserID(user.Id)
+	if err != nil {
+		return Domain{}, errors.New("failed to revoke user tokens")
+	}
+
 	return forgotPassword, nil
 }
+
+func (fpu *ForgotPasswordUseCase) RedeemMagicLink(token string) (users.Domain, error) {
+	tokenData, err := fpu.validateToken(token, PurposeMagicLink)
+	if err != nil {
+		return users.Domain{}, err
+	}
+
+	// marking the link as used only succeeds once, so concurrent requests cannot both redeem it
+	err = fpu.forgotPassword.MarkAsUsed(tokenData.Id)
+	if err != nil {
+		return users.Domain{}, errors.New("token has been used")
+	}
+
+	user, err := fpu.userRepository.GetByEmail(tokenData.Email)
+	if err != nil {
+		return users.Domain{}, errors.New("email is not registered")
+	}
+
+	return user, nil
+}
+
+func (fpu *ForgotPasswordUseCase) InvalidateTokens(email string) error {
+	err := fpu.forgotPassword.InvalidateAllByEmail(email)
+	if err != nil {
+		return errors.New("failed to invalidate tokens")
+	}
+
+	return nil
+}
@@ -3,9 +3,11 @@ package forgot_password_test
 import (
 	"charum/business/forgot_password"
 	_forgotPassMock "charum/business/forgot_password/mocks"
+	_refreshTokenMock "charum/business/refresh_tokens/mocks"
 	"charum/business/users"
 	_userMock "charum/business/users/mocks"
 	_mailgunMock "charum/helper/mailgun/mocks"
+	"charum/util"
 	"errors"
 	"testing"
 	"time"
@@ -19,13 +21,14 @@ var (
 	forgotPasswordRepository _forgotPassMock.Repository
 	forgotPasswordUseCase    forgot_password.UseCase
 	userRepository           _userMock.Repository
+	refreshTokenRepository   _refreshTokenMock.Repository
 	mailgun                  _mailgunMock.Function
 	forgotPasswordDomain     forgot_password.Domain
 	userDomain               users.Domain
 )
 
 func TestMain(m *testing.M) {
-	forgotPasswordUseCase = forgot_password.NewForgotPasswordUseCase(&forgotPasswordRepository, &userRepository, &mailgun)
+	forgotPasswordUseCase = forgot_password.NewForgotPasswordUseCase(&forgotPasswordRepository, &userRepository, &refreshTokenRepository, &mailgun)
 
 	userDomain = users.Domain{
 		Id:          primitive.NewObjectID(),
@@ -46,6 +49,8 @@ func TestMain(m *testing.M) {
 func TestGenerate(t *testing.T) {
 	t.Run("Test Case 1 | Valid Generate", func(t *testing.T) {
 		userRepository.On("GetByEmail", forgotPasswordDomain.Email).Return(userDomain, nil).Once()
+		forgotPasswordRepository.On("GetLatestByEmail", forgotPasswordDomain.Email).Return(forgot_password.Domain{}, errors.New("not found")).Once()
+		forgotPasswordRepository.On("InvalidateAllByEmail", forgotPasswordDomain.Email).Return(nil).Once()
 		forgotPasswordRepository.On("Generate", &forgotPasswordDomain).Return(forgotPasswordDomain, nil).Once()
 		mailgun.On("SendMail", mock.Anything, mock.Anything).Return("", nil).Once()
 		_, err := forgotPasswordUseCase.Generate(&forgotPasswordDomain)
@@ -53,8 +58,24 @@ func TestGenerate(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("Test Case 2 | Valid Generate | Only the hash of the token is stored", func(t *testing.T) {
+		var sentToken string
+		userRepository.On("GetByEmail", forgotPasswordDomain.Email).Return(userDomain, nil).Once()
+		forgotPasswordRepository.On("GetLatestByEmail", forgotPasswordDomain.Email).Return(forgot_password.Domain{}, errors.New("not found")).Once()
+		forgotPasswordRepository.On("InvalidateAllByEmail", forgotPasswordDomain.Email).Return(nil).Once()
+		forgotPasswordRepository.On("Generate", &forgotPasswordDomain).Return(forgotPasswordDomain, nil).Once()
+		mailgun.On("SendMail", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			sentToken = args.String(1)
+		}).Return("", nil).Once()
+		_, err := forgotPasswordUseCase.Generate(&forgotPasswordDomain)
+
+		assert.Len(t, sentToken, 80)
+		assert.Equal(t, util.HashToken(sentToken), forgotPasswordDomain.Token)
+		assert.Nil(t, err)
+	})
+
 	// create test that email is not found
-	t.Run("Test Case 2 | Email Not Found", func(t *testing.T) {
+	t.Run("Test Case 3 | Email Not Found", func(t *testing.T) {
 		expectedError := errors.New("email is not registered")
 
 		userRepository.On("GetByEmail", forgotPasswordDomain.Email).Return(users.Domain{}, expectedError).Once()
@@ -63,10 +84,41 @@ func TestGenerate(t *testing.T) {
 		assert.NotNil(t, err)
 	})
 
-	t.Run("Test Case 2 | Failed to Reset Password", func(t *testing.T) {
+	t.Run("Test Case 4 | Invalid Generate | Requested again within the cooldown", func(t *testing.T) {
+		expectedError := errors.New("please wait before requesting another reset token")
+		latest := forgot_password.Domain{
+			Email:     forgotPasswordDomain.Email,
+			CreatedAt: primitive.NewDateTimeFromTime(time.Now().Add(-10 * time.Second)),
+		}
+
+		userRepository.On("GetByEmail", forgotPasswordDomain.Email).Return(userDomain, nil).Once()
+		forgotPasswordRepository.On("GetLatestByEmail", forgotPasswordDomain.Email).Return(latest, nil).Once()
+		_, err := forgotPasswordUseCase.Generate(&forgotPasswordDomain)
+
+		assert.Equal(t, expectedError, err)
+	})
+
+	t.Run("Test Case 5 | Invalid Generate | Failed to invalidate previous tokens", func(t *testing.T) {
+		expectedError := errors.New("failed to invalidate tokens")
+		latest := forgot_password.Domain{
+			Email:     forgotPasswordDomain.Email,
+			CreatedAt: primitive.NewDateTimeFromTime(time.Now().Add(-10 * time.Minute)),
+		}
+
+		userRepository.On("GetByEmail", forgotPasswordDomain.Email).Return(userDomain, nil).Once()
+		forgotPasswordRepository.On("GetLatestByEmail", forgotPasswordDomain.Email).Return(latest, nil).Once()
+		forgotPasswordRepository.On("InvalidateAllByEmail", forgotPasswordDomain.Email).Return(errors.New("unexpected error")).Once()
+		_, err := forgotPasswordUseCase.Generate(&forgotPasswordDomain)
+
+		assert.Equal(t, expectedError, err)
+	})
+
+	t.Run("Test Case 6 | Failed to Reset Password", func(t *testing.T) {
 		expectedError := errors.New("failed to reset password")
 
 		userRepository.On("GetByEmail", forgotPasswordDomain.Email).Return(userDomain, nil).Once()
+		forgotPasswordRepository.On("GetLatestByEmail", forgotPasswordDomain.Email).Return(forgot_password.Domain{}, errors.New("not found")).Once()
+		forgotPasswordRepository.On("InvalidateAllByEmail", forgotPasswordDomain.Email).Return(nil).Once()
 		forgotPasswordRepository.On("Generate", &forgotPasswordDomain).Return(forgot_password.Domain{}, expectedError).Once()
 		_, err := forgotPasswordUseCase.Generate(&forgotPasswordDomain)
 
@@ -81,12 +133,11 @@ func TestValidate(t *testing.T) {
 		CreatedAt: primitive.NewDateTimeFromTime(time.Now()),
 		UpdatedAt: primitive.NewDateTimeFromTime(time.Now()),
 		ExpiredAt: primitive.NewDateTimeFromTime(time.Now().Add(time.Minute * 30)),
+		Purpose:   forgot_password.PurposeResetPassword,
 		IsUsed:    false,
 	}
 	t.Run("Test Case 1 | Valid Validate", func(t *testing.T) {
-		userRepository.On("GetByEmail", forgotPasswordDomain.Email).Return(userDomain, nil).Once()
-		forgotPasswordRepository.On("Generate", &forgotPasswordDomain).Return(forgotPasswordDomain, nil).Once()
-		forgotPasswordRepository.On("GetByToken", forgotPasswordDomain.Token).Return(forgotPasswordDomain, nil).Once()
+		forgotPasswordRepository.On("GetByToken", util.HashToken(forgotPasswordDomain.Token)).Return(forgotPasswordDomain, nil).Once()
 		_, err := forgotPasswordUseCase.ValidateToken(forgotPasswordDomain.Token)
 
 		assert.Nil(t, err)
@@ -95,9 +146,7 @@ func TestValidate(t *testing.T) {
 	// create test that token is not valid
 	t.Run("Test Case 2 | Failed to Get Token", func(t *testing.T) {
 		expectedError := errors.New("failed to get token")
-		userRepository.On("GetByEmail", forgotPasswordDomain.Email).Return(users.Domain{}, nil).Once()
-		forgotPasswordRepository.On("Generate", &forgotPasswordDomain).Return(forgot_password.Domain{}, nil).Once()
-		forgotPasswordRepository.On("GetByToken", forgotPasswordDomain.Token).Return(forgot_password.Domain{}, expectedError).Once()
+		forgotPasswordRepository.On("GetByToken", util.HashToken(forgotPasswordDomain.Token)).Return(forgot_password.Domain{}, expectedError).Once()
 		forgotPasswordDomain.IsUsed = true
 		_, err := forgotPasswordUseCase.ValidateToken(forgotPasswordDomain.Token)
 		assert.Equal(t, expectedError, err)
@@ -106,9 +155,7 @@ func TestValidate(t *testing.T) {
 	// create test that token is expired
 	t.Run("Test Case 3 | Token Expired ", func(t *testing.T) {
 		expectedError := errors.New("token has expired")
-		userRepository.On("GetByEmail", forgotPasswordDomain.Email).Return(users.Domain{}, nil).Once()
-		forgotPasswordRepository.On("Generate", &forgotPasswordDomain).Return(forgot_password.Domain{}, nil).Once()
-		forgotPasswordRepository.On("GetByToken", forgotPasswordDomain.Token).Return(forgot_password.Domain{}, nil).Once()
+		forgotPasswordRepository.On("GetByToken", util.HashToken(forgotPasswordDomain.Token)).Return(forgot_password.Domain{Purpose: forgot_password.PurposeResetPassword}, nil).Once()
 		forgotPasswordDomain.IsUsed = true
 		_, err := forgotPasswordUseCase.ValidateToken(forgotPasswordDomain.Token)
 		assert.Equal(t, expectedError, err)
@@ -117,9 +164,7 @@ func TestValidate(t *testing.T) {
 	// create test that token has been used
 	t.Run("Test Case 4 | Token Has Been Used", func(t *testing.T) {
 		expectedError := errors.New("token has been used")
-		userRepository.On("GetByEmail", forgotPasswordDomain.Email).Return(users.Domain{}, nil).Once()
-		forgotPasswordRepository.On("Generate", &forgotPasswordDomain).Return(forgot_password.Domain{}, nil).Once()
-		forgotPasswordRepository.On("GetByToken", forgotPasswordDomain.Token).Return(forgot_password.Domain{}, nil).Once()
+		forgotPasswordRepository.On("GetByToken", util.HashToken(forgotPasswordDomain.Token)).Return(forgot_password.Domain{}, nil).Once()
 		forgotPasswordDomain.IsUsed = true
 		_, err := forgotPasswordUseCase.ValidateToken(forgotPasswordDomain.Token)
 		assert.NotNil(t, expectedError, err)
@@ -130,7 +175,7 @@ func TestValidate(t *testing.T) {
 // get by token
 func TestGetByToken(t *testing.T) {
 	t.Run("Test Case 1 | Valid Get By Token", func(t *testing.T) {
-		forgotPasswordRepository.On("GetByToken", forgotPasswordDomain.Token).Return(forgotPasswordDomain, nil).Once()
+		forgotPasswordRepository.On("GetByToken", util.HashToken(forgotPasswordDomain.Token)).Return(forgotPasswordDomain, nil).Once()
 		_, err := forgotPasswordUseCase.GetByToken(forgotPasswordDomain.Token)
 
 		assert.Nil(t, err)
@@ -139,7 +184,7 @@ func TestGetByToken(t *testing.T) {
 	// create test that token is not found
 	t.Run("Test Case 2 | Token Not Found", func(t *testing.T) {
 		expectedError := errors.New("failed to get token")
-		forgotPasswordRepository.On("GetByToken", forgotPasswordDomain.Token).Return(forgot_password.Domain{}, expectedError).Once()
+		forgotPasswordRepository.On("GetByToken", util.HashToken(forgotPasswordDomain.Token)).Return(forgot_password.Domain{}, expectedError).Once()
 		_, err := forgotPasswordUseCase.GetByToken(forgotPasswordDomain.Token)
 
 		assert.Equal(t, expectedError, err)
@@ -165,3 +210,207 @@ func TestGetById(t *testing.T) {
 		assert.Equal(t, expectedError, err)
 	})
 }
+
+func TestGenerateMagicLink(t *testing.T) {
+	magicLinkDomain := forgot_password.Domain{
+		Email: userDomain.Email,
+	}
+
+	t.Run("Test Case 1 | Valid Generate Magic Link", func(t *testing.T) {
+		var sentToken string
+		userRepository.On("GetByEmail", magicLinkDomain.Email).Return(userDomain, nil).Once()
+		forgotPasswordRepository.On("GetLatestByEmail", magicLinkDomain.Email).Return(forgot_password.Domain{}, errors.New("not found")).Once()
+		forgotPasswordRepository.On("InvalidateAllByEmail", magicLinkDomain.Email).Return(nil).Once()
+		forgotPasswordRepository.On("Generate", &magicLinkDomain).Return(magicLinkDomain, nil).Once()
+		mailgun.On("SendMagicLinkMail", magicLinkDomain.Email, mock.Anything).Run(func(args mock.Arguments) {
+			sentToken = args.String(1)
+		}).Return("", nil).Once()
+
+		_, err := forgotPasswordUseCase.GenerateMagicLink(&magicLinkDomain)
+
+		assert.Equal(t, forgot_password.PurposeMagicLink, magicLinkDomain.Purpose)
+		assert.Equal(t, util.HashToken(sentToken), magicLinkDomain.Token)
+		assert.True(t, magicLinkDomain.ExpiredAt.Time().Before(time.Now().Add(16*time.Minute)))
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test Case 2 | Invalid Generate Magic Link | Email not registered", func(t *testing.T) {
+		userRepository.On("GetByEmail", magicLinkDomain.Email).Return(users.Domain{}, errors.New("not found")).Once()
+
+		_, err := forgotPasswordUseCase.GenerateMagicLink(&magicLinkDomain)
+
+		assert.Equal(t, errors.New("email is not registered"), err)
+	})
+
+	t.Run("Test Case 3 | Invalid Generate Magic Link | Requested again within the cooldown", func(t *testing.T) {
+		latest := forgot_password.Domain{
+			Email:     magicLinkDomain.Email,
+			CreatedAt: primitive.NewDateTimeFromTime(time.Now()),
+		}
+		userRepository.On("GetByEmail", magicLinkDomain.Email).Return(userDomain, nil).Once()
+		forgotPasswordRepository.On("GetLatestByEmail", magicLinkDomain.Email).Return(latest, nil).Once()
+
+		_, err := forgotPasswordUseCase.GenerateMagicLink(&magicLinkDomain)
+
+		assert.Equal(t, errors.New("please wait before requesting another login link"), err)
+	})
+
+	t.Run("Test Case 4 | Invalid Generate Magic Link | Failed to send email", func(t *testing.T) {
+		expectedError := errors.New("failed to send email")
+		userRepository.On("GetByEmail", magicLinkDomain.Email).Return(userDomain, nil).Once()
+		forgotPasswordRepository.On("GetLatestByEmail", magicLinkDomain.Email).Return(forgot_password.Domain{}, errors.New("not found")).Once()
+		forgotPasswordRepository.On("InvalidateAllByEmail", magicLinkDomain.Email).Return(nil).Once()
+		forgotPasswordRepository.On("Generate", &magicLinkDomain).Return(magicLinkDomain, nil).Once()
+		mailgun.On("SendMagicLinkMail", magicLinkDomain.Email, mock.Anything).Return("", expectedError).Once()
+		forgotPasswordRepository.On("Delete", mock.Anything).Return(nil).Once()
+
+		_, err := forgotPasswordUseCase.GenerateMagicLink(&magicLinkDomain)
+
+		assert.Equal(t, expectedError, err)
+	})
+}
+
+func TestRedeemMagicLink(t *testing.T) {
+	magicLinkDomain := forgot_password.Domain{
+		Id:        primitive.NewObjectID(),
+		Email:     userDomain.Email,
+		Token:     util.HashToken("magic-link-token"),
+		Purpose:   forgot_password.PurposeMagicLink,
+		CreatedAt: primitive.NewDateTimeFromTime(time.Now()),
+		UpdatedAt: primitive.NewDateTimeFromTime(time.Now()),
+		ExpiredAt: primitive.NewDateTimeFromTime(time.Now().Add(time.Minute * 15)),
+		IsUsed:    false,
+	}
+
+	t.Run("Test Case 1 | Valid Redeem Magic Link", func(t *testing.T) {
+		forgotPasswordRepository.On("GetByToken", util.HashToken("magic-link-token")).Return(magicLinkDomain, nil).Once()
+		forgotPasswordRepository.On("MarkAsUsed", magicLinkDomain.Id).Return(nil).Once()
+		userRepository.On("GetByEmail", magicLinkDomain.Email).Return(userDomain, nil).Once()
+
+		user, err := forgotPasswordUseCase.RedeemMagicLink("magic-link-token")
+
+		assert.Equal(t, userDomain, user)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test Case 2 | Invalid Redeem Magic Link | Token has been used", func(t *testing.T) {
+		copyDomain := magicLinkDomain
+		copyDomain.IsUsed = true
+		forgotPasswordRepository.On("GetByToken", util.HashToken("magic-link-token")).Return(copyDomain, nil).Once()
+
+		user, err := forgotPasswordUseCase.RedeemMagicLink("magic-link-token")
+
+		assert.Equal(t, users.Domain{}, user)
+		assert.Equal(t, errors.New("token has been used"), err)
+	})
+
+	t.Run("Test Case 3 | Invalid Redeem Magic Link | Token has expired", func(t *testing.T) {
+		copyDomain := magicLinkDomain
+		copyDomain.ExpiredAt = primitive.NewDateTimeFromTime(time.Now().Add(-time.Minute))
+		forgotPasswordRepository.On("GetByToken", util.HashToken("magic-link-token")).Return(copyDomain, nil).Once()
+
+		user, err := forgotPasswordUseCase.RedeemMagicLink("magic-link-token")
+
+		assert.Equal(t, users.Domain{}, user)
+		assert.Equal(t, errors.New("token has expired"), err)
+	})
+
+	t.Run("Test Case 4 | Invalid Redeem Magic Link | Redeemed concurrently", func(t *testing.T) {
+		forgotPasswordRepository.On("GetByToken", util.HashToken("magic-link-token")).Return(magicLinkDomain, nil).Once()
+		forgotPasswordRepository.On("MarkAsUsed", magicLinkDomain.Id).Return(errors.New("token has been used")).Once()
+
+		user, err := forgotPasswordUseCase.RedeemMagicLink("magic-link-token")
+
+		assert.Equal(t, users.Domain{}, user)
+		assert.Equal(t, errors.New("token has been used"), err)
+	})
+
+	t.Run("Test Case 5 | Invalid Redeem Magic Link | Password reset token", func(t *testing.T) {
+		copyDomain := magicLinkDomain
+		copyDomain.Purpose = forgot_password.PurposeResetPassword
+		forgotPasswordRepository.On("GetByToken", util.HashToken("magic-link-token")).Return(copyDomain, nil).Once()
+
+		user, err := forgotPasswordUseCase.RedeemMagicLink("magic-link-token")
+
+		assert.Equal(t, users.Domain{}, user)
+		assert.Equal(t, errors.New("failed to get token"), err)
+	})
+}
+
+func TestUpdatePassword(t *testing.T) {
+	tokenDomain := forgot_password.Domain{
+		Id:        primitive.NewObjectID(),
+		Email:     userDomain.Email,
+		Token:     util.HashToken("reset-token"),
+		Purpose:   forgot_password.PurposeResetPassword,
+		CreatedAt: primitive.NewDateTimeFromTime(time.Now()),
+		UpdatedAt: primitive.NewDateTimeFromTime(time.Now()),
+		ExpiredAt: primitive.NewDateTimeFromTime(time.Now().Add(time.Minute * 30)),
+		IsUsed:    false,
+	}
+
+	t.Run("Test Case 1 | Valid Update Password", func(t *testing.T) {
+		copyDomain := tokenDomain
+		usedDomain := tokenDomain
+		usedDomain.IsUsed = true
+
+		forgotPasswordRepository.On("GetByToken", util.HashToken("reset-token")).Return(copyDomain, nil).Once()
+		userRepository.On("GetByEmail", copyDomain.Email).Return(userDomain, nil).Once()
+		userRepository.On("UpdatePassword", mock.Anything).Return(userDomain, nil).Once()
+		forgotPasswordRepository.On("Update", mock.Anything).Return(usedDomain, nil).Once()
+		forgotPasswordRepository.On("InvalidateAllByEmail", copyDomain.Email).Return(nil).Once()
+		refreshTokenRepository.On("RevokeAllByUserID", userDomain.Id).Return(nil).Once()
+
+		result, err := forgotPasswordUseCase.UpdatePassword(&forgot_password.Domain{Token: "reset-token", Password: "NewPassword"})
+
+		assert.Equal(t, usedDomain, result)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test Case 2 | Invalid Update Password | Token has been used", func(t *testing.T) {
+		copyDomain := tokenDomain
+		copyDomain.IsUsed = true
+
+		forgotPasswordRepository.On("GetByToken", util.HashToken("reset-token")).Return(copyDomain, nil).Once()
+
+		result, err := forgotPasswordUseCase.UpdatePassword(&forgot_password.Domain{Token: "reset-token", Password: "NewPassword"})
+
+		assert.Equal(t, forgot_password.Domain{}, result)
+		assert.Equal(t, errors.New("token has been used"), err)
+	})
+
+	t.Run("Test Case 3 | Invalid Update Password | Failed to revoke user tokens", func(t *testing.T) {
+		copyDomain := tokenDomain
+		expectedError := errors.New("failed to revoke user tokens")
+
+		forgotPasswordRepository.On("GetByToken", util.HashToken("reset-token")).Return(copyDomain, nil).Once()
+		userRepository.On("GetByEmail", copyDomain.Email).Return(userDomain, nil).Once()
+		userRepository.On("UpdatePassword", mock.Anything).Return(userDomain, nil).Once()
+		forgotPasswordRepository.On("Update", mock.Anything).Return(copyDomain, nil).Once()
+		forgotPasswordRepository.On("InvalidateAllByEmail", copyDomain.Email).Return(nil).Once()
+		refreshTokenRepository.On("RevokeAllByUserID", userDomain.Id).Return(errors.New("unexpected error")).Once()
+
+		result, err := forgotPasswordUseCase.UpdatePassword(&forgot_password.Domain{Token: "reset-token", Password: "NewPassword"})
+
+		assert.Equal(t, forgot_password.Domain{}, result)
+		assert.Equal(t, expectedError, err)
+	})
+}
+
+func TestInvalidateTokens(t *testing.T) {
+	t.Run("Test Case 1 | Valid Invalidate Tokens", func(t *testing.T) {
+		forgotPasswordRepository.On("InvalidateAllByEmail", userDomain.Email).Return(nil).Once()
+
+		err := forgotPasswordUseCase.InvalidateTokens(userDomain.Email)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test Case 2 | Invalid Invalidate Tokens | Repository error", func(t *testing.T) {
+		forgotPasswordRepository.On("InvalidateAllByEmail", userDomain.Email).Return(errors.New("unexpected error")).Once()
+
+		err := forgotPasswordUseCase.InvalidateTokens(userDomain.Email)
+
+		assert.Equal(t, errors.New("failed to invalidate tokens"), err)
+	})
+}
@@ -0,0 +1,26 @@
+package login_attempts
+
+import "go.mongodb.org/mongo-driver/bson/primitive"
+
+type Domain struct {
+	Id           primitive.ObjectID `json:"_id" bson:"_id"`
+	Key          string             `json:"key" bson:"key"`
+	FailedCount  int                `json:"failedCount" bson:"failedCount"`
+	LastFailedAt primitive.DateTime `json:"lastFailedAt" bson:"lastFailedAt"`
+	LockedUntil  primitive.DateTime `json:"lockedUntil" bson:"lockedUntil"`
+	CreatedAt    primitive.DateTime `json:"createdAt" bson:"createdAt"`
+	UpdatedAt    primitive.DateTime `json:"updatedAt" bson:"updatedAt"`
+}
+
+type Repository interface {
+	// Create
+	Create(domain *Domain) (Domain, error)
+	// Read
+	GetByID(id primitive.ObjectID) (Domain, error)
+	GetByKey(key string) (Domain, error)
+	GetAllLocked() ([]Domain, error)
+	// Update
+	Update(domain *Domain) (Domain, error)
+	// Delete
+	Delete(id primitive.ObjectID) error
+}
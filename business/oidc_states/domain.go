@@ -0,0 +1,22 @@
+package oidc_states
+
+import "go.mongodb.org/mongo-driver/bson/primitive"
+
+type Domain struct {
+	Id           primitive.ObjectID `json:"_id" bson:"_id"`
+	State        string             `json:"-" bson:"state"`
+	CodeVerifier string             `json:"-" bson:"codeVerifier"`
+	Nonce        string             `json:"-" bson:"nonce"`
+	ExpiredAt    primitive.DateTime `json:"expiredAt" bson:"expiredAt"`
+	CreatedAt    primitive.DateTime `json:"createdAt" bson:"createdAt"`
+}
+
+type Repository interface {
+	// Create
+	Create(domain *Domain) (Domain, error)
+	// Read
+	GetByID(id primitive.ObjectID) (Domain, error)
+	GetByState(state string) (Domain, error)
+	// Delete
+	Delete(id primitive.ObjectID) error
+}
@@ -0,0 +1,129 @@
+package permissions
+
+import "go.mongodb.org/mongo-driver/bson/primitive"
+
+type Domain struct {
+	Id          primitive.ObjectID `json:"_id" bson:"_id"`
+	Role        string             `json:"role" bson:"role"`
+	Permissions []string           `json:"permissions" bson:"permissions"`
+	CreatedAt   primitive.DateTime `json:"createdAt" bson:"createdAt"`
+	UpdatedAt   primitive.DateTime `json:"updatedAt" bson:"updatedAt"`
+}
+
+const (
+	AccountManageOwn        = "account:manage:own"
+	ThreadCreate            = "thread:create"
+	ThreadInteract          = "thread:interact"
+	ThreadUpdateOwn         = "thread:update:own"
+	ThreadUpdateAny         = "thread:update:any"
+	ThreadDeleteOwn         = "thread:delete:own"
+	ThreadDeleteAny         = "thread:delete:any"
+	CommentCreate           = "comment:create"
+	CommentUpdateOwn        = "comment:update:own"
+	CommentUpdateAny        = "comment:update:any"
+	CommentDeleteOwn        = "comment:delete:own"
+	CommentDeleteAny        = "comment:delete:any"
+	ContentModerateAssigned = "content:moderate:assigned"
+	ContentModerateAny      = "content:moderate:any"
+	ReportCreate            = "report:create"
+	ReportReview            = "report:review"
+	TopicManage             = "topic:manage"
+	UserManage              = "user:manage"
+	StatisticsRead          = "statistics:read"
+	PermissionManage        = "permission:manage"
+)
+
+// All lists every permission known to the policy, a mapping can only grant permissions from this list
+var All = []string{
+	AccountManageOwn,
+	ThreadCreate,
+	ThreadInteract,
+	ThreadUpdateOwn,
+	ThreadUpdateAny,
+	ThreadDeleteOwn,
+	ThreadDeleteAny,
+	CommentCreate,
+	CommentUpdateOwn,
+	CommentUpdateAny,
+	CommentDeleteOwn,
+	CommentDeleteAny,
+	ContentModerateAssigned,
+	ContentModerateAny,
+	ReportCreate,
+	ReportReview,
+	TopicManage,
+	UserManage,
+	StatisticsRead,
+	PermissionManage,
+}
+
+var userPermissions = []string{
+	AccountManageOwn,
+	ThreadCreate,
+	ThreadInteract,
+	ThreadUpdateOwn,
+	ThreadDeleteOwn,
+	CommentCreate,
+	CommentUpdateOwn,
+	CommentDeleteOwn,
+	ReportCreate,
+}
+
+// Defaults is the mapping seeded for roles that have not been customized yet
+var Defaults = map[string][]string{
+	"user":      userPermissions,
+	"moderator": append(append([]string{}, userPermissions...), ContentModerateAssigned),
+	"admin":     All,
+}
+
+// Allows reports whether the role is granted the permission
+func (domain Domain) Allows(permission string) bool {
+	for _, granted := range domain.Permissions {
+		if granted == permission {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AllowsOn reports whether the role may act on a resource, the any permission also covers resources owned by other users
+func (domain Domain) AllowsOn(own string, any string, isOwner bool) bool {
+	if domain.Allows(any) {
+		return true
+	}
+
+	return isOwner && domain.Allows(own)
+}
+
+func IsKnown(permission string) bool {
+	for _, known := range All {
+		if known == permission {
+			return true
+		}
+	}
+
+	return false
+}
+
+type Repository interface {
+	// Create
+	Create(domain *Domain) (Domain, error)
+	// Read
+	GetByID(id primitive.ObjectID) (Domain, error)
+	GetByRole(role string) (Domain, error)
+	GetAll() ([]Domain, error)
+	// Update
+	Update(domain *Domain) (Domain, error)
+}
+
+type UseCase interface {
+	// Create
+	SeedDefaults() error
+	// Read
+	GetAll() ([]Domain, error)
+	GetByRole(role string) (Domain, error)
+	Can(role string, permission string) bool
+	// Update
+	Update(domain *Domain) (Domain, error)
+}
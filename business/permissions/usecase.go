@@ -0,0 +1,115 @@
+package permissions
+
+import (
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type PermissionUseCase struct {
+	permissionRepository Repository
+}
+
+func NewPermissionUseCase(pr Repository) UseCase {
+	return &PermissionUseCase{
+		permissionRepository: pr,
+	}
+}
+
+/*
+Create
+*/
+
+// SeedDefaults stores the default mapping of every built-in role that is not stored yet, customized roles are left untouched
+func (pu *PermissionUseCase) SeedDefaults() error {
+	for role, permissions := range Defaults {
+		_, err := pu.permissionRepository.GetByRole(role)
+		if err == nil {
+			continue
+		}
+
+		_, err = pu.permissionRepository.Create(&Domain{
+			Id:          primitive.NewObjectID(),
+			Role:        role,
+			Permissions: permissions,
+			CreatedAt:   primitive.NewDateTimeFromTime(time.Now()),
+			UpdatedAt:   primitive.NewDateTimeFromTime(time.Now()),
+		})
+		if err != nil {
+			return errors.New("failed to seed permissions")
+		}
+	}
+
+	return nil
+}
+
+/*
+Read
+*/
+
+func (pu *PermissionUseCase) GetAll() ([]Domain, error) {
+	result, err := pu.permissionRepository.GetAll()
+	if err != nil {
+		return []Domain{}, errors.New("failed to get permissions")
+	}
+
+	return result, nil
+}
+
+func (pu *PermissionUseCase) GetByRole(role string) (Domain, error) {
+	result, err := pu.permissionRepository.GetByRole(role)
+	if err != nil {
+		return Domain{}, errors.New("failed to get role")
+	}
+
+	return result, nil
+}
+
+func (pu *PermissionUseCase) Can(role string, permission string) bool {
+	result, err := pu.permissionRepository.GetByRole(role)
+	if err != nil {
+		return false
+	}
+
+	return result.Allows(permission)
+}
+
+/*
+Update
+*/
+
+func (pu *PermissionUseCase) Update(domain *Domain) (Domain, error) {
+	result, err := pu.permissionRepository.GetByRole(domain.Role)
+	if err != nil {
+		return Domain{}, errors.New("failed to get role")
+	}
+
+	permissions := []string{}
+	seen := map[string]bool{}
+	for _, permission := range domain.Permissions {
+		if !IsKnown(permission) {
+			return Domain{}, errors.New("unknown permission " + permission)
+		}
+
+		if !seen[permission] {
+			seen[permission] = true
+			permissions = append(permissions, permission)
+		}
+	}
+
+	// otherwise nobody would be left to edit the mapping again
+	if domain.Role == "admin" && !seen[PermissionManage] {
+		return Domain{}, errors.New("admin role must keep the " + PermissionManage + " permission")
+	}
+
+	result.Permissions = permissions
+	result.UpdatedAt = primitive.NewDateTimeFromTime(time.Now())
+
+	updatedPermission, err := pu.permissionRepository.Update(&result)
+	if err != nil {
+		return Domain{}, errors.New("failed to update permissions")
+	}
+
+	return updatedPermission, nil
+}
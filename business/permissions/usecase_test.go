@@ -0,0 +1,194 @@
+package permissions_test
+
+import (
+	"charum/business/permissions"
+	_permissionMock "charum/business/permissions/mocks"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var (
+	permissionRepository _permissionMock.Repository
+	permissionUseCase    permissions.UseCase
+	permissionDomain     permissions.Domain
+)
+
+func TestMain(m *testing.M) {
+	permissionUseCase = permissions.NewPermissionUseCase(&permissionRepository)
+
+	permissionDomain = permissions.Domain{
+		Id:          primitive.NewObjectID(),
+		Role:        "user",
+		Permissions: permissions.Defaults["user"],
+		CreatedAt:   primitive.NewDateTimeFromTime(time.Now()),
+		UpdatedAt:   primitive.NewDateTimeFromTime(time.Now()),
+	}
+
+	m.Run()
+}
+
+func TestAllowsOn(t *testing.T) {
+	t.Run("Test case 1 | Own permission covers own resources only", func(t *testing.T) {
+		policy := permissions.Domain{Permissions: []string{permissions.ThreadUpdateOwn}}
+
+		assert.True(t, policy.AllowsOn(permissions.ThreadUpdateOwn, permissions.ThreadUpdateAny, true))
+		assert.False(t, policy.AllowsOn(permissions.ThreadUpdateOwn, permissions.ThreadUpdateAny, false))
+	})
+
+	t.Run("Test case 2 | Any permission covers every resource", func(t *testing.T) {
+		policy := permissions.Domain{Permissions: []string{permissions.ThreadUpdateAny}}
+
+		assert.True(t, policy.AllowsOn(permissions.ThreadUpdateOwn, permissions.ThreadUpdateAny, true))
+		assert.True(t, policy.AllowsOn(permissions.ThreadUpdateOwn, permissions.ThreadUpdateAny, false))
+	})
+}
+
+func TestSeedDefaults(t *testing.T) {
+	t.Run("Test case 1 | Valid seed defaults | Every role is missing", func(t *testing.T) {
+		for role := range permissions.Defaults {
+			permissionRepository.On("GetByRole", role).Return(permissions.Domain{}, errors.New("not found")).Once()
+		}
+		permissionRepository.On("Create", mock.Anything).Return(permissionDomain, nil).Times(len(permissions.Defaults))
+
+		err := permissionUseCase.SeedDefaults()
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Valid seed defaults | Customized roles are kept", func(t *testing.T) {
+		for role := range permissions.Defaults {
+			permissionRepository.On("GetByRole", role).Return(permissionDomain, nil).Once()
+		}
+
+		err := permissionUseCase.SeedDefaults()
+
+		assert.Nil(t, err)
+		permissionRepository.AssertNumberOfCalls(t, "Create", len(permissions.Defaults))
+	})
+
+	t.Run("Test case 3 | Invalid seed defaults | Failed to create role", func(t *testing.T) {
+		expectedErr := errors.New("failed to seed permissions")
+		permissionRepository.On("GetByRole", mock.Anything).Return(permissions.Domain{}, errors.New("not found")).Once()
+		permissionRepository.On("Create", mock.Anything).Return(permissions.Domain{}, errors.New("unexpected error")).Once()
+
+		err := permissionUseCase.SeedDefaults()
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestGetAll(t *testing.T) {
+	t.Run("Test case 1 | Valid get all", func(t *testing.T) {
+		permissionRepository.On("GetAll").Return([]permissions.Domain{permissionDomain}, nil).Once()
+
+		result, err := permissionUseCase.GetAll()
+
+		assert.Equal(t, []permissions.Domain{permissionDomain}, result)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Invalid get all | Failed to get permissions", func(t *testing.T) {
+		expectedErr := errors.New("failed to get permissions")
+		permissionRepository.On("GetAll").Return([]permissions.Domain{}, errors.New("unexpected error")).Once()
+
+		result, err := permissionUseCase.GetAll()
+
+		assert.Equal(t, []permissions.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestCan(t *testing.T) {
+	t.Run("Test case 1 | Role is granted the permission", func(t *testing.T) {
+		permissionRepository.On("GetByRole", "can-user").Return(permissionDomain, nil).Once()
+
+		assert.True(t, permissionUseCase.Can("can-user", permissions.ThreadCreate))
+	})
+
+	t.Run("Test case 2 | Role is not granted the permission", func(t *testing.T) {
+		permissionRepository.On("GetByRole", "can-user").Return(permissionDomain, nil).Once()
+
+		assert.False(t, permissionUseCase.Can("can-user", permissions.PermissionManage))
+	})
+
+	t.Run("Test case 3 | Role does not exist", func(t *testing.T) {
+		permissionRepository.On("GetByRole", "can-unknown").Return(permissions.Domain{}, errors.New("not found")).Once()
+
+		assert.False(t, permissionUseCase.Can("can-unknown", permissions.ThreadCreate))
+	})
+}
+
+func TestUpdate(t *testing.T) {
+	t.Run("Test case 1 | Valid update | Duplicated permissions are removed", func(t *testing.T) {
+		expected := permissionDomain
+		expected.Permissions = []string{permissions.ThreadCreate, permissions.ThreadUpdateAny}
+
+		permissionRepository.On("GetByRole", "update-user").Return(permissionDomain, nil).Once()
+		permissionRepository.On("Update", mock.MatchedBy(func(domain *permissions.Domain) bool {
+			return assert.ObjectsAreEqual(expected.Permissions, domain.Permissions)
+		})).Return(expected, nil).Once()
+
+		result, err := permissionUseCase.Update(&permissions.Domain{
+			Role:        "update-user",
+			Permissions: []string{permissions.ThreadCreate, permissions.ThreadUpdateAny, permissions.ThreadCreate},
+		})
+
+		assert.Equal(t, expected, result)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Invalid update | Role does not exist", func(t *testing.T) {
+		expectedErr := errors.New("failed to get role")
+		permissionRepository.On("GetByRole", "update-unknown").Return(permissions.Domain{}, errors.New("not found")).Once()
+
+		result, err := permissionUseCase.Update(&permissions.Domain{Role: "update-unknown"})
+
+		assert.Equal(t, permissions.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 3 | Invalid update | Unknown permission", func(t *testing.T) {
+		expectedErr := errors.New("unknown permission thread:fly")
+		permissionRepository.On("GetByRole", "update-user").Return(permissionDomain, nil).Once()
+
+		result, err := permissionUseCase.Update(&permissions.Domain{
+			Role:        "update-user",
+			Permissions: []string{"thread:fly"},
+		})
+
+		assert.Equal(t, permissions.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 4 | Invalid update | Admin must keep permission management", func(t *testing.T) {
+		expectedErr := errors.New("admin role must keep the permission:manage permission")
+		permissionRepository.On("GetByRole", "admin").Return(permissionDomain, nil).Once()
+
+		result, err := permissionUseCase.Update(&permissions.Domain{
+			Role:        "admin",
+			Permissions: []string{permissions.UserManage},
+		})
+
+		assert.Equal(t, permissions.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 5 | Invalid update | Failed to update permissions", func(t *testing.T) {
+		expectedErr := errors.New("failed to update permissions")
+		permissionRepository.On("GetByRole", "update-user").Return(permissionDomain, nil).Once()
+		permissionRepository.On("Update", mock.Anything).Return(permissions.Domain{}, errors.New("unexpected error")).Once()
+
+		result, err := permissionUseCase.Update(&permissions.Domain{
+			Role:        "update-user",
+			Permissions: []string{permissions.ThreadCreate},
+		})
+
+		assert.Equal(t, permissions.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+}
@@ -0,0 +1,34 @@
+package refresh_tokens
+
+import "go.mongodb.org/mongo-driver/bson/primitive"
+
+type Domain struct {
+	Id            primitive.ObjectID `json:"_id" bson:"_id"`
+	UserID        primitive.ObjectID `json:"userID" bson:"userID"`
+	Token         string             `json:"-" bson:"token"`
+	PreviousToken string             `json:"-" bson:"previousToken"`
+	AccessTokenID string             `json:"-" bson:"accessTokenID"`
+	UserAgent     string             `json:"userAgent" bson:"userAgent"`
+	IPAddress     string             `json:"ipAddress" bson:"ipAddress"`
+	IsRevoked     bool               `json:"isRevoked" bson:"isRevoked"`
+	LastSeenAt    primitive.DateTime `json:"lastSeenAt" bson:"lastSeenAt"`
+	ExpiredAt     primitive.DateTime `json:"expiredAt" bson:"expiredAt"`
+	CreatedAt     primitive.DateTime `json:"createdAt" bson:"createdAt"`
+	UpdatedAt     primitive.DateTime `json:"updatedAt" bson:"updatedAt"`
+}
+
+type Repository interface {
+	// Create
+	Create(domain *Domain) (Domain, error)
+	// Read
+	GetByID(id primitive.ObjectID) (Domain, error)
+	GetByToken(token string) (Domain, error)
+	GetByPreviousToken(token string) (Domain, error)
+	GetByAccessTokenID(accessTokenID string) (Domain, error)
+	GetAllActiveByUserID(userID primitive.ObjectID) ([]Domain, error)
+	// Update
+	Update(domain *Domain) (Domain, error)
+	UpdateLastSeen(id primitive.ObjectID) error
+	RevokeAllByUserID(userID primitive.ObjectID) error
+	RevokeOthersByUserID(userID primitive.ObjectID, accessTokenID string) error
+}
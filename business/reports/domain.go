@@ -18,6 +18,7 @@ type Repository interface {
 	// Read
 	GetByReportedID(id primitive.ObjectID) (int, error)
 	CheckByUserID(userID primitive.ObjectID, reportedID primitive.ObjectID) (Domain, error)
+	GetAllByUserID(userID primitive.ObjectID) ([]Domain, error)
 	GetAll() ([]Domain, error)
 	GetAllReportedUsers() ([]Domain, error)
 	GetAllReportedThreads() ([]Domain, error)
@@ -31,4 +32,6 @@ type UseCase interface {
 	GetAll() (int, error)
 	GetAllReportedUsers() (int, error)
 	GetAllReportedThreads() (int, error)
+	ModeratorGetAllReportedThreads(moderatorID primitive.ObjectID) (int, error)
+	ModeratorGetByReportedThreadID(moderatorID primitive.ObjectID, threadID primitive.ObjectID) (int, error)
 }
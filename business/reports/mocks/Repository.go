@@ -79,6 +79,29 @@ func (_m *Repository) GetAll() ([]reports.Domain, error) {
 	return r0, r1
 }
 
+// GetAllByUserID provides a mock function with given fields: userID
+func (_m *Repository) GetAllByUserID(userID primitive.ObjectID) ([]reports.Domain, error) {
+	ret := _m.Called(userID)
+
+	var r0 []reports.Domain
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID) []reports.Domain); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]reports.Domain)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(primitive.ObjectID) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAllReportedThreads provides a mock function with given fields:
 func (_m *Repository) GetAllReportedThreads() ([]reports.Domain, error) {
 	ret := _m.Called()
@@ -119,6 +119,48 @@ func (_m *UseCase) GetByReportedID(id primitive.ObjectID) (int, error) {
 	return r0, r1
 }
 
+// ModeratorGetAllReportedThreads provides a mock function with given fields: moderatorID
+func (_m *UseCase) ModeratorGetAllReportedThreads(moderatorID primitive.ObjectID) (int, error) {
+	ret := _m.Called(moderatorID)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID) int); ok {
+		r0 = rf(moderatorID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(primitive.ObjectID) error); ok {
+		r1 = rf(moderatorID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModeratorGetByReportedThreadID provides a mock function with given fields: moderatorID, threadID
+func (_m *UseCase) ModeratorGetByReportedThreadID(moderatorID primitive.ObjectID, threadID primitive.ObjectID) (int, error) {
+	ret := _m.Called(moderatorID, threadID)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID, primitive.ObjectID) int); ok {
+		r0 = rf(moderatorID, threadID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(primitive.ObjectID, primitive.ObjectID) error); ok {
+		r1 = rf(moderatorID, threadID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewUseCase interface {
 	mock.TestingT
 	Cleanup(func())
@@ -1,7 +1,10 @@
 package reports
 
 import (
+	"charum/business/permissions"
 	"charum/business/threads"
+	"charum/business/topics"
+	userBlocks "charum/business/user_blocks"
 	"charum/business/users"
 	"errors"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -9,16 +12,22 @@ import (
 )
 
 type ReportUseCase struct {
-	reportRepository Repository
-	userRepository   users.Repository
-	threadRepository threads.Repository
+	reportRepository     Repository
+	userRepository       users.Repository
+	userBlockRepository  userBlocks.Repository
+	threadRepository     threads.Repository
+	topicRepository      topics.Repository
+	permissionRepository permissions.Repository
 }
 
-func NewReportUseCase(rr Repository, ur users.Repository, tr threads.Repository) UseCase {
+func NewReportUseCase(rr Repository, ur users.Repository, ubr userBlocks.Repository, tr threads.Repository, tor topics.Repository, pr permissions.Repository) UseCase {
 	return &ReportUseCase{
-		reportRepository: rr,
-		userRepository:   ur,
-		threadRepository: tr,
+		reportRepository:     rr,
+		userRepository:       ur,
+		userBlockRepository:  ubr,
+		threadRepository:     tr,
+		topicRepository:      tor,
+		permissionRepository: pr,
 	}
 }
 
@@ -32,6 +41,12 @@ func (ru *ReportUseCase) Create(domain *Domain) (Domain, error) {
 	if err != nil {
 		return Domain{}, errors.New("ID not found")
 	}
+	if reportedType == "user" {
+		_, err = ru.userBlockRepository.GetByUserIDTargetIDAndType(domain.ReportedID, domain.UserID, userBlocks.TypeBlock)
+		if err == nil {
+			return Domain{}, errors.New("user is blocked by this user")
+		}
+	}
 	_, err = ru.reportRepository.CheckByUserID(domain.UserID, domain.ReportedID)
 	if err == nil {
 		return Domain{}, errors.New("already reported")
@@ -108,3 +123,89 @@ func (ru *ReportUseCase) GetAllReportedThreads() (int, error) {
 	totalReports := len(reports)
 	return totalReports, nil
 }
+
+// moderatedTopicIDs returns the topics the user may review, where a nil map means every topic
+func (ru *ReportUseCase) moderatedTopicIDs(moderatorID primitive.ObjectID) (map[primitive.ObjectID]bool, error) {
+	moderator, err := ru.userRepository.GetByID(moderatorID)
+	if err != nil {
+		return nil, errors.New("failed to get user")
+	}
+
+	policy, err := ru.permissionRepository.GetByRole(moderator.Role)
+	if err != nil {
+		return nil, errors.New("failed to get permissions")
+	}
+
+	if policy.Allows(permissions.ReportReview) {
+		return nil, nil
+	}
+
+	if !policy.Allows(permissions.ContentModerateAssigned) {
+		return nil, errors.New("user is not a moderator")
+	}
+
+	moderatedTopics, err := ru.topicRepository.GetAllByModeratorID(moderatorID)
+	if err != nil {
+		return nil, errors.New("failed to get topics")
+	}
+
+	topicIDs := map[primitive.ObjectID]bool{}
+	for _, topic := range moderatedTopics {
+		topicIDs[topic.Id] = true
+	}
+
+	return topicIDs, nil
+}
+
+func (ru *ReportUseCase) ModeratorGetAllReportedThreads(moderatorID primitive.ObjectID) (int, error) {
+	topicIDs, err := ru.moderatedTopicIDs(moderatorID)
+	if err != nil {
+		return 0, err
+	}
+
+	reports, err := ru.reportRepository.GetAllReportedThreads()
+	if err != nil {
+		return 0, errors.New("failed to get reports")
+	}
+
+	if topicIDs == nil {
+		return len(reports), nil
+	}
+
+	totalReports := 0
+	for _, report := range reports {
+		thread, err := ru.threadRepository.GetByID(report.ReportedID)
+		if err != nil {
+			continue
+		}
+
+		if topicIDs[thread.TopicID] {
+			totalReports++
+		}
+	}
+
+	return totalReports, nil
+}
+
+func (ru *ReportUseCase) ModeratorGetByReportedThreadID(moderatorID primitive.ObjectID, threadID primitive.ObjectID) (int, error) {
+	topicIDs, err := ru.moderatedTopicIDs(moderatorID)
+	if err != nil {
+		return 0, err
+	}
+
+	thread, err := ru.threadRepository.GetByID(threadID)
+	if err != nil {
+		return 0, errors.New("failed to get thread")
+	}
+
+	if topicIDs != nil && !topicIDs[thread.TopicID] {
+		return 0, errors.New("user is not a moderator of this topic")
+	}
+
+	reports, err := ru.reportRepository.GetByReportedID(threadID)
+	if err != nil {
+		return 0, errors.New("failed to get reports")
+	}
+
+	return reports, nil
+}
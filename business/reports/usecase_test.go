@@ -1,14 +1,20 @@
 package reports_test
 
 import (
+	"charum/business/permissions"
+	_permissionMock "charum/business/permissions/mocks"
 	"charum/business/reports"
 	_ReportMock "charum/business/reports/mocks"
 	"charum/business/threads"
 	_threadMock "charum/business/threads/mocks"
 	"charum/business/topics"
+	_topicMock "charum/business/topics/mocks"
+	userBlocks "charum/business/user_blocks"
+	_userBlockMock "charum/business/user_blocks/mocks"
 	"charum/business/users"
 	_userMock "charum/business/users/mocks"
 	dtoThread "charum/dto/threads"
+	dtoUser "charum/dto/users"
 	"errors"
 	"testing"
 	"time"
@@ -19,19 +25,22 @@ import (
 )
 
 var (
-	ReportUseCase    reports.UseCase
-	ReportRepository _ReportMock.Repository
-	ThreadRepository _threadMock.Repository
-	UserRepository   _userMock.Repository
-	userDomain       users.Domain
-	threadDomain     threads.Domain
-	reportDomain     reports.Domain
-	threadResponse   dtoThread.Response
-	topicDomain      topics.Domain
+	ReportUseCase        reports.UseCase
+	ReportRepository     _ReportMock.Repository
+	ThreadRepository     _threadMock.Repository
+	TopicRepository      _topicMock.Repository
+	UserRepository       _userMock.Repository
+	UserBlockRepository  _userBlockMock.Repository
+	PermissionRepository _permissionMock.Repository
+	userDomain           users.Domain
+	threadDomain         threads.Domain
+	reportDomain         reports.Domain
+	threadResponse       dtoThread.Response
+	topicDomain          topics.Domain
 )
 
 func TestMain(m *testing.M) {
-	ReportUseCase = reports.NewReportUseCase(&ReportRepository, &UserRepository, &ThreadRepository)
+	ReportUseCase = reports.NewReportUseCase(&ReportRepository, &UserRepository, &UserBlockRepository, &ThreadRepository, &TopicRepository, &PermissionRepository)
 
 	reportDomain = reports.Domain{
 		Id:           primitive.NewObjectID(),
@@ -81,7 +90,7 @@ func TestMain(m *testing.M) {
 	threadResponse = dtoThread.Response{
 		Id:            threadDomain.Id,
 		Topic:         topicDomain,
-		Creator:       userDomain,
+		Creator:       dtoUser.FromDomain(userDomain),
 		Title:         threadDomain.Title,
 		Description:   threadDomain.Description,
 		Likes:         []dtoThread.Like{},
@@ -108,6 +117,7 @@ func TestCreate(t *testing.T) {
 		ReportRepository.On("GetByReportedID", reportDomain.ReportedID).Return(reportDomain, nil).Once()
 		ThreadRepository.On("GetByID", mock.Anything).Return(threadDomain, nil).Once()
 		UserRepository.On("GetByID", mock.Anything).Return(userDomain, nil).Once()
+		UserBlockRepository.On("GetByUserIDTargetIDAndType", reportDomain.ReportedID, reportDomain.UserID, userBlocks.TypeBlock).Return(userBlocks.Domain{}, errors.New("not found")).Once()
 		ReportRepository.On("CheckByUserID", mock.Anything, mock.Anything).Return(reports.Domain{}, errors.New("not reported")).Once()
 
 		_, err := ReportUseCase.Create(&reportDomain)
@@ -122,6 +132,7 @@ func TestCreate(t *testing.T) {
 		ReportRepository.On("GetByReportedID", reportDomain.ReportedID).Return(reportDomain, nil).Once()
 		ThreadRepository.On("GetByID", mock.Anything).Return(threadDomain, nil).Once()
 		UserRepository.On("GetByID", mock.Anything).Return(userDomain, nil).Once()
+		UserBlockRepository.On("GetByUserIDTargetIDAndType", reportDomain.ReportedID, reportDomain.UserID, userBlocks.TypeBlock).Return(userBlocks.Domain{}, errors.New("not found")).Once()
 		ReportRepository.On("CheckByUserID", mock.Anything, mock.Anything).Return(reports.Domain{}, errors.New("not reported")).Once()
 
 		_, err := ReportUseCase.Create(&reportDomain)
@@ -137,12 +148,23 @@ func TestCreate(t *testing.T) {
 		ReportRepository.On("GetByReportedID", reportDomain.ReportedID).Return(reportDomain, nil).Once()
 		ThreadRepository.On("GetByID", mock.Anything).Return(threadDomain, nil).Once()
 		UserRepository.On("GetByID", mock.Anything).Return(userDomain, nil).Once()
+		UserBlockRepository.On("GetByUserIDTargetIDAndType", reportDomain.ReportedID, reportDomain.UserID, userBlocks.TypeBlock).Return(userBlocks.Domain{}, errors.New("not found")).Once()
 		ReportRepository.On("CheckByUserID", mock.Anything, mock.Anything).Return(reportDomain, nil).Once()
 
 		_, err := ReportUseCase.Create(&reportDomain)
 
 		assert.Equal(t, expectedErr, err)
 	})
+
+	t.Run("Test Case 4 | Invalid Create Report - reporter is blocked by the reported user", func(t *testing.T) {
+		expectedErr := errors.New("user is blocked by this user")
+		UserRepository.On("GetByID", reportDomain.ReportedID).Return(userDomain, nil).Once()
+		UserBlockRepository.On("GetByUserIDTargetIDAndType", reportDomain.ReportedID, reportDomain.UserID, userBlocks.TypeBlock).Return(userBlocks.Domain{Id: primitive.NewObjectID()}, nil).Once()
+
+		_, err := ReportUseCase.Create(&reportDomain)
+
+		assert.Equal(t, expectedErr, err)
+	})
 }
 
 func TestGetAll(t *testing.T) {
@@ -198,3 +220,103 @@ func TestGetAllReportedUsers(t *testing.T) {
 		assert.Equal(t, err, expectedErr)
 	})
 }
+
+// get reported threads within the moderated topics
+func TestModeratorGetAllReportedThreads(t *testing.T) {
+	// fresh mocks, the create tests above leave unconsumed thread lookups that would answer these calls
+	reportRepository := _ReportMock.Repository{}
+	userRepository := _userMock.Repository{}
+	userBlockRepository := _userBlockMock.Repository{}
+	threadRepository := _threadMock.Repository{}
+	topicRepository := _topicMock.Repository{}
+	permissionRepository := _permissionMock.Repository{}
+	reportUseCase := reports.NewReportUseCase(&reportRepository, &userRepository, &userBlockRepository, &threadRepository, &topicRepository, &permissionRepository)
+
+	moderatorDomain := userDomain
+	moderatorDomain.Id = primitive.NewObjectID()
+	moderatorDomain.Role = "moderator"
+
+	otherThread := threadDomain
+	otherThread.Id = primitive.NewObjectID()
+	otherThread.TopicID = primitive.NewObjectID()
+	otherReport := reportDomain
+	otherReport.ReportedID = otherThread.Id
+
+	t.Run("Test Case 1 | Valid Moderator Get All Reported Threads | Only reports within the moderated topics are counted", func(t *testing.T) {
+		userRepository.On("GetByID", moderatorDomain.Id).Return(moderatorDomain, nil).Once()
+		permissionRepository.On("GetByRole", "moderator").Return(permissions.Domain{Role: "moderator", Permissions: permissions.Defaults["moderator"]}, nil).Once()
+		topicRepository.On("GetAllByModeratorID", moderatorDomain.Id).Return([]topics.Domain{topicDomain}, nil).Once()
+		reportRepository.On("GetAllReportedThreads").Return([]reports.Domain{reportDomain, otherReport}, nil).Once()
+		threadRepository.On("GetByID", threadDomain.Id).Return(threadDomain, nil).Once()
+		threadRepository.On("GetByID", otherThread.Id).Return(otherThread, nil).Once()
+
+		res, err := reportUseCase.ModeratorGetAllReportedThreads(moderatorDomain.Id)
+
+		assert.Equal(t, 1, res)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test Case 2 | Valid Moderator Get All Reported Threads | Admin counts every report", func(t *testing.T) {
+		adminDomain := moderatorDomain
+		adminDomain.Role = "admin"
+		userRepository.On("GetByID", adminDomain.Id).Return(adminDomain, nil).Once()
+		permissionRepository.On("GetByRole", "admin").Return(permissions.Domain{Role: "admin", Permissions: permissions.Defaults["admin"]}, nil).Once()
+		reportRepository.On("GetAllReportedThreads").Return([]reports.Domain{reportDomain, otherReport}, nil).Once()
+
+		res, err := reportUseCase.ModeratorGetAllReportedThreads(adminDomain.Id)
+
+		assert.Equal(t, 2, res)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test Case 3 | Invalid Moderator Get All Reported Threads | User is not a moderator", func(t *testing.T) {
+		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
+		permissionRepository.On("GetByRole", "user").Return(permissions.Domain{Role: "user", Permissions: permissions.Defaults["user"]}, nil).Once()
+
+		res, err := reportUseCase.ModeratorGetAllReportedThreads(userDomain.Id)
+
+		assert.Equal(t, 0, res)
+		assert.Equal(t, errors.New("user is not a moderator"), err)
+	})
+}
+
+// get reports of a thread within the moderated topics
+func TestModeratorGetByReportedThreadID(t *testing.T) {
+	// fresh mocks, the create tests above leave unconsumed thread lookups that would answer these calls
+	reportRepository := _ReportMock.Repository{}
+	userRepository := _userMock.Repository{}
+	userBlockRepository := _userBlockMock.Repository{}
+	threadRepository := _threadMock.Repository{}
+	topicRepository := _topicMock.Repository{}
+	permissionRepository := _permissionMock.Repository{}
+	reportUseCase := reports.NewReportUseCase(&reportRepository, &userRepository, &userBlockRepository, &threadRepository, &topicRepository, &permissionRepository)
+
+	moderatorDomain := userDomain
+	moderatorDomain.Id = primitive.NewObjectID()
+	moderatorDomain.Role = "moderator"
+
+	t.Run("Test Case 1 | Valid Moderator Get By Reported Thread ID", func(t *testing.T) {
+		userRepository.On("GetByID", moderatorDomain.Id).Return(moderatorDomain, nil).Once()
+		permissionRepository.On("GetByRole", "moderator").Return(permissions.Domain{Role: "moderator", Permissions: permissions.Defaults["moderator"]}, nil).Once()
+		topicRepository.On("GetAllByModeratorID", moderatorDomain.Id).Return([]topics.Domain{topicDomain}, nil).Once()
+		threadRepository.On("GetByID", threadDomain.Id).Return(threadDomain, nil).Once()
+		reportRepository.On("GetByReportedID", threadDomain.Id).Return(3, nil).Once()
+
+		res, err := reportUseCase.ModeratorGetByReportedThreadID(moderatorDomain.Id, threadDomain.Id)
+
+		assert.Equal(t, 3, res)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test Case 2 | Invalid Moderator Get By Reported Thread ID | Thread outside of the moderated topics", func(t *testing.T) {
+		userRepository.On("GetByID", moderatorDomain.Id).Return(moderatorDomain, nil).Once()
+		permissionRepository.On("GetByRole", "moderator").Return(permissions.Domain{Role: "moderator", Permissions: permissions.Defaults["moderator"]}, nil).Once()
+		topicRepository.On("GetAllByModeratorID", moderatorDomain.Id).Return([]topics.Domain{}, nil).Once()
+		threadRepository.On("GetByID", threadDomain.Id).Return(threadDomain, nil).Once()
+
+		res, err := reportUseCase.ModeratorGetByReportedThreadID(moderatorDomain.Id, threadDomain.Id)
+
+		assert.Equal(t, 0, res)
+		assert.Equal(t, errors.New("user is not a moderator of this topic"), err)
+	})
+}
@@ -0,0 +1,57 @@
+package suspensions
+
+import "go.mongodb.org/mongo-driver/bson/primitive"
+
+const (
+	ReasonSpam                 = "spam"
+	ReasonHarassment           = "harassment"
+	ReasonHateSpeech           = "hate speech"
+	ReasonInappropriateContent = "inappropriate content"
+	ReasonImpersonation        = "impersonation"
+	ReasonOther                = "other"
+)
+
+var Reasons = []string{
+	ReasonSpam,
+	ReasonHarassment,
+	ReasonHateSpeech,
+	ReasonInappropriateContent,
+	ReasonImpersonation,
+	ReasonOther,
+}
+
+// Domain is one suspension of a user, EndAt is empty for a suspension that lasts until it is lifted by an admin
+type Domain struct {
+	Id        primitive.ObjectID `json:"_id" bson:"_id"`
+	UserID    primitive.ObjectID `json:"userID" bson:"userID"`
+	AdminID   primitive.ObjectID `json:"adminID" bson:"adminID"`
+	Reason    string             `json:"reason" bson:"reason"`
+	Detail    string             `json:"detail" bson:"detail"`
+	EndAt     primitive.DateTime `json:"endAt,omitempty" bson:"endAt,omitempty"`
+	LiftedAt  primitive.DateTime `json:"liftedAt,omitempty" bson:"liftedAt,omitempty"`
+	LiftedBy  primitive.ObjectID `json:"liftedBy,omitempty" bson:"liftedBy,omitempty"`
+	CreatedAt primitive.DateTime `json:"createdAt" bson:"createdAt"`
+	UpdatedAt primitive.DateTime `json:"updatedAt" bson:"updatedAt"`
+}
+
+type Repository interface {
+	// Create
+	Create(domain *Domain) (Domain, error)
+	// Read
+	GetByID(id primitive.ObjectID) (Domain, error)
+	GetActiveByUserID(userID primitive.ObjectID) (Domain, error)
+	GetAllByUserID(userID primitive.ObjectID) ([]Domain, error)
+	GetAllExpired(now primitive.DateTime) ([]Domain, error)
+	// Update
+	Update(domain *Domain) (Domain, error)
+}
+
+func IsValidReason(reason string) bool {
+	for _, r := range Reasons {
+		if r == reason {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,95 @@
+package thread_revisions
+
+import "strings"
+
+const (
+	LineEqual   = "equal"
+	LineAdded   = "added"
+	LineRemoved = "removed"
+)
+
+type FieldDiff struct {
+	From    string `json:"from"`
+	To      string `json:"to"`
+	Changed bool   `json:"changed"`
+}
+
+type LineDiff struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+type Diff struct {
+	From        Domain     `json:"from"`
+	To          Domain     `json:"to"`
+	Title       FieldDiff  `json:"title"`
+	TopicID     FieldDiff  `json:"topicID"`
+	ImageURL    FieldDiff  `json:"imageURL"`
+	Description []LineDiff `json:"description"`
+}
+
+// Compare returns the changes between two versions of a thread, the description is compared line by line
+func Compare(from Domain, to Domain) Diff {
+	return Diff{
+		From:        from,
+		To:          to,
+		Title:       compareField(from.Title, to.Title),
+		TopicID:     compareField(from.TopicID.Hex(), to.TopicID.Hex()),
+		ImageURL:    compareField(from.ImageURL, to.ImageURL),
+		Description: compareLines(strings.Split(from.Description, "\n"), strings.Split(to.Description, "\n")),
+	}
+}
+
+func compareField(from string, to string) FieldDiff {
+	return FieldDiff{
+		From:    from,
+		To:      to,
+		Changed: from != to,
+	}
+}
+
+// compareLines walks the longest common subsequence of both texts, lines outside of it were removed or added
+func compareLines(from []string, to []string) []LineDiff {
+	lcs := make([][]int, len(from)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(to)+1)
+	}
+
+	for i := len(from) - 1; i >= 0; i-- {
+		for j := len(to) - 1; j >= 0; j-- {
+			if from[i] == to[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	result := []LineDiff{}
+	i, j := 0, 0
+	for i < len(from) && j < len(to) {
+		if from[i] == to[j] {
+			result = append(result, LineDiff{Type: LineEqual, Text: from[i]})
+			i++
+			j++
+		} else if lcs[i+1][j] >= lcs[i][j+1] {
+			result = append(result, LineDiff{Type: LineRemoved, Text: from[i]})
+			i++
+		} else {
+			result = append(result, LineDiff{Type: LineAdded, Text: to[j]})
+			j++
+		}
+	}
+
+	for ; i < len(from); i++ {
+		result = append(result, LineDiff{Type: LineRemoved, Text: from[i]})
+	}
+
+	for ; j < len(to); j++ {
+		result = append(result, LineDiff{Type: LineAdded, Text: to[j]})
+	}
+
+	return result
+}
@@ -0,0 +1,26 @@
+package thread_revisions
+
+import "go.mongodb.org/mongo-driver/bson/primitive"
+
+// Domain is the content a thread had before one of its edits, IsAdminEdit marks edits made on behalf of someone else
+type Domain struct {
+	Id          primitive.ObjectID `json:"_id" bson:"_id"`
+	ThreadID    primitive.ObjectID `json:"threadID" bson:"threadID"`
+	EditorID    primitive.ObjectID `json:"editorID" bson:"editorID"`
+	IsAdminEdit bool               `json:"isAdminEdit" bson:"isAdminEdit"`
+	TopicID     primitive.ObjectID `json:"topicID" bson:"topicID"`
+	Title       string             `json:"title" bson:"title"`
+	Description string             `json:"description" bson:"description"`
+	ImageURL    string             `json:"imageURL" bson:"imageURL"`
+	CreatedAt   primitive.DateTime `json:"createdAt" bson:"createdAt"`
+}
+
+type Repository interface {
+	// Create
+	Create(domain *Domain) (Domain, error)
+	// Read
+	GetByID(id primitive.ObjectID) (Domain, error)
+	GetAllByThreadID(threadID primitive.ObjectID) ([]Domain, error)
+	// Delete
+	DeleteAllByThreadID(threadID primitive.ObjectID) error
+}
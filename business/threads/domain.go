@@ -1,6 +1,8 @@
 package threads
 
 import (
+	bannedTags "charum/business/banned_tags"
+	threadRevisions "charum/business/thread_revisions"
 	dtoPagination "charum/dto/pagination"
 	dtoQuery "charum/dto/query"
 	dtoThread "charum/dto/threads"
@@ -9,6 +11,11 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
+const (
+	PinScopeGlobal = "global"
+	PinScopeTopic  = "topic"
+)
+
 type Domain struct {
 	Id            primitive.ObjectID `json:"_id" bson:"_id"`
 	TopicID       primitive.ObjectID `json:"topicID" bson:"topicID"`
@@ -19,32 +26,72 @@ type Domain struct {
 	ImageURL      string             `json:"imageURL" bson:"imageURL"`
 	SuspendStatus string             `json:"suspendStatus,omitempty" bson:"suspendStatus"`
 	SuspendDetail string             `json:"suspendDetail,omitempty" bson:"suspendDetail"`
+	EditedAt      primitive.DateTime `json:"editedAt,omitempty" bson:"editedAt,omitempty"`
+	DeletedAt     primitive.DateTime `json:"deletedAt,omitempty" bson:"deletedAt,omitempty"`
+	Tags          []string           `json:"tags" bson:"tags"`
+	IsPinned      bool               `json:"isPinned,omitempty" bson:"isPinned,omitempty"`
+	IsTopicPinned bool               `json:"isTopicPinned,omitempty" bson:"isTopicPinned,omitempty"`
+	IsLocked      bool               `json:"isLocked,omitempty" bson:"isLocked,omitempty"`
+	IsDraft       bool               `json:"isDraft,omitempty" bson:"isDraft,omitempty"`
+	PublishAt     primitive.DateTime `json:"publishAt,omitempty" bson:"publishAt,omitempty"`
 	CreatedAt     primitive.DateTime `json:"createdAt" bson:"createdAt"`
 	UpdatedAt     primitive.DateTime `json:"updatedAt" bson:"updatedAt"`
 }
 
+// TagCount is how many visible threads use a tag
+type TagCount struct {
+	Name  string `json:"name" bson:"_id"`
+	Count int    `json:"count" bson:"count"`
+}
+
 type Like struct {
 	UserID    primitive.ObjectID `json:"userID" bson:"userID"`
 	Timestamp primitive.DateTime `json:"timestamp" bson:"timestamp"`
 }
 
+// TrashRepository is shared by the comments, follows and bookmarks repositories, they go to the trash together with their thread
+type TrashRepository interface {
+	SoftDeleteAllByThreadID(threadID primitive.ObjectID, deletedAt primitive.DateTime) error
+}
+
 type Repository interface {
 	// Create
 	Create(domain *Domain) (Domain, error)
 	// Read
-	GetManyWithPagination(query dtoQuery.Request, domain *Domain) ([]Domain, int, error)
+	GetManyWithPagination(query dtoQuery.Request, domain *Domain, excludedCreatorIDs []primitive.ObjectID, matchAllTags bool) ([]Domain, int, error)
+	GetManyByCreatorIDs(query dtoQuery.Request, creatorIDs []primitive.ObjectID) ([]Domain, int, error)
 	GetByID(id primitive.ObjectID) (Domain, error)
 	GetAllByTopicID(topicID primitive.ObjectID) ([]Domain, error)
 	GetAllByUserID(userID primitive.ObjectID) ([]Domain, error)
+	CountByUserID(userID primitive.ObjectID) (int, error)
+	CountLikesByUserID(userID primitive.ObjectID) (int, error)
 	GetAll() ([]Domain, error)
 	GetLikedByUserID(userID primitive.ObjectID) ([]Domain, error)
 	CheckLikedByUserID(userID primitive.ObjectID, threadID primitive.ObjectID) error
+	GetTrashedByID(id primitive.ObjectID) (Domain, error)
+	GetTrashWithPagination(query dtoQuery.Request) ([]Domain, int, error)
+	GetAllTrashedBefore(before primitive.DateTime) ([]Domain, error)
+	GetDraftByID(id primitive.ObjectID) (Domain, error)
+	GetDraftsByCreatorID(creatorID primitive.ObjectID) ([]Domain, error)
+	GetDraftsDueBefore(before primitive.DateTime) ([]Domain, error)
+	GetTagCounts(prefix string, limit int) ([]TagCount, error)
+	CountByTag(name string) (int, error)
 	// Update
 	Update(domain *Domain) (Domain, error)
 	SuspendByUserID(domain *Domain) error
+	UnsuspendByUserID(domain *Domain) error
+	UpdateSuspendStatus(domain *Domain) error
+	UpdatePinStatus(domain *Domain) error
+	UpdateLockStatus(domain *Domain) error
 	AppendLike(userID primitive.ObjectID, threadID primitive.ObjectID) error
 	RemoveLike(userID primitive.ObjectID, threadID primitive.ObjectID) error
 	RemoveUserFromAllLikes(userID primitive.ObjectID) error
+	SoftDelete(domain *Domain) error
+	Restore(id primitive.ObjectID) error
+	UpdateDraft(domain *Domain) (Domain, error)
+	Publish(domain *Domain) (Domain, error)
+	RenameTag(from string, to string) error
+	RemoveTag(name string) error
 	// Delete
 	Delete(id primitive.ObjectID) error
 	DeleteAllByUserID(id primitive.ObjectID) error
@@ -53,25 +100,54 @@ type Repository interface {
 type UseCase interface {
 	// Create
 	Create(domain *Domain, image *multipart.FileHeader) (Domain, error)
+	CreateDraft(domain *Domain, image *multipart.FileHeader) (Domain, error)
 	// Read
-	GetManyWithPagination(pagination dtoPagination.Request, domain *Domain) ([]Domain, int, int, error)
+	GetManyWithPagination(pagination dtoPagination.Request, domain *Domain, excludedCreatorIDs []primitive.ObjectID, matchAllTags bool) ([]Domain, int, int, error)
 	GetByID(id primitive.ObjectID) (Domain, error)
 	GetAllByTopicID(topicID primitive.ObjectID) ([]Domain, error)
 	GetAllByUserID(userID primitive.ObjectID) ([]Domain, error)
+	GetManyByUserID(pagination dtoPagination.Request, userID primitive.ObjectID) ([]Domain, int, int, error)
+	CountByUserID(userID primitive.ObjectID) (int, error)
+	CountLikesByUserID(userID primitive.ObjectID) (int, error)
 	GetAll() (int, error)
 	GetLikedByUserID(userID primitive.ObjectID) ([]Domain, error)
 	DomainToResponse(domain Domain, userID primitive.ObjectID) (dtoThread.Response, error)
 	DomainsToResponseArray(domains []Domain, userID primitive.ObjectID) ([]dtoThread.Response, error)
+	GetRevisions(userID primitive.ObjectID, threadID primitive.ObjectID) ([]threadRevisions.Domain, error)
+	CompareRevisions(userID primitive.ObjectID, threadID primitive.ObjectID, fromID primitive.ObjectID, toID primitive.ObjectID) (threadRevisions.Diff, error)
+	GetTrashWithPagination(pagination dtoPagination.Request) ([]Domain, int, int, error)
+	GetDrafts(userID primitive.ObjectID) ([]Domain, error)
+	GetDraftByID(userID primitive.ObjectID, threadID primitive.ObjectID) (Domain, error)
+	GetTags(prefix string, limit int) ([]TagCount, error)
+	GetBannedTags() ([]bannedTags.Domain, error)
 	// Update
 	UserUpdate(domain *Domain, image *multipart.FileHeader) (Domain, error)
 	AdminUpdate(domain *Domain, image *multipart.FileHeader) (Domain, error)
-	SuspendByUserID(userID primitive.ObjectID) error
+	SuspendByUserID(userID primitive.ObjectID, detail string) error
+	UnsuspendByUserID(userID primitive.ObjectID) error
+	ModeratorSuspend(moderatorID primitive.ObjectID, threadID primitive.ObjectID, detail string) (Domain, error)
+	ModeratorUnsuspend(moderatorID primitive.ObjectID, threadID primitive.ObjectID) (Domain, error)
+	Pin(moderatorID primitive.ObjectID, threadID primitive.ObjectID, scope string) (Domain, error)
+	Unpin(moderatorID primitive.ObjectID, threadID primitive.ObjectID) (Domain, error)
+	Lock(moderatorID primitive.ObjectID, threadID primitive.ObjectID) (Domain, error)
+	Unlock(moderatorID primitive.ObjectID, threadID primitive.ObjectID) (Domain, error)
 	Like(userID primitive.ObjectID, threadID primitive.ObjectID) error
 	Unlike(userID primitive.ObjectID, threadID primitive.ObjectID) error
 	RemoveUserFromAllLikes(userID primitive.ObjectID) error
+	Restore(threadID primitive.ObjectID) (Domain, error)
+	UpdateDraft(domain *Domain, image *multipart.FileHeader) (Domain, error)
+	PublishDraft(userID primitive.ObjectID, threadID primitive.ObjectID) (Domain, error)
+	PublishScheduled() ([]Domain, error)
+	RenameTag(from string, to string) error
+	MergeTag(from string, to string) error
+	BanTag(adminID primitive.ObjectID, name string) (bannedTags.Domain, error)
 	// Delete
 	Delete(userID primitive.ObjectID, threadID primitive.ObjectID) (Domain, error)
 	DeleteAllByUserID(id primitive.ObjectID) error
 	DeleteByThreadID(threadID primitive.ObjectID) error
 	AdminDelete(threadID primitive.ObjectID) (Domain, error)
+	ModeratorDelete(moderatorID primitive.ObjectID, threadID primitive.ObjectID) (Domain, error)
+	PurgeTrash() ([]Domain, error)
+	DeleteDraft(userID primitive.ObjectID, threadID primitive.ObjectID) (Domain, error)
+	UnbanTag(name string) (bannedTags.Domain, error)
 }
@@ -44,6 +44,69 @@ func (_m *Repository) CheckLikedByUserID(userID primitive.ObjectID, threadID pri
 	return r0
 }
 
+// CountByTag provides a mock function with given fields: name
+func (_m *Repository) CountByTag(name string) (int, error) {
+	ret := _m.Called(name)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(string) int); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountByUserID provides a mock function with given fields: userID
+func (_m *Repository) CountByUserID(userID primitive.ObjectID) (int, error) {
+	ret := _m.Called(userID)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID) int); ok {
+		r0 = rf(userID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(primitive.ObjectID) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountLikesByUserID provides a mock function with given fields: userID
+func (_m *Repository) CountLikesByUserID(userID primitive.ObjectID) (int, error) {
+	ret := _m.Called(userID)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID) int); ok {
+		r0 = rf(userID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(primitive.ObjectID) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Create provides a mock function with given fields: domain
 func (_m *Repository) Create(domain *threads.Domain) (threads.Domain, error) {
 	ret := _m.Called(domain)
@@ -162,6 +225,29 @@ func (_m *Repository) GetAllByUserID(userID primitive.ObjectID) ([]threads.Domai
 	return r0, r1
 }
 
+// GetAllTrashedBefore provides a mock function with given fields: before
+func (_m *Repository) GetAllTrashedBefore(before primitive.DateTime) ([]threads.Domain, error) {
+	ret := _m.Called(before)
+
+	var r0 []threads.Domain
+	if rf, ok := ret.Get(0).(func(primitive.DateTime) []threads.Domain); ok {
+		r0 = rf(before)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]threads.Domain)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(primitive.DateTime) error); ok {
+		r1 = rf(before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetByID provides a mock function with given fields: id
 func (_m *Repository) GetByID(id primitive.ObjectID) (threads.Domain, error) {
 	ret := _m.Called(id)
@@ -183,6 +269,73 @@ func (_m *Repository) GetByID(id primitive.ObjectID) (threads.Domain, error) {
 	return r0, r1
 }
 
+// GetDraftByID provides a mock function with given fields: id
+func (_m *Repository) GetDraftByID(id primitive.ObjectID) (threads.Domain, error) {
+	ret := _m.Called(id)
+
+	var r0 threads.Domain
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID) threads.Domain); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(threads.Domain)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(primitive.ObjectID) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDraftsByCreatorID provides a mock function with given fields: creatorID
+func (_m *Repository) GetDraftsByCreatorID(creatorID primitive.ObjectID) ([]threads.Domain, error) {
+	ret := _m.Called(creatorID)
+
+	var r0 []threads.Domain
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID) []threads.Domain); ok {
+		r0 = rf(creatorID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]threads.Domain)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(primitive.ObjectID) error); ok {
+		r1 = rf(creatorID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDraftsDueBefore provides a mock function with given fields: before
+func (_m *Repository) GetDraftsDueBefore(before primitive.DateTime) ([]threads.Domain, error) {
+	ret := _m.Called(before)
+
+	var r0 []threads.Domain
+	if rf, ok := ret.Get(0).(func(primitive.DateTime) []threads.Domain); ok {
+		r0 = rf(before)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]threads.Domain)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(primitive.DateTime) error); ok {
+		r1 = rf(before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetLikedByUserID provides a mock function with given fields: userID
 func (_m *Repository) GetLikedByUserID(userID primitive.ObjectID) ([]threads.Domain, error) {
 	ret := _m.Called(userID)
@@ -206,13 +359,96 @@ func (_m *Repository) GetLikedByUserID(userID primitive.ObjectID) ([]threads.Dom
 	return r0, r1
 }
 
-// GetManyWithPagination provides a mock function with given fields: _a0, domain
-func (_m *Repository) GetManyWithPagination(_a0 query.Request, domain *threads.Domain) ([]threads.Domain, int, error) {
-	ret := _m.Called(_a0, domain)
+// GetManyByCreatorIDs provides a mock function with given fields: _a0, creatorIDs
+func (_m *Repository) GetManyByCreatorIDs(_a0 query.Request, creatorIDs []primitive.ObjectID) ([]threads.Domain, int, error) {
+	ret := _m.Called(_a0, creatorIDs)
+
+	var r0 []threads.Domain
+	if rf, ok := ret.Get(0).(func(query.Request, []primitive.ObjectID) []threads.Domain); ok {
+		r0 = rf(_a0, creatorIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]threads.Domain)
+		}
+	}
+
+	var r1 int
+	if rf, ok := ret.Get(1).(func(query.Request, []primitive.ObjectID) int); ok {
+		r1 = rf(_a0, creatorIDs)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(query.Request, []primitive.ObjectID) error); ok {
+		r2 = rf(_a0, creatorIDs)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetManyWithPagination provides a mock function with given fields: _a0, domain, excludedCreatorIDs, matchAllTags
+func (_m *Repository) GetManyWithPagination(_a0 query.Request, domain *threads.Domain, excludedCreatorIDs []primitive.ObjectID, matchAllTags bool) ([]threads.Domain, int, error) {
+	ret := _m.Called(_a0, domain, excludedCreatorIDs, matchAllTags)
+
+	var r0 []threads.Domain
+	if rf, ok := ret.Get(0).(func(query.Request, *threads.Domain, []primitive.ObjectID, bool) []threads.Domain); ok {
+		r0 = rf(_a0, domain, excludedCreatorIDs, matchAllTags)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]threads.Domain)
+		}
+	}
+
+	var r1 int
+	if rf, ok := ret.Get(1).(func(query.Request, *threads.Domain, []primitive.ObjectID, bool) int); ok {
+		r1 = rf(_a0, domain, excludedCreatorIDs, matchAllTags)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(query.Request, *threads.Domain, []primitive.ObjectID, bool) error); ok {
+		r2 = rf(_a0, domain, excludedCreatorIDs, matchAllTags)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetTagCounts provides a mock function with given fields: prefix, limit
+func (_m *Repository) GetTagCounts(prefix string, limit int) ([]threads.TagCount, error) {
+	ret := _m.Called(prefix, limit)
+
+	var r0 []threads.TagCount
+	if rf, ok := ret.Get(0).(func(string, int) []threads.TagCount); ok {
+		r0 = rf(prefix, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]threads.TagCount)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(prefix, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTrashWithPagination provides a mock function with given fields: _a0
+func (_m *Repository) GetTrashWithPagination(_a0 query.Request) ([]threads.Domain, int, error) {
+	ret := _m.Called(_a0)
 
 	var r0 []threads.Domain
-	if rf, ok := ret.Get(0).(func(query.Request, *threads.Domain) []threads.Domain); ok {
-		r0 = rf(_a0, domain)
+	if rf, ok := ret.Get(0).(func(query.Request) []threads.Domain); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]threads.Domain)
@@ -220,15 +456,15 @@ func (_m *Repository) GetManyWithPagination(_a0 query.Request, domain *threads.D
 	}
 
 	var r1 int
-	if rf, ok := ret.Get(1).(func(query.Request, *threads.Domain) int); ok {
-		r1 = rf(_a0, domain)
+	if rf, ok := ret.Get(1).(func(query.Request) int); ok {
+		r1 = rf(_a0)
 	} else {
 		r1 = ret.Get(1).(int)
 	}
 
 	var r2 error
-	if rf, ok := ret.Get(2).(func(query.Request, *threads.Domain) error); ok {
-		r2 = rf(_a0, domain)
+	if rf, ok := ret.Get(2).(func(query.Request) error); ok {
+		r2 = rf(_a0)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -236,6 +472,48 @@ func (_m *Repository) GetManyWithPagination(_a0 query.Request, domain *threads.D
 	return r0, r1, r2
 }
 
+// GetTrashedByID provides a mock function with given fields: id
+func (_m *Repository) GetTrashedByID(id primitive.ObjectID) (threads.Domain, error) {
+	ret := _m.Called(id)
+
+	var r0 threads.Domain
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID) threads.Domain); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(threads.Domain)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(primitive.ObjectID) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Publish provides a mock function with given fields: domain
+func (_m *Repository) Publish(domain *threads.Domain) (threads.Domain, error) {
+	ret := _m.Called(domain)
+
+	var r0 threads.Domain
+	if rf, ok := ret.Get(0).(func(*threads.Domain) threads.Domain); ok {
+		r0 = rf(domain)
+	} else {
+		r0 = ret.Get(0).(threads.Domain)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*threads.Domain) error); ok {
+		r1 = rf(domain)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RemoveLike provides a mock function with given fields: userID, threadID
 func (_m *Repository) RemoveLike(userID primitive.ObjectID, threadID primitive.ObjectID) error {
 	ret := _m.Called(userID, threadID)
@@ -250,6 +528,20 @@ func (_m *Repository) RemoveLike(userID primitive.ObjectID, threadID primitive.O
 	return r0
 }
 
+// RemoveTag provides a mock function with given fields: name
+func (_m *Repository) RemoveTag(name string) error {
+	ret := _m.Called(name)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // RemoveUserFromAllLikes provides a mock function with given fields: userID
 func (_m *Repository) RemoveUserFromAllLikes(userID primitive.ObjectID) error {
 	ret := _m.Called(userID)
@@ -264,6 +556,48 @@ func (_m *Repository) RemoveUserFromAllLikes(userID primitive.ObjectID) error {
 	return r0
 }
 
+// RenameTag provides a mock function with given fields: from, to
+func (_m *Repository) RenameTag(from string, to string) error {
+	ret := _m.Called(from, to)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(from, to)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Restore provides a mock function with given fields: id
+func (_m *Repository) Restore(id primitive.ObjectID) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SoftDelete provides a mock function with given fields: domain
+func (_m *Repository) SoftDelete(domain *threads.Domain) error {
+	ret := _m.Called(domain)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*threads.Domain) error); ok {
+		r0 = rf(domain)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SuspendByUserID provides a mock function with given fields: domain
 func (_m *Repository) SuspendByUserID(domain *threads.Domain) error {
 	ret := _m.Called(domain)
@@ -278,6 +612,20 @@ func (_m *Repository) SuspendByUserID(domain *threads.Domain) error {
 	return r0
 }
 
+// UnsuspendByUserID provides a mock function with given fields: domain
+func (_m *Repository) UnsuspendByUserID(domain *threads.Domain) error {
+	ret := _m.Called(domain)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*threads.Domain) error); ok {
+		r0 = rf(domain)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields: domain
 func (_m *Repository) Update(domain *threads.Domain) (threads.Domain, error) {
 	ret := _m.Called(domain)
@@ -299,6 +647,69 @@ func (_m *Repository) Update(domain *threads.Domain) (threads.Domain, error) {
 	return r0, r1
 }
 
+// UpdateDraft provides a mock function with given fields: domain
+func (_m *Repository) UpdateDraft(domain *threads.Domain) (threads.Domain, error) {
+	ret := _m.Called(domain)
+
+	var r0 threads.Domain
+	if rf, ok := ret.Get(0).(func(*threads.Domain) threads.Domain); ok {
+		r0 = rf(domain)
+	} else {
+		r0 = ret.Get(0).(threads.Domain)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*threads.Domain) error); ok {
+		r1 = rf(domain)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateLockStatus provides a mock function with given fields: domain
+func (_m *Repository) UpdateLockStatus(domain *threads.Domain) error {
+	ret := _m.Called(domain)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*threads.Domain) error); ok {
+		r0 = rf(domain)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdatePinStatus provides a mock function with given fields: domain
+func (_m *Repository) UpdatePinStatus(domain *threads.Domain) error {
+	ret := _m.Called(domain)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*threads.Domain) error); ok {
+		r0 = rf(domain)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateSuspendStatus provides a mock function with given fields: domain
+func (_m *Repository) UpdateSuspendStatus(domain *threads.Domain) error {
+	ret := _m.Called(domain)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*threads.Domain) error); ok {
+		r0 = rf(domain)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewRepository interface {
 	mock.TestingT
 	Cleanup(func())
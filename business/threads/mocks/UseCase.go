@@ -14,6 +14,10 @@ import (
 	primitive "go.mongodb.org/mongo-driver/bson/primitive"
 
 	threads "charum/business/threads"
+
+	thread_revisions "charum/business/thread_revisions"
+
+	banned_tags "charum/business/banned_tags"
 )
 
 // UseCase is an autogenerated mock type for the UseCase type
@@ -63,6 +67,90 @@ func (_m *UseCase) AdminUpdate(domain *threads.Domain, image *multipart.FileHead
 	return r0, r1
 }
 
+// BanTag provides a mock function with given fields: adminID, name
+func (_m *UseCase) BanTag(adminID primitive.ObjectID, name string) (banned_tags.Domain, error) {
+	ret := _m.Called(adminID, name)
+
+	var r0 banned_tags.Domain
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID, string) banned_tags.Domain); ok {
+		r0 = rf(adminID, name)
+	} else {
+		r0 = ret.Get(0).(banned_tags.Domain)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(primitive.ObjectID, string) error); ok {
+		r1 = rf(adminID, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CompareRevisions provides a mock function with given fields: userID, threadID, fromID, toID
+func (_m *UseCase) CompareRevisions(userID primitive.ObjectID, threadID primitive.ObjectID, fromID primitive.ObjectID, toID primitive.ObjectID) (thread_revisions.Diff, error) {
+	ret := _m.Called(userID, threadID, fromID, toID)
+
+	var r0 thread_revisions.Diff
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID, primitive.ObjectID, primitive.ObjectID, primitive.ObjectID) thread_revisions.Diff); ok {
+		r0 = rf(userID, threadID, fromID, toID)
+	} else {
+		r0 = ret.Get(0).(thread_revisions.Diff)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(primitive.ObjectID, primitive.ObjectID, primitive.ObjectID, primitive.ObjectID) error); ok {
+		r1 = rf(userID, threadID, fromID, toID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountByUserID provides a mock function with given fields: userID
+func (_m *UseCase) CountByUserID(userID primitive.ObjectID) (int, error) {
+	ret := _m.Called(userID)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID) int); ok {
+		r0 = rf(userID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(primitive.ObjectID) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountLikesByUserID provides a mock function with given fields: userID
+func (_m *UseCase) CountLikesByUserID(userID primitive.ObjectID) (int, error) {
+	ret := _m.Called(userID)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID) int); ok {
+		r0 = rf(userID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(primitive.ObjectID) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Create provides a mock function with given fields: domain, image
 func (_m *UseCase) Create(domain *threads.Domain, image *multipart.FileHeader) (threads.Domain, error) {
 	ret := _m.Called(domain, image)
@@ -84,6 +172,27 @@ func (_m *UseCase) Create(domain *threads.Domain, image *multipart.FileHeader) (
 	return r0, r1
 }
 
+// CreateDraft provides a mock function with given fields: domain, image
+func (_m *UseCase) CreateDraft(domain *threads.Domain, image *multipart.FileHeader) (threads.Domain, error) {
+	ret := _m.Called(domain, image)
+
+	var r0 threads.Domain
+	if rf, ok := ret.Get(0).(func(*threads.Domain, *multipart.FileHeader) threads.Domain); ok {
+		r0 = rf(domain, image)
+	} else {
+		r0 = ret.Get(0).(threads.Domain)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*threads.Domain, *multipart.FileHeader) error); ok {
+		r1 = rf(domain, image)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Delete provides a mock function with given fields: userID, threadID
 func (_m *UseCase) Delete(userID primitive.ObjectID, threadID primitive.ObjectID) (threads.Domain, error) {
 	ret := _m.Called(userID, threadID)
@@ -133,6 +242,27 @@ func (_m *UseCase) DeleteByThreadID(threadID primitive.ObjectID) error {
 	return r0
 }
 
+// DeleteDraft provides a mock function with given fields: userID, threadID
+func (_m *UseCase) DeleteDraft(userID primitive.ObjectID, threadID primitive.ObjectID) (threads.Domain, error) {
+	ret := _m.Called(userID, threadID)
+
+	var r0 threads.Domain
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID, primitive.ObjectID) threads.Domain); ok {
+		r0 = rf(userID, threadID)
+	} else {
+		r0 = ret.Get(0).(threads.Domain)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(primitive.ObjectID, primitive.ObjectID) error); ok {
+		r1 = rf(userID, threadID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DomainToResponse provides a mock function with given fields: domain, userID
 func (_m *UseCase) DomainToResponse(domain threads.Domain, userID primitive.ObjectID) (dtothreads.Response, error) {
 	ret := _m.Called(domain, userID)
@@ -244,6 +374,29 @@ func (_m *UseCase) GetAllByUserID(userID primitive.ObjectID) ([]threads.Domain,
 	return r0, r1
 }
 
+// GetBannedTags provides a mock function with given fields:
+func (_m *UseCase) GetBannedTags() ([]banned_tags.Domain, error) {
+	ret := _m.Called()
+
+	var r0 []banned_tags.Domain
+	if rf, ok := ret.Get(0).(func() []banned_tags.Domain); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]banned_tags.Domain)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetByID provides a mock function with given fields: id
 func (_m *UseCase) GetByID(id primitive.ObjectID) (threads.Domain, error) {
 	ret := _m.Called(id)
@@ -265,6 +418,50 @@ func (_m *UseCase) GetByID(id primitive.ObjectID) (threads.Domain, error) {
 	return r0, r1
 }
 
+// GetDraftByID provides a mock function with given fields: userID, threadID
+func (_m *UseCase) GetDraftByID(userID primitive.ObjectID, threadID primitive.ObjectID) (threads.Domain, error) {
+	ret := _m.Called(userID, threadID)
+
+	var r0 threads.Domain
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID, primitive.ObjectID) threads.Domain); ok {
+		r0 = rf(userID, threadID)
+	} else {
+		r0 = ret.Get(0).(threads.Domain)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(primitive.ObjectID, primitive.ObjectID) error); ok {
+		r1 = rf(userID, threadID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDrafts provides a mock function with given fields: userID
+func (_m *UseCase) GetDrafts(userID primitive.ObjectID) ([]threads.Domain, error) {
+	ret := _m.Called(userID)
+
+	var r0 []threads.Domain
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID) []threads.Domain); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]threads.Domain)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(primitive.ObjectID) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetLikedByUserID provides a mock function with given fields: userID
 func (_m *UseCase) GetLikedByUserID(userID primitive.ObjectID) ([]threads.Domain, error) {
 	ret := _m.Called(userID)
@@ -288,13 +485,13 @@ func (_m *UseCase) GetLikedByUserID(userID primitive.ObjectID) ([]threads.Domain
 	return r0, r1
 }
 
-// GetManyWithPagination provides a mock function with given fields: _a0, domain
-func (_m *UseCase) GetManyWithPagination(_a0 pagination.Request, domain *threads.Domain) ([]threads.Domain, int, int, error) {
-	ret := _m.Called(_a0, domain)
+// GetManyByUserID provides a mock function with given fields: _a0, userID
+func (_m *UseCase) GetManyByUserID(_a0 pagination.Request, userID primitive.ObjectID) ([]threads.Domain, int, int, error) {
+	ret := _m.Called(_a0, userID)
 
 	var r0 []threads.Domain
-	if rf, ok := ret.Get(0).(func(pagination.Request, *threads.Domain) []threads.Domain); ok {
-		r0 = rf(_a0, domain)
+	if rf, ok := ret.Get(0).(func(pagination.Request, primitive.ObjectID) []threads.Domain); ok {
+		r0 = rf(_a0, userID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]threads.Domain)
@@ -302,22 +499,142 @@ func (_m *UseCase) GetManyWithPagination(_a0 pagination.Request, domain *threads
 	}
 
 	var r1 int
-	if rf, ok := ret.Get(1).(func(pagination.Request, *threads.Domain) int); ok {
-		r1 = rf(_a0, domain)
+	if rf, ok := ret.Get(1).(func(pagination.Request, primitive.ObjectID) int); ok {
+		r1 = rf(_a0, userID)
 	} else {
 		r1 = ret.Get(1).(int)
 	}
 
 	var r2 int
-	if rf, ok := ret.Get(2).(func(pagination.Request, *threads.Domain) int); ok {
-		r2 = rf(_a0, domain)
+	if rf, ok := ret.Get(2).(func(pagination.Request, primitive.ObjectID) int); ok {
+		r2 = rf(_a0, userID)
 	} else {
 		r2 = ret.Get(2).(int)
 	}
 
 	var r3 error
-	if rf, ok := ret.Get(3).(func(pagination.Request, *threads.Domain) error); ok {
-		r3 = rf(_a0, domain)
+	if rf, ok := ret.Get(3).(func(pagination.Request, primitive.ObjectID) error); ok {
+		r3 = rf(_a0, userID)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
+// GetManyWithPagination provides a mock function with given fields: _a0, domain, excludedCreatorIDs, matchAllTags
+func (_m *UseCase) GetManyWithPagination(_a0 pagination.Request, domain *threads.Domain, excludedCreatorIDs []primitive.ObjectID, matchAllTags bool) ([]threads.Domain, int, int, error) {
+	ret := _m.Called(_a0, domain, excludedCreatorIDs, matchAllTags)
+
+	var r0 []threads.Domain
+	if rf, ok := ret.Get(0).(func(pagination.Request, *threads.Domain, []primitive.ObjectID, bool) []threads.Domain); ok {
+		r0 = rf(_a0, domain, excludedCreatorIDs, matchAllTags)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]threads.Domain)
+		}
+	}
+
+	var r1 int
+	if rf, ok := ret.Get(1).(func(pagination.Request, *threads.Domain, []primitive.ObjectID, bool) int); ok {
+		r1 = rf(_a0, domain, excludedCreatorIDs, matchAllTags)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 int
+	if rf, ok := ret.Get(2).(func(pagination.Request, *threads.Domain, []primitive.ObjectID, bool) int); ok {
+		r2 = rf(_a0, domain, excludedCreatorIDs, matchAllTags)
+	} else {
+		r2 = ret.Get(2).(int)
+	}
+
+	var r3 error
+	if rf, ok := ret.Get(3).(func(pagination.Request, *threads.Domain, []primitive.ObjectID, bool) error); ok {
+		r3 = rf(_a0, domain, excludedCreatorIDs, matchAllTags)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
+// GetRevisions provides a mock function with given fields: userID, threadID
+func (_m *UseCase) GetRevisions(userID primitive.ObjectID, threadID primitive.ObjectID) ([]thread_revisions.Domain, error) {
+	ret := _m.Called(userID, threadID)
+
+	var r0 []thread_revisions.Domain
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID, primitive.ObjectID) []thread_revisions.Domain); ok {
+		r0 = rf(userID, threadID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]thread_revisions.Domain)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(primitive.ObjectID, primitive.ObjectID) error); ok {
+		r1 = rf(userID, threadID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTags provides a mock function with given fields: prefix, limit
+func (_m *UseCase) GetTags(prefix string, limit int) ([]threads.TagCount, error) {
+	ret := _m.Called(prefix, limit)
+
+	var r0 []threads.TagCount
+	if rf, ok := ret.Get(0).(func(string, int) []threads.TagCount); ok {
+		r0 = rf(prefix, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]threads.TagCount)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(prefix, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTrashWithPagination provides a mock function with given fields: _a0
+func (_m *UseCase) GetTrashWithPagination(_a0 pagination.Request) ([]threads.Domain, int, int, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []threads.Domain
+	if rf, ok := ret.Get(0).(func(pagination.Request) []threads.Domain); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]threads.Domain)
+		}
+	}
+
+	var r1 int
+	if rf, ok := ret.Get(1).(func(pagination.Request) int); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 int
+	if rf, ok := ret.Get(2).(func(pagination.Request) int); ok {
+		r2 = rf(_a0)
+	} else {
+		r2 = ret.Get(2).(int)
+	}
+
+	var r3 error
+	if rf, ok := ret.Get(3).(func(pagination.Request) error); ok {
+		r3 = rf(_a0)
 	} else {
 		r3 = ret.Error(3)
 	}
@@ -339,6 +656,192 @@ func (_m *UseCase) Like(userID primitive.ObjectID, threadID primitive.ObjectID)
 	return r0
 }
 
+// Lock provides a mock function with given fields: moderatorID, threadID
+func (_m *UseCase) Lock(moderatorID primitive.ObjectID, threadID primitive.ObjectID) (threads.Domain, error) {
+	ret := _m.Called(moderatorID, threadID)
+
+	var r0 threads.Domain
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID, primitive.ObjectID) threads.Domain); ok {
+		r0 = rf(moderatorID, threadID)
+	} else {
+		r0 = ret.Get(0).(threads.Domain)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(primitive.ObjectID, primitive.ObjectID) error); ok {
+		r1 = rf(moderatorID, threadID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MergeTag provides a mock function with given fields: from, to
+func (_m *UseCase) MergeTag(from string, to string) error {
+	ret := _m.Called(from, to)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(from, to)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ModeratorDelete provides a mock function with given fields: moderatorID, threadID
+func (_m *UseCase) ModeratorDelete(moderatorID primitive.ObjectID, threadID primitive.ObjectID) (threads.Domain, error) {
+	ret := _m.Called(moderatorID, threadID)
+
+	var r0 threads.Domain
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID, primitive.ObjectID) threads.Domain); ok {
+		r0 = rf(moderatorID, threadID)
+	} else {
+		r0 = ret.Get(0).(threads.Domain)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(primitive.ObjectID, primitive.ObjectID) error); ok {
+		r1 = rf(moderatorID, threadID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModeratorSuspend provides a mock function with given fields: moderatorID, threadID, detail
+func (_m *UseCase) ModeratorSuspend(moderatorID primitive.ObjectID, threadID primitive.ObjectID, detail string) (threads.Domain, error) {
+	ret := _m.Called(moderatorID, threadID, detail)
+
+	var r0 threads.Domain
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID, primitive.ObjectID, string) threads.Domain); ok {
+		r0 = rf(moderatorID, threadID, detail)
+	} else {
+		r0 = ret.Get(0).(threads.Domain)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(primitive.ObjectID, primitive.ObjectID, string) error); ok {
+		r1 = rf(moderatorID, threadID, detail)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModeratorUnsuspend provides a mock function with given fields: moderatorID, threadID
+func (_m *UseCase) ModeratorUnsuspend(moderatorID primitive.ObjectID, threadID primitive.ObjectID) (threads.Domain, error) {
+	ret := _m.Called(moderatorID, threadID)
+
+	var r0 threads.Domain
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID, primitive.ObjectID) threads.Domain); ok {
+		r0 = rf(moderatorID, threadID)
+	} else {
+		r0 = ret.Get(0).(threads.Domain)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(primitive.ObjectID, primitive.ObjectID) error); ok {
+		r1 = rf(moderatorID, threadID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Pin provides a mock function with given fields: moderatorID, threadID, scope
+func (_m *UseCase) Pin(moderatorID primitive.ObjectID, threadID primitive.ObjectID, scope string) (threads.Domain, error) {
+	ret := _m.Called(moderatorID, threadID, scope)
+
+	var r0 threads.Domain
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID, primitive.ObjectID, string) threads.Domain); ok {
+		r0 = rf(moderatorID, threadID, scope)
+	} else {
+		r0 = ret.Get(0).(threads.Domain)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(primitive.ObjectID, primitive.ObjectID, string) error); ok {
+		r1 = rf(moderatorID, threadID, scope)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PublishDraft provides a mock function with given fields: userID, threadID
+func (_m *UseCase) PublishDraft(userID primitive.ObjectID, threadID primitive.ObjectID) (threads.Domain, error) {
+	ret := _m.Called(userID, threadID)
+
+	var r0 threads.Domain
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID, primitive.ObjectID) threads.Domain); ok {
+		r0 = rf(userID, threadID)
+	} else {
+		r0 = ret.Get(0).(threads.Domain)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(primitive.ObjectID, primitive.ObjectID) error); ok {
+		r1 = rf(userID, threadID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PublishScheduled provides a mock function with given fields:
+func (_m *UseCase) PublishScheduled() ([]threads.Domain, error) {
+	ret := _m.Called()
+
+	var r0 []threads.Domain
+	if rf, ok := ret.Get(0).(func() []threads.Domain); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]threads.Domain)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PurgeTrash provides a mock function with given fields:
+func (_m *UseCase) PurgeTrash() ([]threads.Domain, error) {
+	ret := _m.Called()
+
+	var r0 []threads.Domain
+	if rf, ok := ret.Get(0).(func() []threads.Domain); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]threads.Domain)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RemoveUserFromAllLikes provides a mock function with given fields: userID
 func (_m *UseCase) RemoveUserFromAllLikes(userID primitive.ObjectID) error {
 	ret := _m.Called(userID)
@@ -353,13 +856,48 @@ func (_m *UseCase) RemoveUserFromAllLikes(userID primitive.ObjectID) error {
 	return r0
 }
 
-// SuspendByUserID provides a mock function with given fields: userID
-func (_m *UseCase) SuspendByUserID(userID primitive.ObjectID) error {
-	ret := _m.Called(userID)
+// RenameTag provides a mock function with given fields: from, to
+func (_m *UseCase) RenameTag(from string, to string) error {
+	ret := _m.Called(from, to)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(primitive.ObjectID) error); ok {
-		r0 = rf(userID)
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(from, to)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Restore provides a mock function with given fields: threadID
+func (_m *UseCase) Restore(threadID primitive.ObjectID) (threads.Domain, error) {
+	ret := _m.Called(threadID)
+
+	var r0 threads.Domain
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID) threads.Domain); ok {
+		r0 = rf(threadID)
+	} else {
+		r0 = ret.Get(0).(threads.Domain)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(primitive.ObjectID) error); ok {
+		r1 = rf(threadID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SuspendByUserID provides a mock function with given fields: userID, detail
+func (_m *UseCase) SuspendByUserID(userID primitive.ObjectID, detail string) error {
+	ret := _m.Called(userID, detail)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID, string) error); ok {
+		r0 = rf(userID, detail)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -367,6 +905,27 @@ func (_m *UseCase) SuspendByUserID(userID primitive.ObjectID) error {
 	return r0
 }
 
+// UnbanTag provides a mock function with given fields: name
+func (_m *UseCase) UnbanTag(name string) (banned_tags.Domain, error) {
+	ret := _m.Called(name)
+
+	var r0 banned_tags.Domain
+	if rf, ok := ret.Get(0).(func(string) banned_tags.Domain); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(banned_tags.Domain)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Unlike provides a mock function with given fields: userID, threadID
 func (_m *UseCase) Unlike(userID primitive.ObjectID, threadID primitive.ObjectID) error {
 	ret := _m.Called(userID, threadID)
@@ -381,6 +940,83 @@ func (_m *UseCase) Unlike(userID primitive.ObjectID, threadID primitive.ObjectID
 	return r0
 }
 
+// Unlock provides a mock function with given fields: moderatorID, threadID
+func (_m *UseCase) Unlock(moderatorID primitive.ObjectID, threadID primitive.ObjectID) (threads.Domain, error) {
+	ret := _m.Called(moderatorID, threadID)
+
+	var r0 threads.Domain
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID, primitive.ObjectID) threads.Domain); ok {
+		r0 = rf(moderatorID, threadID)
+	} else {
+		r0 = ret.Get(0).(threads.Domain)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(primitive.ObjectID, primitive.ObjectID) error); ok {
+		r1 = rf(moderatorID, threadID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Unpin provides a mock function with given fields: moderatorID, threadID
+func (_m *UseCase) Unpin(moderatorID primitive.ObjectID, threadID primitive.ObjectID) (threads.Domain, error) {
+	ret := _m.Called(moderatorID, threadID)
+
+	var r0 threads.Domain
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID, primitive.ObjectID) threads.Domain); ok {
+		r0 = rf(moderatorID, threadID)
+	} else {
+		r0 = ret.Get(0).(threads.Domain)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(primitive.ObjectID, primitive.ObjectID) error); ok {
+		r1 = rf(moderatorID, threadID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UnsuspendByUserID provides a mock function with given fields: userID
+func (_m *UseCase) UnsuspendByUserID(userID primitive.ObjectID) error {
+	ret := _m.Called(userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID) error); ok {
+		r0 = rf(userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateDraft provides a mock function with given fields: domain, image
+func (_m *UseCase) UpdateDraft(domain *threads.Domain, image *multipart.FileHeader) (threads.Domain, error) {
+	ret := _m.Called(domain, image)
+
+	var r0 threads.Domain
+	if rf, ok := ret.Get(0).(func(*threads.Domain, *multipart.FileHeader) threads.Domain); ok {
+		r0 = rf(domain, image)
+	} else {
+		r0 = ret.Get(0).(threads.Domain)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*threads.Domain, *multipart.FileHeader) error); ok {
+		r1 = rf(domain, image)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UserUpdate provides a mock function with given fields: domain, image
 func (_m *UseCase) UserUpdate(domain *threads.Domain, image *multipart.FileHeader) (threads.Domain, error) {
 	ret := _m.Called(domain, image)
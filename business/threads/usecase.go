@@ -1,34 +1,66 @@
 package threads
 
 import (
+	bannedTags "charum/business/banned_tags"
+	"charum/business/permissions"
+	threadRevisions "charum/business/thread_revisions"
 	"charum/business/topics"
 	"charum/business/users"
 	dtoPagination "charum/dto/pagination"
 	dtoQuery "charum/dto/query"
 	dtoThread "charum/dto/threads"
+	dtoUser "charum/dto/users"
 	"charum/helper/cloudinary"
 	"charum/util"
 	"errors"
+	"fmt"
 	"math"
 	"mime/multipart"
+	"regexp"
+	"strings"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
+const (
+	// trashRetention is how long a soft deleted thread stays restorable before PurgeTrash removes it for good
+	trashRetention = 30 * 24 * time.Hour
+
+	maxTags      = 5
+	maxTagLength = 30
+)
+
+var (
+	tagPattern    = regexp.MustCompile("^[a-z0-9]+(-[a-z0-9]+)*$")
+	tagSeparators = regexp.MustCompile("[\\s_]+")
+)
+
 type ThreadUseCase struct {
-	threadRepository Repository
-	topicRepository  topics.Repository
-	userRepository   users.Repository
-	cloudinary       cloudinary.Function
+	threadRepository         Repository
+	threadRevisionRepository threadRevisions.Repository
+	bannedTagRepository      bannedTags.Repository
+	topicRepository          topics.Repository
+	userRepository           users.Repository
+	permissionRepository     permissions.Repository
+	commentRepository        TrashRepository
+	followThreadRepository   TrashRepository
+	bookmarkRepository       TrashRepository
+	cloudinary               cloudinary.Function
 }
 
-func NewThreadUseCase(thr Repository, tor topics.Repository, ur users.Repository, c cloudinary.Function) UseCase {
+func NewThreadUseCase(thr Repository, trr threadRevisions.Repository, btr bannedTags.Repository, tor topics.Repository, ur users.Repository, pr permissions.Repository, cr TrashRepository, ftr TrashRepository, br TrashRepository, c cloudinary.Function) UseCase {
 	return &ThreadUseCase{
-		threadRepository: thr,
-		topicRepository:  tor,
-		userRepository:   ur,
-		cloudinary:       c,
+		threadRepository:         thr,
+		threadRevisionRepository: trr,
+		bannedTagRepository:      btr,
+		topicRepository:          tor,
+		userRepository:           ur,
+		permissionRepository:     pr,
+		commentRepository:        cr,
+		followThreadRepository:   ftr,
+		bookmarkRepository:       br,
+		cloudinary:               c,
 	}
 }
 
@@ -42,6 +74,11 @@ func (tu *ThreadUseCase) Create(domain *Domain, image *multipart.FileHeader) (Do
 		return Domain{}, errors.New("failed to get topic")
 	}
 
+	err = tu.checkTags(domain)
+	if err != nil {
+		return Domain{}, err
+	}
+
 	if image != nil {
 		cloudinaryURL, err := tu.cloudinary.Upload("thread", image, util.GenerateUUID())
 		if err != nil {
@@ -71,11 +108,162 @@ func (tu *ThreadUseCase) Create(domain *Domain, image *multipart.FileHeader) (Do
 	return thread, nil
 }
 
+// CreateDraft saves an unfinished thread that only its creator can see, the title and description may still be empty
+func (tu *ThreadUseCase) CreateDraft(domain *Domain, image *multipart.FileHeader) (Domain, error) {
+	_, err := tu.topicRepository.GetByID(domain.TopicID)
+	if err != nil {
+		return Domain{}, errors.New("failed to get topic")
+	}
+
+	err = checkSchedule(*domain)
+	if err != nil {
+		return Domain{}, err
+	}
+
+	err = tu.checkScheduler(*domain)
+	if err != nil {
+		return Domain{}, err
+	}
+
+	err = tu.checkTags(domain)
+	if err != nil {
+		return Domain{}, err
+	}
+
+	if image != nil {
+		cloudinaryURL, err := tu.cloudinary.Upload("thread", image, util.GenerateUUID())
+		if err != nil {
+			return Domain{}, errors.New("failed to upload image")
+		}
+
+		domain.ImageURL = cloudinaryURL
+	}
+
+	domain.Id = primitive.NewObjectID()
+	domain.Likes = []Like{}
+	domain.IsDraft = true
+	domain.CreatedAt = primitive.NewDateTimeFromTime(time.Now())
+	domain.UpdatedAt = primitive.NewDateTimeFromTime(time.Now())
+
+	draft, err := tu.threadRepository.Create(domain)
+	if err != nil {
+		if domain.ImageURL != "" {
+			delErr := tu.cloudinary.Delete("thread", util.GetFilenameWithoutExtension(domain.ImageURL))
+			if delErr != nil {
+				return Domain{}, errors.New("failed to delete image")
+			}
+		}
+
+		return Domain{}, errors.New("failed to create draft")
+	}
+
+	return draft, nil
+}
+
+// checkSchedule makes sure a scheduled draft is complete enough to be published without its creator
+func checkSchedule(domain Domain) error {
+	if domain.PublishAt == 0 {
+		return nil
+	}
+
+	if domain.PublishAt.Time().Before(time.Now()) {
+		return errors.New("publish time must be in the future")
+	}
+
+	return checkPublishable(domain)
+}
+
+// checkScheduler keeps unverified users from getting a thread out through the scheduler, they cannot publish one themselves either
+func (tu *ThreadUseCase) checkScheduler(domain Domain) error {
+	if domain.PublishAt == 0 {
+		return nil
+	}
+
+	creator, err := tu.userRepository.GetByID(domain.CreatorID)
+	if err != nil {
+		return errors.New("failed to get user")
+	}
+
+	if !creator.EmailVerified {
+		return errors.New("email is not verified, drafts cannot be scheduled")
+	}
+
+	return nil
+}
+
+func checkPublishable(domain Domain) error {
+	if domain.Title == "" || domain.Description == "" {
+		return errors.New("draft must have a title and description to be published")
+	}
+
+	return nil
+}
+
+// checkTags normalizes the tags of the thread and rejects the banned ones
+func (tu *ThreadUseCase) checkTags(domain *Domain) error {
+	tags, err := normalizeTags(domain.Tags)
+	if err != nil {
+		return err
+	}
+
+	if len(tags) > 0 {
+		banned, err := tu.bannedTagRepository.GetByNames(tags)
+		if err != nil {
+			return errors.New("failed to get banned tags")
+		}
+
+		if len(banned) > 0 {
+			return fmt.Errorf("tag %s is banned", banned[0].Name)
+		}
+	}
+
+	domain.Tags = tags
+
+	return nil
+}
+
+// normalizeTags lowercases the tags, joins their words with dashes and drops the duplicates
+func normalizeTags(tags []string) ([]string, error) {
+	result := []string{}
+	seen := map[string]bool{}
+
+	for _, tag := range tags {
+		tag = normalizeTag(tag)
+		if tag == "" || seen[tag] {
+			continue
+		}
+
+		if !isValidTag(tag) {
+			return nil, fmt.Errorf("invalid tag %s, a tag may only contain letters, numbers and dashes and be at most %d characters", tag, maxTagLength)
+		}
+
+		seen[tag] = true
+		result = append(result, tag)
+	}
+
+	if len(result) > maxTags {
+		return nil, fmt.Errorf("a thread can have at most %d tags", maxTags)
+	}
+
+	return result, nil
+}
+
+func normalizeTag(tag string) string {
+	tag = strings.TrimPrefix(strings.TrimSpace(tag), "#")
+	return tagSeparators.ReplaceAllString(strings.ToLower(strings.TrimSpace(tag)), "-")
+}
+
+func isValidTag(tag string) bool {
+	return len(tag) <= maxTagLength && tagPattern.MatchString(tag)
+}
+
 /*
 Read
 */
 
-func (tu *ThreadUseCase) GetManyWithPagination(pagination dtoPagination.Request, domain *Domain) ([]Domain, int, int, error) {
+// GetManyWithPagination leaves out the threads created by excludedCreatorIDs, e.g. the users blocked or muted by the viewer,
+// the threads can be filtered by having any or, with matchAllTags, all of domain.Tags
+func (tu *ThreadUseCase) GetManyWithPagination(pagination dtoPagination.Request, domain *Domain, excludedCreatorIDs []primitive.ObjectID, matchAllTags bool) ([]Domain, int, int, error) {
 	skip := pagination.Limit * (pagination.Page - 1)
 	var orderInMongo int
 
@@ -99,7 +287,17 @@ func (tu *ThreadUseCase) GetManyWithPagination(pagination dtoPagination.Request,
 		}
 	}
 
-	threads, totalData, err := tu.threadRepository.GetManyWithPagination(query, domain)
+	if len(domain.Tags) > 0 {
+		tags := []string{}
+		for _, tag := range domain.Tags {
+			if tag = normalizeTag(tag); tag != "" {
+				tags = append(tags, tag)
+			}
+		}
+		domain.Tags = tags
+	}
+
+	threads, totalData, err := tu.threadRepository.GetManyWithPagination(query, domain, excludedCreatorIDs, matchAllTags)
 	if err != nil {
 		return []Domain{}, 0, 0, errors.New("failed to get threads")
 	}
@@ -136,6 +334,49 @@ func (tu *ThreadUseCase) GetAllByUserID(userID primitive.ObjectID) ([]Domain, er
 	return threads, nil
 }
 
+func (tu *ThreadUseCase) GetManyByUserID(pagination dtoPagination.Request, userID primitive.ObjectID) ([]Domain, int, int, error) {
+	var orderInMongo int
+	if pagination.Order == "asc" {
+		orderInMongo = 1
+	} else {
+		orderInMongo = -1
+	}
+
+	query := dtoQuery.Request{
+		Skip:  pagination.Limit * (pagination.Page - 1),
+		Limit: pagination.Limit,
+		Order: orderInMongo,
+		Sort:  pagination.Sort,
+	}
+
+	threads, totalData, err := tu.threadRepository.GetManyByCreatorIDs(query, []primitive.ObjectID{userID})
+	if err != nil {
+		return []Domain{}, 0, 0, errors.New("failed to get threads")
+	}
+
+	totalPage := math.Ceil(float64(totalData) / float64(pagination.Limit))
+
+	return threads, int(totalPage), totalData, nil
+}
+
+func (tu *ThreadUseCase) CountByUserID(userID primitive.ObjectID) (int, error) {
+	count, err := tu.threadRepository.CountByUserID(userID)
+	if err != nil {
+		return 0, errors.New("failed to count threads")
+	}
+
+	return count, nil
+}
+
+func (tu *ThreadUseCase) CountLikesByUserID(userID primitive.ObjectID) (int, error) {
+	count, err := tu.threadRepository.CountLikesByUserID(userID)
+	if err != nil {
+		return 0, errors.New("failed to count likes")
+	}
+
+	return count, nil
+}
+
 func (tu *ThreadUseCase) GetLikedByUserID(userID primitive.ObjectID) ([]Domain, error) {
 	threads, err := tu.threadRepository.GetLikedByUserID(userID)
 	if err != nil {
@@ -155,6 +396,71 @@ func (tu *ThreadUseCase) GetAll() (int, error) {
 	return len(threads), nil
 }
 
+func (tu *ThreadUseCase) GetTrashWithPagination(pagination dtoPagination.Request) ([]Domain, int, int, error) {
+	skip := pagination.Limit * (pagination.Page - 1)
+	var orderInMongo int
+
+	if pagination.Order == "asc" {
+		orderInMongo = 1
+	} else {
+		orderInMongo = -1
+	}
+
+	query := dtoQuery.Request{
+		Skip:  skip,
+		Limit: pagination.Limit,
+		Order: orderInMongo,
+		Sort:  pagination.Sort,
+	}
+
+	threads, totalData, err := tu.threadRepository.GetTrashWithPagination(query)
+	if err != nil {
+		return []Domain{}, 0, 0, errors.New("failed to get trashed threads")
+	}
+
+	totalPage := math.Ceil(float64(totalData) / float64(pagination.Limit))
+
+	return threads, int(totalPage), totalData, nil
+}
+
+func (tu *ThreadUseCase) GetDrafts(userID primitive.ObjectID) ([]Domain, error) {
+	drafts, err := tu.threadRepository.GetDraftsByCreatorID(userID)
+	if err != nil {
+		return []Domain{}, errors.New("failed to get drafts")
+	}
+
+	return drafts, nil
+}
+
+// GetDraftByID reports a draft of another user as not found so its existence is not leaked
+func (tu *ThreadUseCase) GetDraftByID(userID primitive.ObjectID, threadID primitive.ObjectID) (Domain, error) {
+	draft, err := tu.threadRepository.GetDraftByID(threadID)
+	if err != nil || draft.CreatorID != userID {
+		return Domain{}, errors.New("failed to get draft")
+	}
+
+	return draft, nil
+}
+
+// GetTags lists the tags by how many threads use them, prefix is used to autocomplete a tag
+func (tu *ThreadUseCase) GetTags(prefix string, limit int) ([]TagCount, error) {
+	tags, err := tu.threadRepository.GetTagCounts(normalizeTag(prefix), limit)
+	if err != nil {
+		return []TagCount{}, errors.New("failed to get tags")
+	}
+
+	return tags, nil
+}
+
+func (tu *ThreadUseCase) GetBannedTags() ([]bannedTags.Domain, error) {
+	tags, err := tu.bannedTagRepository.GetAll()
+	if err != nil {
+		return []bannedTags.Domain{}, errors.New("failed to get banned tags")
+	}
+
+	return tags, nil
+}
+
 func (tu *ThreadUseCase) DomainToResponse(domain Domain, userID primitive.ObjectID) (dtoThread.Response, error) {
 	creator, err := tu.userRepository.GetByID(domain.CreatorID)
 	if err != nil {
@@ -174,7 +480,7 @@ func (tu *ThreadUseCase) DomainToResponse(domain Domain, userID primitive.Object
 		}
 
 		likes = append(likes, dtoThread.Like{
-			User:      user,
+			User:      dtoUser.FromDomain(user),
 			Timestamp: like.Timestamp,
 		})
 	}
@@ -193,7 +499,7 @@ func (tu *ThreadUseCase) DomainToResponse(domain Domain, userID primitive.Object
 	return dtoThread.Response{
 		Id:            domain.Id,
 		Topic:         topic,
-		Creator:       creator,
+		Creator:       dtoUser.FromDomain(creator),
 		Title:         domain.Title,
 		Description:   domain.Description,
 		Likes:         likes,
@@ -204,6 +510,15 @@ func (tu *ThreadUseCase) DomainToResponse(domain Domain, userID primitive.Object
 		ImageURL:      domain.ImageURL,
 		SuspendStatus: domain.SuspendStatus,
 		SuspendDetail: domain.SuspendDetail,
+		Tags:          domain.Tags,
+		IsPinned:      domain.IsPinned,
+		IsTopicPinned: domain.IsTopicPinned,
+		IsLocked:      domain.IsLocked,
+		IsDraft:       domain.IsDraft,
+		PublishAt:     domain.PublishAt,
+		IsEdited:      domain.EditedAt != 0,
+		EditedAt:      domain.EditedAt,
+		DeletedAt:     domain.DeletedAt,
 		CreatedAt:     domain.CreatedAt,
 		UpdatedAt:     domain.UpdatedAt,
 	}, nil
@@ -223,6 +538,76 @@ func (tu *ThreadUseCase) DomainsToResponseArray(domains []Domain, userID primiti
 	return responses, nil
 }
 
+func (tu *ThreadUseCase) GetRevisions(userID primitive.ObjectID, threadID primitive.ObjectID) ([]threadRevisions.Domain, error) {
+	thread, err := tu.getRevisionAccess(userID, threadID)
+	if err != nil {
+		return []threadRevisions.Domain{}, err
+	}
+
+	revisions, err := tu.threadRevisionRepository.GetAllByThreadID(thread.Id)
+	if err != nil {
+		return []threadRevisions.Domain{}, errors.New("failed to get thread revisions")
+	}
+
+	return revisions, nil
+}
+
+// CompareRevisions diffs two revisions of a thread, a nil toID compares against the current version of the thread
+func (tu *ThreadUseCase) CompareRevisions(userID primitive.ObjectID, threadID primitive.ObjectID, fromID primitive.ObjectID, toID primitive.ObjectID) (threadRevisions.Diff, error) {
+	thread, err := tu.getRevisionAccess(userID, threadID)
+	if err != nil {
+		return threadRevisions.Diff{}, err
+	}
+
+	from, err := tu.threadRevisionRepository.GetByID(fromID)
+	if err != nil || from.ThreadID != thread.Id {
+		return threadRevisions.Diff{}, errors.New("failed to get thread revision")
+	}
+
+	to := toRevision(thread, primitive.NilObjectID, false)
+	to.CreatedAt = thread.UpdatedAt
+	if toID != primitive.NilObjectID {
+		to, err = tu.threadRevisionRepository.GetByID(toID)
+		if err != nil || to.ThreadID != thread.Id {
+			return threadRevisions.Diff{}, errors.New("failed to get thread revision")
+		}
+	}
+
+	return threadRevisions.Compare(from, to), nil
+}
+
+// getRevisionAccess returns the thread when the user created it or moderates its topic
+func (tu *ThreadUseCase) getRevisionAccess(userID primitive.ObjectID, threadID primitive.ObjectID) (Domain, error) {
+	thread, err := tu.threadRepository.GetByID(threadID)
+	if err != nil {
+		return Domain{}, errors.New("failed to get thread")
+	}
+
+	if thread.CreatorID != userID {
+		err = tu.checkModerator(userID, thread.TopicID)
+		if err != nil {
+			return Domain{}, err
+		}
+	}
+
+	return thread, nil
+}
+
+// toRevision snapshots the editable content of the thread before it is overwritten
+func toRevision(thread Domain, editorID primitive.ObjectID, isAdminEdit bool) threadRevisions.Domain {
+	return threadRevisions.Domain{
+		Id:          primitive.NewObjectID(),
+		ThreadID:    thread.Id,
+		EditorID:    editorID,
+		IsAdminEdit: isAdminEdit,
+		TopicID:     thread.TopicID,
+		Title:       thread.Title,
+		Description: thread.Description,
+		ImageURL:    thread.ImageURL,
+		CreatedAt:   primitive.NewDateTimeFromTime(time.Now()),
+	}
+}
+
 /*
 Update
 */
@@ -238,18 +623,29 @@ func (tu *ThreadUseCase) UserUpdate(domain *Domain, image *multipart.FileHeader)
 		return Domain{}, errors.New("failed to get thread")
 	}
 
-	if thread.CreatorID != domain.CreatorID {
+	err = tu.checkTags(domain)
+	if err != nil {
+		return Domain{}, err
+	}
+
+	policy, err := tu.getPolicy(domain.CreatorID)
+	if err != nil {
+		return Domain{}, err
+	}
+
+	if !policy.AllowsOn(permissions.ThreadUpdateOwn, permissions.ThreadUpdateAny, thread.CreatorID == domain.CreatorID) {
 		return Domain{}, errors.New("user are not the thread creator")
 	}
 
-	if image != nil {
-		if thread.ImageURL != "" {
-			delErr := tu.cloudinary.Delete("thread", util.GetFilenameWithoutExtension(thread.ImageURL))
-			if delErr != nil {
-				return Domain{}, errors.New("failed to delete image")
-			}
-		}
+	// an edit of another user's thread goes through the any permission, so it is recorded as an admin edit
+	revision := toRevision(thread, domain.CreatorID, thread.CreatorID != domain.CreatorID)
+	_, err = tu.threadRevisionRepository.Create(&revision)
+	if err != nil {
+		return Domain{}, errors.New("failed to save thread revision")
+	}
 
+	// the replaced image stays in the revision, it is deleted together with the revisions of the thread
+	if image != nil {
 		cloudinaryURL, err := tu.cloudinary.Upload("thread", image, util.GenerateUUID())
 		if err != nil {
 			return Domain{}, err
@@ -261,6 +657,8 @@ func (tu *ThreadUseCase) UserUpdate(domain *Domain, image *multipart.FileHeader)
 	thread.TopicID = domain.TopicID
 	thread.Title = domain.Title
 	thread.Description = domain.Description
+	thread.Tags = domain.Tags
+	thread.EditedAt = primitive.NewDateTimeFromTime(time.Now())
 	thread.UpdatedAt = primitive.NewDateTimeFromTime(time.Now())
 
 	updatedThread, err := tu.threadRepository.Update(&thread)
@@ -282,14 +680,19 @@ func (tu *ThreadUseCase) AdminUpdate(domain *Domain, image *multipart.FileHeader
 		return Domain{}, errors.New("failed to get thread")
 	}
 
-	if image != nil {
-		if thread.ImageURL != "" {
-			delErr := tu.cloudinary.Delete("thread", util.GetFilenameWithoutExtension(thread.ImageURL))
-			if delErr != nil {
-				return Domain{}, errors.New("failed to delete image")
-			}
-		}
+	err = tu.checkTags(domain)
+	if err != nil {
+		return Domain{}, err
+	}
+
+	revision := toRevision(thread, domain.CreatorID, true)
+	_, err = tu.threadRevisionRepository.Create(&revision)
+	if err != nil {
+		return Domain{}, errors.New("failed to save thread revision")
+	}
 
+	// the replaced image stays in the revision, it is deleted together with the revisions of the thread
+	if image != nil {
 		cloudinaryURL, err := tu.cloudinary.Upload("thread", image, util.GenerateUUID())
 		if err != nil {
 			return Domain{}, err
@@ -301,6 +704,8 @@ func (tu *ThreadUseCase) AdminUpdate(domain *Domain, image *multipart.FileHeader
 	thread.TopicID = domain.TopicID
 	thread.Title = domain.Title
 	thread.Description = domain.Description
+	thread.Tags = domain.Tags
+	thread.EditedAt = primitive.NewDateTimeFromTime(time.Now())
 	thread.UpdatedAt = primitive.NewDateTimeFromTime(time.Now())
 
 	updatedThread, err := tu.threadRepository.Update(&thread)
@@ -311,11 +716,11 @@ func (tu *ThreadUseCase) AdminUpdate(domain *Domain, image *multipart.FileHeader
 	return updatedThread, nil
 }
 
-func (tu *ThreadUseCase) SuspendByUserID(userID primitive.ObjectID) error {
+func (tu *ThreadUseCase) SuspendByUserID(userID primitive.ObjectID, detail string) error {
 	domain := Domain{
 		CreatorID:     userID,
 		SuspendStatus: "user suspend",
-		SuspendDetail: "user is violate the rules",
+		SuspendDetail: detail,
 	}
 
 	err := tu.threadRepository.SuspendByUserID(&domain)
@@ -326,29 +731,269 @@ func (tu *ThreadUseCase) SuspendByUserID(userID primitive.ObjectID) error {
 	return nil
 }
 
-func (tu *ThreadUseCase) Like(userID primitive.ObjectID, threadID primitive.ObjectID) error {
-	_, err := tu.threadRepository.GetByID(threadID)
-	if err != nil {
-		return errors.New("failed to get thread")
-	}
-
-	err = tu.threadRepository.CheckLikedByUserID(userID, threadID)
-	if err == nil {
-		return errors.New("user already like this thread")
+func (tu *ThreadUseCase) UnsuspendByUserID(userID primitive.ObjectID) error {
+	domain := Domain{
+		CreatorID:     userID,
+		SuspendStatus: "user suspend",
 	}
 
-	err = tu.threadRepository.AppendLike(userID, threadID)
+	err := tu.threadRepository.UnsuspendByUserID(&domain)
 	if err != nil {
-		return errors.New("failed to like thread")
+		return errors.New("failed to unsuspend user threads")
 	}
 
 	return nil
 }
 
-func (tu *ThreadUseCase) Unlike(userID primitive.ObjectID, threadID primitive.ObjectID) error {
-	_, err := tu.threadRepository.GetByID(threadID)
+func (tu *ThreadUseCase) ModeratorSuspend(moderatorID primitive.ObjectID, threadID primitive.ObjectID, detail string) (Domain, error) {
+	thread, err := tu.threadRepository.GetByID(threadID)
 	if err != nil {
-		return errors.New("failed to get thread")
+		return Domain{}, errors.New("failed to get thread")
+	}
+
+	err = tu.checkModerator(moderatorID, thread.TopicID)
+	if err != nil {
+		return Domain{}, err
+	}
+
+	if thread.SuspendStatus != "" {
+		return Domain{}, errors.New("thread is already suspended")
+	}
+
+	if detail == "" {
+		detail = "thread is violate the rules"
+	}
+
+	thread.SuspendStatus = "moderator suspend"
+	thread.SuspendDetail = detail
+	thread.UpdatedAt = primitive.NewDateTimeFromTime(time.Now())
+
+	err = tu.threadRepository.UpdateSuspendStatus(&thread)
+	if err != nil {
+		return Domain{}, errors.New("failed to suspend thread")
+	}
+
+	return thread, nil
+}
+
+func (tu *ThreadUseCase) ModeratorUnsuspend(moderatorID primitive.ObjectID, threadID primitive.ObjectID) (Domain, error) {
+	thread, err := tu.threadRepository.GetByID(threadID)
+	if err != nil {
+		return Domain{}, errors.New("failed to get thread")
+	}
+
+	err = tu.checkModerator(moderatorID, thread.TopicID)
+	if err != nil {
+		return Domain{}, err
+	}
+
+	if thread.SuspendStatus == "" {
+		return Domain{}, errors.New("thread is not suspended")
+	}
+
+	thread.SuspendStatus = ""
+	thread.SuspendDetail = ""
+	thread.UpdatedAt = primitive.NewDateTimeFromTime(time.Now())
+
+	err = tu.threadRepository.UpdateSuspendStatus(&thread)
+	if err != nil {
+		return Domain{}, errors.New("failed to unsuspend thread")
+	}
+
+	return thread, nil
+}
+
+// Pin puts the thread first in the thread lists, a global pin can only be changed by admins
+func (tu *ThreadUseCase) Pin(moderatorID primitive.ObjectID, threadID primitive.ObjectID, scope string) (Domain, error) {
+	if scope != PinScopeGlobal && scope != PinScopeTopic {
+		return Domain{}, errors.New("pin scope must be global or topic")
+	}
+
+	thread, err := tu.threadRepository.GetByID(threadID)
+	if err != nil {
+		return Domain{}, errors.New("failed to get thread")
+	}
+
+	err = tu.checkPinAccess(moderatorID, thread, scope == PinScopeGlobal || thread.IsPinned)
+	if err != nil {
+		return Domain{}, err
+	}
+
+	if (scope == PinScopeGlobal && thread.IsPinned) || (scope == PinScopeTopic && thread.IsTopicPinned) {
+		return Domain{}, errors.New("thread is already pinned")
+	}
+
+	thread.IsPinned = scope == PinScopeGlobal
+	thread.IsTopicPinned = scope == PinScopeTopic
+	thread.UpdatedAt = primitive.NewDateTimeFromTime(time.Now())
+
+	err = tu.threadRepository.UpdatePinStatus(&thread)
+	if err != nil {
+		return Domain{}, errors.New("failed to pin thread")
+	}
+
+	return thread, nil
+}
+
+func (tu *ThreadUseCase) Unpin(moderatorID primitive.ObjectID, threadID primitive.ObjectID) (Domain, error) {
+	thread, err := tu.threadRepository.GetByID(threadID)
+	if err != nil {
+		return Domain{}, errors.New("failed to get thread")
+	}
+
+	err = tu.checkPinAccess(moderatorID, thread, thread.IsPinned)
+	if err != nil {
+		return Domain{}, err
+	}
+
+	if !thread.IsPinned && !thread.IsTopicPinned {
+		return Domain{}, errors.New("thread is not pinned")
+	}
+
+	thread.IsPinned = false
+	thread.IsTopicPinned = false
+	thread.UpdatedAt = primitive.NewDateTimeFromTime(time.Now())
+
+	err = tu.threadRepository.UpdatePinStatus(&thread)
+	if err != nil {
+		return Domain{}, errors.New("failed to unpin thread")
+	}
+
+	return thread, nil
+}
+
+// checkPinAccess lets moderators pin within the topics assigned to them, global pins are left to admins
+func (tu *ThreadUseCase) checkPinAccess(moderatorID primitive.ObjectID, thread Domain, global bool) error {
+	if !global {
+		return tu.checkModerator(moderatorID, thread.TopicID)
+	}
+
+	policy, err := tu.getPolicy(moderatorID)
+	if err != nil {
+		return err
+	}
+
+	if !policy.Allows(permissions.ContentModerateAny) {
+		return errors.New("only admins can change a global pin")
+	}
+
+	return nil
+}
+
+// Lock stops the thread from getting new comments
+func (tu *ThreadUseCase) Lock(moderatorID primitive.ObjectID, threadID primitive.ObjectID) (Domain, error) {
+	thread, err := tu.threadRepository.GetByID(threadID)
+	if err != nil {
+		return Domain{}, errors.New("failed to get thread")
+	}
+
+	err = tu.checkModerator(moderatorID, thread.TopicID)
+	if err != nil {
+		return Domain{}, err
+	}
+
+	if thread.IsLocked {
+		return Domain{}, errors.New("thread is already locked")
+	}
+
+	thread.IsLocked = true
+	thread.UpdatedAt = primitive.NewDateTimeFromTime(time.Now())
+
+	err = tu.threadRepository.UpdateLockStatus(&thread)
+	if err != nil {
+		return Domain{}, errors.New("failed to lock thread")
+	}
+
+	return thread, nil
+}
+
+func (tu *ThreadUseCase) Unlock(moderatorID primitive.ObjectID, threadID primitive.ObjectID) (Domain, error) {
+	thread, err := tu.threadRepository.GetByID(threadID)
+	if err != nil {
+		return Domain{}, errors.New("failed to get thread")
+	}
+
+	err = tu.checkModerator(moderatorID, thread.TopicID)
+	if err != nil {
+		return Domain{}, err
+	}
+
+	if !thread.IsLocked {
+		return Domain{}, errors.New("thread is not locked")
+	}
+
+	thread.IsLocked = false
+	thread.UpdatedAt = primitive.NewDateTimeFromTime(time.Now())
+
+	err = tu.threadRepository.UpdateLockStatus(&thread)
+	if err != nil {
+		return Domain{}, errors.New("failed to unlock thread")
+	}
+
+	return thread, nil
+}
+
+// checkModerator allows admins everywhere and moderators only within the topics assigned to them
+func (tu *ThreadUseCase) checkModerator(moderatorID primitive.ObjectID, topicID primitive.ObjectID) error {
+	policy, err := tu.getPolicy(moderatorID)
+	if err != nil {
+		return err
+	}
+
+	if policy.Allows(permissions.ContentModerateAny) {
+		return nil
+	}
+
+	topic, err := tu.topicRepository.GetByID(topicID)
+	if err != nil {
+		return errors.New("failed to get topic")
+	}
+
+	if !policy.Allows(permissions.ContentModerateAssigned) || !topic.IsModeratedBy(moderatorID) {
+		return errors.New("user is not a moderator of this topic")
+	}
+
+	return nil
+}
+
+// getPolicy returns the permissions granted to the role of the user
+func (tu *ThreadUseCase) getPolicy(userID primitive.ObjectID) (permissions.Domain, error) {
+	user, err := tu.userRepository.GetByID(userID)
+	if err != nil {
+		return permissions.Domain{}, errors.New("failed to get user")
+	}
+
+	policy, err := tu.permissionRepository.GetByRole(user.Role)
+	if err != nil {
+		return permissions.Domain{}, errors.New("failed to get permissions")
+	}
+
+	return policy, nil
+}
+
+func (tu *ThreadUseCase) Like(userID primitive.ObjectID, threadID primitive.ObjectID) error {
+	_, err := tu.threadRepository.GetByID(threadID)
+	if err != nil {
+		return errors.New("failed to get thread")
+	}
+
+	err = tu.threadRepository.CheckLikedByUserID(userID, threadID)
+	if err == nil {
+		return errors.New("user already like this thread")
+	}
+
+	err = tu.threadRepository.AppendLike(userID, threadID)
+	if err != nil {
+		return errors.New("failed to like thread")
+	}
+
+	return nil
+}
+
+func (tu *ThreadUseCase) Unlike(userID primitive.ObjectID, threadID primitive.ObjectID) error {
+	_, err := tu.threadRepository.GetByID(threadID)
+	if err != nil {
+		return errors.New("failed to get thread")
 	}
 
 	err = tu.threadRepository.CheckLikedByUserID(userID, threadID)
@@ -373,6 +1018,253 @@ func (tu *ThreadUseCase) RemoveUserFromAllLikes(userID primitive.ObjectID) error
 	return nil
 }
 
+// UpdateDraft overwrites the whole draft so clients can autosave it as often as they like
+func (tu *ThreadUseCase) UpdateDraft(domain *Domain, image *multipart.FileHeader) (Domain, error) {
+	draft, err := tu.GetDraftByID(domain.CreatorID, domain.Id)
+	if err != nil {
+		return Domain{}, err
+	}
+
+	if domain.TopicID != draft.TopicID {
+		_, err = tu.topicRepository.GetByID(domain.TopicID)
+		if err != nil {
+			return Domain{}, errors.New("failed to get topic")
+		}
+	}
+
+	err = checkSchedule(*domain)
+	if err != nil {
+		return Domain{}, err
+	}
+
+	err = tu.checkScheduler(*domain)
+	if err != nil {
+		return Domain{}, err
+	}
+
+	err = tu.checkTags(domain)
+	if err != nil {
+		return Domain{}, err
+	}
+
+	if image != nil {
+		if draft.ImageURL != "" {
+			delErr := tu.cloudinary.Delete("thread", util.GetFilenameWithoutExtension(draft.ImageURL))
+			if delErr != nil {
+				return Domain{}, errors.New("failed to delete image")
+			}
+		}
+
+		cloudinaryURL, err := tu.cloudinary.Upload("thread", image, util.GenerateUUID())
+		if err != nil {
+			return Domain{}, errors.New("failed to upload image")
+		}
+
+		draft.ImageURL = cloudinaryURL
+	}
+
+	draft.TopicID = domain.TopicID
+	draft.Title = domain.Title
+	draft.Description = domain.Description
+	draft.Tags = domain.Tags
+	draft.PublishAt = domain.PublishAt
+	draft.UpdatedAt = primitive.NewDateTimeFromTime(time.Now())
+
+	updatedDraft, err := tu.threadRepository.UpdateDraft(&draft)
+	if err != nil {
+		return Domain{}, errors.New("failed to update draft")
+	}
+
+	return updatedDraft, nil
+}
+
+func (tu *ThreadUseCase) PublishDraft(userID primitive.ObjectID, threadID primitive.ObjectID) (Domain, error) {
+	draft, err := tu.GetDraftByID(userID, threadID)
+	if err != nil {
+		return Domain{}, err
+	}
+
+	_, err = tu.topicRepository.GetByID(draft.TopicID)
+	if err != nil {
+		return Domain{}, errors.New("failed to get topic")
+	}
+
+	err = checkPublishable(draft)
+	if err != nil {
+		return Domain{}, err
+	}
+
+	return tu.publish(draft)
+}
+
+// PublishScheduled publishes the drafts whose publish time has passed, a draft is left unpublished while its creator is suspended and is unscheduled when its topic no longer exists
+func (tu *ThreadUseCase) PublishScheduled() ([]Domain, error) {
+	drafts, err := tu.threadRepository.GetDraftsDueBefore(primitive.NewDateTimeFromTime(time.Now()))
+	if err != nil {
+		return []Domain{}, errors.New("failed to get scheduled drafts")
+	}
+
+	published := []Domain{}
+	for _, draft := range drafts {
+		creator, err := tu.userRepository.GetByID(draft.CreatorID)
+		if err != nil || !creator.IsActive || !creator.EmailVerified {
+			continue
+		}
+
+		_, err = tu.topicRepository.GetByID(draft.TopicID)
+		if err != nil {
+			draft.PublishAt = 0
+
+			_, err = tu.threadRepository.UpdateDraft(&draft)
+			if err != nil {
+				return published, errors.New("failed to update draft")
+			}
+
+			continue
+		}
+
+		thread, err := tu.publish(draft)
+		if err != nil {
+			return published, err
+		}
+
+		published = append(published, thread)
+	}
+
+	return published, nil
+}
+
+// publish makes the draft public, it is dated at the time of publishing rather than when the draft was started
+func (tu *ThreadUseCase) publish(draft Domain) (Domain, error) {
+	draft.CreatedAt = primitive.NewDateTimeFromTime(time.Now())
+	draft.UpdatedAt = primitive.NewDateTimeFromTime(time.Now())
+
+	thread, err := tu.threadRepository.Publish(&draft)
+	if err != nil {
+		return Domain{}, errors.New("failed to publish draft")
+	}
+
+	return thread, nil
+}
+
+// RenameTag renames a tag on every thread, the new name must not be in use yet
+func (tu *ThreadUseCase) RenameTag(from string, to string) error {
+	from, to = normalizeTag(from), normalizeTag(to)
+
+	count, err := tu.threadRepository.CountByTag(from)
+	if err != nil || count == 0 {
+		return errors.New("failed to get tag")
+	}
+
+	if !isValidTag(to) {
+		return fmt.Errorf("invalid tag %s, a tag may only contain letters, numbers and dashes and be at most %d characters", to, maxTagLength)
+	}
+
+	if from == to {
+		return errors.New("new tag name is the same as the old one")
+	}
+
+	count, err = tu.threadRepository.CountByTag(to)
+	if err != nil {
+		return errors.New("failed to get tag")
+	}
+
+	if count > 0 {
+		return errors.New("tag already exists, merge the tags instead")
+	}
+
+	_, err = tu.bannedTagRepository.GetByName(to)
+	if err == nil {
+		return fmt.Errorf("tag %s is banned", to)
+	}
+
+	err = tu.threadRepository.RenameTag(from, to)
+	if err != nil {
+		return errors.New("failed to rename tag")
+	}
+
+	return nil
+}
+
+// MergeTag moves the threads tagged with from over to the existing tag to
+func (tu *ThreadUseCase) MergeTag(from string, to string) error {
+	from, to = normalizeTag(from), normalizeTag(to)
+
+	if from == to {
+		return errors.New("cannot merge a tag into itself")
+	}
+
+	for _, tag := range []string{from, to} {
+		count, err := tu.threadRepository.CountByTag(tag)
+		if err != nil || count == 0 {
+			return errors.New("failed to get tag")
+		}
+	}
+
+	err := tu.threadRepository.RenameTag(from, to)
+	if err != nil {
+		return errors.New("failed to merge tags")
+	}
+
+	return nil
+}
+
+// BanTag stops the tag from being used and removes it from every thread
+func (tu *ThreadUseCase) BanTag(adminID primitive.ObjectID, name string) (bannedTags.Domain, error) {
+	name = normalizeTag(name)
+	if !isValidTag(name) {
+		return bannedTags.Domain{}, fmt.Errorf("invalid tag %s, a tag may only contain letters, numbers and dashes and be at most %d characters", name, maxTagLength)
+	}
+
+	_, err := tu.bannedTagRepository.GetByName(name)
+	if err == nil {
+		return bannedTags.Domain{}, errors.New("tag already banned")
+	}
+
+	bannedTag, err := tu.bannedTagRepository.Create(&bannedTags.Domain{
+		Id:        primitive.NewObjectID(),
+		Name:      name,
+		BannedBy:  adminID,
+		CreatedAt: primitive.NewDateTimeFromTime(time.Now()),
+	})
+	if err != nil {
+		return bannedTags.Domain{}, errors.New("failed to ban tag")
+	}
+
+	err = tu.threadRepository.RemoveTag(name)
+	if err != nil {
+		return bannedTags.Domain{}, errors.New("failed to remove tag from threads")
+	}
+
+	return bannedTag, nil
+}
+
+func (tu *ThreadUseCase) Restore(threadID primitive.ObjectID) (Domain, error) {
+	thread, err := tu.threadRepository.GetTrashedByID(threadID)
+	if err != nil {
+		return Domain{}, errors.New("failed to get trashed thread")
+	}
+
+	_, err = tu.topicRepository.GetByID(thread.TopicID)
+	if err != nil {
+		return Domain{}, errors.New("failed to get topic")
+	}
+
+	_, err = tu.userRepository.GetByID(thread.CreatorID)
+	if err != nil {
+		return Domain{}, errors.New("failed to get user")
+	}
+
+	err = tu.threadRepository.Restore(threadID)
+	if err != nil {
+		return Domain{}, errors.New("failed to restore thread")
+	}
+
+	thread.DeletedAt = 0
+
+	return thread, nil
+}
+
 /*
 Delete
 */
@@ -383,23 +1275,16 @@ func (tu *ThreadUseCase) Delete(userID primitive.ObjectID, threadID primitive.Ob
 		return Domain{}, errors.New("failed to get thread")
 	}
 
-	if thread.CreatorID != userID {
-		return Domain{}, errors.New("user are not the thread creator")
-	}
-
-	if thread.ImageURL != "" {
-		delErr := tu.cloudinary.Delete("thread", util.GetFilenameWithoutExtension(thread.ImageURL))
-		if delErr != nil {
-			return Domain{}, errors.New("failed to delete image")
-		}
+	policy, err := tu.getPolicy(userID)
+	if err != nil {
+		return Domain{}, err
 	}
 
-	err = tu.threadRepository.Delete(threadID)
-	if err != nil {
-		return Domain{}, errors.New("failed to delete thread")
+	if !policy.AllowsOn(permissions.ThreadDeleteOwn, permissions.ThreadDeleteAny, thread.CreatorID == userID) {
+		return Domain{}, errors.New("user are not the thread creator")
 	}
 
-	return thread, nil
+	return tu.softDelete(thread)
 }
 
 func (tu *ThreadUseCase) DeleteAllByUserID(userID primitive.ObjectID) error {
@@ -408,12 +1293,15 @@ func (tu *ThreadUseCase) DeleteAllByUserID(userID primitive.ObjectID) error {
 		return errors.New("failed to get user threads")
 	}
 
-	for _, thread := range threads {
-		if thread.ImageURL != "" {
-			delErr := tu.cloudinary.Delete("thread", util.GetFilenameWithoutExtension(thread.ImageURL))
-			if delErr != nil {
-				return errors.New("failed to delete image")
-			}
+	drafts, err := tu.threadRepository.GetDraftsByCreatorID(userID)
+	if err != nil {
+		return errors.New("failed to get user drafts")
+	}
+
+	for _, thread := range append(threads, drafts...) {
+		err = tu.deleteImagesAndRevisions(thread)
+		if err != nil {
+			return err
 		}
 	}
 
@@ -431,11 +1319,9 @@ func (tu *ThreadUseCase) DeleteByThreadID(threadID primitive.ObjectID) error {
 		return errors.New("failed to get thread")
 	}
 
-	if thread.ImageURL != "" {
-		delErr := tu.cloudinary.Delete("thread", util.GetFilenameWithoutExtension(thread.ImageURL))
-		if delErr != nil {
-			return errors.New("failed to delete image")
-		}
+	err = tu.deleteImagesAndRevisions(thread)
+	if err != nil {
+		return err
 	}
 
 	err = tu.threadRepository.Delete(threadID)
@@ -452,17 +1338,149 @@ func (tu *ThreadUseCase) AdminDelete(threadID primitive.ObjectID) (Domain, error
 		return Domain{}, errors.New("failed to get thread")
 	}
 
-	if thread.ImageURL != "" {
-		err = tu.cloudinary.Delete("thread", util.GetFilenameWithoutExtension(thread.ImageURL))
-		if err != nil {
+	return tu.softDelete(thread)
+}
+
+func (tu *ThreadUseCase) ModeratorDelete(moderatorID primitive.ObjectID, threadID primitive.ObjectID) (Domain, error) {
+	thread, err := tu.threadRepository.GetByID(threadID)
+	if err != nil {
+		return Domain{}, errors.New("failed to get thread")
+	}
+
+	err = tu.checkModerator(moderatorID, thread.TopicID)
+	if err != nil {
+		return Domain{}, err
+	}
+
+	return tu.AdminDelete(threadID)
+}
+
+func (tu *ThreadUseCase) DeleteDraft(userID primitive.ObjectID, threadID primitive.ObjectID) (Domain, error) {
+	draft, err := tu.GetDraftByID(userID, threadID)
+	if err != nil {
+		return Domain{}, err
+	}
+
+	if draft.ImageURL != "" {
+		delErr := tu.cloudinary.Delete("thread", util.GetFilenameWithoutExtension(draft.ImageURL))
+		if delErr != nil {
 			return Domain{}, errors.New("failed to delete image")
 		}
 	}
 
 	err = tu.threadRepository.Delete(threadID)
+	if err != nil {
+		return Domain{}, errors.New("failed to delete draft")
+	}
+
+	return draft, nil
+}
+
+// softDelete moves the thread to the trash together with its comments, follows and bookmarks, the image is kept so the thread can still be restored
+func (tu *ThreadUseCase) softDelete(thread Domain) (Domain, error) {
+	thread.DeletedAt = primitive.NewDateTimeFromTime(time.Now())
+
+	err := tu.threadRepository.SoftDelete(&thread)
 	if err != nil {
 		return Domain{}, errors.New("failed to delete thread")
 	}
 
+	err = tu.commentRepository.SoftDeleteAllByThreadID(thread.Id, thread.DeletedAt)
+	if err != nil {
+		return Domain{}, errors.New("failed to delete thread's comments")
+	}
+
+	err = tu.followThreadRepository.SoftDeleteAllByThreadID(thread.Id, thread.DeletedAt)
+	if err != nil {
+		return Domain{}, errors.New("failed to delete all follow thread")
+	}
+
+	err = tu.bookmarkRepository.SoftDeleteAllByThreadID(thread.Id, thread.DeletedAt)
+	if err != nil {
+		return Domain{}, errors.New("failed to delete bookmark")
+	}
+
 	return thread, nil
 }
+
+// UnbanTag lets the tag be used again, threads it was removed from do not get it back
+func (tu *ThreadUseCase) UnbanTag(name string) (bannedTags.Domain, error) {
+	bannedTag, err := tu.bannedTagRepository.GetByName(normalizeTag(name))
+	if err != nil {
+		return bannedTags.Domain{}, errors.New("failed to get banned tag")
+	}
+
+	err = tu.bannedTagRepository.Delete(bannedTag.Id)
+	if err != nil {
+		return bannedTags.Domain{}, errors.New("failed to unban tag")
+	}
+
+	return bannedTag, nil
+}
+
+// PurgeTrash permanently deletes the threads that have been in the trash longer than trashRetention and returns them so the caller can purge their dependents
+func (tu *ThreadUseCase) PurgeTrash() ([]Domain, error) {
+	threads, err := tu.threadRepository.GetAllTrashedBefore(primitive.NewDateTimeFromTime(time.Now().Add(-trashRetention)))
+	if err != nil {
+		return []Domain{}, errors.New("failed to get trashed threads")
+	}
+
+	// the threads purged so far are returned even when one fails, their dependents still have to be purged by the caller
+	purged := []Domain{}
+	failed := false
+	for _, thread := range threads {
+		err = tu.deleteImagesAndRevisions(thread)
+		if err != nil {
+			failed = true
+			continue
+		}
+
+		err = tu.threadRepository.Delete(thread.Id)
+		if err != nil {
+			failed = true
+			continue
+		}
+
+		purged = append(purged, thread)
+	}
+
+	if failed {
+		return purged, errors.New("failed to purge trashed threads")
+	}
+
+	return purged, nil
+}
+
+// deleteImagesAndRevisions deletes the image of the thread and the older images its revisions still point to, then the revisions
+func (tu *ThreadUseCase) deleteImagesAndRevisions(thread Domain) error {
+	revisions, err := tu.threadRevisionRepository.GetAllByThreadID(thread.Id)
+	if err != nil {
+		return errors.New("failed to get thread revisions")
+	}
+
+	imageURLs := []string{thread.ImageURL}
+	for _, revision := range revisions {
+		imageURLs = append(imageURLs, revision.ImageURL)
+	}
+
+	deleted := map[string]bool{}
+	for _, imageURL := range imageURLs {
+		if imageURL == "" || deleted[imageURL] {
+			continue
+		}
+
+		err = tu.cloudinary.Delete("thread", util.GetFilenameWithoutExtension(imageURL))
+		if err != nil {
+			return errors.New("failed to delete image")
+		}
+
+		deleted[imageURL] = true
+	}
+
+	err = tu.threadRevisionRepository.DeleteAllByThreadID(thread.Id)
+	if err != nil {
+		return errors.New("failed to delete thread revisions")
+	}
+
+	return nil
+}
@@ -1,6 +1,12 @@
 package threads_test
 
 import (
+	bannedTags "charum/business/banned_tags"
+	_bannedTagMock "charum/business/banned_tags/mocks"
+	"charum/business/permissions"
+	_permissionMock "charum/business/permissions/mocks"
+	threadRevisions "charum/business/thread_revisions"
+	_threadRevisionMock "charum/business/thread_revisions/mocks"
 	"charum/business/threads"
 	_threadMock "charum/business/threads/mocks"
 	"charum/business/topics"
@@ -10,6 +16,7 @@ import (
 	dtoPagination "charum/dto/pagination"
 	dtoQuery "charum/dto/query"
 	dtoThread "charum/dto/threads"
+	dtoUser "charum/dto/users"
 	_cloudinaryMock "charum/helper/cloudinary/mocks"
 	"errors"
 	"mime/multipart"
@@ -22,19 +29,28 @@ import (
 )
 
 var (
-	threadRepository     _threadMock.Repository
-	topicRepository      _topicMock.Repository
-	userRepository       _userMock.Repository
-	cloudinaryRepository _cloudinaryMock.Function
-	threadUseCase        threads.UseCase
-	topicDomain          topics.Domain
-	threadDomain         threads.Domain
-	userDomain           users.Domain
-	image                *multipart.FileHeader
+	threadRepository         _threadMock.Repository
+	threadRevisionRepository _threadRevisionMock.Repository
+	bannedTagRepository      _bannedTagMock.Repository
+	topicRepository          _topicMock.Repository
+	userRepository           _userMock.Repository
+	permissionRepository     _permissionMock.Repository
+	commentRepository        _threadMock.TrashRepository
+	followThreadRepository   _threadMock.TrashRepository
+	bookmarkRepository       _threadMock.TrashRepository
+	cloudinaryRepository     _cloudinaryMock.Function
+	threadUseCase            threads.UseCase
+	topicDomain              topics.Domain
+	threadDomain             threads.Domain
+	userDomain               users.Domain
+	userPermission           permissions.Domain
+	moderatorPermission      permissions.Domain
+	adminPermission          permissions.Domain
+	image                    *multipart.FileHeader
 )
 
 func TestMain(m *testing.M) {
-	threadUseCase = threads.NewThreadUseCase(&threadRepository, &topicRepository, &userRepository, &cloudinaryRepository)
+	threadUseCase = threads.NewThreadUseCase(&threadRepository, &threadRevisionRepository, &bannedTagRepository, &topicRepository, &userRepository, &permissionRepository, &commentRepository, &followThreadRepository, &bookmarkRepository, &cloudinaryRepository)
 
 	userDomain = users.Domain{
 		Id:          primitive.NewObjectID(),
@@ -48,6 +64,24 @@ func TestMain(m *testing.M) {
 		UpdatedAt:   primitive.NewDateTimeFromTime(time.Now()),
 	}
 
+	userPermission = permissions.Domain{
+		Id:          primitive.NewObjectID(),
+		Role:        "user",
+		Permissions: permissions.Defaults["user"],
+	}
+
+	moderatorPermission = permissions.Domain{
+		Id:          primitive.NewObjectID(),
+		Role:        "moderator",
+		Permissions: permissions.Defaults["moderator"],
+	}
+
+	adminPermission = permissions.Domain{
+		Id:          primitive.NewObjectID(),
+		Role:        "admin",
+		Permissions: permissions.Defaults["admin"],
+	}
+
 	topicDomain = topics.Domain{
 		Id:          primitive.NewObjectID(),
 		Topic:       "topic",
@@ -139,6 +173,61 @@ func TestCreate(t *testing.T) {
 		assert.Equal(t, threads.Domain{}, result)
 		assert.Equal(t, err, expectedErr)
 	})
+
+	t.Run("Test case 6 | Valid create thread | Tags are normalized", func(t *testing.T) {
+		copyDomain := threadDomain
+		copyDomain.Tags = []string{"Go Lang", "#go_lang", "API"}
+		expectedDomain := copyDomain
+		expectedDomain.Tags = []string{"go-lang", "api"}
+
+		topicRepository.On("GetByID", threadDomain.TopicID).Return(topicDomain, nil).Once()
+		bannedTagRepository.On("GetByNames", []string{"go-lang", "api"}).Return([]bannedTags.Domain{}, nil).Once()
+		threadRepository.On("Create", mock.MatchedBy(func(domain *threads.Domain) bool {
+			return assert.ObjectsAreEqual(expectedDomain.Tags, domain.Tags)
+		})).Return(expectedDomain, nil).Once()
+
+		result, err := threadUseCase.Create(&copyDomain, nil)
+
+		assert.Equal(t, []string{"go-lang", "api"}, result.Tags)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 7 | Invalid create thread | Invalid tag", func(t *testing.T) {
+		copyDomain := threadDomain
+		copyDomain.Tags = []string{"c++"}
+
+		topicRepository.On("GetByID", threadDomain.TopicID).Return(topicDomain, nil).Once()
+
+		result, err := threadUseCase.Create(&copyDomain, nil)
+
+		assert.Equal(t, threads.Domain{}, result)
+		assert.Contains(t, err.Error(), "invalid tag c++")
+	})
+
+	t.Run("Test case 8 | Invalid create thread | Too many tags", func(t *testing.T) {
+		copyDomain := threadDomain
+		copyDomain.Tags = []string{"a", "b", "c", "d", "e", "f"}
+
+		topicRepository.On("GetByID", threadDomain.TopicID).Return(topicDomain, nil).Once()
+
+		result, err := threadUseCase.Create(&copyDomain, nil)
+
+		assert.Equal(t, threads.Domain{}, result)
+		assert.Equal(t, errors.New("a thread can have at most 5 tags"), err)
+	})
+
+	t.Run("Test case 9 | Invalid create thread | Banned tag", func(t *testing.T) {
+		copyDomain := threadDomain
+		copyDomain.Tags = []string{"spam"}
+
+		topicRepository.On("GetByID", threadDomain.TopicID).Return(topicDomain, nil).Once()
+		bannedTagRepository.On("GetByNames", []string{"spam"}).Return([]bannedTags.Domain{{Name: "spam"}}, nil).Once()
+
+		result, err := threadUseCase.Create(&copyDomain, nil)
+
+		assert.Equal(t, threads.Domain{}, result)
+		assert.Equal(t, errors.New("tag spam is banned"), err)
+	})
 }
 
 func TestGetManyWithPagination(t *testing.T) {
@@ -157,9 +246,9 @@ func TestGetManyWithPagination(t *testing.T) {
 			Order: -1,
 		}
 		topicRepository.On("GetByID", threadDomain.TopicID).Return(topicDomain, nil).Once()
-		threadRepository.On("GetManyWithPagination", query, &threadDomain).Return([]threads.Domain{threadDomain}, 1, nil).Once()
+		threadRepository.On("GetManyWithPagination", query, &threadDomain, []primitive.ObjectID{}, false).Return([]threads.Domain{threadDomain}, 1, nil).Once()
 
-		result, totalPage, totalData, err := threadUseCase.GetManyWithPagination(pagination, &threadDomain)
+		result, totalPage, totalData, err := threadUseCase.GetManyWithPagination(pagination, &threadDomain, []primitive.ObjectID{}, false)
 
 		assert.NotNil(t, result)
 		assert.NotZero(t, totalPage)
@@ -184,9 +273,9 @@ func TestGetManyWithPagination(t *testing.T) {
 			Order: 1,
 		}
 		topicRepository.On("GetByID", threadDomain.TopicID).Return(topicDomain, nil).Once()
-		threadRepository.On("GetManyWithPagination", query, &threadDomain).Return([]threads.Domain{}, 0, expectedErr).Once()
+		threadRepository.On("GetManyWithPagination", query, &threadDomain, []primitive.ObjectID{}, false).Return([]threads.Domain{}, 0, expectedErr).Once()
 
-		result, totalPage, totalData, err := threadUseCase.GetManyWithPagination(pagination, &threadDomain)
+		result, totalPage, totalData, err := threadUseCase.GetManyWithPagination(pagination, &threadDomain, []primitive.ObjectID{}, false)
 
 		assert.Equal(t, []threads.Domain{}, result)
 		assert.Zero(t, totalPage)
@@ -206,13 +295,64 @@ func TestGetManyWithPagination(t *testing.T) {
 
 		topicRepository.On("GetByID", threadDomain.TopicID).Return(topics.Domain{}, expectedErr).Once()
 
-		result, totalPage, totalData, err := threadUseCase.GetManyWithPagination(pagination, &threadDomain)
+		result, totalPage, totalData, err := threadUseCase.GetManyWithPagination(pagination, &threadDomain, []primitive.ObjectID{}, false)
 
 		assert.Equal(t, []threads.Domain{}, result)
 		assert.Zero(t, totalPage)
 		assert.Zero(t, totalData)
 		assert.Equal(t, err, expectedErr)
 	})
+	t.Run("Test case 4 | Valid get thread with sort and order | Hidden creators are excluded", func(t *testing.T) {
+		pagination := dtoPagination.Request{
+			Page:  1,
+			Limit: 2,
+			Sort:  "createdAt",
+			Order: "desc",
+		}
+
+		query := dtoQuery.Request{
+			Skip:  0,
+			Limit: 2,
+			Sort:  "createdAt",
+			Order: -1,
+		}
+		excludedCreatorIDs := []primitive.ObjectID{primitive.NewObjectID()}
+		topicRepository.On("GetByID", threadDomain.TopicID).Return(topicDomain, nil).Once()
+		threadRepository.On("GetManyWithPagination", query, &threadDomain, excludedCreatorIDs, false).Return([]threads.Domain{threadDomain}, 1, nil).Once()
+
+		result, totalPage, totalData, err := threadUseCase.GetManyWithPagination(pagination, &threadDomain, excludedCreatorIDs, false)
+
+		assert.NotNil(t, result)
+		assert.NotZero(t, totalPage)
+		assert.NotZero(t, totalData)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 5 | Valid get thread with sort and order | Filter by all of the normalized tags", func(t *testing.T) {
+		pagination := dtoPagination.Request{
+			Page:  1,
+			Limit: 2,
+			Sort:  "createdAt",
+			Order: "desc",
+		}
+
+		query := dtoQuery.Request{
+			Skip:  0,
+			Limit: 2,
+			Sort:  "createdAt",
+			Order: -1,
+		}
+		filter := threads.Domain{Tags: []string{"Go Lang", "#API", " "}}
+		normalizedFilter := threads.Domain{Tags: []string{"go-lang", "api"}}
+		threadRepository.On("GetManyWithPagination", query, &normalizedFilter, []primitive.ObjectID{}, true).Return([]threads.Domain{threadDomain}, 1, nil).Once()
+
+		result, totalPage, totalData, err := threadUseCase.GetManyWithPagination(pagination, &filter, []primitive.ObjectID{}, true)
+
+		assert.NotNil(t, result)
+		assert.NotZero(t, totalPage)
+		assert.NotZero(t, totalData)
+		assert.Nil(t, err)
+	})
 }
 
 func TestGetByID(t *testing.T) {
@@ -278,6 +418,87 @@ func TestGetAllByUserID(t *testing.T) {
 	})
 }
 
+func TestGetManyByUserID(t *testing.T) {
+	pagination := dtoPagination.Request{
+		Page:  2,
+		Limit: 5,
+		Sort:  "createdAt",
+		Order: "desc",
+	}
+
+	query := dtoQuery.Request{
+		Skip:  5,
+		Limit: 5,
+		Sort:  "createdAt",
+		Order: -1,
+	}
+
+	t.Run("Test case 1 | Valid get many thread by user id", func(t *testing.T) {
+		threadRepository.On("GetManyByCreatorIDs", query, []primitive.ObjectID{threadDomain.CreatorID}).Return([]threads.Domain{threadDomain}, 6, nil).Once()
+
+		result, totalPage, totalData, err := threadUseCase.GetManyByUserID(pagination, threadDomain.CreatorID)
+
+		assert.Equal(t, []threads.Domain{threadDomain}, result)
+		assert.Equal(t, 2, totalPage)
+		assert.Equal(t, 6, totalData)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Invalid get many thread by user id | Error when getting threads", func(t *testing.T) {
+		expectedErr := errors.New("failed to get threads")
+		threadRepository.On("GetManyByCreatorIDs", query, []primitive.ObjectID{threadDomain.CreatorID}).Return([]threads.Domain{}, 0, errors.New("unexpected error")).Once()
+
+		result, totalPage, totalData, err := threadUseCase.GetManyByUserID(pagination, threadDomain.CreatorID)
+
+		assert.Equal(t, []threads.Domain{}, result)
+		assert.Zero(t, totalPage)
+		assert.Zero(t, totalData)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestCountByUserID(t *testing.T) {
+	t.Run("Test case 1 | Valid count thread by user id", func(t *testing.T) {
+		threadRepository.On("CountByUserID", threadDomain.CreatorID).Return(3, nil).Once()
+
+		result, err := threadUseCase.CountByUserID(threadDomain.CreatorID)
+
+		assert.Equal(t, 3, result)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Invalid count thread by user id | Error when counting threads", func(t *testing.T) {
+		expectedErr := errors.New("failed to count threads")
+		threadRepository.On("CountByUserID", threadDomain.CreatorID).Return(0, errors.New("unexpected error")).Once()
+
+		result, err := threadUseCase.CountByUserID(threadDomain.CreatorID)
+
+		assert.Zero(t, result)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestCountLikesByUserID(t *testing.T) {
+	t.Run("Test case 1 | Valid count likes by user id", func(t *testing.T) {
+		threadRepository.On("CountLikesByUserID", threadDomain.CreatorID).Return(7, nil).Once()
+
+		result, err := threadUseCase.CountLikesByUserID(threadDomain.CreatorID)
+
+		assert.Equal(t, 7, result)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Invalid count likes by user id | Error when counting likes", func(t *testing.T) {
+		expectedErr := errors.New("failed to count likes")
+		threadRepository.On("CountLikesByUserID", threadDomain.CreatorID).Return(0, errors.New("unexpected error")).Once()
+
+		result, err := threadUseCase.CountLikesByUserID(threadDomain.CreatorID)
+
+		assert.Zero(t, result)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
 func TestDomainToResponse(t *testing.T) {
 	t.Run("Test case 1 | Valid domain to response", func(t *testing.T) {
 		userRepository.On("GetByID", threadDomain.CreatorID).Return(userDomain, nil).Once()
@@ -286,6 +507,7 @@ func TestDomainToResponse(t *testing.T) {
 		result, actualErr := threadUseCase.DomainToResponse(threadDomain, userDomain.Id)
 
 		assert.NotNil(t, result)
+		assert.Equal(t, dtoUser.FromDomain(userDomain), result.Creator)
 		assert.Nil(t, actualErr)
 	})
 
@@ -358,8 +580,10 @@ func TestUserUpdate(t *testing.T) {
 	t.Run("Test case 1 | Valid user update thread", func(t *testing.T) {
 		topicRepository.On("GetByID", threadDomain.TopicID).Return(topicDomain, nil).Once()
 		threadRepository.On("GetByID", threadDomain.Id).Return(threadDomain, nil).Once()
-		cloudinaryRepository.On("Delete", mock.Anything, mock.Anything).Return(nil).Once()
+		userRepository.On("GetByID", threadDomain.CreatorID).Return(userDomain, nil).Once()
+		permissionRepository.On("GetByRole", userDomain.Role).Return(userPermission, nil).Once()
 		cloudinaryRepository.On("Upload", mock.Anything, mock.Anything, mock.Anything).Return("image", nil).Once()
+		threadRevisionRepository.On("Create", mock.Anything).Return(threadRevisions.Domain{}, nil).Once()
 		threadRepository.On("Update", mock.Anything).Return(threadDomain, nil).Once()
 
 		result, err := threadUseCase.UserUpdate(&threadDomain, image)
@@ -372,8 +596,10 @@ func TestUserUpdate(t *testing.T) {
 		expectedErr := errors.New("failed to update thread")
 		topicRepository.On("GetByID", threadDomain.TopicID).Return(topicDomain, nil).Once()
 		threadRepository.On("GetByID", threadDomain.Id).Return(threadDomain, nil).Once()
-		cloudinaryRepository.On("Delete", mock.Anything, mock.Anything).Return(nil).Once()
+		userRepository.On("GetByID", threadDomain.CreatorID).Return(userDomain, nil).Once()
+		permissionRepository.On("GetByRole", userDomain.Role).Return(userPermission, nil).Once()
 		cloudinaryRepository.On("Upload", mock.Anything, mock.Anything, mock.Anything).Return("image", nil).Once()
+		threadRevisionRepository.On("Create", mock.Anything).Return(threadRevisions.Domain{}, nil).Once()
 		threadRepository.On("Update", mock.Anything).Return(threads.Domain{}, expectedErr).Once()
 
 		result, err := threadUseCase.UserUpdate(&threadDomain, image)
@@ -403,11 +629,13 @@ func TestUserUpdate(t *testing.T) {
 		assert.Equal(t, expectedErr, err)
 	})
 
-	t.Run("Test case 5 | Invalid user update thread | Error when deleting image", func(t *testing.T) {
-		expectedErr := errors.New("failed to delete image")
+	t.Run("Test case 5 | Invalid user update thread | Error when saving revision before uploading image", func(t *testing.T) {
+		expectedErr := errors.New("failed to save thread revision")
 		topicRepository.On("GetByID", threadDomain.TopicID).Return(topicDomain, nil).Once()
 		threadRepository.On("GetByID", threadDomain.Id).Return(threadDomain, nil).Once()
-		cloudinaryRepository.On("Delete", mock.Anything, mock.Anything).Return(expectedErr).Once()
+		userRepository.On("GetByID", threadDomain.CreatorID).Return(userDomain, nil).Once()
+		permissionRepository.On("GetByRole", userDomain.Role).Return(userPermission, nil).Once()
+		threadRevisionRepository.On("Create", mock.Anything).Return(threadRevisions.Domain{}, errors.New("unexpected error")).Once()
 
 		result, err := threadUseCase.UserUpdate(&threadDomain, image)
 
@@ -419,7 +647,9 @@ func TestUserUpdate(t *testing.T) {
 		expectedErr := errors.New("failed to upload image")
 		topicRepository.On("GetByID", threadDomain.TopicID).Return(topicDomain, nil).Once()
 		threadRepository.On("GetByID", threadDomain.Id).Return(threadDomain, nil).Once()
-		cloudinaryRepository.On("Delete", mock.Anything, mock.Anything).Return(nil).Once()
+		userRepository.On("GetByID", threadDomain.CreatorID).Return(userDomain, nil).Once()
+		permissionRepository.On("GetByRole", userDomain.Role).Return(userPermission, nil).Once()
+		threadRevisionRepository.On("Create", mock.Anything).Return(threadRevisions.Domain{}, nil).Once()
 		cloudinaryRepository.On("Upload", mock.Anything, mock.Anything, mock.Anything).Return("", expectedErr).Once()
 
 		result, err := threadUseCase.UserUpdate(&threadDomain, image)
@@ -432,8 +662,10 @@ func TestUserUpdate(t *testing.T) {
 		expectedErr := errors.New("failed to update thread")
 		topicRepository.On("GetByID", threadDomain.TopicID).Return(topicDomain, nil).Once()
 		threadRepository.On("GetByID", threadDomain.Id).Return(threadDomain, nil).Once()
-		cloudinaryRepository.On("Delete", mock.Anything, mock.Anything).Return(nil).Once()
+		userRepository.On("GetByID", threadDomain.CreatorID).Return(userDomain, nil).Once()
+		permissionRepository.On("GetByRole", userDomain.Role).Return(userPermission, nil).Once()
 		cloudinaryRepository.On("Upload", mock.Anything, mock.Anything, mock.Anything).Return("image", nil).Once()
+		threadRevisionRepository.On("Create", mock.Anything).Return(threadRevisions.Domain{}, nil).Once()
 		threadRepository.On("Update", mock.Anything).Return(threads.Domain{}, expectedErr).Once()
 
 		result, err := threadUseCase.UserUpdate(&threadDomain, image)
@@ -448,20 +680,129 @@ func TestUserUpdate(t *testing.T) {
 		copyDomain.CreatorID = primitive.NewObjectID()
 		topicRepository.On("GetByID", threadDomain.TopicID).Return(topicDomain, nil).Once()
 		threadRepository.On("GetByID", threadDomain.Id).Return(copyDomain, nil).Once()
+		userRepository.On("GetByID", threadDomain.CreatorID).Return(userDomain, nil).Once()
+		permissionRepository.On("GetByRole", userDomain.Role).Return(userPermission, nil).Once()
 
 		result, err := threadUseCase.UserUpdate(&threadDomain, image)
 
 		assert.Equal(t, threads.Domain{}, result)
 		assert.Equal(t, expectedErr, err)
 	})
+
+	t.Run("Test case 9 | Valid user update thread | Role may update any thread", func(t *testing.T) {
+		editor := userDomain
+		editor.Id = primitive.NewObjectID()
+		editor.Role = "editor"
+		editorPermission := permissions.Domain{Role: "editor", Permissions: []string{permissions.ThreadUpdateAny}}
+		copyDomain := threadDomain
+		copyDomain.CreatorID = editor.Id
+
+		topicRepository.On("GetByID", threadDomain.TopicID).Return(topicDomain, nil).Once()
+		threadRepository.On("GetByID", threadDomain.Id).Return(threadDomain, nil).Once()
+		userRepository.On("GetByID", editor.Id).Return(editor, nil).Once()
+		permissionRepository.On("GetByRole", "editor").Return(editorPermission, nil).Once()
+		threadRevisionRepository.On("Create", mock.Anything).Return(threadRevisions.Domain{}, nil).Once()
+		threadRepository.On("Update", mock.Anything).Return(threadDomain, nil).Once()
+
+		result, err := threadUseCase.UserUpdate(&copyDomain, nil)
+
+		assert.Equal(t, threadDomain, result)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 10 | Invalid user update thread | Role is not allowed to update own thread", func(t *testing.T) {
+		expectedErr := errors.New("user are not the thread creator")
+		readOnly := userDomain
+		readOnly.Id = primitive.NewObjectID()
+		readOnly.Role = "read-only"
+		copyDomain := threadDomain
+		copyDomain.Id = primitive.NewObjectID()
+		copyDomain.CreatorID = readOnly.Id
+
+		topicRepository.On("GetByID", copyDomain.TopicID).Return(topicDomain, nil).Once()
+		threadRepository.On("GetByID", copyDomain.Id).Return(copyDomain, nil).Once()
+		userRepository.On("GetByID", readOnly.Id).Return(readOnly, nil).Once()
+		permissionRepository.On("GetByRole", "read-only").Return(permissions.Domain{Role: "read-only"}, nil).Once()
+
+		result, err := threadUseCase.UserUpdate(&copyDomain, nil)
+
+		assert.Equal(t, threads.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 11 | Invalid user update thread | Error when getting permissions", func(t *testing.T) {
+		expectedErr := errors.New("failed to get permissions")
+		unknown := userDomain
+		unknown.Id = primitive.NewObjectID()
+		unknown.Role = "unknown"
+		copyDomain := threadDomain
+		copyDomain.Id = primitive.NewObjectID()
+		copyDomain.CreatorID = unknown.Id
+
+		topicRepository.On("GetByID", copyDomain.TopicID).Return(topicDomain, nil).Once()
+		threadRepository.On("GetByID", copyDomain.Id).Return(copyDomain, nil).Once()
+		userRepository.On("GetByID", unknown.Id).Return(unknown, nil).Once()
+		permissionRepository.On("GetByRole", "unknown").Return(permissions.Domain{}, errors.New("not found")).Once()
+
+		result, err := threadUseCase.UserUpdate(&copyDomain, nil)
+
+		assert.Equal(t, threads.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 12 | Valid user update thread | Previous version is saved as a revision", func(t *testing.T) {
+		owner := userDomain
+		owner.Id = primitive.NewObjectID()
+		thread := threadDomain
+		thread.Id = primitive.NewObjectID()
+		thread.CreatorID = owner.Id
+		input := thread
+		input.Title = "Edited Title"
+
+		topicRepository.On("GetByID", thread.TopicID).Return(topicDomain, nil).Once()
+		threadRepository.On("GetByID", thread.Id).Return(thread, nil).Once()
+		userRepository.On("GetByID", owner.Id).Return(owner, nil).Once()
+		permissionRepository.On("GetByRole", owner.Role).Return(userPermission, nil).Once()
+		threadRevisionRepository.On("Create", mock.MatchedBy(func(revision *threadRevisions.Domain) bool {
+			return revision.ThreadID == thread.Id && revision.EditorID == owner.Id && !revision.IsAdminEdit && revision.Title == thread.Title
+		})).Return(threadRevisions.Domain{}, nil).Once()
+		threadRepository.On("Update", mock.MatchedBy(func(updated *threads.Domain) bool {
+			return updated.Title == "Edited Title" && updated.EditedAt != 0
+		})).Return(input, nil).Once()
+
+		result, err := threadUseCase.UserUpdate(&input, nil)
+
+		assert.Equal(t, input, result)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 13 | Invalid user update thread | Error when saving revision", func(t *testing.T) {
+		expectedErr := errors.New("failed to save thread revision")
+		owner := userDomain
+		owner.Id = primitive.NewObjectID()
+		thread := threadDomain
+		thread.Id = primitive.NewObjectID()
+		thread.CreatorID = owner.Id
+
+		topicRepository.On("GetByID", thread.TopicID).Return(topicDomain, nil).Once()
+		threadRepository.On("GetByID", thread.Id).Return(thread, nil).Once()
+		userRepository.On("GetByID", owner.Id).Return(owner, nil).Once()
+		permissionRepository.On("GetByRole", owner.Role).Return(userPermission, nil).Once()
+		threadRevisionRepository.On("Create", mock.Anything).Return(threadRevisions.Domain{}, errors.New("unexpected error")).Once()
+
+		result, err := threadUseCase.UserUpdate(&thread, nil)
+
+		assert.Equal(t, threads.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
 }
 
 func TestAdminUpdate(t *testing.T) {
 	t.Run("Test case 1 | Valid admin update thread", func(t *testing.T) {
 		topicRepository.On("GetByID", threadDomain.TopicID).Return(topicDomain, nil).Once()
 		threadRepository.On("GetByID", threadDomain.Id).Return(threadDomain, nil).Once()
-		cloudinaryRepository.On("Delete", mock.Anything, mock.Anything).Return(nil).Once()
 		cloudinaryRepository.On("Upload", mock.Anything, mock.Anything, mock.Anything).Return("image", nil).Once()
+		threadRevisionRepository.On("Create", mock.Anything).Return(threadRevisions.Domain{}, nil).Once()
 		threadRepository.On("Update", mock.Anything).Return(threadDomain, nil).Once()
 
 		result, err := threadUseCase.AdminUpdate(&threadDomain, image)
@@ -491,11 +832,11 @@ func TestAdminUpdate(t *testing.T) {
 		assert.Equal(t, expectedErr, err)
 	})
 
-	t.Run("Test case 4 | Invalid admin update thread | Error when deleting image", func(t *testing.T) {
-		expectedErr := errors.New("failed to delete image")
+	t.Run("Test case 4 | Invalid admin update thread | Error when saving revision before uploading image", func(t *testing.T) {
+		expectedErr := errors.New("failed to save thread revision")
 		topicRepository.On("GetByID", threadDomain.TopicID).Return(topicDomain, nil).Once()
 		threadRepository.On("GetByID", threadDomain.Id).Return(threadDomain, nil).Once()
-		cloudinaryRepository.On("Delete", mock.Anything, mock.Anything).Return(expectedErr).Once()
+		threadRevisionRepository.On("Create", mock.Anything).Return(threadRevisions.Domain{}, errors.New("unexpected error")).Once()
 
 		result, err := threadUseCase.AdminUpdate(&threadDomain, image)
 
@@ -507,7 +848,7 @@ func TestAdminUpdate(t *testing.T) {
 		expectedErr := errors.New("failed to upload image")
 		topicRepository.On("GetByID", threadDomain.TopicID).Return(topicDomain, nil).Once()
 		threadRepository.On("GetByID", threadDomain.Id).Return(threadDomain, nil).Once()
-		cloudinaryRepository.On("Delete", mock.Anything, mock.Anything).Return(nil).Once()
+		threadRevisionRepository.On("Create", mock.Anything).Return(threadRevisions.Domain{}, nil).Once()
 		cloudinaryRepository.On("Upload", mock.Anything, mock.Anything, mock.Anything).Return("", expectedErr).Once()
 
 		result, err := threadUseCase.AdminUpdate(&threadDomain, image)
@@ -520,8 +861,8 @@ func TestAdminUpdate(t *testing.T) {
 		expectedErr := errors.New("failed to update thread")
 		topicRepository.On("GetByID", threadDomain.TopicID).Return(topicDomain, nil).Once()
 		threadRepository.On("GetByID", threadDomain.Id).Return(threadDomain, nil).Once()
-		cloudinaryRepository.On("Delete", mock.Anything, mock.Anything).Return(nil).Once()
 		cloudinaryRepository.On("Upload", mock.Anything, mock.Anything, mock.Anything).Return("image", nil).Once()
+		threadRevisionRepository.On("Create", mock.Anything).Return(threadRevisions.Domain{}, nil).Once()
 		threadRepository.On("Update", mock.Anything).Return(threads.Domain{}, expectedErr).Once()
 
 		result, err := threadUseCase.AdminUpdate(&threadDomain, image)
@@ -529,13 +870,173 @@ func TestAdminUpdate(t *testing.T) {
 		assert.Equal(t, threads.Domain{}, result)
 		assert.Equal(t, expectedErr, err)
 	})
+
+	t.Run("Test case 7 | Valid admin update thread | Revision is recorded as an admin edit", func(t *testing.T) {
+		adminID := primitive.NewObjectID()
+		thread := threadDomain
+		thread.Id = primitive.NewObjectID()
+		input := thread
+		input.CreatorID = adminID
+
+		topicRepository.On("GetByID", thread.TopicID).Return(topicDomain, nil).Once()
+		threadRepository.On("GetByID", thread.Id).Return(thread, nil).Once()
+		threadRevisionRepository.On("Create", mock.MatchedBy(func(revision *threadRevisions.Domain) bool {
+			return revision.ThreadID == thread.Id && revision.EditorID == adminID && revision.IsAdminEdit
+		})).Return(threadRevisions.Domain{}, nil).Once()
+		threadRepository.On("Update", mock.MatchedBy(func(updated *threads.Domain) bool {
+			return updated.CreatorID == thread.CreatorID
+		})).Return(thread, nil).Once()
+
+		result, err := threadUseCase.AdminUpdate(&input, nil)
+
+		assert.Equal(t, thread, result)
+		assert.Nil(t, err)
+	})
+}
+
+func TestGetRevisions(t *testing.T) {
+	t.Run("Test case 1 | Valid get revisions | Thread creator", func(t *testing.T) {
+		thread := threadDomain
+		thread.Id = primitive.NewObjectID()
+		revisions := []threadRevisions.Domain{{Id: primitive.NewObjectID(), ThreadID: thread.Id, Title: "Old Title"}}
+
+		threadRepository.On("GetByID", thread.Id).Return(thread, nil).Once()
+		threadRevisionRepository.On("GetAllByThreadID", thread.Id).Return(revisions, nil).Once()
+
+		result, err := threadUseCase.GetRevisions(thread.CreatorID, thread.Id)
+
+		assert.Equal(t, revisions, result)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Valid get revisions | Moderator of the topic", func(t *testing.T) {
+		moderator := userDomain
+		moderator.Id = primitive.NewObjectID()
+		moderator.Role = "moderator"
+		moderatedTopic := topicDomain
+		moderatedTopic.Id = primitive.NewObjectID()
+		moderatedTopic.ModeratorIDs = []primitive.ObjectID{moderator.Id}
+		thread := threadDomain
+		thread.Id = primitive.NewObjectID()
+		thread.TopicID = moderatedTopic.Id
+
+		threadRepository.On("GetByID", thread.Id).Return(thread, nil).Once()
+		userRepository.On("GetByID", moderator.Id).Return(moderator, nil).Once()
+		permissionRepository.On("GetByRole", "moderator").Return(moderatorPermission, nil).Once()
+		topicRepository.On("GetByID", moderatedTopic.Id).Return(moderatedTopic, nil).Once()
+		threadRevisionRepository.On("GetAllByThreadID", thread.Id).Return([]threadRevisions.Domain{}, nil).Once()
+
+		result, err := threadUseCase.GetRevisions(moderator.Id, thread.Id)
+
+		assert.Equal(t, []threadRevisions.Domain{}, result)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 3 | Invalid get revisions | User is not a moderator of this topic", func(t *testing.T) {
+		expectedErr := errors.New("user is not a moderator of this topic")
+		other := userDomain
+		other.Id = primitive.NewObjectID()
+		thread := threadDomain
+		thread.Id = primitive.NewObjectID()
+
+		threadRepository.On("GetByID", thread.Id).Return(thread, nil).Once()
+		userRepository.On("GetByID", other.Id).Return(other, nil).Once()
+		permissionRepository.On("GetByRole", other.Role).Return(userPermission, nil).Once()
+		topicRepository.On("GetByID", thread.TopicID).Return(topicDomain, nil).Once()
+
+		result, err := threadUseCase.GetRevisions(other.Id, thread.Id)
+
+		assert.Equal(t, []threadRevisions.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 4 | Invalid get revisions | Error when getting revisions", func(t *testing.T) {
+		expectedErr := errors.New("failed to get thread revisions")
+		thread := threadDomain
+		thread.Id = primitive.NewObjectID()
+
+		threadRepository.On("GetByID", thread.Id).Return(thread, nil).Once()
+		threadRevisionRepository.On("GetAllByThreadID", thread.Id).Return([]threadRevisions.Domain{}, errors.New("unexpected error")).Once()
+
+		result, err := threadUseCase.GetRevisions(thread.CreatorID, thread.Id)
+
+		assert.Equal(t, []threadRevisions.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestCompareRevisions(t *testing.T) {
+	t.Run("Test case 1 | Valid compare revisions | Two revisions", func(t *testing.T) {
+		thread := threadDomain
+		thread.Id = primitive.NewObjectID()
+		from := threadRevisions.Domain{Id: primitive.NewObjectID(), ThreadID: thread.Id, TopicID: thread.TopicID, Title: "First", Description: "line one\nline two"}
+		to := threadRevisions.Domain{Id: primitive.NewObjectID(), ThreadID: thread.Id, TopicID: thread.TopicID, Title: "Second", Description: "line one\nline three"}
+
+		threadRepository.On("GetByID", thread.Id).Return(thread, nil).Once()
+		threadRevisionRepository.On("GetByID", from.Id).Return(from, nil).Once()
+		threadRevisionRepository.On("GetByID", to.Id).Return(to, nil).Once()
+
+		result, err := threadUseCase.CompareRevisions(thread.CreatorID, thread.Id, from.Id, to.Id)
+
+		assert.Nil(t, err)
+		assert.Equal(t, threadRevisions.FieldDiff{From: "First", To: "Second", Changed: true}, result.Title)
+		assert.False(t, result.TopicID.Changed)
+		assert.Equal(t, []threadRevisions.LineDiff{
+			{Type: threadRevisions.LineEqual, Text: "line one"},
+			{Type: threadRevisions.LineRemoved, Text: "line two"},
+			{Type: threadRevisions.LineAdded, Text: "line three"},
+		}, result.Description)
+	})
+
+	t.Run("Test case 2 | Valid compare revisions | Against the current version", func(t *testing.T) {
+		thread := threadDomain
+		thread.Id = primitive.NewObjectID()
+		from := threadRevisions.Domain{Id: primitive.NewObjectID(), ThreadID: thread.Id, TopicID: thread.TopicID, Title: "Old Title", Description: thread.Description, ImageURL: thread.ImageURL}
+
+		threadRepository.On("GetByID", thread.Id).Return(thread, nil).Once()
+		threadRevisionRepository.On("GetByID", from.Id).Return(from, nil).Once()
+
+		result, err := threadUseCase.CompareRevisions(thread.CreatorID, thread.Id, from.Id, primitive.NilObjectID)
+
+		assert.Nil(t, err)
+		assert.Equal(t, threadRevisions.FieldDiff{From: "Old Title", To: thread.Title, Changed: true}, result.Title)
+		assert.False(t, result.ImageURL.Changed)
+		assert.Equal(t, []threadRevisions.LineDiff{{Type: threadRevisions.LineEqual, Text: thread.Description}}, result.Description)
+	})
+
+	t.Run("Test case 3 | Invalid compare revisions | Revision belongs to another thread", func(t *testing.T) {
+		expectedErr := errors.New("failed to get thread revision")
+		thread := threadDomain
+		thread.Id = primitive.NewObjectID()
+		from := threadRevisions.Domain{Id: primitive.NewObjectID(), ThreadID: primitive.NewObjectID()}
+
+		threadRepository.On("GetByID", thread.Id).Return(thread, nil).Once()
+		threadRevisionRepository.On("GetByID", from.Id).Return(from, nil).Once()
+
+		result, err := threadUseCase.CompareRevisions(thread.CreatorID, thread.Id, from.Id, primitive.NilObjectID)
+
+		assert.Equal(t, threadRevisions.Diff{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 4 | Invalid compare revisions | Error when getting thread", func(t *testing.T) {
+		expectedErr := errors.New("failed to get thread")
+		threadID := primitive.NewObjectID()
+
+		threadRepository.On("GetByID", threadID).Return(threads.Domain{}, errors.New("unexpected error")).Once()
+
+		result, err := threadUseCase.CompareRevisions(primitive.NewObjectID(), threadID, primitive.NewObjectID(), primitive.NilObjectID)
+
+		assert.Equal(t, threadRevisions.Diff{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
 }
 
 func TestSuspendByUserID(t *testing.T) {
 	t.Run("Test case 1 | Valid suspend thread by user id", func(t *testing.T) {
 		threadRepository.On("SuspendByUserID", mock.Anything).Return(nil).Once()
 
-		err := threadUseCase.SuspendByUserID(threadDomain.CreatorID)
+		err := threadUseCase.SuspendByUserID(threadDomain.CreatorID, "spam")
 
 		assert.Nil(t, err)
 	})
@@ -544,7 +1045,26 @@ func TestSuspendByUserID(t *testing.T) {
 		expectedErr := errors.New("failed to suspend user threads")
 		threadRepository.On("SuspendByUserID", mock.Anything).Return(expectedErr).Once()
 
-		err := threadUseCase.SuspendByUserID(threadDomain.CreatorID)
+		err := threadUseCase.SuspendByUserID(threadDomain.CreatorID, "spam")
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestUnsuspendByUserID(t *testing.T) {
+	t.Run("Test case 1 | Valid unsuspend thread by user id", func(t *testing.T) {
+		threadRepository.On("UnsuspendByUserID", mock.Anything).Return(nil).Once()
+
+		err := threadUseCase.UnsuspendByUserID(threadDomain.CreatorID)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Invalid unsuspend thread by user id | Error when unsuspending threads", func(t *testing.T) {
+		expectedErr := errors.New("failed to unsuspend user threads")
+		threadRepository.On("UnsuspendByUserID", mock.Anything).Return(expectedErr).Once()
+
+		err := threadUseCase.UnsuspendByUserID(threadDomain.CreatorID)
 
 		assert.Equal(t, expectedErr, err)
 	})
@@ -656,13 +1176,18 @@ func TestRemoveUserFromAllLikes(t *testing.T) {
 func TestDelete(t *testing.T) {
 	t.Run("Test case 1 | Valid delete thread", func(t *testing.T) {
 		threadRepository.On("GetByID", threadDomain.Id).Return(threadDomain, nil).Once()
-		cloudinaryRepository.On("Delete", mock.Anything, mock.Anything).Return(nil).Once()
-		threadRepository.On("Delete", mock.Anything).Return(nil).Once()
+		userRepository.On("GetByID", threadDomain.CreatorID).Return(userDomain, nil).Once()
+		permissionRepository.On("GetByRole", userDomain.Role).Return(userPermission, nil).Once()
+		threadRepository.On("SoftDelete", mock.Anything).Return(nil).Once()
+		commentRepository.On("SoftDeleteAllByThreadID", mock.Anything, mock.Anything).Return(nil).Once()
+		followThreadRepository.On("SoftDeleteAllByThreadID", mock.Anything, mock.Anything).Return(nil).Once()
+		bookmarkRepository.On("SoftDeleteAllByThreadID", mock.Anything, mock.Anything).Return(nil).Once()
 
 		thread, err := threadUseCase.Delete(threadDomain.CreatorID, threadDomain.Id)
 
 		assert.Nil(t, err)
-		assert.Equal(t, threadDomain, thread)
+		assert.Equal(t, threadDomain.Id, thread.Id)
+		assert.NotZero(t, thread.DeletedAt)
 	})
 
 	t.Run("Test case 2 | Invalid delete thread | Error when getting thread by id", func(t *testing.T) {
@@ -677,18 +1202,9 @@ func TestDelete(t *testing.T) {
 	t.Run("Test case 4 | Invalid delete thread | Error when deleting thread", func(t *testing.T) {
 		expectedErr := errors.New("failed to delete thread")
 		threadRepository.On("GetByID", threadDomain.Id).Return(threadDomain, nil).Once()
-		cloudinaryRepository.On("Delete", mock.Anything, mock.Anything).Return(nil).Once()
-		threadRepository.On("Delete", mock.Anything).Return(expectedErr).Once()
-
-		_, err := threadUseCase.Delete(threadDomain.CreatorID, threadDomain.Id)
-
-		assert.Equal(t, expectedErr, err)
-	})
-
-	t.Run("Test case 5 | Invalid delete thread | Error when deleting image", func(t *testing.T) {
-		expectedErr := errors.New("failed to delete image")
-		threadRepository.On("GetByID", threadDomain.Id).Return(threadDomain, nil).Once()
-		cloudinaryRepository.On("Delete", mock.Anything, mock.Anything).Return(expectedErr).Once()
+		userRepository.On("GetByID", threadDomain.CreatorID).Return(userDomain, nil).Once()
+		permissionRepository.On("GetByRole", userDomain.Role).Return(userPermission, nil).Once()
+		threadRepository.On("SoftDelete", mock.Anything).Return(expectedErr).Once()
 
 		_, err := threadUseCase.Delete(threadDomain.CreatorID, threadDomain.Id)
 
@@ -701,17 +1217,45 @@ func TestDelete(t *testing.T) {
 		copyDomain.CreatorID = primitive.NewObjectID()
 
 		threadRepository.On("GetByID", threadDomain.Id).Return(copyDomain, nil).Once()
+		userRepository.On("GetByID", threadDomain.CreatorID).Return(userDomain, nil).Once()
+		permissionRepository.On("GetByRole", userDomain.Role).Return(userPermission, nil).Once()
 
 		_, err := threadUseCase.Delete(threadDomain.CreatorID, threadDomain.Id)
 
 		assert.Equal(t, expectedErr, err)
 	})
+
+	t.Run("Test case 9 | Valid user delete thread | Role may delete any thread", func(t *testing.T) {
+		admin := userDomain
+		admin.Id = primitive.NewObjectID()
+		admin.Role = "admin"
+		copyDomain := threadDomain
+		copyDomain.Id = primitive.NewObjectID()
+		copyDomain.ImageURL = ""
+
+		threadRepository.On("GetByID", copyDomain.Id).Return(copyDomain, nil).Once()
+		userRepository.On("GetByID", admin.Id).Return(admin, nil).Once()
+		permissionRepository.On("GetByRole", "admin").Return(adminPermission, nil).Once()
+		threadRepository.On("SoftDelete", mock.Anything).Return(nil).Once()
+		commentRepository.On("SoftDeleteAllByThreadID", mock.Anything, mock.Anything).Return(nil).Once()
+		followThreadRepository.On("SoftDeleteAllByThreadID", mock.Anything, mock.Anything).Return(nil).Once()
+		bookmarkRepository.On("SoftDeleteAllByThreadID", mock.Anything, mock.Anything).Return(nil).Once()
+
+		thread, err := threadUseCase.Delete(admin.Id, copyDomain.Id)
+
+		assert.Nil(t, err)
+		assert.Equal(t, copyDomain.Id, thread.Id)
+		assert.NotZero(t, thread.DeletedAt)
+	})
 }
 
 func TestDeleteAllByUserID(t *testing.T) {
 	t.Run("Test case 1 | Valid delete all thread by user id", func(t *testing.T) {
 		threadRepository.On("GetAllByUserID", threadDomain.CreatorID).Return([]threads.Domain{threadDomain}, nil).Once()
+		threadRepository.On("GetDraftsByCreatorID", threadDomain.CreatorID).Return([]threads.Domain{}, nil).Once()
+		threadRevisionRepository.On("GetAllByThreadID", threadDomain.Id).Return([]threadRevisions.Domain{}, nil).Once()
 		cloudinaryRepository.On("Delete", mock.Anything, mock.Anything).Return(nil).Once()
+		threadRevisionRepository.On("DeleteAllByThreadID", threadDomain.Id).Return(nil).Once()
 		threadRepository.On("DeleteAllByUserID", mock.Anything).Return(nil).Once()
 
 		err := threadUseCase.DeleteAllByUserID(threadDomain.CreatorID)
@@ -722,7 +1266,10 @@ func TestDeleteAllByUserID(t *testing.T) {
 	t.Run("Test case 2 | Invalid delete all thread by user id | Error when deleting thread by user id", func(t *testing.T) {
 		expectedErr := errors.New("failed to delete user threads")
 		threadRepository.On("GetAllByUserID", threadDomain.CreatorID).Return([]threads.Domain{threadDomain}, nil).Once()
+		threadRepository.On("GetDraftsByCreatorID", threadDomain.CreatorID).Return([]threads.Domain{}, nil).Once()
+		threadRevisionRepository.On("GetAllByThreadID", threadDomain.Id).Return([]threadRevisions.Domain{}, nil).Once()
 		cloudinaryRepository.On("Delete", mock.Anything, mock.Anything).Return(nil).Once()
+		threadRevisionRepository.On("DeleteAllByThreadID", threadDomain.Id).Return(nil).Once()
 		threadRepository.On("DeleteAllByUserID", mock.Anything).Return(expectedErr).Once()
 
 		err := threadUseCase.DeleteAllByUserID(threadDomain.CreatorID)
@@ -733,6 +1280,8 @@ func TestDeleteAllByUserID(t *testing.T) {
 	t.Run("Test case 3 | Invalid delete all thread by user id | Error when deleting image", func(t *testing.T) {
 		expectedErr := errors.New("failed to delete image")
 		threadRepository.On("GetAllByUserID", threadDomain.CreatorID).Return([]threads.Domain{threadDomain}, nil).Once()
+		threadRepository.On("GetDraftsByCreatorID", threadDomain.CreatorID).Return([]threads.Domain{}, nil).Once()
+		threadRevisionRepository.On("GetAllByThreadID", threadDomain.Id).Return([]threadRevisions.Domain{}, nil).Once()
 		cloudinaryRepository.On("Delete", mock.Anything, mock.Anything).Return(expectedErr).Once()
 
 		err := threadUseCase.DeleteAllByUserID(threadDomain.CreatorID)
@@ -748,12 +1297,40 @@ func TestDeleteAllByUserID(t *testing.T) {
 
 		assert.Equal(t, expectedErr, err)
 	})
+
+	t.Run("Test case 5 | Invalid delete all thread by user id | Error when getting drafts by user id", func(t *testing.T) {
+		expectedErr := errors.New("failed to get user drafts")
+		threadRepository.On("GetAllByUserID", threadDomain.CreatorID).Return([]threads.Domain{}, nil).Once()
+		threadRepository.On("GetDraftsByCreatorID", threadDomain.CreatorID).Return([]threads.Domain{}, errors.New("unexpected error")).Once()
+
+		err := threadUseCase.DeleteAllByUserID(threadDomain.CreatorID)
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 6 | Valid delete all thread by user id | Draft images are deleted too", func(t *testing.T) {
+		draft := threadDomain
+		draft.IsDraft = true
+		draft.ImageURL = "https://example.com/draft-image.png"
+		threadRepository.On("GetAllByUserID", threadDomain.CreatorID).Return([]threads.Domain{}, nil).Once()
+		threadRepository.On("GetDraftsByCreatorID", threadDomain.CreatorID).Return([]threads.Domain{draft}, nil).Once()
+		threadRevisionRepository.On("GetAllByThreadID", draft.Id).Return([]threadRevisions.Domain{}, nil).Once()
+		cloudinaryRepository.On("Delete", "thread", "draft-image").Return(nil).Once()
+		threadRevisionRepository.On("DeleteAllByThreadID", draft.Id).Return(nil).Once()
+		threadRepository.On("DeleteAllByUserID", threadDomain.CreatorID).Return(nil).Once()
+
+		err := threadUseCase.DeleteAllByUserID(threadDomain.CreatorID)
+
+		assert.Nil(t, err)
+	})
 }
 
 func TestDeleteByThreadID(t *testing.T) {
 	t.Run("Test case 1 | Valid delete thread by thread id", func(t *testing.T) {
 		threadRepository.On("GetByID", threadDomain.Id).Return(threadDomain, nil).Once()
+		threadRevisionRepository.On("GetAllByThreadID", threadDomain.Id).Return([]threadRevisions.Domain{}, nil).Once()
 		cloudinaryRepository.On("Delete", mock.Anything, mock.Anything).Return(nil).Once()
+		threadRevisionRepository.On("DeleteAllByThreadID", threadDomain.Id).Return(nil).Once()
 		threadRepository.On("Delete", mock.Anything).Return(nil).Once()
 
 		err := threadUseCase.DeleteByThreadID(threadDomain.Id)
@@ -764,7 +1341,9 @@ func TestDeleteByThreadID(t *testing.T) {
 	t.Run("Test case 2 | Invalid delete thread by thread id | Error when deleting thread by thread id", func(t *testing.T) {
 		expectedErr := errors.New("failed to delete thread")
 		threadRepository.On("GetByID", threadDomain.Id).Return(threadDomain, nil).Once()
+		threadRevisionRepository.On("GetAllByThreadID", threadDomain.Id).Return([]threadRevisions.Domain{}, nil).Once()
 		cloudinaryRepository.On("Delete", mock.Anything, mock.Anything).Return(nil).Once()
+		threadRevisionRepository.On("DeleteAllByThreadID", threadDomain.Id).Return(nil).Once()
 		threadRepository.On("Delete", mock.Anything).Return(expectedErr).Once()
 
 		err := threadUseCase.DeleteByThreadID(threadDomain.Id)
@@ -775,6 +1354,7 @@ func TestDeleteByThreadID(t *testing.T) {
 	t.Run("Test case 3 | Invalid delete thread by thread id | Error when deleting image", func(t *testing.T) {
 		expectedErr := errors.New("failed to delete image")
 		threadRepository.On("GetByID", threadDomain.Id).Return(threadDomain, nil).Once()
+		threadRevisionRepository.On("GetAllByThreadID", threadDomain.Id).Return([]threadRevisions.Domain{}, nil).Once()
 		cloudinaryRepository.On("Delete", mock.Anything, mock.Anything).Return(expectedErr).Once()
 
 		err := threadUseCase.DeleteByThreadID(threadDomain.Id)
@@ -790,18 +1370,50 @@ func TestDeleteByThreadID(t *testing.T) {
 
 		assert.Equal(t, expectedErr, err)
 	})
+
+	t.Run("Test case 5 | Valid delete thread by thread id | Images of older revisions are deleted too", func(t *testing.T) {
+		thread := threadDomain
+		thread.ImageURL = "https://example.com/current-image.png"
+		revisions := []threadRevisions.Domain{
+			{ThreadID: thread.Id, ImageURL: "https://example.com/older-image.png"},
+			{ThreadID: thread.Id, ImageURL: "https://example.com/current-image.png"},
+		}
+		threadRepository.On("GetByID", thread.Id).Return(thread, nil).Once()
+		threadRevisionRepository.On("GetAllByThreadID", thread.Id).Return(revisions, nil).Once()
+		cloudinaryRepository.On("Delete", "thread", "current-image").Return(nil).Once()
+		cloudinaryRepository.On("Delete", "thread", "older-image").Return(nil).Once()
+		threadRevisionRepository.On("DeleteAllByThreadID", thread.Id).Return(nil).Once()
+		threadRepository.On("Delete", thread.Id).Return(nil).Once()
+
+		err := threadUseCase.DeleteByThreadID(thread.Id)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 6 | Invalid delete thread by thread id | Error when getting thread revisions", func(t *testing.T) {
+		expectedErr := errors.New("failed to get thread revisions")
+		threadRepository.On("GetByID", threadDomain.Id).Return(threadDomain, nil).Once()
+		threadRevisionRepository.On("GetAllByThreadID", threadDomain.Id).Return([]threadRevisions.Domain{}, errors.New("unexpected error")).Once()
+
+		err := threadUseCase.DeleteByThreadID(threadDomain.Id)
+
+		assert.Equal(t, expectedErr, err)
+	})
 }
 
 func TestAdminDelete(t *testing.T) {
 	t.Run("Test case 1 | Valid admin delete thread", func(t *testing.T) {
 		threadRepository.On("GetByID", threadDomain.Id).Return(threadDomain, nil).Once()
-		cloudinaryRepository.On("Delete", mock.Anything, mock.Anything).Return(nil).Once()
-		threadRepository.On("Delete", mock.Anything).Return(nil).Once()
+		threadRepository.On("SoftDelete", mock.Anything).Return(nil).Once()
+		commentRepository.On("SoftDeleteAllByThreadID", mock.Anything, mock.Anything).Return(nil).Once()
+		followThreadRepository.On("SoftDeleteAllByThreadID", mock.Anything, mock.Anything).Return(nil).Once()
+		bookmarkRepository.On("SoftDeleteAllByThreadID", mock.Anything, mock.Anything).Return(nil).Once()
 
 		thread, err := threadUseCase.AdminDelete(threadDomain.Id)
 
 		assert.Nil(t, err)
-		assert.Equal(t, threadDomain, thread)
+		assert.Equal(t, threadDomain.Id, thread.Id)
+		assert.NotZero(t, thread.DeletedAt)
 	})
 
 	t.Run("Test case 2 | Invalid admin delete thread | Error when getting thread by id", func(t *testing.T) {
@@ -816,41 +1428,1317 @@ func TestAdminDelete(t *testing.T) {
 	t.Run("Test case 3 | Invalid admin delete thread | Error when deleting thread", func(t *testing.T) {
 		expectedErr := errors.New("failed to delete thread")
 		threadRepository.On("GetByID", threadDomain.Id).Return(threadDomain, nil).Once()
-		cloudinaryRepository.On("Delete", mock.Anything, mock.Anything).Return(nil).Once()
-		threadRepository.On("Delete", mock.Anything).Return(expectedErr).Once()
+		threadRepository.On("SoftDelete", mock.Anything).Return(expectedErr).Once()
 
 		_, err := threadUseCase.AdminDelete(threadDomain.Id)
 
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("Test case 4 | Valid admin delete thread | Dependents are trashed together with the thread", func(t *testing.T) {
+		var commentsDeletedAt primitive.DateTime
+		threadRepository.On("GetByID", threadDomain.Id).Return(threadDomain, nil).Once()
+		threadRepository.On("SoftDelete", mock.Anything).Return(nil).Once()
+		commentRepository.On("SoftDeleteAllByThreadID", threadDomain.Id, mock.Anything).Run(func(args mock.Arguments) {
+			commentsDeletedAt = args.Get(1).(primitive.DateTime)
+		}).Return(nil).Once()
+		followThreadRepository.On("SoftDeleteAllByThreadID", threadDomain.Id, mock.Anything).Return(nil).Once()
+		bookmarkRepository.On("SoftDeleteAllByThreadID", threadDomain.Id, mock.Anything).Return(nil).Once()
 
-	t.Run("Test case 4 | Invalid admin delete thread | Error when deleting image", func(t *testing.T) {
-		expectedErr := errors.New("failed to delete image")
+		thread, err := threadUseCase.AdminDelete(threadDomain.Id)
+
+		assert.Nil(t, err)
+		assert.Equal(t, thread.DeletedAt, commentsDeletedAt)
+	})
+
+	t.Run("Test case 5 | Invalid admin delete thread | Error when deleting comments", func(t *testing.T) {
+		expectedErr := errors.New("failed to delete thread's comments")
 		threadRepository.On("GetByID", threadDomain.Id).Return(threadDomain, nil).Once()
-		cloudinaryRepository.On("Delete", mock.Anything, mock.Anything).Return(expectedErr).Once()
+		threadRepository.On("SoftDelete", mock.Anything).Return(nil).Once()
+		commentRepository.On("SoftDeleteAllByThreadID", threadDomain.Id, mock.Anything).Return(errors.New("unexpected error")).Once()
 
 		_, err := threadUseCase.AdminDelete(threadDomain.Id)
 
 		assert.Equal(t, expectedErr, err)
 	})
+
 }
 
-func TestGetAll(t *testing.T) {
-	t.Run("Test case 1 | Valid admin get all threads", func(t *testing.T) {
-		threadRepository.On("GetAll").Return([]threads.Domain{threadDomain}, nil).Once()
+func TestModeratorSuspend(t *testing.T) {
+	t.Run("Test case 1 | Valid moderator suspend thread", func(t *testing.T) {
+		moderator := userDomain
+		moderator.Id = primitive.NewObjectID()
+		moderator.Role = "moderator"
+		moderatedTopic := topicDomain
+		moderatedTopic.Id = primitive.NewObjectID()
+		moderatedTopic.ModeratorIDs = []primitive.ObjectID{moderator.Id}
+		thread := threadDomain
+		thread.Id = primitive.NewObjectID()
+		thread.TopicID = moderatedTopic.Id
+
+		threadRepository.On("GetByID", thread.Id).Return(thread, nil).Once()
+		userRepository.On("GetByID", moderator.Id).Return(moderator, nil).Once()
+		permissionRepository.On("GetByRole", "moderator").Return(moderatorPermission, nil).Once()
+		topicRepository.On("GetByID", moderatedTopic.Id).Return(moderatedTopic, nil).Once()
+		threadRepository.On("UpdateSuspendStatus", mock.Anything).Return(nil).Once()
+
+		result, err := threadUseCase.ModeratorSuspend(moderator.Id, thread.Id, "")
 
-		thread, err := threadUseCase.GetAll()
+		assert.Nil(t, err)
+		assert.Equal(t, "moderator suspend", result.SuspendStatus)
+		assert.Equal(t, "thread is violate the rules", result.SuspendDetail)
+	})
+
+	t.Run("Test case 2 | Valid moderator suspend thread | Admin can suspend any thread", func(t *testing.T) {
+		admin := userDomain
+		admin.Id = primitive.NewObjectID()
+		admin.Role = "admin"
+		thread := threadDomain
+		thread.Id = primitive.NewObjectID()
+
+		threadRepository.On("GetByID", thread.Id).Return(thread, nil).Once()
+		userRepository.On("GetByID", admin.Id).Return(admin, nil).Once()
+		permissionRepository.On("GetByRole", "admin").Return(adminPermission, nil).Once()
+		threadRepository.On("UpdateSuspendStatus", mock.Anything).Return(nil).Once()
+
+		result, err := threadUseCase.ModeratorSuspend(admin.Id, thread.Id, "spam")
 
 		assert.Nil(t, err)
-		assert.Equal(t, 1, thread)
+		assert.Equal(t, "spam", result.SuspendDetail)
 	})
 
-	t.Run("Test case 2 | Invalid admin get all threads | Error when getting all threads", func(t *testing.T) {
-		expectedErr := errors.New("failed to get threads")
-		threadRepository.On("GetAll").Return([]threads.Domain{}, expectedErr).Once()
+	t.Run("Test case 3 | Invalid moderator suspend thread | Thread is outside the moderated topics", func(t *testing.T) {
+		expectedErr := errors.New("user is not a moderator of this topic")
+		moderator := userDomain
+		moderator.Id = primitive.NewObjectID()
+		moderator.Role = "moderator"
+		otherTopic := topicDomain
+		otherTopic.Id = primitive.NewObjectID()
+		thread := threadDomain
+		thread.Id = primitive.NewObjectID()
+		thread.TopicID = otherTopic.Id
 
-		_, err := threadUseCase.GetAll()
+		threadRepository.On("GetByID", thread.Id).Return(thread, nil).Once()
+		userRepository.On("GetByID", moderator.Id).Return(moderator, nil).Once()
+		permissionRepository.On("GetByRole", "moderator").Return(moderatorPermission, nil).Once()
+		topicRepository.On("GetByID", otherTopic.Id).Return(otherTopic, nil).Once()
+
+		result, err := threadUseCase.ModeratorSuspend(moderator.Id, thread.Id, "")
+
+		assert.Equal(t, threads.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 4 | Invalid moderator suspend thread | Thread is already suspended", func(t *testing.T) {
+		expectedErr := errors.New("thread is already suspended")
+		admin := userDomain
+		admin.Id = primitive.NewObjectID()
+		admin.Role = "admin"
+		thread := threadDomain
+		thread.Id = primitive.NewObjectID()
+		thread.SuspendStatus = "moderator suspend"
+
+		threadRepository.On("GetByID", thread.Id).Return(thread, nil).Once()
+		userRepository.On("GetByID", admin.Id).Return(admin, nil).Once()
+		permissionRepository.On("GetByRole", "admin").Return(adminPermission, nil).Once()
+
+		result, err := threadUseCase.ModeratorSuspend(admin.Id, thread.Id, "")
+
+		assert.Equal(t, threads.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 5 | Invalid moderator suspend thread | Error when getting thread", func(t *testing.T) {
+		expectedErr := errors.New("failed to get thread")
+		threadID := primitive.NewObjectID()
+
+		threadRepository.On("GetByID", threadID).Return(threads.Domain{}, expectedErr).Once()
+
+		result, err := threadUseCase.ModeratorSuspend(userDomain.Id, threadID, "")
+
+		assert.Equal(t, threads.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestModeratorUnsuspend(t *testing.T) {
+	t.Run("Test case 1 | Valid moderator unsuspend thread", func(t *testing.T) {
+		admin := userDomain
+		admin.Id = primitive.NewObjectID()
+		admin.Role = "admin"
+		thread := threadDomain
+		thread.Id = primitive.NewObjectID()
+		thread.SuspendStatus = "moderator suspend"
+		thread.SuspendDetail = "spam"
+
+		threadRepository.On("GetByID", thread.Id).Return(thread, nil).Once()
+		userRepository.On("GetByID", admin.Id).Return(admin, nil).Once()
+		permissionRepository.On("GetByRole", "admin").Return(adminPermission, nil).Once()
+		threadRepository.On("UpdateSuspendStatus", mock.Anything).Return(nil).Once()
+
+		result, err := threadUseCase.ModeratorUnsuspend(admin.Id, thread.Id)
+
+		assert.Nil(t, err)
+		assert.Empty(t, result.SuspendStatus)
+		assert.Empty(t, result.SuspendDetail)
+	})
+
+	t.Run("Test case 2 | Invalid moderator unsuspend thread | Thread is not suspended", func(t *testing.T) {
+		expectedErr := errors.New("thread is not suspended")
+		admin := userDomain
+		admin.Id = primitive.NewObjectID()
+		admin.Role = "admin"
+		thread := threadDomain
+		thread.Id = primitive.NewObjectID()
+
+		threadRepository.On("GetByID", thread.Id).Return(thread, nil).Once()
+		userRepository.On("GetByID", admin.Id).Return(admin, nil).Once()
+		permissionRepository.On("GetByRole", "admin").Return(adminPermission, nil).Once()
+
+		result, err := threadUseCase.ModeratorUnsuspend(admin.Id, thread.Id)
+
+		assert.Equal(t, threads.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 3 | Invalid moderator unsuspend thread | Error when updating suspend status", func(t *testing.T) {
+		expectedErr := errors.New("failed to unsuspend thread")
+		admin := userDomain
+		admin.Id = primitive.NewObjectID()
+		admin.Role = "admin"
+		thread := threadDomain
+		thread.Id = primitive.NewObjectID()
+		thread.SuspendStatus = "moderator suspend"
+
+		threadRepository.On("GetByID", thread.Id).Return(thread, nil).Once()
+		userRepository.On("GetByID", admin.Id).Return(admin, nil).Once()
+		permissionRepository.On("GetByRole", "admin").Return(adminPermission, nil).Once()
+		threadRepository.On("UpdateSuspendStatus", mock.Anything).Return(expectedErr).Once()
+
+		result, err := threadUseCase.ModeratorUnsuspend(admin.Id, thread.Id)
+
+		assert.Equal(t, threads.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestPin(t *testing.T) {
+	t.Run("Test case 1 | Valid pin thread | Moderator pins within the topic", func(t *testing.T) {
+		moderator := userDomain
+		moderator.Id = primitive.NewObjectID()
+		moderator.Role = "moderator"
+		moderatedTopic := topicDomain
+		moderatedTopic.Id = primitive.NewObjectID()
+		moderatedTopic.ModeratorIDs = []primitive.ObjectID{moderator.Id}
+		thread := threadDomain
+		thread.Id = primitive.NewObjectID()
+		thread.TopicID = moderatedTopic.Id
+
+		threadRepository.On("GetByID", thread.Id).Return(thread, nil).Once()
+		userRepository.On("GetByID", moderator.Id).Return(moderator, nil).Once()
+		permissionRepository.On("GetByRole", "moderator").Return(moderatorPermission, nil).Once()
+		topicRepository.On("GetByID", moderatedTopic.Id).Return(moderatedTopic, nil).Once()
+		threadRepository.On("UpdatePinStatus", mock.Anything).Return(nil).Once()
+
+		result, err := threadUseCase.Pin(moderator.Id, thread.Id, threads.PinScopeTopic)
+
+		assert.Nil(t, err)
+		assert.True(t, result.IsTopicPinned)
+		assert.False(t, result.IsPinned)
+	})
+
+	t.Run("Test case 2 | Valid pin thread | Admin pins globally", func(t *testing.T) {
+		admin := userDomain
+		admin.Id = primitive.NewObjectID()
+		admin.Role = "admin"
+		thread := threadDomain
+		thread.Id = primitive.NewObjectID()
+		thread.IsTopicPinned = true
+
+		threadRepository.On("GetByID", thread.Id).Return(thread, nil).Once()
+		userRepository.On("GetByID", admin.Id).Return(admin, nil).Once()
+		permissionRepository.On("GetByRole", "admin").Return(adminPermission, nil).Once()
+		threadRepository.On("UpdatePinStatus", mock.Anything).Return(nil).Once()
+
+		result, err := threadUseCase.Pin(admin.Id, thread.Id, threads.PinScopeGlobal)
+
+		assert.Nil(t, err)
+		assert.True(t, result.IsPinned)
+		assert.False(t, result.IsTopicPinned)
+	})
+
+	t.Run("Test case 3 | Invalid pin thread | Invalid scope", func(t *testing.T) {
+		expectedErr := errors.New("pin scope must be global or topic")
+
+		result, err := threadUseCase.Pin(primitive.NewObjectID(), threadDomain.Id, "forever")
+
+		assert.Equal(t, threads.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 4 | Invalid pin thread | Moderator pins globally", func(t *testing.T) {
+		expectedErr := errors.New("only admins can change a global pin")
+		moderator := userDomain
+		moderator.Id = primitive.NewObjectID()
+		moderator.Role = "moderator"
+		thread := threadDomain
+		thread.Id = primitive.NewObjectID()
+
+		threadRepository.On("GetByID", thread.Id).Return(thread, nil).Once()
+		userRepository.On("GetByID", moderator.Id).Return(moderator, nil).Once()
+		permissionRepository.On("GetByRole", "moderator").Return(moderatorPermission, nil).Once()
+
+		result, err := threadUseCase.Pin(moderator.Id, thread.Id, threads.PinScopeGlobal)
+
+		assert.Equal(t, threads.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 5 | Invalid pin thread | Thread is already pinned", func(t *testing.T) {
+		expectedErr := errors.New("thread is already pinned")
+		admin := userDomain
+		admin.Id = primitive.NewObjectID()
+		admin.Role = "admin"
+		thread := threadDomain
+		thread.Id = primitive.NewObjectID()
+		thread.IsPinned = true
+
+		threadRepository.On("GetByID", thread.Id).Return(thread, nil).Once()
+		userRepository.On("GetByID", admin.Id).Return(admin, nil).Once()
+		permissionRepository.On("GetByRole", "admin").Return(adminPermission, nil).Once()
+
+		result, err := threadUseCase.Pin(admin.Id, thread.Id, threads.PinScopeGlobal)
+
+		assert.Equal(t, threads.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 6 | Invalid pin thread | Error when updating pin status", func(t *testing.T) {
+		expectedErr := errors.New("failed to pin thread")
+		admin := userDomain
+		admin.Id = primitive.NewObjectID()
+		admin.Role = "admin"
+		thread := threadDomain
+		thread.Id = primitive.NewObjectID()
+
+		threadRepository.On("GetByID", thread.Id).Return(thread, nil).Once()
+		userRepository.On("GetByID", admin.Id).Return(admin, nil).Once()
+		permissionRepository.On("GetByRole", "admin").Return(adminPermission, nil).Once()
+		threadRepository.On("UpdatePinStatus", mock.Anything).Return(expectedErr).Once()
+
+		result, err := threadUseCase.Pin(admin.Id, thread.Id, threads.PinScopeGlobal)
+
+		assert.Equal(t, threads.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestUnpin(t *testing.T) {
+	t.Run("Test case 1 | Valid unpin thread", func(t *testing.T) {
+		admin := userDomain
+		admin.Id = primitive.NewObjectID()
+		admin.Role = "admin"
+		thread := threadDomain
+		thread.Id = primitive.NewObjectID()
+		thread.IsPinned = true
+
+		threadRepository.On("GetByID", thread.Id).Return(thread, nil).Once()
+		userRepository.On("GetByID", admin.Id).Return(admin, nil).Once()
+		permissionRepository.On("GetByRole", "admin").Return(adminPermission, nil).Once()
+		threadRepository.On("UpdatePinStatus", mock.Anything).Return(nil).Once()
+
+		result, err := threadUseCase.Unpin(admin.Id, thread.Id)
+
+		assert.Nil(t, err)
+		assert.False(t, result.IsPinned)
+		assert.False(t, result.IsTopicPinned)
+	})
+
+	t.Run("Test case 2 | Invalid unpin thread | Moderator unpins a global pin", func(t *testing.T) {
+		expectedErr := errors.New("only admins can change a global pin")
+		moderator := userDomain
+		moderator.Id = primitive.NewObjectID()
+		moderator.Role = "moderator"
+		thread := threadDomain
+		thread.Id = primitive.NewObjectID()
+		thread.IsPinned = true
+
+		threadRepository.On("GetByID", thread.Id).Return(thread, nil).Once()
+		userRepository.On("GetByID", moderator.Id).Return(moderator, nil).Once()
+		permissionRepository.On("GetByRole", "moderator").Return(moderatorPermission, nil).Once()
+
+		result, err := threadUseCase.Unpin(moderator.Id, thread.Id)
+
+		assert.Equal(t, threads.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 3 | Invalid unpin thread | Thread is not pinned", func(t *testing.T) {
+		expectedErr := errors.New("thread is not pinned")
+		admin := userDomain
+		admin.Id = primitive.NewObjectID()
+		admin.Role = "admin"
+		thread := threadDomain
+		thread.Id = primitive.NewObjectID()
+
+		threadRepository.On("GetByID", thread.Id).Return(thread, nil).Once()
+		userRepository.On("GetByID", admin.Id).Return(admin, nil).Once()
+		permissionRepository.On("GetByRole", "admin").Return(adminPermission, nil).Once()
+
+		result, err := threadUseCase.Unpin(admin.Id, thread.Id)
+
+		assert.Equal(t, threads.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestLock(t *testing.T) {
+	t.Run("Test case 1 | Valid lock thread", func(t *testing.T) {
+		moderator := userDomain
+		moderator.Id = primitive.NewObjectID()
+		moderator.Role = "moderator"
+		moderatedTopic := topicDomain
+		moderatedTopic.Id = primitive.NewObjectID()
+		moderatedTopic.ModeratorIDs = []primitive.ObjectID{moderator.Id}
+		thread := threadDomain
+		thread.Id = primitive.NewObjectID()
+		thread.TopicID = moderatedTopic.Id
+
+		threadRepository.On("GetByID", thread.Id).Return(thread, nil).Once()
+		userRepository.On("GetByID", moderator.Id).Return(moderator, nil).Once()
+		permissionRepository.On("GetByRole", "moderator").Return(moderatorPermission, nil).Once()
+		topicRepository.On("GetByID", moderatedTopic.Id).Return(moderatedTopic, nil).Once()
+		threadRepository.On("UpdateLockStatus", mock.Anything).Return(nil).Once()
+
+		result, err := threadUseCase.Lock(moderator.Id, thread.Id)
+
+		assert.Nil(t, err)
+		assert.True(t, result.IsLocked)
+	})
+
+	t.Run("Test case 2 | Invalid lock thread | Not a moderator of the topic", func(t *testing.T) {
+		expectedErr := errors.New("user is not a moderator of this topic")
+		moderator := userDomain
+		moderator.Id = primitive.NewObjectID()
+		moderator.Role = "moderator"
+		thread := threadDomain
+		thread.Id = primitive.NewObjectID()
+
+		threadRepository.On("GetByID", thread.Id).Return(thread, nil).Once()
+		userRepository.On("GetByID", moderator.Id).Return(moderator, nil).Once()
+		permissionRepository.On("GetByRole", "moderator").Return(moderatorPermission, nil).Once()
+		topicRepository.On("GetByID", thread.TopicID).Return(topicDomain, nil).Once()
+
+		result, err := threadUseCase.Lock(moderator.Id, thread.Id)
+
+		assert.Equal(t, threads.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 3 | Invalid lock thread | Thread is already locked", func(t *testing.T) {
+		expectedErr := errors.New("thread is already locked")
+		admin := userDomain
+		admin.Id = primitive.NewObjectID()
+		admin.Role = "admin"
+		thread := threadDomain
+		thread.Id = primitive.NewObjectID()
+		thread.IsLocked = true
+
+		threadRepository.On("GetByID", thread.Id).Return(thread, nil).Once()
+		userRepository.On("GetByID", admin.Id).Return(admin, nil).Once()
+		permissionRepository.On("GetByRole", "admin").Return(adminPermission, nil).Once()
+
+		result, err := threadUseCase.Lock(admin.Id, thread.Id)
+
+		assert.Equal(t, threads.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 4 | Invalid lock thread | Error when updating lock status", func(t *testing.T) {
+		expectedErr := errors.New("failed to lock thread")
+		admin := userDomain
+		admin.Id = primitive.NewObjectID()
+		admin.Role = "admin"
+		thread := threadDomain
+		thread.Id = primitive.NewObjectID()
+
+		threadRepository.On("GetByID", thread.Id).Return(thread, nil).Once()
+		userRepository.On("GetByID", admin.Id).Return(admin, nil).Once()
+		permissionRepository.On("GetByRole", "admin").Return(adminPermission, nil).Once()
+		threadRepository.On("UpdateLockStatus", mock.Anything).Return(expectedErr).Once()
+
+		result, err := threadUseCase.Lock(admin.Id, thread.Id)
+
+		assert.Equal(t, threads.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestUnlock(t *testing.T) {
+	t.Run("Test case 1 | Valid unlock thread", func(t *testing.T) {
+		admin := userDomain
+		admin.Id = primitive.NewObjectID()
+		admin.Role = "admin"
+		thread := threadDomain
+		thread.Id = primitive.NewObjectID()
+		thread.IsLocked = true
+
+		threadRepository.On("GetByID", thread.Id).Return(thread, nil).Once()
+		userRepository.On("GetByID", admin.Id).Return(admin, nil).Once()
+		permissionRepository.On("GetByRole", "admin").Return(adminPermission, nil).Once()
+		threadRepository.On("UpdateLockStatus", mock.Anything).Return(nil).Once()
+
+		result, err := threadUseCase.Unlock(admin.Id, thread.Id)
+
+		assert.Nil(t, err)
+		assert.False(t, result.IsLocked)
+	})
+
+	t.Run("Test case 2 | Invalid unlock thread | Thread is not locked", func(t *testing.T) {
+		expectedErr := errors.New("thread is not locked")
+		admin := userDomain
+		admin.Id = primitive.NewObjectID()
+		admin.Role = "admin"
+		thread := threadDomain
+		thread.Id = primitive.NewObjectID()
+
+		threadRepository.On("GetByID", thread.Id).Return(thread, nil).Once()
+		userRepository.On("GetByID", admin.Id).Return(admin, nil).Once()
+		permissionRepository.On("GetByRole", "admin").Return(adminPermission, nil).Once()
+
+		result, err := threadUseCase.Unlock(admin.Id, thread.Id)
+
+		assert.Equal(t, threads.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestModeratorDelete(t *testing.T) {
+	t.Run("Test case 1 | Valid moderator delete thread", func(t *testing.T) {
+		moderator := userDomain
+		moderator.Id = primitive.NewObjectID()
+		moderator.Role = "moderator"
+		moderatedTopic := topicDomain
+		moderatedTopic.Id = primitive.NewObjectID()
+		moderatedTopic.ModeratorIDs = []primitive.ObjectID{moderator.Id}
+		thread := threadDomain
+		thread.Id = primitive.NewObjectID()
+		thread.TopicID = moderatedTopic.Id
+		thread.ImageURL = ""
+
+		threadRepository.On("GetByID", thread.Id).Return(thread, nil).Twice()
+		userRepository.On("GetByID", moderator.Id).Return(moderator, nil).Once()
+		permissionRepository.On("GetByRole", "moderator").Return(moderatorPermission, nil).Once()
+		topicRepository.On("GetByID", moderatedTopic.Id).Return(moderatedTopic, nil).Once()
+		threadRepository.On("SoftDelete", mock.Anything).Return(nil).Once()
+		commentRepository.On("SoftDeleteAllByThreadID", mock.Anything, mock.Anything).Return(nil).Once()
+		followThreadRepository.On("SoftDeleteAllByThreadID", mock.Anything, mock.Anything).Return(nil).Once()
+		bookmarkRepository.On("SoftDeleteAllByThreadID", mock.Anything, mock.Anything).Return(nil).Once()
+
+		result, err := threadUseCase.ModeratorDelete(moderator.Id, thread.Id)
+
+		assert.Nil(t, err)
+		assert.Equal(t, thread.Id, result.Id)
+		assert.NotZero(t, result.DeletedAt)
+	})
+
+	t.Run("Test case 2 | Invalid moderator delete thread | User is not a moderator", func(t *testing.T) {
+		expectedErr := errors.New("user is not a moderator of this topic")
+		thread := threadDomain
+		thread.Id = primitive.NewObjectID()
+		thread.TopicID = primitive.NewObjectID()
+		regularUser := userDomain
+		regularUser.Id = primitive.NewObjectID()
+
+		threadRepository.On("GetByID", thread.Id).Return(thread, nil).Once()
+		userRepository.On("GetByID", regularUser.Id).Return(regularUser, nil).Once()
+		permissionRepository.On("GetByRole", "user").Return(userPermission, nil).Once()
+		topicRepository.On("GetByID", thread.TopicID).Return(topicDomain, nil).Once()
+
+		result, err := threadUseCase.ModeratorDelete(regularUser.Id, thread.Id)
+
+		assert.Equal(t, threads.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestGetTrashWithPagination(t *testing.T) {
+	t.Run("Test case 1 | Valid get trashed threads", func(t *testing.T) {
+		pagination := dtoPagination.Request{
+			Page:  1,
+			Limit: 25,
+			Sort:  "deletedAt",
+			Order: "desc",
+		}
+
+		trashed := threadDomain
+		trashed.DeletedAt = primitive.NewDateTimeFromTime(time.Now())
+		threadRepository.On("GetTrashWithPagination", mock.Anything).Return([]threads.Domain{trashed}, 1, nil).Once()
+
+		result, totalPage, totalData, err := threadUseCase.GetTrashWithPagination(pagination)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []threads.Domain{trashed}, result)
+		assert.Equal(t, 1, totalPage)
+		assert.Equal(t, 1, totalData)
+	})
+
+	t.Run("Test case 2 | Invalid get trashed threads | Error when getting trashed threads", func(t *testing.T) {
+		expectedErr := errors.New("failed to get trashed threads")
+		pagination := dtoPagination.Request{
+			Page:  1,
+			Limit: 25,
+			Sort:  "deletedAt",
+			Order: "desc",
+		}
+
+		threadRepository.On("GetTrashWithPagination", mock.Anything).Return([]threads.Domain{}, 0, errors.New("unexpected error")).Once()
+
+		_, _, _, err := threadUseCase.GetTrashWithPagination(pagination)
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestRestore(t *testing.T) {
+	t.Run("Test case 1 | Valid restore thread", func(t *testing.T) {
+		trashed := threadDomain
+		trashed.DeletedAt = primitive.NewDateTimeFromTime(time.Now())
+		threadRepository.On("GetTrashedByID", trashed.Id).Return(trashed, nil).Once()
+		topicRepository.On("GetByID", trashed.TopicID).Return(topicDomain, nil).Once()
+		userRepository.On("GetByID", trashed.CreatorID).Return(userDomain, nil).Once()
+		threadRepository.On("Restore", trashed.Id).Return(nil).Once()
+
+		result, err := threadUseCase.Restore(trashed.Id)
+
+		assert.Nil(t, err)
+		assert.Equal(t, threadDomain, result)
+	})
+
+	t.Run("Test case 2 | Invalid restore thread | Thread is not in the trash", func(t *testing.T) {
+		expectedErr := errors.New("failed to get trashed thread")
+		threadRepository.On("GetTrashedByID", threadDomain.Id).Return(threads.Domain{}, errors.New("not found")).Once()
+
+		_, err := threadUseCase.Restore(threadDomain.Id)
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 3 | Invalid restore thread | Topic has been deleted", func(t *testing.T) {
+		expectedErr := errors.New("failed to get topic")
+		threadRepository.On("GetTrashedByID", threadDomain.Id).Return(threadDomain, nil).Once()
+		topicRepository.On("GetByID", threadDomain.TopicID).Return(topics.Domain{}, errors.New("not found")).Once()
+
+		_, err := threadUseCase.Restore(threadDomain.Id)
 
 		assert.Equal(t, expectedErr, err)
 	})
+
+	t.Run("Test case 4 | Invalid restore thread | Error when restoring thread", func(t *testing.T) {
+		expectedErr := errors.New("failed to restore thread")
+		threadRepository.On("GetTrashedByID", threadDomain.Id).Return(threadDomain, nil).Once()
+		topicRepository.On("GetByID", threadDomain.TopicID).Return(topicDomain, nil).Once()
+		userRepository.On("GetByID", threadDomain.CreatorID).Return(userDomain, nil).Once()
+		threadRepository.On("Restore", threadDomain.Id).Return(errors.New("unexpected error")).Once()
+
+		_, err := threadUseCase.Restore(threadDomain.Id)
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestPurgeTrash(t *testing.T) {
+	t.Run("Test case 1 | Valid purge trash", func(t *testing.T) {
+		trashed := threadDomain
+		trashed.DeletedAt = primitive.NewDateTimeFromTime(time.Now().Add(-31 * 24 * time.Hour))
+		threadRepository.On("GetAllTrashedBefore", mock.Anything).Return([]threads.Domain{trashed}, nil).Once()
+		threadRevisionRepository.On("GetAllByThreadID", trashed.Id).Return([]threadRevisions.Domain{}, nil).Once()
+		cloudinaryRepository.On("Delete", "thread", mock.Anything).Return(nil).Once()
+		threadRevisionRepository.On("DeleteAllByThreadID", trashed.Id).Return(nil).Once()
+		threadRepository.On("Delete", trashed.Id).Return(nil).Once()
+
+		result, err := threadUseCase.PurgeTrash()
+
+		assert.Nil(t, err)
+		assert.Equal(t, []threads.Domain{trashed}, result)
+	})
+
+	t.Run("Test case 2 | Valid purge trash | Nothing to purge", func(t *testing.T) {
+		threadRepository.On("GetAllTrashedBefore", mock.Anything).Return([]threads.Domain{}, nil).Once()
+
+		result, err := threadUseCase.PurgeTrash()
+
+		assert.Nil(t, err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("Test case 3 | Invalid purge trash | Error when deleting image", func(t *testing.T) {
+		expectedErr := errors.New("failed to purge trashed threads")
+		threadRepository.On("GetAllTrashedBefore", mock.Anything).Return([]threads.Domain{threadDomain}, nil).Once()
+		threadRevisionRepository.On("GetAllByThreadID", threadDomain.Id).Return([]threadRevisions.Domain{}, nil).Once()
+		cloudinaryRepository.On("Delete", "thread", mock.Anything).Return(errors.New("unexpected error")).Once()
+
+		_, err := threadUseCase.PurgeTrash()
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 4 | Invalid purge trash | Error when deleting thread revisions", func(t *testing.T) {
+		expectedErr := errors.New("failed to purge trashed threads")
+		threadRepository.On("GetAllTrashedBefore", mock.Anything).Return([]threads.Domain{threadDomain}, nil).Once()
+		threadRevisionRepository.On("GetAllByThreadID", threadDomain.Id).Return([]threadRevisions.Domain{}, nil).Once()
+		cloudinaryRepository.On("Delete", "thread", mock.Anything).Return(nil).Once()
+		threadRevisionRepository.On("DeleteAllByThreadID", threadDomain.Id).Return(errors.New("unexpected error")).Once()
+
+		_, err := threadUseCase.PurgeTrash()
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 5 | Invalid purge trash | Error when getting trashed threads", func(t *testing.T) {
+		expectedErr := errors.New("failed to get trashed threads")
+		threadRepository.On("GetAllTrashedBefore", mock.Anything).Return([]threads.Domain{}, errors.New("unexpected error")).Once()
+
+		_, err := threadUseCase.PurgeTrash()
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 6 | Invalid purge trash | Threads purged before the failure are still returned", func(t *testing.T) {
+		expectedErr := errors.New("failed to purge trashed threads")
+		purged := threadDomain
+		purged.Id = primitive.NewObjectID()
+		purged.ImageURL = ""
+		failing := threadDomain
+		failing.Id = primitive.NewObjectID()
+		failing.ImageURL = ""
+		threadRepository.On("GetAllTrashedBefore", mock.Anything).Return([]threads.Domain{purged, failing}, nil).Once()
+		threadRevisionRepository.On("GetAllByThreadID", purged.Id).Return([]threadRevisions.Domain{}, nil).Once()
+		threadRevisionRepository.On("DeleteAllByThreadID", purged.Id).Return(nil).Once()
+		threadRepository.On("Delete", purged.Id).Return(nil).Once()
+		threadRevisionRepository.On("GetAllByThreadID", failing.Id).Return([]threadRevisions.Domain{}, nil).Once()
+		threadRevisionRepository.On("DeleteAllByThreadID", failing.Id).Return(nil).Once()
+		threadRepository.On("Delete", failing.Id).Return(errors.New("unexpected error")).Once()
+
+		result, err := threadUseCase.PurgeTrash()
+
+		assert.Equal(t, []threads.Domain{purged}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestGetAll(t *testing.T) {
+	t.Run("Test case 1 | Valid admin get all threads", func(t *testing.T) {
+		threadRepository.On("GetAll").Return([]threads.Domain{threadDomain}, nil).Once()
+
+		thread, err := threadUseCase.GetAll()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, thread)
+	})
+
+	t.Run("Test case 2 | Invalid admin get all threads | Error when getting all threads", func(t *testing.T) {
+		expectedErr := errors.New("failed to get threads")
+		threadRepository.On("GetAll").Return([]threads.Domain{}, expectedErr).Once()
+
+		_, err := threadUseCase.GetAll()
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestCreateDraft(t *testing.T) {
+	t.Run("Test case 1 | Valid create draft | Only the topic is filled in", func(t *testing.T) {
+		draft := threads.Domain{
+			TopicID:   topicDomain.Id,
+			CreatorID: userDomain.Id,
+		}
+		topicRepository.On("GetByID", topicDomain.Id).Return(topicDomain, nil).Once()
+		threadRepository.On("Create", mock.Anything).Return(draft, nil).Once()
+
+		result, err := threadUseCase.CreateDraft(&draft, nil)
+
+		assert.Nil(t, err)
+		assert.True(t, draft.IsDraft)
+		assert.Equal(t, draft.TopicID, result.TopicID)
+	})
+
+	t.Run("Test case 2 | Valid create draft | Scheduled for the future", func(t *testing.T) {
+		draft := threads.Domain{
+			TopicID:     topicDomain.Id,
+			CreatorID:   userDomain.Id,
+			Title:       "Scheduled",
+			Description: "Scheduled Description",
+			PublishAt:   primitive.NewDateTimeFromTime(time.Now().Add(time.Hour)),
+		}
+		verifiedUser := userDomain
+		verifiedUser.EmailVerified = true
+		topicRepository.On("GetByID", topicDomain.Id).Return(topicDomain, nil).Once()
+		userRepository.On("GetByID", userDomain.Id).Return(verifiedUser, nil).Once()
+		threadRepository.On("Create", mock.Anything).Return(draft, nil).Once()
+
+		result, err := threadUseCase.CreateDraft(&draft, nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, draft.PublishAt, result.PublishAt)
+	})
+
+	t.Run("Test case 3 | Invalid create draft | Publish time is in the past", func(t *testing.T) {
+		expectedErr := errors.New("publish time must be in the future")
+		draft := threads.Domain{
+			TopicID:     topicDomain.Id,
+			Title:       "Scheduled",
+			Description: "Scheduled Description",
+			PublishAt:   primitive.NewDateTimeFromTime(time.Now().Add(-time.Hour)),
+		}
+		topicRepository.On("GetByID", topicDomain.Id).Return(topicDomain, nil).Once()
+
+		_, err := threadUseCase.CreateDraft(&draft, nil)
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 4 | Invalid create draft | Scheduled draft is incomplete", func(t *testing.T) {
+		expectedErr := errors.New("draft must have a title and description to be published")
+		draft := threads.Domain{
+			TopicID:   topicDomain.Id,
+			PublishAt: primitive.NewDateTimeFromTime(time.Now().Add(time.Hour)),
+		}
+		topicRepository.On("GetByID", topicDomain.Id).Return(topicDomain, nil).Once()
+
+		_, err := threadUseCase.CreateDraft(&draft, nil)
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 5 | Invalid create draft | Error when getting topic", func(t *testing.T) {
+		expectedErr := errors.New("failed to get topic")
+		draft := threads.Domain{TopicID: topicDomain.Id}
+		topicRepository.On("GetByID", topicDomain.Id).Return(topics.Domain{}, errors.New("not found")).Once()
+
+		_, err := threadUseCase.CreateDraft(&draft, nil)
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 6 | Invalid create draft | Error when creating draft", func(t *testing.T) {
+		expectedErr := errors.New("failed to create draft")
+		draft := threads.Domain{TopicID: topicDomain.Id}
+		topicRepository.On("GetByID", topicDomain.Id).Return(topicDomain, nil).Once()
+		threadRepository.On("Create", mock.Anything).Return(threads.Domain{}, errors.New("unexpected error")).Once()
+
+		_, err := threadUseCase.CreateDraft(&draft, nil)
+
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("Test case 7 | Invalid create draft | Unverified user cannot schedule a draft", func(t *testing.T) {
+		expectedErr := errors.New("email is not verified, drafts cannot be scheduled")
+		draft := threads.Domain{
+			TopicID:     topicDomain.Id,
+			CreatorID:   userDomain.Id,
+			Title:       "Scheduled",
+			Description: "Scheduled Description",
+			PublishAt:   primitive.NewDateTimeFromTime(time.Now().Add(time.Hour)),
+		}
+		topicRepository.On("GetByID", topicDomain.Id).Return(topicDomain, nil).Once()
+		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
+
+		_, err := threadUseCase.CreateDraft(&draft, nil)
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+}
+
+func TestGetDrafts(t *testing.T) {
+	t.Run("Test case 1 | Valid get drafts", func(t *testing.T) {
+		draft := threadDomain
+		draft.IsDraft = true
+		threadRepository.On("GetDraftsByCreatorID", userDomain.Id).Return([]threads.Domain{draft}, nil).Once()
+
+		result, err := threadUseCase.GetDrafts(userDomain.Id)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []threads.Domain{draft}, result)
+	})
+
+	t.Run("Test case 2 | Invalid get drafts | Error when getting drafts", func(t *testing.T) {
+		expectedErr := errors.New("failed to get drafts")
+		threadRepository.On("GetDraftsByCreatorID", userDomain.Id).Return([]threads.Domain{}, errors.New("unexpected error")).Once()
+
+		_, err := threadUseCase.GetDrafts(userDomain.Id)
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestGetDraftByID(t *testing.T) {
+	t.Run("Test case 1 | Valid get draft by id", func(t *testing.T) {
+		draft := threadDomain
+		draft.IsDraft = true
+		threadRepository.On("GetDraftByID", draft.Id).Return(draft, nil).Once()
+
+		result, err := threadUseCase.GetDraftByID(draft.CreatorID, draft.Id)
+
+		assert.Nil(t, err)
+		assert.Equal(t, draft, result)
+	})
+
+	t.Run("Test case 2 | Invalid get draft by id | Draft belongs to another user", func(t *testing.T) {
+		expectedErr := errors.New("failed to get draft")
+		draft := threadDomain
+		draft.IsDraft = true
+		threadRepository.On("GetDraftByID", draft.Id).Return(draft, nil).Once()
+
+		_, err := threadUseCase.GetDraftByID(primitive.NewObjectID(), draft.Id)
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 3 | Invalid get draft by id | Draft does not exist", func(t *testing.T) {
+		expectedErr := errors.New("failed to get draft")
+		threadRepository.On("GetDraftByID", threadDomain.Id).Return(threads.Domain{}, errors.New("not found")).Once()
+
+		_, err := threadUseCase.GetDraftByID(threadDomain.CreatorID, threadDomain.Id)
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestUpdateDraft(t *testing.T) {
+	t.Run("Test case 1 | Valid update draft", func(t *testing.T) {
+		draft := threadDomain
+		draft.IsDraft = true
+		input := threads.Domain{
+			Id:        draft.Id,
+			CreatorID: draft.CreatorID,
+			TopicID:   draft.TopicID,
+			Title:     "Autosaved Title",
+		}
+		threadRepository.On("GetDraftByID", draft.Id).Return(draft, nil).Once()
+		threadRepository.On("UpdateDraft", mock.Anything).Return(draft, nil).Once()
+
+		_, err := threadUseCase.UpdateDraft(&input, nil)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Invalid update draft | Draft belongs to another user", func(t *testing.T) {
+		expectedErr := errors.New("failed to get draft")
+		draft := threadDomain
+		draft.IsDraft = true
+		input := threads.Domain{
+			Id:        draft.Id,
+			CreatorID: primitive.NewObjectID(),
+			TopicID:   draft.TopicID,
+		}
+		threadRepository.On("GetDraftByID", draft.Id).Return(draft, nil).Once()
+
+		_, err := threadUseCase.UpdateDraft(&input, nil)
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 3 | Invalid update draft | New topic does not exist", func(t *testing.T) {
+		expectedErr := errors.New("failed to get topic")
+		draft := threadDomain
+		draft.IsDraft = true
+		input := threads.Domain{
+			Id:        draft.Id,
+			CreatorID: draft.CreatorID,
+			TopicID:   primitive.NewObjectID(),
+		}
+		threadRepository.On("GetDraftByID", draft.Id).Return(draft, nil).Once()
+		topicRepository.On("GetByID", input.TopicID).Return(topics.Domain{}, errors.New("not found")).Once()
+
+		_, err := threadUseCase.UpdateDraft(&input, nil)
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 4 | Invalid update draft | Error when updating draft", func(t *testing.T) {
+		expectedErr := errors.New("failed to update draft")
+		draft := threadDomain
+		draft.IsDraft = true
+		input := threads.Domain{
+			Id:        draft.Id,
+			CreatorID: draft.CreatorID,
+			TopicID:   draft.TopicID,
+		}
+		threadRepository.On("GetDraftByID", draft.Id).Return(draft, nil).Once()
+		threadRepository.On("UpdateDraft", mock.Anything).Return(threads.Domain{}, errors.New("unexpected error")).Once()
+
+		_, err := threadUseCase.UpdateDraft(&input, nil)
+
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("Test case 5 | Invalid update draft | Unverified user cannot schedule a draft", func(t *testing.T) {
+		expectedErr := errors.New("email is not verified, drafts cannot be scheduled")
+		draft := threadDomain
+		draft.IsDraft = true
+		input := threads.Domain{
+			Id:          draft.Id,
+			CreatorID:   draft.CreatorID,
+			TopicID:     draft.TopicID,
+			Title:       "Scheduled",
+			Description: "Scheduled Description",
+			PublishAt:   primitive.NewDateTimeFromTime(time.Now().Add(time.Hour)),
+		}
+		unverifiedUser := userDomain
+		unverifiedUser.EmailVerified = false
+		threadRepository.On("GetDraftByID", draft.Id).Return(draft, nil).Once()
+		userRepository.On("GetByID", draft.CreatorID).Return(unverifiedUser, nil).Once()
+
+		_, err := threadUseCase.UpdateDraft(&input, nil)
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+}
+
+func TestPublishDraft(t *testing.T) {
+	t.Run("Test case 1 | Valid publish draft", func(t *testing.T) {
+		draft := threadDomain
+		draft.IsDraft = true
+		threadRepository.On("GetDraftByID", draft.Id).Return(draft, nil).Once()
+		topicRepository.On("GetByID", draft.TopicID).Return(topicDomain, nil).Once()
+		threadRepository.On("Publish", mock.Anything).Return(threadDomain, nil).Once()
+
+		result, err := threadUseCase.PublishDraft(draft.CreatorID, draft.Id)
+
+		assert.Nil(t, err)
+		assert.Equal(t, threadDomain, result)
+	})
+
+	t.Run("Test case 2 | Invalid publish draft | Draft is incomplete", func(t *testing.T) {
+		expectedErr := errors.New("draft must have a title and description to be published")
+		draft := threadDomain
+		draft.IsDraft = true
+		draft.Description = ""
+		threadRepository.On("GetDraftByID", draft.Id).Return(draft, nil).Once()
+		topicRepository.On("GetByID", draft.TopicID).Return(topicDomain, nil).Once()
+
+		_, err := threadUseCase.PublishDraft(draft.CreatorID, draft.Id)
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 3 | Invalid publish draft | Error when publishing draft", func(t *testing.T) {
+		expectedErr := errors.New("failed to publish draft")
+		draft := threadDomain
+		draft.IsDraft = true
+		threadRepository.On("GetDraftByID", draft.Id).Return(draft, nil).Once()
+		topicRepository.On("GetByID", draft.TopicID).Return(topicDomain, nil).Once()
+		threadRepository.On("Publish", mock.Anything).Return(threads.Domain{}, errors.New("unexpected error")).Once()
+
+		_, err := threadUseCase.PublishDraft(draft.CreatorID, draft.Id)
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestPublishScheduled(t *testing.T) {
+	t.Run("Test case 1 | Valid publish scheduled drafts", func(t *testing.T) {
+		draft := threadDomain
+		draft.IsDraft = true
+		draft.PublishAt = primitive.NewDateTimeFromTime(time.Now().Add(-time.Minute))
+		activeUser := userDomain
+		activeUser.IsActive = true
+		activeUser.EmailVerified = true
+		threadRepository.On("GetDraftsDueBefore", mock.Anything).Return([]threads.Domain{draft}, nil).Once()
+		userRepository.On("GetByID", draft.CreatorID).Return(activeUser, nil).Once()
+		topicRepository.On("GetByID", draft.TopicID).Return(topicDomain, nil).Once()
+		threadRepository.On("Publish", mock.Anything).Return(threadDomain, nil).Once()
+
+		result, err := threadUseCase.PublishScheduled()
+
+		assert.Nil(t, err)
+		assert.Equal(t, []threads.Domain{threadDomain}, result)
+	})
+
+	t.Run("Test case 2 | Valid publish scheduled drafts | Creator is suspended", func(t *testing.T) {
+		draft := threadDomain
+		draft.IsDraft = true
+		suspendedUser := userDomain
+		suspendedUser.IsActive = false
+		threadRepository.On("GetDraftsDueBefore", mock.Anything).Return([]threads.Domain{draft}, nil).Once()
+		userRepository.On("GetByID", draft.CreatorID).Return(suspendedUser, nil).Once()
+
+		result, err := threadUseCase.PublishScheduled()
+
+		assert.Nil(t, err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("Test case 3 | Valid publish scheduled drafts | Topic no longer exists", func(t *testing.T) {
+		draft := threadDomain
+		draft.IsDraft = true
+		draft.PublishAt = primitive.NewDateTimeFromTime(time.Now().Add(-time.Minute))
+		activeUser := userDomain
+		activeUser.IsActive = true
+		activeUser.EmailVerified = true
+		threadRepository.On("GetDraftsDueBefore", mock.Anything).Return([]threads.Domain{draft}, nil).Once()
+		userRepository.On("GetByID", draft.CreatorID).Return(activeUser, nil).Once()
+		topicRepository.On("GetByID", draft.TopicID).Return(topics.Domain{}, errors.New("not found")).Once()
+		threadRepository.On("UpdateDraft", mock.MatchedBy(func(domain *threads.Domain) bool {
+			return domain.PublishAt == 0
+		})).Return(draft, nil).Once()
+
+		result, err := threadUseCase.PublishScheduled()
+
+		assert.Nil(t, err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("Test case 4 | Invalid publish scheduled drafts | Error when getting scheduled drafts", func(t *testing.T) {
+		expectedErr := errors.New("failed to get scheduled drafts")
+		threadRepository.On("GetDraftsDueBefore", mock.Anything).Return([]threads.Domain{}, errors.New("unexpected error")).Once()
+
+		_, err := threadUseCase.PublishScheduled()
+
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("Test case 5 | Valid publish scheduled drafts | Creator has not verified the email", func(t *testing.T) {
+		draft := threadDomain
+		draft.IsDraft = true
+		draft.PublishAt = primitive.NewDateTimeFromTime(time.Now().Add(-time.Minute))
+		unverifiedUser := userDomain
+		unverifiedUser.IsActive = true
+		unverifiedUser.EmailVerified = false
+		threadRepository.On("GetDraftsDueBefore", mock.Anything).Return([]threads.Domain{draft}, nil).Once()
+		userRepository.On("GetByID", draft.CreatorID).Return(unverifiedUser, nil).Once()
+
+		result, err := threadUseCase.PublishScheduled()
+
+		assert.Nil(t, err)
+		assert.Empty(t, result)
+	})
+
+}
+
+func TestDeleteDraft(t *testing.T) {
+	t.Run("Test case 1 | Valid delete draft", func(t *testing.T) {
+		draft := threadDomain
+		draft.IsDraft = true
+		threadRepository.On("GetDraftByID", draft.Id).Return(draft, nil).Once()
+		cloudinaryRepository.On("Delete", "thread", mock.Anything).Return(nil).Once()
+		threadRepository.On("Delete", draft.Id).Return(nil).Once()
+
+		result, err := threadUseCase.DeleteDraft(draft.CreatorID, draft.Id)
+
+		assert.Nil(t, err)
+		assert.Equal(t, draft, result)
+	})
+
+	t.Run("Test case 2 | Invalid delete draft | Draft belongs to another user", func(t *testing.T) {
+		expectedErr := errors.New("failed to get draft")
+		draft := threadDomain
+		draft.IsDraft = true
+		threadRepository.On("GetDraftByID", draft.Id).Return(draft, nil).Once()
+
+		_, err := threadUseCase.DeleteDraft(primitive.NewObjectID(), draft.Id)
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 3 | Invalid delete draft | Error when deleting draft", func(t *testing.T) {
+		expectedErr := errors.New("failed to delete draft")
+		draft := threadDomain
+		draft.IsDraft = true
+		draft.ImageURL = ""
+		threadRepository.On("GetDraftByID", draft.Id).Return(draft, nil).Once()
+		threadRepository.On("Delete", draft.Id).Return(errors.New("unexpected error")).Once()
+
+		_, err := threadUseCase.DeleteDraft(draft.CreatorID, draft.Id)
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestGetTags(t *testing.T) {
+	t.Run("Test case 1 | Valid get tags", func(t *testing.T) {
+		tagCounts := []threads.TagCount{{Name: "go-lang", Count: 2}}
+		threadRepository.On("GetTagCounts", "go-lang", 10).Return(tagCounts, nil).Once()
+
+		result, err := threadUseCase.GetTags("Go Lang", 10)
+
+		assert.Equal(t, tagCounts, result)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Invalid get tags | Error when getting tags", func(t *testing.T) {
+		threadRepository.On("GetTagCounts", "", 10).Return([]threads.TagCount{}, errors.New("unexpected error")).Once()
+
+		result, err := threadUseCase.GetTags("", 10)
+
+		assert.Equal(t, []threads.TagCount{}, result)
+		assert.Equal(t, errors.New("failed to get tags"), err)
+	})
+}
+
+func TestGetBannedTags(t *testing.T) {
+	t.Run("Test case 1 | Valid get banned tags", func(t *testing.T) {
+		banned := []bannedTags.Domain{{Id: primitive.NewObjectID(), Name: "spam"}}
+		bannedTagRepository.On("GetAll").Return(banned, nil).Once()
+
+		result, err := threadUseCase.GetBannedTags()
+
+		assert.Equal(t, banned, result)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Invalid get banned tags | Error when getting banned tags", func(t *testing.T) {
+		bannedTagRepository.On("GetAll").Return([]bannedTags.Domain{}, errors.New("unexpected error")).Once()
+
+		result, err := threadUseCase.GetBannedTags()
+
+		assert.Equal(t, []bannedTags.Domain{}, result)
+		assert.Equal(t, errors.New("failed to get banned tags"), err)
+	})
+}
+
+func TestRenameTag(t *testing.T) {
+	t.Run("Test case 1 | Valid rename tag", func(t *testing.T) {
+		threadRepository.On("CountByTag", "golang").Return(2, nil).Once()
+		threadRepository.On("CountByTag", "go-lang").Return(0, nil).Once()
+		bannedTagRepository.On("GetByName", "go-lang").Return(bannedTags.Domain{}, errors.New("not found")).Once()
+		threadRepository.On("RenameTag", "golang", "go-lang").Return(nil).Once()
+
+		err := threadUseCase.RenameTag("golang", "Go Lang")
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Invalid rename tag | Tag not found", func(t *testing.T) {
+		threadRepository.On("CountByTag", "golang").Return(0, nil).Once()
+
+		err := threadUseCase.RenameTag("golang", "go-lang")
+
+		assert.Equal(t, errors.New("failed to get tag"), err)
+	})
+
+	t.Run("Test case 3 | Invalid rename tag | New name is already in use", func(t *testing.T) {
+		threadRepository.On("CountByTag", "golang").Return(2, nil).Once()
+		threadRepository.On("CountByTag", "go").Return(1, nil).Once()
+
+		err := threadUseCase.RenameTag("golang", "go")
+
+		assert.Equal(t, errors.New("tag already exists, merge the tags instead"), err)
+	})
+
+	t.Run("Test case 4 | Invalid rename tag | New name is banned", func(t *testing.T) {
+		threadRepository.On("CountByTag", "golang").Return(2, nil).Once()
+		threadRepository.On("CountByTag", "spam").Return(0, nil).Once()
+		bannedTagRepository.On("GetByName", "spam").Return(bannedTags.Domain{Name: "spam"}, nil).Once()
+
+		err := threadUseCase.RenameTag("golang", "spam")
+
+		assert.Equal(t, errors.New("tag spam is banned"), err)
+	})
+
+	t.Run("Test case 5 | Invalid rename tag | Error when renaming tag", func(t *testing.T) {
+		threadRepository.On("CountByTag", "golang").Return(2, nil).Once()
+		threadRepository.On("CountByTag", "go-lang").Return(0, nil).Once()
+		bannedTagRepository.On("GetByName", "go-lang").Return(bannedTags.Domain{}, errors.New("not found")).Once()
+		threadRepository.On("RenameTag", "golang", "go-lang").Return(errors.New("unexpected error")).Once()
+
+		err := threadUseCase.RenameTag("golang", "go-lang")
+
+		assert.Equal(t, errors.New("failed to rename tag"), err)
+	})
+}
+
+func TestMergeTag(t *testing.T) {
+	t.Run("Test case 1 | Valid merge tag", func(t *testing.T) {
+		threadRepository.On("CountByTag", "golang").Return(2, nil).Once()
+		threadRepository.On("CountByTag", "go").Return(3, nil).Once()
+		threadRepository.On("RenameTag", "golang", "go").Return(nil).Once()
+
+		err := threadUseCase.MergeTag("golang", "go")
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Invalid merge tag | Merge into itself", func(t *testing.T) {
+		err := threadUseCase.MergeTag("go", "#Go")
+
+		assert.Equal(t, errors.New("cannot merge a tag into itself"), err)
+	})
+
+	t.Run("Test case 3 | Invalid merge tag | Target tag not found", func(t *testing.T) {
+		threadRepository.On("CountByTag", "golang").Return(2, nil).Once()
+		threadRepository.On("CountByTag", "go").Return(0, nil).Once()
+
+		err := threadUseCase.MergeTag("golang", "go")
+
+		assert.Equal(t, errors.New("failed to get tag"), err)
+	})
+
+	t.Run("Test case 4 | Invalid merge tag | Error when merging tags", func(t *testing.T) {
+		threadRepository.On("CountByTag", "golang").Return(2, nil).Once()
+		threadRepository.On("CountByTag", "go").Return(3, nil).Once()
+		threadRepository.On("RenameTag", "golang", "go").Return(errors.New("unexpected error")).Once()
+
+		err := threadUseCase.MergeTag("golang", "go")
+
+		assert.Equal(t, errors.New("failed to merge tags"), err)
+	})
+}
+
+func TestBanTag(t *testing.T) {
+	adminID := primitive.NewObjectID()
+	bannedTag := bannedTags.Domain{Id: primitive.NewObjectID(), Name: "spam", BannedBy: adminID}
+
+	t.Run("Test case 1 | Valid ban tag", func(t *testing.T) {
+		bannedTagRepository.On("GetByName", "spam").Return(bannedTags.Domain{}, errors.New("not found")).Once()
+		bannedTagRepository.On("Create", mock.Anything).Return(bannedTag, nil).Once()
+		threadRepository.On("RemoveTag", "spam").Return(nil).Once()
+
+		result, err := threadUseCase.BanTag(adminID, "#Spam")
+
+		assert.Equal(t, bannedTag, result)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Invalid ban tag | Tag already banned", func(t *testing.T) {
+		bannedTagRepository.On("GetByName", "spam").Return(bannedTag, nil).Once()
+
+		result, err := threadUseCase.BanTag(adminID, "spam")
+
+		assert.Equal(t, bannedTags.Domain{}, result)
+		assert.Equal(t, errors.New("tag already banned"), err)
+	})
+
+	t.Run("Test case 3 | Invalid ban tag | Error when removing tag from threads", func(t *testing.T) {
+		bannedTagRepository.On("GetByName", "spam").Return(bannedTags.Domain{}, errors.New("not found")).Once()
+		bannedTagRepository.On("Create", mock.Anything).Return(bannedTag, nil).Once()
+		threadRepository.On("RemoveTag", "spam").Return(errors.New("unexpected error")).Once()
+
+		result, err := threadUseCase.BanTag(adminID, "spam")
+
+		assert.Equal(t, bannedTags.Domain{}, result)
+		assert.Equal(t, errors.New("failed to remove tag from threads"), err)
+	})
+}
+
+func TestUnbanTag(t *testing.T) {
+	bannedTag := bannedTags.Domain{Id: primitive.NewObjectID(), Name: "spam"}
+
+	t.Run("Test case 1 | Valid unban tag", func(t *testing.T) {
+		bannedTagRepository.On("GetByName", "spam").Return(bannedTag, nil).Once()
+		bannedTagRepository.On("Delete", bannedTag.Id).Return(nil).Once()
+
+		result, err := threadUseCase.UnbanTag("spam")
+
+		assert.Equal(t, bannedTag, result)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Invalid unban tag | Banned tag not found", func(t *testing.T) {
+		bannedTagRepository.On("GetByName", "spam").Return(bannedTags.Domain{}, errors.New("not found")).Once()
+
+		result, err := threadUseCase.UnbanTag("spam")
+
+		assert.Equal(t, bannedTags.Domain{}, result)
+		assert.Equal(t, errors.New("failed to get banned tag"), err)
+	})
 }
@@ -9,12 +9,24 @@ import (
 )
 
 type Domain struct {
-	Id          primitive.ObjectID `json:"_id" bson:"_id"`
-	Topic       string             `json:"topic" bson:"topic"`
-	Description string             `json:"description" bson:"description"`
-	ImageURL    string             `json:"imageURL" bson:"imageURL"`
-	CreatedAt   primitive.DateTime `json:"createdAt" bson:"createdAt"`
-	UpdatedAt   primitive.DateTime `json:"updatedAt" bson:"updatedAt"`
+	Id           primitive.ObjectID   `json:"_id" bson:"_id"`
+	Topic        string               `json:"topic" bson:"topic"`
+	Description  string               `json:"description" bson:"description"`
+	ImageURL     string               `json:"imageURL" bson:"imageURL"`
+	ModeratorIDs []primitive.ObjectID `json:"moderatorIDs" bson:"moderatorIDs"`
+	CreatedAt    primitive.DateTime   `json:"createdAt" bson:"createdAt"`
+	UpdatedAt    primitive.DateTime   `json:"updatedAt" bson:"updatedAt"`
+}
+
+// IsModeratedBy reports whether the user is one of the moderators assigned to the topic
+func (domain Domain) IsModeratedBy(userID primitive.ObjectID) bool {
+	for _, moderatorID := range domain.ModeratorIDs {
+		if moderatorID == userID {
+			return true
+		}
+	}
+
+	return false
 }
 
 type Repository interface {
@@ -24,8 +36,11 @@ type Repository interface {
 	GetByID(id primitive.ObjectID) (Domain, error)
 	GetManyWithPagination(query dtoQuery.Request, domain *Domain) ([]Domain, int, error)
 	GetByTopic(topic string) (Domain, error)
+	GetAllByModeratorID(userID primitive.ObjectID) ([]Domain, error)
 	// Update
 	Update(domain *Domain) (Domain, error)
+	AddModerator(topicID primitive.ObjectID, userID primitive.ObjectID) error
+	RemoveModerator(topicID primitive.ObjectID, userID primitive.ObjectID) error
 	// Delete
 	Delete(id primitive.ObjectID) error
 }
@@ -37,8 +52,11 @@ type UseCase interface {
 	GetByID(id primitive.ObjectID) (Domain, error)
 	GetManyWithPagination(pagination dtoPagination.Request, domain *Domain) ([]Domain, int, int, error)
 	GetByTopic(topic string) (Domain, error)
+	GetAllByModeratorID(userID primitive.ObjectID) ([]Domain, error)
 	// Update
 	Update(domain *Domain, image *multipart.FileHeader) (Domain, error)
+	AssignModerator(topicID primitive.ObjectID, userID primitive.ObjectID) (Domain, error)
+	RemoveModerator(topicID primitive.ObjectID, userID primitive.ObjectID) (Domain, error)
 	// Delete
 	Delete(id primitive.ObjectID) (Domain, error)
 }
@@ -16,6 +16,20 @@ type Repository struct {
 	mock.Mock
 }
 
+// AddModerator provides a mock function with given fields: topicID, userID
+func (_m *Repository) AddModerator(topicID primitive.ObjectID, userID primitive.ObjectID) error {
+	ret := _m.Called(topicID, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID, primitive.ObjectID) error); ok {
+		r0 = rf(topicID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Create provides a mock function with given fields: domain
 func (_m *Repository) Create(domain *topics.Domain) (topics.Domain, error) {
 	ret := _m.Called(domain)
@@ -51,6 +65,29 @@ func (_m *Repository) Delete(id primitive.ObjectID) error {
 	return r0
 }
 
+// GetAllByModeratorID provides a mock function with given fields: userID
+func (_m *Repository) GetAllByModeratorID(userID primitive.ObjectID) ([]topics.Domain, error) {
+	ret := _m.Called(userID)
+
+	var r0 []topics.Domain
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID) []topics.Domain); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]topics.Domain)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(primitive.ObjectID) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetByID provides a mock function with given fields: id
 func (_m *Repository) GetByID(id primitive.ObjectID) (topics.Domain, error) {
 	ret := _m.Called(id)
@@ -123,6 +160,20 @@ func (_m *Repository) GetManyWithPagination(_a0 query.Request, domain *topics.Do
 	return r0, r1, r2
 }
 
+// RemoveModerator provides a mock function with given fields: topicID, userID
+func (_m *Repository) RemoveModerator(topicID primitive.ObjectID, userID primitive.ObjectID) error {
+	ret := _m.Called(topicID, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID, primitive.ObjectID) error); ok {
+		r0 = rf(topicID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields: domain
 func (_m *Repository) Update(domain *topics.Domain) (topics.Domain, error) {
 	ret := _m.Called(domain)
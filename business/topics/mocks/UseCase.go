@@ -19,6 +19,27 @@ type UseCase struct {
 	mock.Mock
 }
 
+// AssignModerator provides a mock function with given fields: topicID, userID
+func (_m *UseCase) AssignModerator(topicID primitive.ObjectID, userID primitive.ObjectID) (topics.Domain, error) {
+	ret := _m.Called(topicID, userID)
+
+	var r0 topics.Domain
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID, primitive.ObjectID) topics.Domain); ok {
+		r0 = rf(topicID, userID)
+	} else {
+		r0 = ret.Get(0).(topics.Domain)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(primitive.ObjectID, primitive.ObjectID) error); ok {
+		r1 = rf(topicID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Create provides a mock function with given fields: domain, image
 func (_m *UseCase) Create(domain *topics.Domain, image *multipart.FileHeader) (topics.Domain, error) {
 	ret := _m.Called(domain, image)
@@ -61,6 +82,29 @@ func (_m *UseCase) Delete(id primitive.ObjectID) (topics.Domain, error) {
 	return r0, r1
 }
 
+// GetAllByModeratorID provides a mock function with given fields: userID
+func (_m *UseCase) GetAllByModeratorID(userID primitive.ObjectID) ([]topics.Domain, error) {
+	ret := _m.Called(userID)
+
+	var r0 []topics.Domain
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID) []topics.Domain); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]topics.Domain)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(primitive.ObjectID) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetByID provides a mock function with given fields: id
 func (_m *UseCase) GetByID(id primitive.ObjectID) (topics.Domain, error) {
 	ret := _m.Called(id)
@@ -140,6 +184,27 @@ func (_m *UseCase) GetManyWithPagination(_a0 pagination.Request, domain *topics.
 	return r0, r1, r2, r3
 }
 
+// RemoveModerator provides a mock function with given fields: topicID, userID
+func (_m *UseCase) RemoveModerator(topicID primitive.ObjectID, userID primitive.ObjectID) (topics.Domain, error) {
+	ret := _m.Called(topicID, userID)
+
+	var r0 topics.Domain
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID, primitive.ObjectID) topics.Domain); ok {
+		r0 = rf(topicID, userID)
+	} else {
+		r0 = ret.Get(0).(topics.Domain)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(primitive.ObjectID, primitive.ObjectID) error); ok {
+		r1 = rf(topicID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: domain, image
 func (_m *UseCase) Update(domain *topics.Domain, image *multipart.FileHeader) (topics.Domain, error) {
 	ret := _m.Called(domain, image)
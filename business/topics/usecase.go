@@ -1,6 +1,7 @@
 package topics
 
 import (
+	"charum/business/users"
 	dtoPagination "charum/dto/pagination"
 	dtoQuery "charum/dto/query"
 	_cloudinary "charum/helper/cloudinary"
@@ -15,12 +16,14 @@ import (
 
 type TopicUseCase struct {
 	topicsRepository Repository
+	userRepository   users.Repository
 	cloudinary       _cloudinary.Function
 }
 
-func NewTopicUseCase(tr Repository, cld _cloudinary.Function) UseCase {
+func NewTopicUseCase(tr Repository, ur users.Repository, cld _cloudinary.Function) UseCase {
 	return &TopicUseCase{
 		topicsRepository: tr,
+		userRepository:   ur,
 		cloudinary:       cld,
 	}
 }
@@ -101,6 +104,14 @@ func (tu *TopicUseCase) GetByTopic(topic string) (Domain, error) {
 	return result, nil
 }
 
+func (tu *TopicUseCase) GetAllByModeratorID(userID primitive.ObjectID) ([]Domain, error) {
+	result, err := tu.topicsRepository.GetAllByModeratorID(userID)
+	if err != nil {
+		return []Domain{}, errors.New("failed to get topics")
+	}
+	return result, nil
+}
+
 /*
 Update
 */
@@ -144,6 +155,103 @@ func (tu *TopicUseCase) Update(domain *Domain, image *multipart.FileHeader) (Dom
 	return updatedResult, nil
 }
 
+func (tu *TopicUseCase) AssignModerator(topicID primitive.ObjectID, userID primitive.ObjectID) (Domain, error) {
+	topic, err := tu.topicsRepository.GetByID(topicID)
+	if err != nil {
+		return Domain{}, errors.New("failed to get topic")
+	}
+
+	user, err := tu.userRepository.GetByID(userID)
+	if err != nil {
+		return Domain{}, errors.New("failed to get user")
+	}
+
+	if user.Role == "admin" {
+		return Domain{}, errors.New("admin cannot be assigned as moderator")
+	}
+
+	if topic.IsModeratedBy(userID) {
+		return Domain{}, errors.New("user is already a moderator of this topic")
+	}
+
+	err = tu.topicsRepository.AddModerator(topicID, userID)
+	if err != nil {
+		return Domain{}, errors.New("failed to assign moderator")
+	}
+
+	if user.Role != "moderator" {
+		user.Role = "moderator"
+		user.UpdatedAt = primitive.NewDateTimeFromTime(time.Now())
+		_, err = tu.userRepository.Update(&user)
+		if err != nil {
+			return Domain{}, errors.New("failed to update user role")
+		}
+	}
+
+	result, err := tu.topicsRepository.GetByID(topicID)
+	if err != nil {
+		return Domain{}, errors.New("failed to get topic")
+	}
+	return result, nil
+}
+
+func (tu *TopicUseCase) RemoveModerator(topicID primitive.ObjectID, userID primitive.ObjectID) (Domain, error) {
+	topic, err := tu.topicsRepository.GetByID(topicID)
+	if err != nil {
+		return Domain{}, errors.New("failed to get topic")
+	}
+
+	if !topic.IsModeratedBy(userID) {
+		return Domain{}, errors.New("user is not a moderator of this topic")
+	}
+
+	err = tu.topicsRepository.RemoveModerator(topicID, userID)
+	if err != nil {
+		return Domain{}, errors.New("failed to remove moderator")
+	}
+
+	err = tu.demoteUnassignedModerator(userID)
+	if err != nil {
+		return Domain{}, err
+	}
+
+	result, err := tu.topicsRepository.GetByID(topicID)
+	if err != nil {
+		return Domain{}, errors.New("failed to get topic")
+	}
+	return result, nil
+}
+
+// demoteUnassignedModerator turns a moderator back into a regular user once no topic is assigned to them anymore
+func (tu *TopicUseCase) demoteUnassignedModerator(userID primitive.ObjectID) error {
+	remainingTopics, err := tu.topicsRepository.GetAllByModeratorID(userID)
+	if err != nil {
+		return errors.New("failed to get topics")
+	}
+
+	if len(remainingTopics) > 0 {
+		return nil
+	}
+
+	user, err := tu.userRepository.GetByID(userID)
+	if err != nil {
+		return errors.New("failed to get user")
+	}
+
+	if user.Role != "moderator" {
+		return nil
+	}
+
+	user.Role = "user"
+	user.UpdatedAt = primitive.NewDateTimeFromTime(time.Now())
+	_, err = tu.userRepository.Update(&user)
+	if err != nil {
+		return errors.New("failed to update user role")
+	}
+
+	return nil
+}
+
 /*
 Delete
 */
@@ -166,5 +274,12 @@ func (tu *TopicUseCase) Delete(id primitive.ObjectID) (Domain, error) {
 		return Domain{}, errors.New("failed to delete topic")
 	}
 
+	for _, moderatorID := range result.ModeratorIDs {
+		err = tu.demoteUnassignedModerator(moderatorID)
+		if err != nil {
+			return Domain{}, err
+		}
+	}
+
 	return result, nil
 }
@@ -3,6 +3,8 @@ package topics_test
 import (
 	"charum/business/topics"
 	_topicMock "charum/business/topics/mocks"
+	"charum/business/users"
+	_userMock "charum/business/users/mocks"
 	dtoPagination "charum/dto/pagination"
 	_cloudinaryMock "charum/helper/cloudinary/mocks"
 	"errors"
@@ -17,14 +19,16 @@ import (
 
 var (
 	topicRepository      _topicMock.Repository
+	userRepository       _userMock.Repository
 	cloudinaryRepository _cloudinaryMock.Function
 	topicUseCase         topics.UseCase
 	topicDomain          topics.Domain
+	userDomain           users.Domain
 	image                *multipart.FileHeader
 )
 
 func TestMain(m *testing.M) {
-	topicUseCase = topics.NewTopicUseCase(&topicRepository, &cloudinaryRepository)
+	topicUseCase = topics.NewTopicUseCase(&topicRepository, &userRepository, &cloudinaryRepository)
 
 	topicDomain = topics.Domain{
 		Id:          primitive.NewObjectID(),
@@ -34,6 +38,18 @@ func TestMain(m *testing.M) {
 		UpdatedAt:   primitive.NewDateTimeFromTime(time.Now()),
 	}
 
+	userDomain = users.Domain{
+		Id:          primitive.NewObjectID(),
+		Email:       "email",
+		UserName:    "username",
+		DisplayName: "displayname",
+		Password:    "password",
+		IsActive:    true,
+		Role:        "user",
+		CreatedAt:   primitive.NewDateTimeFromTime(time.Now()),
+		UpdatedAt:   primitive.NewDateTimeFromTime(time.Now()),
+	}
+
 	image = &multipart.FileHeader{}
 
 	m.Run()
@@ -166,6 +182,27 @@ func TestGetManyWithPagination(t *testing.T) {
 	})
 }
 
+func TestGetAllByModeratorID(t *testing.T) {
+	t.Run("Test case 1 | Valid get topics by moderator id", func(t *testing.T) {
+		topicRepository.On("GetAllByModeratorID", userDomain.Id).Return([]topics.Domain{topicDomain}, nil).Once()
+
+		result, err := topicUseCase.GetAllByModeratorID(userDomain.Id)
+
+		assert.Equal(t, []topics.Domain{topicDomain}, result)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Invalid get topics by moderator id | Error when getting topics", func(t *testing.T) {
+		expectedErr := errors.New("failed to get topics")
+		topicRepository.On("GetAllByModeratorID", userDomain.Id).Return([]topics.Domain{}, expectedErr).Once()
+
+		result, err := topicUseCase.GetAllByModeratorID(userDomain.Id)
+
+		assert.Equal(t, []topics.Domain{}, result)
+		assert.Equal(t, err, expectedErr)
+	})
+}
+
 func TestUpdate(t *testing.T) {
 	t.Run("Test case 1 | Valid update topic", func(t *testing.T) {
 		copyDomain := topicDomain
@@ -243,6 +280,174 @@ func TestUpdate(t *testing.T) {
 	})
 }
 
+func TestAssignModerator(t *testing.T) {
+	t.Run("Test case 1 | Valid assign moderator", func(t *testing.T) {
+		moderatedTopic := topicDomain
+		moderatedTopic.Id = primitive.NewObjectID()
+		updatedTopic := moderatedTopic
+		updatedTopic.ModeratorIDs = []primitive.ObjectID{userDomain.Id}
+
+		topicRepository.On("GetByID", moderatedTopic.Id).Return(moderatedTopic, nil).Once()
+		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
+		topicRepository.On("AddModerator", moderatedTopic.Id, userDomain.Id).Return(nil).Once()
+		userRepository.On("Update", mock.Anything).Return(userDomain, nil).Once()
+		topicRepository.On("GetByID", moderatedTopic.Id).Return(updatedTopic, nil).Once()
+
+		result, err := topicUseCase.AssignModerator(moderatedTopic.Id, userDomain.Id)
+
+		assert.Equal(t, updatedTopic, result)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Invalid assign moderator | Error when getting topic", func(t *testing.T) {
+		expectedErr := errors.New("failed to get topic")
+		moderatedTopic := topicDomain
+		moderatedTopic.Id = primitive.NewObjectID()
+
+		topicRepository.On("GetByID", moderatedTopic.Id).Return(topics.Domain{}, expectedErr).Once()
+
+		result, err := topicUseCase.AssignModerator(moderatedTopic.Id, userDomain.Id)
+
+		assert.Equal(t, topics.Domain{}, result)
+		assert.Equal(t, err, expectedErr)
+	})
+
+	t.Run("Test case 3 | Invalid assign moderator | Error when getting user", func(t *testing.T) {
+		expectedErr := errors.New("failed to get user")
+		moderatedTopic := topicDomain
+		moderatedTopic.Id = primitive.NewObjectID()
+		otherUser := primitive.NewObjectID()
+
+		topicRepository.On("GetByID", moderatedTopic.Id).Return(moderatedTopic, nil).Once()
+		userRepository.On("GetByID", otherUser).Return(users.Domain{}, expectedErr).Once()
+
+		result, err := topicUseCase.AssignModerator(moderatedTopic.Id, otherUser)
+
+		assert.Equal(t, topics.Domain{}, result)
+		assert.Equal(t, err, expectedErr)
+	})
+
+	t.Run("Test case 4 | Invalid assign moderator | User is an admin", func(t *testing.T) {
+		expectedErr := errors.New("admin cannot be assigned as moderator")
+		moderatedTopic := topicDomain
+		moderatedTopic.Id = primitive.NewObjectID()
+		admin := userDomain
+		admin.Id = primitive.NewObjectID()
+		admin.Role = "admin"
+
+		topicRepository.On("GetByID", moderatedTopic.Id).Return(moderatedTopic, nil).Once()
+		userRepository.On("GetByID", admin.Id).Return(admin, nil).Once()
+
+		result, err := topicUseCase.AssignModerator(moderatedTopic.Id, admin.Id)
+
+		assert.Equal(t, topics.Domain{}, result)
+		assert.Equal(t, err, expectedErr)
+	})
+
+	t.Run("Test case 5 | Invalid assign moderator | User is already a moderator of the topic", func(t *testing.T) {
+		expectedErr := errors.New("user is already a moderator of this topic")
+		moderatedTopic := topicDomain
+		moderatedTopic.Id = primitive.NewObjectID()
+		moderatedTopic.ModeratorIDs = []primitive.ObjectID{userDomain.Id}
+
+		topicRepository.On("GetByID", moderatedTopic.Id).Return(moderatedTopic, nil).Once()
+		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
+
+		result, err := topicUseCase.AssignModerator(moderatedTopic.Id, userDomain.Id)
+
+		assert.Equal(t, topics.Domain{}, result)
+		assert.Equal(t, err, expectedErr)
+	})
+
+	t.Run("Test case 6 | Invalid assign moderator | Error when adding moderator", func(t *testing.T) {
+		expectedErr := errors.New("failed to assign moderator")
+		moderatedTopic := topicDomain
+		moderatedTopic.Id = primitive.NewObjectID()
+
+		topicRepository.On("GetByID", moderatedTopic.Id).Return(moderatedTopic, nil).Once()
+		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
+		topicRepository.On("AddModerator", moderatedTopic.Id, userDomain.Id).Return(expectedErr).Once()
+
+		result, err := topicUseCase.AssignModerator(moderatedTopic.Id, userDomain.Id)
+
+		assert.Equal(t, topics.Domain{}, result)
+		assert.Equal(t, err, expectedErr)
+	})
+}
+
+func TestRemoveModerator(t *testing.T) {
+	t.Run("Test case 1 | Valid remove moderator | Moderator is demoted when no topic is left", func(t *testing.T) {
+		moderator := userDomain
+		moderator.Id = primitive.NewObjectID()
+		moderator.Role = "moderator"
+		moderatedTopic := topicDomain
+		moderatedTopic.Id = primitive.NewObjectID()
+		moderatedTopic.ModeratorIDs = []primitive.ObjectID{moderator.Id}
+		updatedTopic := moderatedTopic
+		updatedTopic.ModeratorIDs = nil
+
+		topicRepository.On("GetByID", moderatedTopic.Id).Return(moderatedTopic, nil).Once()
+		topicRepository.On("RemoveModerator", moderatedTopic.Id, moderator.Id).Return(nil).Once()
+		topicRepository.On("GetAllByModeratorID", moderator.Id).Return([]topics.Domain{}, nil).Once()
+		userRepository.On("GetByID", moderator.Id).Return(moderator, nil).Once()
+		userRepository.On("Update", mock.MatchedBy(func(user *users.Domain) bool {
+			return user.Id == moderator.Id && user.Role == "user"
+		})).Return(moderator, nil).Once()
+		topicRepository.On("GetByID", moderatedTopic.Id).Return(updatedTopic, nil).Once()
+
+		result, err := topicUseCase.RemoveModerator(moderatedTopic.Id, moderator.Id)
+
+		assert.Equal(t, updatedTopic, result)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Valid remove moderator | Moderator keeps the role while moderating other topics", func(t *testing.T) {
+		moderator := primitive.NewObjectID()
+		moderatedTopic := topicDomain
+		moderatedTopic.Id = primitive.NewObjectID()
+		moderatedTopic.ModeratorIDs = []primitive.ObjectID{moderator}
+
+		topicRepository.On("GetByID", moderatedTopic.Id).Return(moderatedTopic, nil).Once()
+		topicRepository.On("RemoveModerator", moderatedTopic.Id, moderator).Return(nil).Once()
+		topicRepository.On("GetAllByModeratorID", moderator).Return([]topics.Domain{topicDomain}, nil).Once()
+		topicRepository.On("GetByID", moderatedTopic.Id).Return(topicDomain, nil).Once()
+
+		result, err := topicUseCase.RemoveModerator(moderatedTopic.Id, moderator)
+
+		assert.Equal(t, topicDomain, result)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 3 | Invalid remove moderator | User is not a moderator of the topic", func(t *testing.T) {
+		expectedErr := errors.New("user is not a moderator of this topic")
+		moderatedTopic := topicDomain
+		moderatedTopic.Id = primitive.NewObjectID()
+
+		topicRepository.On("GetByID", moderatedTopic.Id).Return(moderatedTopic, nil).Once()
+
+		result, err := topicUseCase.RemoveModerator(moderatedTopic.Id, userDomain.Id)
+
+		assert.Equal(t, topics.Domain{}, result)
+		assert.Equal(t, err, expectedErr)
+	})
+
+	t.Run("Test case 4 | Invalid remove moderator | Error when removing moderator", func(t *testing.T) {
+		expectedErr := errors.New("failed to remove moderator")
+		moderator := primitive.NewObjectID()
+		moderatedTopic := topicDomain
+		moderatedTopic.Id = primitive.NewObjectID()
+		moderatedTopic.ModeratorIDs = []primitive.ObjectID{moderator}
+
+		topicRepository.On("GetByID", moderatedTopic.Id).Return(moderatedTopic, nil).Once()
+		topicRepository.On("RemoveModerator", moderatedTopic.Id, moderator).Return(expectedErr).Once()
+
+		result, err := topicUseCase.RemoveModerator(moderatedTopic.Id, moderator)
+
+		assert.Equal(t, topics.Domain{}, result)
+		assert.Equal(t, err, expectedErr)
+	})
+}
+
 func TestDelete(t *testing.T) {
 	t.Run("Test case 1 | Valid delete topic", func(t *testing.T) {
 		topicRepository.On("GetByID", topicDomain.Id).Return(topicDomain, nil).Once()
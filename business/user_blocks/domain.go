@@ -0,0 +1,45 @@
+package user_blocks
+
+import (
+	"charum/business/users"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	TypeBlock = "block"
+	TypeMute  = "mute"
+)
+
+type Domain struct {
+	Id        primitive.ObjectID `json:"_id" bson:"_id"`
+	UserID    primitive.ObjectID `json:"userID" bson:"userID"`
+	TargetID  primitive.ObjectID `json:"targetID" bson:"targetID"`
+	Type      string             `json:"type" bson:"type"`
+	CreatedAt primitive.DateTime `json:"createdAt" bson:"createdAt"`
+	UpdatedAt primitive.DateTime `json:"updatedAt" bson:"updatedAt"`
+}
+
+type Repository interface {
+	// Create
+	Create(domain *Domain) (Domain, error)
+	// Read
+	GetByID(id primitive.ObjectID) (Domain, error)
+	GetByUserIDTargetIDAndType(userID primitive.ObjectID, targetID primitive.ObjectID, blockType string) (Domain, error)
+	GetAllByUserID(userID primitive.ObjectID) ([]Domain, error)
+	GetAllByUserIDAndType(userID primitive.ObjectID, blockType string) ([]Domain, error)
+	// Delete
+	Delete(id primitive.ObjectID) error
+	DeleteAllByUserID(userID primitive.ObjectID) error
+}
+
+type UseCase interface {
+	// Create
+	Create(domain *Domain) (Domain, error)
+	// Read
+	GetAllByUserIDAndType(userID primitive.ObjectID, blockType string) ([]users.Domain, error)
+	GetHiddenUserIDs(userID primitive.ObjectID) ([]primitive.ObjectID, error)
+	// Delete
+	Delete(domain *Domain) (Domain, error)
+	DeleteAllByUserID(userID primitive.ObjectID) error
+}
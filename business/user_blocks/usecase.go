@@ -0,0 +1,136 @@
+package user_blocks
+
+import (
+	"charum/business/users"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// pastTense is used to build the error messages of both block and mute
+var pastTense = map[string]string{
+	TypeBlock: "blocked",
+	TypeMute:  "muted",
+}
+
+type UserBlockUseCase struct {
+	userBlockRepository Repository
+	userRepository      users.Repository
+}
+
+func NewUserBlockUseCase(ubr Repository, ur users.Repository) UseCase {
+	return &UserBlockUseCase{
+		userBlockRepository: ubr,
+		userRepository:      ur,
+	}
+}
+
+/*
+Create
+*/
+
+func (ubu *UserBlockUseCase) Create(domain *Domain) (Domain, error) {
+	if domain.Type != TypeBlock && domain.Type != TypeMute {
+		return Domain{}, errors.New("type must be block or mute")
+	}
+
+	if domain.UserID == domain.TargetID {
+		return Domain{}, errors.New("user cannot " + domain.Type + " themselves")
+	}
+
+	_, err := ubu.userBlockRepository.GetByUserIDTargetIDAndType(domain.UserID, domain.TargetID, domain.Type)
+	if err == nil {
+		return Domain{}, errors.New("user has already " + pastTense[domain.Type] + " this user")
+	}
+
+	_, err = ubu.userRepository.GetByID(domain.UserID)
+	if err != nil {
+		return Domain{}, errors.New("failed to get user")
+	}
+
+	_, err = ubu.userRepository.GetByID(domain.TargetID)
+	if err != nil {
+		return Domain{}, errors.New("failed to get target user")
+	}
+
+	domain.Id = primitive.NewObjectID()
+	domain.CreatedAt = primitive.NewDateTimeFromTime(time.Now())
+	domain.UpdatedAt = primitive.NewDateTimeFromTime(time.Now())
+
+	result, err := ubu.userBlockRepository.Create(domain)
+	if err != nil {
+		return Domain{}, errors.New("failed to " + domain.Type + " user")
+	}
+
+	return result, nil
+}
+
+/*
+Read
+*/
+
+func (ubu *UserBlockUseCase) GetAllByUserIDAndType(userID primitive.ObjectID, blockType string) ([]users.Domain, error) {
+	blocks, err := ubu.userBlockRepository.GetAllByUserIDAndType(userID, blockType)
+	if err != nil {
+		return []users.Domain{}, errors.New("failed to get " + pastTense[blockType] + " users")
+	}
+
+	result := []users.Domain{}
+	for _, block := range blocks {
+		user, err := ubu.userRepository.GetByID(block.TargetID)
+		if err != nil {
+			return []users.Domain{}, errors.New("failed to get user")
+		}
+
+		result = append(result, user)
+	}
+
+	return result, nil
+}
+
+// GetHiddenUserIDs returns the users blocked or muted by the user, their content should not be shown to the user
+func (ubu *UserBlockUseCase) GetHiddenUserIDs(userID primitive.ObjectID) ([]primitive.ObjectID, error) {
+	blocks, err := ubu.userBlockRepository.GetAllByUserID(userID)
+	if err != nil {
+		return []primitive.ObjectID{}, errors.New("failed to get hidden users")
+	}
+
+	hiddenUserIDs := []primitive.ObjectID{}
+	seen := map[primitive.ObjectID]bool{}
+	for _, block := range blocks {
+		if !seen[block.TargetID] {
+			seen[block.TargetID] = true
+			hiddenUserIDs = append(hiddenUserIDs, block.TargetID)
+		}
+	}
+
+	return hiddenUserIDs, nil
+}
+
+/*
+Delete
+*/
+
+func (ubu *UserBlockUseCase) Delete(domain *Domain) (Domain, error) {
+	result, err := ubu.userBlockRepository.GetByUserIDTargetIDAndType(domain.UserID, domain.TargetID, domain.Type)
+	if err != nil {
+		return Domain{}, errors.New("user has not " + pastTense[domain.Type] + " this user")
+	}
+
+	err = ubu.userBlockRepository.Delete(result.Id)
+	if err != nil {
+		return Domain{}, errors.New("failed to un" + domain.Type + " user")
+	}
+
+	return result, nil
+}
+
+func (ubu *UserBlockUseCase) DeleteAllByUserID(userID primitive.ObjectID) error {
+	err := ubu.userBlockRepository.DeleteAllByUserID(userID)
+	if err != nil {
+		return errors.New("failed to delete all user block")
+	}
+
+	return nil
+}
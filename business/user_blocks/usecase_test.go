@@ -0,0 +1,268 @@
+package user_blocks_test
+
+import (
+	userBlocks "charum/business/user_blocks"
+	_userBlockMock "charum/business/user_blocks/mocks"
+	"charum/business/users"
+	_userMock "charum/business/users/mocks"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var (
+	userBlockRepository _userBlockMock.Repository
+	userRepository      _userMock.Repository
+	userBlockUseCase    userBlocks.UseCase
+	blockDomain         userBlocks.Domain
+	muteDomain          userBlocks.Domain
+	userDomain          users.Domain
+	targetDomain        users.Domain
+)
+
+func TestMain(m *testing.M) {
+	userBlockUseCase = userBlocks.NewUserBlockUseCase(&userBlockRepository, &userRepository)
+
+	userDomain = users.Domain{
+		Id:        primitive.NewObjectID(),
+		Email:     "user@charum.com",
+		UserName:  "user",
+		Role:      "user",
+		IsActive:  true,
+		CreatedAt: primitive.NewDateTimeFromTime(time.Now()),
+		UpdatedAt: primitive.NewDateTimeFromTime(time.Now()),
+	}
+
+	targetDomain = users.Domain{
+		Id:        primitive.NewObjectID(),
+		Email:     "target@charum.com",
+		UserName:  "target",
+		Role:      "user",
+		IsActive:  true,
+		CreatedAt: primitive.NewDateTimeFromTime(time.Now()),
+		UpdatedAt: primitive.NewDateTimeFromTime(time.Now()),
+	}
+
+	blockDomain = userBlocks.Domain{
+		Id:        primitive.NewObjectID(),
+		UserID:    userDomain.Id,
+		TargetID:  targetDomain.Id,
+		Type:      userBlocks.TypeBlock,
+		CreatedAt: primitive.NewDateTimeFromTime(time.Now()),
+		UpdatedAt: primitive.NewDateTimeFromTime(time.Now()),
+	}
+
+	muteDomain = userBlocks.Domain{
+		Id:        primitive.NewObjectID(),
+		UserID:    userDomain.Id,
+		TargetID:  targetDomain.Id,
+		Type:      userBlocks.TypeMute,
+		CreatedAt: primitive.NewDateTimeFromTime(time.Now()),
+		UpdatedAt: primitive.NewDateTimeFromTime(time.Now()),
+	}
+
+	m.Run()
+}
+
+func TestCreate(t *testing.T) {
+	t.Run("Test case 1 | Valid block user", func(t *testing.T) {
+		userBlockRepository.On("GetByUserIDTargetIDAndType", userDomain.Id, targetDomain.Id, userBlocks.TypeBlock).Return(userBlocks.Domain{}, errors.New("not found")).Once()
+		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
+		userRepository.On("GetByID", targetDomain.Id).Return(targetDomain, nil).Once()
+		userBlockRepository.On("Create", mock.Anything).Return(blockDomain, nil).Once()
+
+		result, err := userBlockUseCase.Create(&userBlocks.Domain{UserID: userDomain.Id, TargetID: targetDomain.Id, Type: userBlocks.TypeBlock})
+
+		assert.Equal(t, blockDomain, result)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Valid mute user", func(t *testing.T) {
+		userBlockRepository.On("GetByUserIDTargetIDAndType", userDomain.Id, targetDomain.Id, userBlocks.TypeMute).Return(userBlocks.Domain{}, errors.New("not found")).Once()
+		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
+		userRepository.On("GetByID", targetDomain.Id).Return(targetDomain, nil).Once()
+		userBlockRepository.On("Create", mock.Anything).Return(muteDomain, nil).Once()
+
+		result, err := userBlockUseCase.Create(&userBlocks.Domain{UserID: userDomain.Id, TargetID: targetDomain.Id, Type: userBlocks.TypeMute})
+
+		assert.Equal(t, muteDomain, result)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 3 | Invalid block user | Invalid type", func(t *testing.T) {
+		expectedErr := errors.New("type must be block or mute")
+
+		result, err := userBlockUseCase.Create(&userBlocks.Domain{UserID: userDomain.Id, TargetID: targetDomain.Id, Type: "hide"})
+
+		assert.Equal(t, userBlocks.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 4 | Invalid block user | User blocks themselves", func(t *testing.T) {
+		expectedErr := errors.New("user cannot block themselves")
+
+		result, err := userBlockUseCase.Create(&userBlocks.Domain{UserID: userDomain.Id, TargetID: userDomain.Id, Type: userBlocks.TypeBlock})
+
+		assert.Equal(t, userBlocks.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 5 | Invalid mute user | User is already muted", func(t *testing.T) {
+		expectedErr := errors.New("user has already muted this user")
+		userBlockRepository.On("GetByUserIDTargetIDAndType", userDomain.Id, targetDomain.Id, userBlocks.TypeMute).Return(muteDomain, nil).Once()
+
+		result, err := userBlockUseCase.Create(&userBlocks.Domain{UserID: userDomain.Id, TargetID: targetDomain.Id, Type: userBlocks.TypeMute})
+
+		assert.Equal(t, userBlocks.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 6 | Invalid block user | Failed to get user", func(t *testing.T) {
+		expectedErr := errors.New("failed to get user")
+		userBlockRepository.On("GetByUserIDTargetIDAndType", userDomain.Id, targetDomain.Id, userBlocks.TypeBlock).Return(userBlocks.Domain{}, errors.New("not found")).Once()
+		userRepository.On("GetByID", userDomain.Id).Return(users.Domain{}, errors.New("not found")).Once()
+
+		result, err := userBlockUseCase.Create(&userBlocks.Domain{UserID: userDomain.Id, TargetID: targetDomain.Id, Type: userBlocks.TypeBlock})
+
+		assert.Equal(t, userBlocks.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 7 | Invalid block user | Failed to get target user", func(t *testing.T) {
+		expectedErr := errors.New("failed to get target user")
+		userBlockRepository.On("GetByUserIDTargetIDAndType", userDomain.Id, targetDomain.Id, userBlocks.TypeBlock).Return(userBlocks.Domain{}, errors.New("not found")).Once()
+		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
+		userRepository.On("GetByID", targetDomain.Id).Return(users.Domain{}, errors.New("not found")).Once()
+
+		result, err := userBlockUseCase.Create(&userBlocks.Domain{UserID: userDomain.Id, TargetID: targetDomain.Id, Type: userBlocks.TypeBlock})
+
+		assert.Equal(t, userBlocks.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 8 | Invalid block user | Failed to create block", func(t *testing.T) {
+		expectedErr := errors.New("failed to block user")
+		userBlockRepository.On("GetByUserIDTargetIDAndType", userDomain.Id, targetDomain.Id, userBlocks.TypeBlock).Return(userBlocks.Domain{}, errors.New("not found")).Once()
+		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
+		userRepository.On("GetByID", targetDomain.Id).Return(targetDomain, nil).Once()
+		userBlockRepository.On("Create", mock.Anything).Return(userBlocks.Domain{}, errors.New("unexpected error")).Once()
+
+		result, err := userBlockUseCase.Create(&userBlocks.Domain{UserID: userDomain.Id, TargetID: targetDomain.Id, Type: userBlocks.TypeBlock})
+
+		assert.Equal(t, userBlocks.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestGetAllByUserIDAndType(t *testing.T) {
+	t.Run("Test case 1 | Valid get blocked users", func(t *testing.T) {
+		userBlockRepository.On("GetAllByUserIDAndType", userDomain.Id, userBlocks.TypeBlock).Return([]userBlocks.Domain{blockDomain}, nil).Once()
+		userRepository.On("GetByID", targetDomain.Id).Return(targetDomain, nil).Once()
+
+		result, err := userBlockUseCase.GetAllByUserIDAndType(userDomain.Id, userBlocks.TypeBlock)
+
+		assert.Equal(t, []users.Domain{targetDomain}, result)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Invalid get muted users | Failed to get muted users", func(t *testing.T) {
+		expectedErr := errors.New("failed to get muted users")
+		userBlockRepository.On("GetAllByUserIDAndType", userDomain.Id, userBlocks.TypeMute).Return([]userBlocks.Domain{}, errors.New("unexpected error")).Once()
+
+		result, err := userBlockUseCase.GetAllByUserIDAndType(userDomain.Id, userBlocks.TypeMute)
+
+		assert.Equal(t, []users.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 3 | Invalid get blocked users | Failed to get user", func(t *testing.T) {
+		expectedErr := errors.New("failed to get user")
+		userBlockRepository.On("GetAllByUserIDAndType", userDomain.Id, userBlocks.TypeBlock).Return([]userBlocks.Domain{blockDomain}, nil).Once()
+		userRepository.On("GetByID", targetDomain.Id).Return(users.Domain{}, errors.New("not found")).Once()
+
+		result, err := userBlockUseCase.GetAllByUserIDAndType(userDomain.Id, userBlocks.TypeBlock)
+
+		assert.Equal(t, []users.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestGetHiddenUserIDs(t *testing.T) {
+	t.Run("Test case 1 | Valid get hidden user ids | Blocked and muted user is returned once", func(t *testing.T) {
+		otherID := primitive.NewObjectID()
+		otherMute := muteDomain
+		otherMute.TargetID = otherID
+		userBlockRepository.On("GetAllByUserID", userDomain.Id).Return([]userBlocks.Domain{blockDomain, muteDomain, otherMute}, nil).Once()
+
+		result, err := userBlockUseCase.GetHiddenUserIDs(userDomain.Id)
+
+		assert.Equal(t, []primitive.ObjectID{targetDomain.Id, otherID}, result)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Invalid get hidden user ids | Failed to get blocks", func(t *testing.T) {
+		expectedErr := errors.New("failed to get hidden users")
+		userBlockRepository.On("GetAllByUserID", userDomain.Id).Return([]userBlocks.Domain{}, errors.New("unexpected error")).Once()
+
+		result, err := userBlockUseCase.GetHiddenUserIDs(userDomain.Id)
+
+		assert.Equal(t, []primitive.ObjectID{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestDelete(t *testing.T) {
+	t.Run("Test case 1 | Valid unblock user", func(t *testing.T) {
+		userBlockRepository.On("GetByUserIDTargetIDAndType", userDomain.Id, targetDomain.Id, userBlocks.TypeBlock).Return(blockDomain, nil).Once()
+		userBlockRepository.On("Delete", blockDomain.Id).Return(nil).Once()
+
+		result, err := userBlockUseCase.Delete(&userBlocks.Domain{UserID: userDomain.Id, TargetID: targetDomain.Id, Type: userBlocks.TypeBlock})
+
+		assert.Equal(t, blockDomain, result)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Invalid unmute user | User is not muted", func(t *testing.T) {
+		expectedErr := errors.New("user has not muted this user")
+		userBlockRepository.On("GetByUserIDTargetIDAndType", userDomain.Id, targetDomain.Id, userBlocks.TypeMute).Return(userBlocks.Domain{}, errors.New("not found")).Once()
+
+		result, err := userBlockUseCase.Delete(&userBlocks.Domain{UserID: userDomain.Id, TargetID: targetDomain.Id, Type: userBlocks.TypeMute})
+
+		assert.Equal(t, userBlocks.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 3 | Invalid unmute user | Failed to delete mute", func(t *testing.T) {
+		expectedErr := errors.New("failed to unmute user")
+		userBlockRepository.On("GetByUserIDTargetIDAndType", userDomain.Id, targetDomain.Id, userBlocks.TypeMute).Return(muteDomain, nil).Once()
+		userBlockRepository.On("Delete", muteDomain.Id).Return(errors.New("unexpected error")).Once()
+
+		result, err := userBlockUseCase.Delete(&userBlocks.Domain{UserID: userDomain.Id, TargetID: targetDomain.Id, Type: userBlocks.TypeMute})
+
+		assert.Equal(t, userBlocks.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestDeleteAllByUserID(t *testing.T) {
+	t.Run("Test case 1 | Valid delete all user block", func(t *testing.T) {
+		userBlockRepository.On("DeleteAllByUserID", userDomain.Id).Return(nil).Once()
+
+		err := userBlockUseCase.DeleteAllByUserID(userDomain.Id)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Invalid delete all user block | Failed to delete", func(t *testing.T) {
+		expectedErr := errors.New("failed to delete all user block")
+		userBlockRepository.On("DeleteAllByUserID", userDomain.Id).Return(errors.New("unexpected error")).Once()
+
+		err := userBlockUseCase.DeleteAllByUserID(userDomain.Id)
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
@@ -1,6 +1,9 @@
 package users
 
 import (
+	"charum/business/login_attempts"
+	"charum/business/refresh_tokens"
+	"charum/business/suspensions"
 	dtoPagination "charum/dto/pagination"
 	dtoQuery "charum/dto/query"
 	"mime/multipart"
@@ -9,20 +12,28 @@ import (
 )
 
 type Domain struct {
-	Id                primitive.ObjectID `json:"_id" bson:"_id"`
-	Email             string             `json:"email" bson:"email"`
-	UserName          string             `json:"userName" bson:"userName"`
-	DisplayName       string             `json:"displayName" bson:"displayName"`
-	Biodata           string             `json:"biodata" bson:"biodata"`
-	SocialMedia       string             `json:"socialMedia" bson:"socialMedia"`
-	Password          string             `json:"-"`
-	OldPassword       string             `json:"-"`
-	NewPassword       string             `json:"-"`
-	IsActive          bool               `json:"isActive" bson:"isActive"`
-	Role              string             `json:"role" bson:"role"`
-	CreatedAt         primitive.DateTime `json:"createdAt" bson:"createdAt"`
-	UpdatedAt         primitive.DateTime `json:"updatedAt" bson:"updatedAt"`
-	ProfilePictureURL string             `json:"profilePictureURL" bson:"profilePictureURL"`
+	Id                  primitive.ObjectID `json:"_id" bson:"_id"`
+	Email               string             `json:"email" bson:"email"`
+	UserName            string             `json:"userName" bson:"userName"`
+	DisplayName         string             `json:"displayName" bson:"displayName"`
+	Biodata             string             `json:"biodata" bson:"biodata"`
+	SocialMedia         string             `json:"socialMedia" bson:"socialMedia"`
+	Password            string             `json:"-"`
+	OldPassword         string             `json:"-"`
+	NewPassword         string             `json:"-"`
+	IsActive            bool               `json:"isActive" bson:"isActive"`
+	EmailVerified       bool               `json:"emailVerified" bson:"emailVerified"`
+	Role                string             `json:"role" bson:"role"`
+	CreatedAt           primitive.DateTime `json:"createdAt" bson:"createdAt"`
+	UpdatedAt           primitive.DateTime `json:"updatedAt" bson:"updatedAt"`
+	ProfilePictureURL   string             `json:"profilePictureURL" bson:"profilePictureURL"`
+	TwoFactorEnabled    bool               `json:"twoFactorEnabled" bson:"twoFactorEnabled"`
+	TwoFactorSecret     string             `json:"-" bson:"twoFactorSecret"`
+	RecoveryCodes       []string           `json:"-" bson:"recoveryCodes"`
+	LastTOTPStep        int64              `json:"-" bson:"lastTOTPStep"`
+	OIDCIssuer          string             `json:"-" bson:"oidcIssuer"`
+	OIDCSubject         string             `json:"-" bson:"oidcSubject"`
+	DeletionScheduledAt primitive.DateTime `json:"deletionScheduledAt" bson:"deletionScheduledAt"`
 }
 
 type Repository interface {
@@ -32,8 +43,10 @@ type Repository interface {
 	GetByID(id primitive.ObjectID) (Domain, error)
 	GetByEmail(email string) (Domain, error)
 	GetByUsername(username string) (Domain, error)
+	GetByOIDCSubject(issuer string, subject string) (Domain, error)
 	GetManyWithPagination(query dtoQuery.Request, domain *Domain) ([]Domain, int, error)
 	GetAll() ([]Domain, error)
+	GetAllDueForDeletion(now primitive.DateTime) ([]Domain, error)
 	// Update
 	Update(domain *Domain) (Domain, error)
 	UpdatePassword(domain *Domain) (Domain, error)
@@ -43,17 +56,39 @@ type Repository interface {
 
 type UseCase interface {
 	// Create
-	Register(domain *Domain, profilePicture *multipart.FileHeader) (Domain, string, error)
+	Register(domain *Domain, profilePicture *multipart.FileHeader, userAgent string, ipAddress string) (Domain, string, string, error)
 	// Read
-	Login(key string, password string) (Domain, string, error)
+	Login(key string, password string, userAgent string, ipAddress string) (Domain, string, string, error)
+	StartOIDCLogin() (string, error)
+	OIDCCallback(state string, code string, userAgent string, ipAddress string) (Domain, string, string, error)
+	VerifyTwoFactor(challengeToken string, code string, userAgent string, ipAddress string) (Domain, string, string, error)
+	LoginWithMagicLink(id primitive.ObjectID, userAgent string, ipAddress string) (Domain, string, string, error)
 	GetManyWithPagination(pagination dtoPagination.Request, domain *Domain) ([]Domain, int, int, error)
 	GetByID(id primitive.ObjectID) (Domain, error)
+	GetByUsername(username string) (Domain, error)
 	GetAll() (int, error)
+	GetSessions(userID primitive.ObjectID) ([]refresh_tokens.Domain, error)
+	GetLockouts() ([]login_attempts.Domain, error)
+	GetSuspensions(userID primitive.ObjectID) ([]suspensions.Domain, error)
+	GetAllDueForDeletion() ([]Domain, error)
 	// Update
-	UpdatePassword(domain *Domain) (Domain, error)
+	UpdatePassword(domain *Domain, currentAccessTokenID string) (Domain, error)
 	Update(domain *Domain, profilePicture *multipart.FileHeader) (Domain, error)
-	Suspend(id primitive.ObjectID) (Domain, error)
-	Unsuspend(id primitive.ObjectID) (Domain, error)
+	Suspend(suspension *suspensions.Domain) (Domain, suspensions.Domain, error)
+	Unsuspend(id primitive.ObjectID, adminID primitive.ObjectID) (Domain, error)
+	LiftExpiredSuspensions() ([]Domain, error)
+	RefreshToken(refreshToken string) (string, string, error)
+	Logout(accessTokenID string) error
+	VerifyEmail(token string) (Domain, error)
+	ResendVerificationEmail(id primitive.ObjectID) error
+	EnrollTwoFactor(id primitive.ObjectID) (string, string, error)
+	ConfirmTwoFactor(id primitive.ObjectID, code string) ([]string, error)
+	DisableTwoFactor(id primitive.ObjectID, code string) error
+	RevokeSession(userID primitive.ObjectID, sessionID primitive.ObjectID) error
+	RevokeAllSessions(userID primitive.ObjectID) error
+	RequestDeletion(id primitive.ObjectID, password string) (Domain, error)
 	// Delete
+	CheckDeletable(id primitive.ObjectID) (Domain, error)
 	Delete(id primitive.ObjectID) (Domain, error)
+	ClearLockout(id primitive.ObjectID) error
 }
@@ -74,6 +74,29 @@ func (_m *Repository) GetAll() ([]users.Domain, error) {
 	return r0, r1
 }
 
+// GetAllDueForDeletion provides a mock function with given fields: now
+func (_m *Repository) GetAllDueForDeletion(now primitive.DateTime) ([]users.Domain, error) {
+	ret := _m.Called(now)
+
+	var r0 []users.Domain
+	if rf, ok := ret.Get(0).(func(primitive.DateTime) []users.Domain); ok {
+		r0 = rf(now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]users.Domain)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(primitive.DateTime) error); ok {
+		r1 = rf(now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetByEmail provides a mock function with given fields: email
 func (_m *Repository) GetByEmail(email string) (users.Domain, error) {
 	ret := _m.Called(email)
@@ -116,6 +139,27 @@ func (_m *Repository) GetByID(id primitive.ObjectID) (users.Domain, error) {
 	return r0, r1
 }
 
+// GetByOIDCSubject provides a mock function with given fields: issuer, subject
+func (_m *Repository) GetByOIDCSubject(issuer string, subject string) (users.Domain, error) {
+	ret := _m.Called(issuer, subject)
+
+	var r0 users.Domain
+	if rf, ok := ret.Get(0).(func(string, string) users.Domain); ok {
+		r0 = rf(issuer, subject)
+	} else {
+		r0 = ret.Get(0).(users.Domain)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(issuer, subject)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetByUsername provides a mock function with given fields: username
 func (_m *Repository) GetByUsername(username string) (users.Domain, error) {
 	ret := _m.Called(username)
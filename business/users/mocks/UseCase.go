@@ -12,6 +12,12 @@ import (
 	primitive "go.mongodb.org/mongo-driver/bson/primitive"
 
 	users "charum/business/users"
+
+	refresh_tokens "charum/business/refresh_tokens"
+
+	login_attempts "charum/business/login_attempts"
+
+	suspensions "charum/business/suspensions"
 )
 
 // UseCase is an autogenerated mock type for the UseCase type
@@ -19,6 +25,64 @@ type UseCase struct {
 	mock.Mock
 }
 
+// CheckDeletable provides a mock function with given fields: id
+func (_m *UseCase) CheckDeletable(id primitive.ObjectID) (users.Domain, error) {
+	ret := _m.Called(id)
+
+	var r0 users.Domain
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID) users.Domain); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(users.Domain)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(primitive.ObjectID) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClearLockout provides a mock function with given fields: id
+func (_m *UseCase) ClearLockout(id primitive.ObjectID) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ConfirmTwoFactor provides a mock function with given fields: id, code
+func (_m *UseCase) ConfirmTwoFactor(id primitive.ObjectID, code string) ([]string, error) {
+	ret := _m.Called(id, code)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID, string) []string); ok {
+		r0 = rf(id, code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(primitive.ObjectID, string) error); ok {
+		r1 = rf(id, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Delete provides a mock function with given fields: id
 func (_m *UseCase) Delete(id primitive.ObjectID) (users.Domain, error) {
 	ret := _m.Called(id)
@@ -40,6 +104,48 @@ func (_m *UseCase) Delete(id primitive.ObjectID) (users.Domain, error) {
 	return r0, r1
 }
 
+// DisableTwoFactor provides a mock function with given fields: id, code
+func (_m *UseCase) DisableTwoFactor(id primitive.ObjectID, code string) error {
+	ret := _m.Called(id, code)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID, string) error); ok {
+		r0 = rf(id, code)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EnrollTwoFactor provides a mock function with given fields: id
+func (_m *UseCase) EnrollTwoFactor(id primitive.ObjectID) (string, string, error) {
+	ret := _m.Called(id)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID) string); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 string
+	if rf, ok := ret.Get(1).(func(primitive.ObjectID) string); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(primitive.ObjectID) error); ok {
+		r2 = rf(id)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // GetAll provides a mock function with given fields:
 func (_m *UseCase) GetAll() (int, error) {
 	ret := _m.Called()
@@ -61,6 +167,29 @@ func (_m *UseCase) GetAll() (int, error) {
 	return r0, r1
 }
 
+// GetAllDueForDeletion provides a mock function with given fields:
+func (_m *UseCase) GetAllDueForDeletion() ([]users.Domain, error) {
+	ret := _m.Called()
+
+	var r0 []users.Domain
+	if rf, ok := ret.Get(0).(func() []users.Domain); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]users.Domain)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetByID provides a mock function with given fields: id
 func (_m *UseCase) GetByID(id primitive.ObjectID) (users.Domain, error) {
 	ret := _m.Called(id)
@@ -82,6 +211,50 @@ func (_m *UseCase) GetByID(id primitive.ObjectID) (users.Domain, error) {
 	return r0, r1
 }
 
+// GetByUsername provides a mock function with given fields: username
+func (_m *UseCase) GetByUsername(username string) (users.Domain, error) {
+	ret := _m.Called(username)
+
+	var r0 users.Domain
+	if rf, ok := ret.Get(0).(func(string) users.Domain); ok {
+		r0 = rf(username)
+	} else {
+		r0 = ret.Get(0).(users.Domain)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLockouts provides a mock function with given fields:
+func (_m *UseCase) GetLockouts() ([]login_attempts.Domain, error) {
+	ret := _m.Called()
+
+	var r0 []login_attempts.Domain
+	if rf, ok := ret.Get(0).(func() []login_attempts.Domain); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]login_attempts.Domain)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetManyWithPagination provides a mock function with given fields: _a0, domain
 func (_m *UseCase) GetManyWithPagination(_a0 pagination.Request, domain *users.Domain) ([]users.Domain, int, int, error) {
 	ret := _m.Called(_a0, domain)
@@ -119,55 +292,215 @@ func (_m *UseCase) GetManyWithPagination(_a0 pagination.Request, domain *users.D
 	return r0, r1, r2, r3
 }
 
-// Login provides a mock function with given fields: key, password
-func (_m *UseCase) Login(key string, password string) (users.Domain, string, error) {
-	ret := _m.Called(key, password)
+// GetSessions provides a mock function with given fields: userID
+func (_m *UseCase) GetSessions(userID primitive.ObjectID) ([]refresh_tokens.Domain, error) {
+	ret := _m.Called(userID)
+
+	var r0 []refresh_tokens.Domain
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID) []refresh_tokens.Domain); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]refresh_tokens.Domain)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(primitive.ObjectID) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSuspensions provides a mock function with given fields: userID
+func (_m *UseCase) GetSuspensions(userID primitive.ObjectID) ([]suspensions.Domain, error) {
+	ret := _m.Called(userID)
+
+	var r0 []suspensions.Domain
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID) []suspensions.Domain); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]suspensions.Domain)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(primitive.ObjectID) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LiftExpiredSuspensions provides a mock function with given fields:
+func (_m *UseCase) LiftExpiredSuspensions() ([]users.Domain, error) {
+	ret := _m.Called()
+
+	var r0 []users.Domain
+	if rf, ok := ret.Get(0).(func() []users.Domain); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]users.Domain)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Login provides a mock function with given fields: key, password, userAgent, ipAddress
+func (_m *UseCase) Login(key string, password string, userAgent string, ipAddress string) (users.Domain, string, string, error) {
+	ret := _m.Called(key, password, userAgent, ipAddress)
 
 	var r0 users.Domain
-	if rf, ok := ret.Get(0).(func(string, string) users.Domain); ok {
-		r0 = rf(key, password)
+	if rf, ok := ret.Get(0).(func(string, string, string, string) users.Domain); ok {
+		r0 = rf(key, password, userAgent, ipAddress)
 	} else {
 		r0 = ret.Get(0).(users.Domain)
 	}
 
 	var r1 string
-	if rf, ok := ret.Get(1).(func(string, string) string); ok {
-		r1 = rf(key, password)
+	if rf, ok := ret.Get(1).(func(string, string, string, string) string); ok {
+		r1 = rf(key, password, userAgent, ipAddress)
 	} else {
 		r1 = ret.Get(1).(string)
 	}
 
-	var r2 error
-	if rf, ok := ret.Get(2).(func(string, string) error); ok {
-		r2 = rf(key, password)
+	var r2 string
+	if rf, ok := ret.Get(2).(func(string, string, string, string) string); ok {
+		r2 = rf(key, password, userAgent, ipAddress)
 	} else {
-		r2 = ret.Error(2)
+		r2 = ret.Get(2).(string)
 	}
 
-	return r0, r1, r2
+	var r3 error
+	if rf, ok := ret.Get(3).(func(string, string, string, string) error); ok {
+		r3 = rf(key, password, userAgent, ipAddress)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
 }
 
-// Register provides a mock function with given fields: domain, profilePicture
-func (_m *UseCase) Register(domain *users.Domain, profilePicture *multipart.FileHeader) (users.Domain, string, error) {
-	ret := _m.Called(domain, profilePicture)
+// LoginWithMagicLink provides a mock function with given fields: id, userAgent, ipAddress
+func (_m *UseCase) LoginWithMagicLink(id primitive.ObjectID, userAgent string, ipAddress string) (users.Domain, string, string, error) {
+	ret := _m.Called(id, userAgent, ipAddress)
 
 	var r0 users.Domain
-	if rf, ok := ret.Get(0).(func(*users.Domain, *multipart.FileHeader) users.Domain); ok {
-		r0 = rf(domain, profilePicture)
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID, string, string) users.Domain); ok {
+		r0 = rf(id, userAgent, ipAddress)
 	} else {
 		r0 = ret.Get(0).(users.Domain)
 	}
 
 	var r1 string
-	if rf, ok := ret.Get(1).(func(*users.Domain, *multipart.FileHeader) string); ok {
-		r1 = rf(domain, profilePicture)
+	if rf, ok := ret.Get(1).(func(primitive.ObjectID, string, string) string); ok {
+		r1 = rf(id, userAgent, ipAddress)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	var r2 string
+	if rf, ok := ret.Get(2).(func(primitive.ObjectID, string, string) string); ok {
+		r2 = rf(id, userAgent, ipAddress)
+	} else {
+		r2 = ret.Get(2).(string)
+	}
+
+	var r3 error
+	if rf, ok := ret.Get(3).(func(primitive.ObjectID, string, string) error); ok {
+		r3 = rf(id, userAgent, ipAddress)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
+// Logout provides a mock function with given fields: accessTokenID
+func (_m *UseCase) Logout(accessTokenID string) error {
+	ret := _m.Called(accessTokenID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(accessTokenID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OIDCCallback provides a mock function with given fields: state, code, userAgent, ipAddress
+func (_m *UseCase) OIDCCallback(state string, code string, userAgent string, ipAddress string) (users.Domain, string, string, error) {
+	ret := _m.Called(state, code, userAgent, ipAddress)
+
+	var r0 users.Domain
+	if rf, ok := ret.Get(0).(func(string, string, string, string) users.Domain); ok {
+		r0 = rf(state, code, userAgent, ipAddress)
+	} else {
+		r0 = ret.Get(0).(users.Domain)
+	}
+
+	var r1 string
+	if rf, ok := ret.Get(1).(func(string, string, string, string) string); ok {
+		r1 = rf(state, code, userAgent, ipAddress)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	var r2 string
+	if rf, ok := ret.Get(2).(func(string, string, string, string) string); ok {
+		r2 = rf(state, code, userAgent, ipAddress)
+	} else {
+		r2 = ret.Get(2).(string)
+	}
+
+	var r3 error
+	if rf, ok := ret.Get(3).(func(string, string, string, string) error); ok {
+		r3 = rf(state, code, userAgent, ipAddress)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
+// RefreshToken provides a mock function with given fields: refreshToken
+func (_m *UseCase) RefreshToken(refreshToken string) (string, string, error) {
+	ret := _m.Called(refreshToken)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(refreshToken)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 string
+	if rf, ok := ret.Get(1).(func(string) string); ok {
+		r1 = rf(refreshToken)
 	} else {
 		r1 = ret.Get(1).(string)
 	}
 
 	var r2 error
-	if rf, ok := ret.Get(2).(func(*users.Domain, *multipart.FileHeader) error); ok {
-		r2 = rf(domain, profilePicture)
+	if rf, ok := ret.Get(2).(func(string) error); ok {
+		r2 = rf(refreshToken)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -175,20 +508,55 @@ func (_m *UseCase) Register(domain *users.Domain, profilePicture *multipart.File
 	return r0, r1, r2
 }
 
-// Suspend provides a mock function with given fields: id
-func (_m *UseCase) Suspend(id primitive.ObjectID) (users.Domain, error) {
-	ret := _m.Called(id)
+// Register provides a mock function with given fields: domain, profilePicture, userAgent, ipAddress
+func (_m *UseCase) Register(domain *users.Domain, profilePicture *multipart.FileHeader, userAgent string, ipAddress string) (users.Domain, string, string, error) {
+	ret := _m.Called(domain, profilePicture, userAgent, ipAddress)
 
 	var r0 users.Domain
-	if rf, ok := ret.Get(0).(func(primitive.ObjectID) users.Domain); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(*users.Domain, *multipart.FileHeader, string, string) users.Domain); ok {
+		r0 = rf(domain, profilePicture, userAgent, ipAddress)
+	} else {
+		r0 = ret.Get(0).(users.Domain)
+	}
+
+	var r1 string
+	if rf, ok := ret.Get(1).(func(*users.Domain, *multipart.FileHeader, string, string) string); ok {
+		r1 = rf(domain, profilePicture, userAgent, ipAddress)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	var r2 string
+	if rf, ok := ret.Get(2).(func(*users.Domain, *multipart.FileHeader, string, string) string); ok {
+		r2 = rf(domain, profilePicture, userAgent, ipAddress)
+	} else {
+		r2 = ret.Get(2).(string)
+	}
+
+	var r3 error
+	if rf, ok := ret.Get(3).(func(*users.Domain, *multipart.FileHeader, string, string) error); ok {
+		r3 = rf(domain, profilePicture, userAgent, ipAddress)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
+// RequestDeletion provides a mock function with given fields: id, password
+func (_m *UseCase) RequestDeletion(id primitive.ObjectID, password string) (users.Domain, error) {
+	ret := _m.Called(id, password)
+
+	var r0 users.Domain
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID, string) users.Domain); ok {
+		r0 = rf(id, password)
 	} else {
 		r0 = ret.Get(0).(users.Domain)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(primitive.ObjectID) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(primitive.ObjectID, string) error); ok {
+		r1 = rf(id, password)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -196,20 +564,111 @@ func (_m *UseCase) Suspend(id primitive.ObjectID) (users.Domain, error) {
 	return r0, r1
 }
 
-// Unsuspend provides a mock function with given fields: id
-func (_m *UseCase) Unsuspend(id primitive.ObjectID) (users.Domain, error) {
+// ResendVerificationEmail provides a mock function with given fields: id
+func (_m *UseCase) ResendVerificationEmail(id primitive.ObjectID) error {
 	ret := _m.Called(id)
 
-	var r0 users.Domain
-	if rf, ok := ret.Get(0).(func(primitive.ObjectID) users.Domain); ok {
+	var r0 error
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID) error); ok {
 		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RevokeAllSessions provides a mock function with given fields: userID
+func (_m *UseCase) RevokeAllSessions(userID primitive.ObjectID) error {
+	ret := _m.Called(userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID) error); ok {
+		r0 = rf(userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RevokeSession provides a mock function with given fields: userID, sessionID
+func (_m *UseCase) RevokeSession(userID primitive.ObjectID, sessionID primitive.ObjectID) error {
+	ret := _m.Called(userID, sessionID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID, primitive.ObjectID) error); ok {
+		r0 = rf(userID, sessionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StartOIDCLogin provides a mock function with given fields:
+func (_m *UseCase) StartOIDCLogin() (string, error) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Suspend provides a mock function with given fields: suspension
+func (_m *UseCase) Suspend(suspension *suspensions.Domain) (users.Domain, suspensions.Domain, error) {
+	ret := _m.Called(suspension)
+
+	var r0 users.Domain
+	if rf, ok := ret.Get(0).(func(*suspensions.Domain) users.Domain); ok {
+		r0 = rf(suspension)
+	} else {
+		r0 = ret.Get(0).(users.Domain)
+	}
+
+	var r1 suspensions.Domain
+	if rf, ok := ret.Get(1).(func(*suspensions.Domain) suspensions.Domain); ok {
+		r1 = rf(suspension)
+	} else {
+		r1 = ret.Get(1).(suspensions.Domain)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(*suspensions.Domain) error); ok {
+		r2 = rf(suspension)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Unsuspend provides a mock function with given fields: id, adminID
+func (_m *UseCase) Unsuspend(id primitive.ObjectID, adminID primitive.ObjectID) (users.Domain, error) {
+	ret := _m.Called(id, adminID)
+
+	var r0 users.Domain
+	if rf, ok := ret.Get(0).(func(primitive.ObjectID, primitive.ObjectID) users.Domain); ok {
+		r0 = rf(id, adminID)
 	} else {
 		r0 = ret.Get(0).(users.Domain)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(primitive.ObjectID) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(primitive.ObjectID, primitive.ObjectID) error); ok {
+		r1 = rf(id, adminID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -238,20 +697,41 @@ func (_m *UseCase) Update(domain *users.Domain, profilePicture *multipart.FileHe
 	return r0, r1
 }
 
-// UpdatePassword provides a mock function with given fields: domain
-func (_m *UseCase) UpdatePassword(domain *users.Domain) (users.Domain, error) {
-	ret := _m.Called(domain)
+// UpdatePassword provides a mock function with given fields: domain, currentAccessTokenID
+func (_m *UseCase) UpdatePassword(domain *users.Domain, currentAccessTokenID string) (users.Domain, error) {
+	ret := _m.Called(domain, currentAccessTokenID)
+
+	var r0 users.Domain
+	if rf, ok := ret.Get(0).(func(*users.Domain, string) users.Domain); ok {
+		r0 = rf(domain, currentAccessTokenID)
+	} else {
+		r0 = ret.Get(0).(users.Domain)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*users.Domain, string) error); ok {
+		r1 = rf(domain, currentAccessTokenID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VerifyEmail provides a mock function with given fields: token
+func (_m *UseCase) VerifyEmail(token string) (users.Domain, error) {
+	ret := _m.Called(token)
 
 	var r0 users.Domain
-	if rf, ok := ret.Get(0).(func(*users.Domain) users.Domain); ok {
-		r0 = rf(domain)
+	if rf, ok := ret.Get(0).(func(string) users.Domain); ok {
+		r0 = rf(token)
 	} else {
 		r0 = ret.Get(0).(users.Domain)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*users.Domain) error); ok {
-		r1 = rf(domain)
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(token)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -259,6 +739,41 @@ func (_m *UseCase) UpdatePassword(domain *users.Domain) (users.Domain, error) {
 	return r0, r1
 }
 
+// VerifyTwoFactor provides a mock function with given fields: challengeToken, code, userAgent, ipAddress
+func (_m *UseCase) VerifyTwoFactor(challengeToken string, code string, userAgent string, ipAddress string) (users.Domain, string, string, error) {
+	ret := _m.Called(challengeToken, code, userAgent, ipAddress)
+
+	var r0 users.Domain
+	if rf, ok := ret.Get(0).(func(string, string, string, string) users.Domain); ok {
+		r0 = rf(challengeToken, code, userAgent, ipAddress)
+	} else {
+		r0 = ret.Get(0).(users.Domain)
+	}
+
+	var r1 string
+	if rf, ok := ret.Get(1).(func(string, string, string, string) string); ok {
+		r1 = rf(challengeToken, code, userAgent, ipAddress)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	var r2 string
+	if rf, ok := ret.Get(2).(func(string, string, string, string) string); ok {
+		r2 = rf(challengeToken, code, userAgent, ipAddress)
+	} else {
+		r2 = ret.Get(2).(string)
+	}
+
+	var r3 error
+	if rf, ok := ret.Get(3).(func(string, string, string, string) error); ok {
+		r3 = rf(challengeToken, code, userAgent, ipAddress)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
 type mockConstructorTestingTNewUseCase interface {
 	mock.TestingT
 	Cleanup(func())
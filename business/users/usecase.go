@@ -1,13 +1,23 @@
 package users
 
 import (
+	"charum/business/login_attempts"
+	"charum/business/oidc_states"
+	"charum/business/refresh_tokens"
+	"charum/business/suspensions"
 	dtoPagination "charum/dto/pagination"
 	dtoQuery "charum/dto/query"
 	"charum/helper/cloudinary"
+	_mailgun "charum/helper/mailgun"
+	"charum/helper/oidc"
 	"charum/util"
+	"crypto/sha256"
+	"encoding/base64"
 	"errors"
+	"fmt"
 	"math"
 	"mime/multipart"
+	"regexp"
 	"strings"
 	"time"
 
@@ -16,31 +26,180 @@ import (
 )
 
 type UserUseCase struct {
-	userRepository Repository
-	cloudinary     cloudinary.Function
+	userRepository         Repository
+	refreshTokenRepository refresh_tokens.Repository
+	oidcStateRepository    oidc_states.Repository
+	loginAttemptRepository login_attempts.Repository
+	suspensionRepository   suspensions.Repository
+	cloudinary             cloudinary.Function
+	mailgun                _mailgun.Function
+	oidc                   oidc.Function
 }
 
-func NewUserUseCase(ur Repository, cld cloudinary.Function) UseCase {
+func NewUserUseCase(ur Repository, rtr refresh_tokens.Repository, osr oidc_states.Repository, lar login_attempts.Repository, sr suspensions.Repository, cld cloudinary.Function, mg _mailgun.Function, oc oidc.Function) UseCase {
 	return &UserUseCase{
-		userRepository: ur,
-		cloudinary:     cld,
+		userRepository:         ur,
+		refreshTokenRepository: rtr,
+		oidcStateRepository:    osr,
+		loginAttemptRepository: lar,
+		suspensionRepository:   sr,
+		cloudinary:             cld,
+		mailgun:                mg,
+		oidc:                   oc,
 	}
 }
 
+const (
+	refreshTokenDuration = 30 * 24 * time.Hour
+	recoveryCodeCount    = 10
+	oidcStateDuration    = 10 * time.Minute
+
+	accountLockoutThreshold = 5
+	ipLockoutThreshold      = 20
+	lockoutBaseDuration     = time.Minute
+	lockoutMaxDuration      = time.Hour
+	failedLoginWindow       = 24 * time.Hour
+
+	deletionGracePeriod = 14 * 24 * time.Hour
+)
+
+var (
+	nonUsernameCharacters = regexp.MustCompile("[^a-z0-9_]+")
+	// compared against when the account does not exist so both paths take the same time
+	dummyPasswordHash, _ = bcrypt.GenerateFromPassword([]byte("charum-dummy-password"), bcrypt.DefaultCost)
+)
+
+func (uu *UserUseCase) generateTokens(user Domain, userAgent string, ipAddress string) (string, string, error) {
+	refreshToken, err := util.GenerateSecureRandomString(80)
+	if err != nil {
+		return "", "", errors.New("failed to generate refresh token")
+	}
+
+	accessTokenID := util.GenerateUUID()
+	_, err = uu.refreshTokenRepository.Create(&refresh_tokens.Domain{
+		Id:            primitive.NewObjectID(),
+		UserID:        user.Id,
+		Token:         util.HashToken(refreshToken),
+		AccessTokenID: accessTokenID,
+		UserAgent:     userAgent,
+		IPAddress:     ipAddress,
+		IsRevoked:     false,
+		LastSeenAt:    primitive.NewDateTimeFromTime(time.Now()),
+		ExpiredAt:     primitive.NewDateTimeFromTime(time.Now().Add(refreshTokenDuration)),
+		CreatedAt:     primitive.NewDateTimeFromTime(time.Now()),
+		UpdatedAt:     primitive.NewDateTimeFromTime(time.Now()),
+	})
+	if err != nil {
+		return "", "", errors.New("failed to create refresh token")
+	}
+
+	token := util.GenerateToken(user.Id.Hex(), user.Role, accessTokenID)
+	return token, refreshToken, nil
+}
+
+func (uu *UserUseCase) checkLockout(key string) error {
+	attempt, err := uu.loginAttemptRepository.GetByKey(key)
+	if err == nil && attempt.LockedUntil.Time().After(time.Now()) {
+		return errors.New("too many failed login attempts, please try again later")
+	}
+
+	return nil
+}
+
+// recordFailedLogin locks the key once the threshold is reached, doubling the lockout with every further failure
+func (uu *UserUseCase) recordFailedLogin(key string, threshold int) {
+	attempt, err := uu.loginAttemptRepository.GetByKey(key)
+	if err != nil {
+		_, _ = uu.loginAttemptRepository.Create(&login_attempts.Domain{
+			Id:           primitive.NewObjectID(),
+			Key:          key,
+			FailedCount:  1,
+			LastFailedAt: primitive.NewDateTimeFromTime(time.Now()),
+			CreatedAt:    primitive.NewDateTimeFromTime(time.Now()),
+			UpdatedAt:    primitive.NewDateTimeFromTime(time.Now()),
+		})
+		return
+	}
+
+	if attempt.LastFailedAt.Time().Before(time.Now().Add(-failedLoginWindow)) {
+		attempt.FailedCount = 0
+	}
+
+	attempt.FailedCount++
+	if attempt.FailedCount >= threshold {
+		lockout := lockoutMaxDuration
+		if exponent := attempt.FailedCount - threshold; exponent < 10 {
+			lockout = lockoutBaseDuration << exponent
+		}
+		if lockout > lockoutMaxDuration {
+			lockout = lockoutMaxDuration
+		}
+
+		attempt.LockedUntil = primitive.NewDateTimeFromTime(time.Now().Add(lockout))
+	}
+
+	attempt.LastFailedAt = primitive.NewDateTimeFromTime(time.Now())
+	attempt.UpdatedAt = primitive.NewDateTimeFromTime(time.Now())
+	_, _ = uu.loginAttemptRepository.Update(&attempt)
+}
+
+func (uu *UserUseCase) clearFailedLogins(key string) {
+	attempt, err := uu.loginAttemptRepository.GetByKey(key)
+	if err == nil {
+		_ = uu.loginAttemptRepository.Delete(attempt.Id)
+	}
+}
+
+// cancelPendingDeletion keeps an account whose owner logs in again during the deletion grace period
+func (uu *UserUseCase) cancelPendingDeletion(user *Domain) error {
+	if user.DeletionScheduledAt == 0 {
+		return nil
+	}
+
+	user.DeletionScheduledAt = 0
+	user.UpdatedAt = primitive.NewDateTimeFromTime(time.Now())
+	updatedUser, err := uu.userRepository.Update(user)
+	if err != nil {
+		return errors.New("failed to cancel account deletion")
+	}
+
+	*user = updatedUser
+	return nil
+}
+
+// verifyTwoFactorCode accepts either a TOTP code that has not been used yet or an unused recovery code, which is consumed
+func (uu *UserUseCase) verifyTwoFactorCode(user *Domain, code string) bool {
+	step, ok := util.ValidateTOTPCode(user.TwoFactorSecret, code, time.Now())
+	if ok && step > user.LastTOTPStep {
+		user.LastTOTPStep = step
+		return true
+	}
+
+	hashedCode := util.HashToken(code)
+	for i, recoveryCode := range user.RecoveryCodes {
+		if recoveryCode == hashedCode {
+			user.RecoveryCodes = append(user.RecoveryCodes[:i], user.RecoveryCodes[i+1:]...)
+			return true
+		}
+	}
+
+	return false
+}
+
 /*
 Create
 */
 
-func (uu *UserUseCase) Register(domain *Domain, profilePicture *multipart.FileHeader) (Domain, string, error) {
+func (uu *UserUseCase) Register(domain *Domain, profilePicture *multipart.FileHeader, userAgent string, ipAddress string) (Domain, string, string, error) {
 	domain.UserName = strings.ToLower(domain.UserName)
 	_, err := uu.userRepository.GetByEmail(domain.Email)
 	if err == nil {
-		return Domain{}, "", errors.New("email is already registered")
+		return Domain{}, "", "", errors.New("email is already registered")
 	}
 
 	_, err = uu.userRepository.GetByUsername(domain.UserName)
 	if err == nil {
-		return Domain{}, "", errors.New("username is already used")
+		return Domain{}, "", "", errors.New("username is already used")
 	}
 
 	encryptedPassword, _ := bcrypt.GenerateFromPassword([]byte(domain.Password), bcrypt.DefaultCost)
@@ -48,7 +207,7 @@ func (uu *UserUseCase) Register(domain *Domain, profilePicture *multipart.FileHe
 	if profilePicture != nil {
 		cloudinaryURL, err := uu.cloudinary.Upload("profilePicture", profilePicture, util.GenerateUUID())
 		if err != nil {
-			return Domain{}, "", errors.New("failed to upload profile picture")
+			return Domain{}, "", "", errors.New("failed to upload profile picture")
 		}
 
 		domain.ProfilePictureURL = cloudinaryURL
@@ -58,6 +217,7 @@ func (uu *UserUseCase) Register(domain *Domain, profilePicture *multipart.FileHe
 	domain.Password = string(encryptedPassword)
 	domain.Role = "user"
 	domain.IsActive = true
+	domain.EmailVerified = false
 	domain.CreatedAt = primitive.NewDateTimeFromTime(time.Now())
 	domain.UpdatedAt = primitive.NewDateTimeFromTime(time.Now())
 
@@ -66,43 +226,357 @@ func (uu *UserUseCase) Register(domain *Domain, profilePicture *multipart.FileHe
 		if domain.ProfilePictureURL != "" {
 			err = uu.cloudinary.Delete("profilePicture", util.GetFilenameWithoutExtension(domain.ProfilePictureURL))
 			if err != nil {
-				return Domain{}, "", errors.New("failed to delete profile picture")
+				return Domain{}, "", "", errors.New("failed to delete profile picture")
 			}
 		}
 
-		return Domain{}, "", errors.New("failed to register user")
+		return Domain{}, "", "", errors.New("failed to register user")
+	}
+
+	// a failed delivery should not undo the registration, the user can request another email later
+	_, _ = uu.mailgun.SendVerificationMail(user.Email, util.GenerateEmailVerificationToken(user.Id.Hex(), user.Email))
+
+	token, refreshToken, err := uu.generateTokens(user, userAgent, ipAddress)
+	if err != nil {
+		return Domain{}, "", "", err
 	}
 
-	token := util.GenerateToken(user.Id.Hex(), user.Role)
-	return user, token, nil
+	return user, token, refreshToken, nil
 }
 
 /*
 Read
 */
 
-func (uu *UserUseCase) Login(key string, password string) (Domain, string, error) {
-	var user Domain
+func (uu *UserUseCase) Login(key string, password string, userAgent string, ipAddress string) (Domain, string, string, error) {
+	ipKey := "ip:" + ipAddress
+	if err := uu.checkLockout(ipKey); err != nil {
+		return Domain{}, "", "", err
+	}
 
 	user, err := uu.userRepository.GetByEmail(key)
 	if err != nil {
 		user, err = uu.userRepository.GetByUsername(key)
+	}
+	userFound := err == nil
+
+	// unknown accounts are tracked by the submitted key so they lock out exactly like real ones
+	accountKey := "account:" + strings.ToLower(key)
+	passwordHash := dummyPasswordHash
+	if userFound {
+		accountKey = "account:" + user.Id.Hex()
+		passwordHash = []byte(user.Password)
+	}
+
+	if err := uu.checkLockout(accountKey); err != nil {
+		return Domain{}, "", "", err
+	}
+
+	err = bcrypt.CompareHashAndPassword(passwordHash, []byte(password))
+	if err != nil || !userFound {
+		uu.recordFailedLogin(accountKey, accountLockoutThreshold)
+		uu.recordFailedLogin(ipKey, ipLockoutThreshold)
+		return Domain{}, "", "", errors.New("invalid email/username or password")
+	}
+
+	uu.clearFailedLogins(accountKey)
+
+	// a suspended user only gets a token that can be used to appeal the suspension
+	if !user.IsActive {
+		return Domain{}, util.GenerateAppealToken(user.Id.Hex()), "", errors.New("user is suspended")
+	}
+
+	// users with two-factor authentication get a short-lived challenge token instead of a session
+	if user.TwoFactorEnabled {
+		return user, util.GenerateTwoFactorChallengeToken(user.Id.Hex()), "", nil
+	}
+
+	err = uu.cancelPendingDeletion(&user)
+	if err != nil {
+		return Domain{}, "", "", err
+	}
+
+	token, refreshToken, err := uu.generateTokens(user, userAgent, ipAddress)
+	if err != nil {
+		return Domain{}, "", "", err
+	}
+
+	return user, token, refreshToken, nil
+}
+
+func (uu *UserUseCase) StartOIDCLogin() (string, error) {
+	state, err := util.GenerateSecureRandomString(32)
+	if err != nil {
+		return "", errors.New("failed to generate oidc state")
+	}
+
+	nonce, err := util.GenerateSecureRandomString(32)
+	if err != nil {
+		return "", errors.New("failed to generate oidc state")
+	}
+
+	codeVerifier, err := util.GenerateSecureRandomString(64)
+	if err != nil {
+		return "", errors.New("failed to generate oidc state")
+	}
+
+	_, err = uu.oidcStateRepository.Create(&oidc_states.Domain{
+		Id:           primitive.NewObjectID(),
+		State:        util.HashToken(state),
+		CodeVerifier: codeVerifier,
+		Nonce:        nonce,
+		ExpiredAt:    primitive.NewDateTimeFromTime(time.Now().Add(oidcStateDuration)),
+		CreatedAt:    primitive.NewDateTimeFromTime(time.Now()),
+	})
+	if err != nil {
+		return "", errors.New("failed to create oidc state")
+	}
+
+	codeChallenge := sha256.Sum256([]byte(codeVerifier))
+	authURL, err := uu.oidc.AuthCodeURL(state, nonce, base64.RawURLEncoding.EncodeToString(codeChallenge[:]))
+	if err != nil {
+		return "", errors.New("failed to get authorization url")
+	}
+
+	return authURL, nil
+}
+
+func (uu *UserUseCase) OIDCCallback(state string, code string, userAgent string, ipAddress string) (Domain, string, string, error) {
+	stateData, err := uu.oidcStateRepository.GetByState(util.HashToken(state))
+	if err != nil {
+		return Domain{}, "", "", errors.New("invalid or expired oidc state")
+	}
+
+	// a state can only be redeemed once
+	err = uu.oidcStateRepository.Delete(stateData.Id)
+	if err != nil {
+		return Domain{}, "", "", errors.New("failed to delete oidc state")
+	}
+
+	if stateData.ExpiredAt.Time().Before(time.Now()) {
+		return Domain{}, "", "", errors.New("invalid or expired oidc state")
+	}
+
+	claims, err := uu.oidc.Exchange(code, stateData.CodeVerifier, stateData.Nonce)
+	if err != nil {
+		return Domain{}, "", "", errors.New("failed to verify oidc login")
+	}
+
+	user, err := uu.userRepository.GetByOIDCSubject(uu.oidc.Issuer(), claims.Subject)
+	if err != nil {
+		user, err = uu.linkOIDCUser(claims)
 		if err != nil {
-			return Domain{}, "", errors.New("email or username is not registered")
+			return Domain{}, "", "", err
 		}
 	}
 
 	if !user.IsActive {
-		return Domain{}, "", errors.New("user is suspended")
+		return Domain{}, util.GenerateAppealToken(user.Id.Hex()), "", errors.New("user is suspended")
 	}
 
-	err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password))
+	if user.TwoFactorEnabled {
+		return user, util.GenerateTwoFactorChallengeToken(user.Id.Hex()), "", nil
+	}
+
+	err = uu.cancelPendingDeletion(&user)
+	if err != nil {
+		return Domain{}, "", "", err
+	}
+
+	token, refreshToken, err := uu.generateTokens(user, userAgent, ipAddress)
+	if err != nil {
+		return Domain{}, "", "", err
+	}
+
+	return user, token, refreshToken, nil
+}
+
+// linkOIDCUser attaches the external subject to the account owning the same verified email, or creates a new account
+func (uu *UserUseCase) linkOIDCUser(claims oidc.Claims) (Domain, error) {
+	if claims.Email == "" || !claims.EmailVerified {
+		return Domain{}, errors.New("oidc provider did not return a verified email")
+	}
+
+	user, err := uu.userRepository.GetByEmail(claims.Email)
+	if err == nil {
+		if user.OIDCSubject != "" {
+			return Domain{}, errors.New("email is already linked to another oidc account")
+		}
+
+		// the local account never proved it owns the email, so whoever registered it loses access to it
+		if !user.EmailVerified {
+			password, err := util.GenerateSecureRandomString(64)
+			if err != nil {
+				return Domain{}, errors.New("failed to link oidc account")
+			}
+			encryptedPassword, _ := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+
+			user.Password = string(encryptedPassword)
+			user.TwoFactorEnabled = false
+			user.TwoFactorSecret = ""
+			user.RecoveryCodes = nil
+
+			err = uu.refreshTokenRepository.RevokeAllByUserID(user.Id)
+			if err != nil {
+				return Domain{}, errors.New("failed to revoke sessions")
+			}
+		}
+
+		user.OIDCIssuer = uu.oidc.Issuer()
+		user.OIDCSubject = claims.Subject
+		user.EmailVerified = true
+		user.UpdatedAt = primitive.NewDateTimeFromTime(time.Now())
+
+		linkedUser, err := uu.userRepository.Update(&user)
+		if err != nil {
+			return Domain{}, errors.New("failed to link oidc account")
+		}
+
+		return linkedUser, nil
+	}
+
+	userName, err := uu.generateUsername(claims.Email)
+	if err != nil {
+		return Domain{}, err
+	}
+
+	// oidc accounts get an unguessable password and can set their own through forgot password
+	password, err := util.GenerateSecureRandomString(64)
 	if err != nil {
-		return Domain{}, "", errors.New("wrong password")
+		return Domain{}, errors.New("failed to register user")
+	}
+	encryptedPassword, _ := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+
+	displayName := claims.Name
+	if displayName == "" {
+		displayName = userName
 	}
 
-	token := util.GenerateToken(user.Id.Hex(), user.Role)
-	return user, token, nil
+	newUser, err := uu.userRepository.Create(&Domain{
+		Id:            primitive.NewObjectID(),
+		Email:         claims.Email,
+		UserName:      userName,
+		DisplayName:   displayName,
+		Password:      string(encryptedPassword),
+		IsActive:      true,
+		EmailVerified: true,
+		Role:          "user",
+		OIDCIssuer:    uu.oidc.Issuer(),
+		OIDCSubject:   claims.Subject,
+		CreatedAt:     primitive.NewDateTimeFromTime(time.Now()),
+		UpdatedAt:     primitive.NewDateTimeFromTime(time.Now()),
+	})
+	if err != nil {
+		return Domain{}, errors.New("failed to register user")
+	}
+
+	return newUser, nil
+}
+
+func (uu *UserUseCase) generateUsername(email string) (string, error) {
+	base := nonUsernameCharacters.ReplaceAllString(strings.ToLower(strings.Split(email, "@")[0]), "")
+	if base == "" {
+		base = "user"
+	}
+
+	userName := base
+	for i := 0; i < 5; i++ {
+		_, err := uu.userRepository.GetByUsername(userName)
+		if err != nil {
+			return userName, nil
+		}
+
+		suffix, err := util.GenerateSecureRandomString(4)
+		if err != nil {
+			break
+		}
+		userName = fmt.Sprintf("%s_%s", base, strings.ToLower(suffix))
+	}
+
+	return "", errors.New("failed to generate username")
+}
+
+func (uu *UserUseCase) VerifyTwoFactor(challengeToken string, code string, userAgent string, ipAddress string) (Domain, string, string, error) {
+	claims, err := util.GetTwoFactorChallengePayload(challengeToken)
+	if err != nil {
+		return Domain{}, "", "", errors.New("invalid or expired challenge token")
+	}
+
+	userID, err := primitive.ObjectIDFromHex(claims.UID)
+	if err != nil {
+		return Domain{}, "", "", errors.New("invalid or expired challenge token")
+	}
+
+	user, err := uu.userRepository.GetByID(userID)
+	if err != nil {
+		return Domain{}, "", "", errors.New("failed to get user")
+	}
+
+	if !user.IsActive {
+		return Domain{}, util.GenerateAppealToken(user.Id.Hex()), "", errors.New("user is suspended")
+	}
+
+	if !user.TwoFactorEnabled {
+		return Domain{}, "", "", errors.New("two-factor authentication is not enabled")
+	}
+
+	accountKey := "account:" + user.Id.Hex()
+	if err := uu.checkLockout(accountKey); err != nil {
+		return Domain{}, "", "", err
+	}
+
+	if !uu.verifyTwoFactorCode(&user, code) {
+		uu.recordFailedLogin(accountKey, accountLockoutThreshold)
+		return Domain{}, "", "", errors.New("invalid two-factor code")
+	}
+
+	uu.clearFailedLogins(accountKey)
+
+	user.UpdatedAt = primitive.NewDateTimeFromTime(time.Now())
+	user, err = uu.userRepository.Update(&user)
+	if err != nil {
+		return Domain{}, "", "", errors.New("failed to update user")
+	}
+
+	err = uu.cancelPendingDeletion(&user)
+	if err != nil {
+		return Domain{}, "", "", err
+	}
+
+	token, refreshToken, err := uu.generateTokens(user, userAgent, ipAddress)
+	if err != nil {
+		return Domain{}, "", "", err
+	}
+
+	return user, token, refreshToken, nil
+}
+
+// LoginWithMagicLink starts a session for a user whose magic link has already been redeemed
+func (uu *UserUseCase) LoginWithMagicLink(id primitive.ObjectID, userAgent string, ipAddress string) (Domain, string, string, error) {
+	user, err := uu.userRepository.GetByID(id)
+	if err != nil {
+		return Domain{}, "", "", errors.New("failed to get user")
+	}
+
+	if !user.IsActive {
+		return Domain{}, util.GenerateAppealToken(user.Id.Hex()), "", errors.New("user is suspended")
+	}
+
+	if user.TwoFactorEnabled {
+		return user, util.GenerateTwoFactorChallengeToken(user.Id.Hex()), "", nil
+	}
+
+	err = uu.cancelPendingDeletion(&user)
+	if err != nil {
+		return Domain{}, "", "", err
+	}
+
+	token, refreshToken, err := uu.generateTokens(user, userAgent, ipAddress)
+	if err != nil {
+		return Domain{}, "", "", err
+	}
+
+	return user, token, refreshToken, nil
 }
 
 func (uu *UserUseCase) GetManyWithPagination(pagination dtoPagination.Request, domain *Domain) ([]Domain, int, int, error) {
@@ -140,6 +614,56 @@ func (uu *UserUseCase) GetByID(id primitive.ObjectID) (Domain, error) {
 	return user, nil
 }
 
+func (uu *UserUseCase) GetByUsername(username string) (Domain, error) {
+	user, err := uu.userRepository.GetByUsername(username)
+	if err != nil {
+		return Domain{}, errors.New("failed to get user")
+	}
+
+	return user, nil
+}
+
+func (uu *UserUseCase) GetSessions(userID primitive.ObjectID) ([]refresh_tokens.Domain, error) {
+	sessions, err := uu.refreshTokenRepository.GetAllActiveByUserID(userID)
+	if err != nil {
+		return []refresh_tokens.Domain{}, errors.New("failed to get sessions")
+	}
+
+	return sessions, nil
+}
+
+func (uu *UserUseCase) GetLockouts() ([]login_attempts.Domain, error) {
+	lockouts, err := uu.loginAttemptRepository.GetAllLocked()
+	if err != nil {
+		return []login_attempts.Domain{}, errors.New("failed to get lockouts")
+	}
+
+	return lockouts, nil
+}
+
+func (uu *UserUseCase) GetSuspensions(userID primitive.ObjectID) ([]suspensions.Domain, error) {
+	_, err := uu.userRepository.GetByID(userID)
+	if err != nil {
+		return []suspensions.Domain{}, errors.New("failed to get user")
+	}
+
+	result, err := uu.suspensionRepository.GetAllByUserID(userID)
+	if err != nil {
+		return []suspensions.Domain{}, errors.New("failed to get suspensions")
+	}
+
+	return result, nil
+}
+
+func (uu *UserUseCase) GetAllDueForDeletion() ([]Domain, error) {
+	result, err := uu.userRepository.GetAllDueForDeletion(primitive.NewDateTimeFromTime(time.Now()))
+	if err != nil {
+		return []Domain{}, errors.New("failed to get users")
+	}
+
+	return result, nil
+}
+
 func (uu *UserUseCase) GetAll() (int, error) {
 	users, err := uu.userRepository.GetAll()
 	if err != nil {
@@ -189,6 +713,11 @@ func (uu *UserUseCase) Update(domain *Domain, profilePicture *multipart.FileHead
 		user.ProfilePictureURL = cloudinaryURL
 	}
 
+	emailChanged := domain.Email != user.Email
+	if emailChanged {
+		user.EmailVerified = false
+	}
+
 	user.Email = domain.Email
 	user.UserName = domain.UserName
 	user.DisplayName = domain.DisplayName
@@ -201,10 +730,16 @@ func (uu *UserUseCase) Update(domain *Domain, profilePicture *multipart.FileHead
 		return Domain{}, errors.New("failed to update user")
 	}
 
+	// the new address has to be verified again, a failed mail can be resent by the user
+	if emailChanged {
+		_, _ = uu.mailgun.SendVerificationMail(user.Email, util.GenerateEmailVerificationToken(user.Id.Hex(), user.Email))
+	}
+
 	return updatedUser, nil
 }
 
-func (uu *UserUseCase) UpdatePassword(domain *Domain) (Domain, error) {
+// UpdatePassword signs the user out of every other session, currentAccessTokenID is the session the password is changed from
+func (uu *UserUseCase) UpdatePassword(domain *Domain, currentAccessTokenID string) (Domain, error) {
 	user, err := uu.userRepository.GetByID(domain.Id)
 	if err != nil {
 		return Domain{}, errors.New("failed to get user")
@@ -224,21 +759,35 @@ func (uu *UserUseCase) UpdatePassword(domain *Domain) (Domain, error) {
 		return Domain{}, errors.New("failed to update password")
 	}
 
+	err = uu.refreshTokenRepository.RevokeOthersByUserID(user.Id, currentAccessTokenID)
+	if err != nil {
+		return Domain{}, errors.New("failed to revoke sessions")
+	}
+
 	return updatedUser, nil
 }
 
-func (uu *UserUseCase) Suspend(id primitive.ObjectID) (Domain, error) {
-	user, err := uu.userRepository.GetByID(id)
+// Suspend deactivates the user and keeps a record of why, by whom and until when the user is suspended
+func (uu *UserUseCase) Suspend(suspension *suspensions.Domain) (Domain, suspensions.Domain, error) {
+	if !suspensions.IsValidReason(suspension.Reason) {
+		return Domain{}, suspensions.Domain{}, errors.New("invalid suspension reason")
+	}
+
+	if suspension.EndAt != 0 && suspension.EndAt.Time().Before(time.Now()) {
+		return Domain{}, suspensions.Domain{}, errors.New("suspension end time must be in the future")
+	}
+
+	user, err := uu.userRepository.GetByID(suspension.UserID)
 	if err != nil {
-		return Domain{}, errors.New("failed to get user")
+		return Domain{}, suspensions.Domain{}, errors.New("failed to get user")
 	}
 
 	if user.Role == "admin" {
-		return Domain{}, errors.New("admin cannot be suspended")
+		return Domain{}, suspensions.Domain{}, errors.New("admin cannot be suspended")
 	}
 
 	if !user.IsActive {
-		return Domain{}, errors.New("user is already suspended")
+		return Domain{}, suspensions.Domain{}, errors.New("user is already suspended")
 	}
 
 	user.IsActive = false
@@ -246,13 +795,32 @@ func (uu *UserUseCase) Suspend(id primitive.ObjectID) (Domain, error) {
 
 	suspendedUser, err := uu.userRepository.Update(&user)
 	if err != nil {
-		return Domain{}, errors.New("failed to suspend user")
+		return Domain{}, suspensions.Domain{}, errors.New("failed to suspend user")
+	}
+
+	suspension.Id = primitive.NewObjectID()
+	suspension.CreatedAt = primitive.NewDateTimeFromTime(time.Now())
+	suspension.UpdatedAt = primitive.NewDateTimeFromTime(time.Now())
+
+	createdSuspension, err := uu.suspensionRepository.Create(suspension)
+	if err != nil {
+		// a suspended user without a suspension record could never be lifted or appealed
+		user.IsActive = true
+		user.UpdatedAt = primitive.NewDateTimeFromTime(time.Now())
+		_, _ = uu.userRepository.Update(&user)
+
+		return Domain{}, suspensions.Domain{}, errors.New("failed to create suspension")
+	}
+
+	err = uu.refreshTokenRepository.RevokeAllByUserID(suspension.UserID)
+	if err != nil {
+		return Domain{}, suspensions.Domain{}, errors.New("failed to revoke user tokens")
 	}
 
-	return suspendedUser, nil
+	return suspendedUser, createdSuspension, nil
 }
 
-func (uu *UserUseCase) Unsuspend(id primitive.ObjectID) (Domain, error) {
+func (uu *UserUseCase) Unsuspend(id primitive.ObjectID, adminID primitive.ObjectID) (Domain, error) {
 	user, err := uu.userRepository.GetByID(id)
 	if err != nil {
 		return Domain{}, errors.New("failed to get user")
@@ -262,6 +830,44 @@ func (uu *UserUseCase) Unsuspend(id primitive.ObjectID) (Domain, error) {
 		return Domain{}, errors.New("user is not suspended")
 	}
 
+	return uu.liftSuspension(user, adminID)
+}
+
+// LiftExpiredSuspensions reactivates every user whose suspension is over, it is run by a background job
+func (uu *UserUseCase) LiftExpiredSuspensions() ([]Domain, error) {
+	expired, err := uu.suspensionRepository.GetAllExpired(primitive.NewDateTimeFromTime(time.Now()))
+	if err != nil {
+		return []Domain{}, errors.New("failed to get expired suspensions")
+	}
+
+	liftedUsers := []Domain{}
+	failed := false
+	for _, suspension := range expired {
+		user, err := uu.userRepository.GetByID(suspension.UserID)
+		if err != nil {
+			failed = true
+			continue
+		}
+
+		// lifted by nobody, the suspension simply ran out
+		user, err = uu.liftSuspension(user, primitive.NilObjectID)
+		if err != nil {
+			failed = true
+			continue
+		}
+
+		liftedUsers = append(liftedUsers, user)
+	}
+
+	if failed {
+		return liftedUsers, errors.New("failed to lift expired suspensions")
+	}
+
+	return liftedUsers, nil
+}
+
+// liftSuspension reactivates the user and closes the user's active suspension record, users suspended before suspensions were recorded have none
+func (uu *UserUseCase) liftSuspension(user Domain, adminID primitive.ObjectID) (Domain, error) {
 	user.IsActive = true
 	user.UpdatedAt = primitive.NewDateTimeFromTime(time.Now())
 
@@ -270,23 +876,363 @@ func (uu *UserUseCase) Unsuspend(id primitive.ObjectID) (Domain, error) {
 		return Domain{}, errors.New("failed to unsuspend user")
 	}
 
+	suspension, err := uu.suspensionRepository.GetActiveByUserID(user.Id)
+	if err != nil {
+		return unsuspendedUser, nil
+	}
+
+	suspension.LiftedAt = primitive.NewDateTimeFromTime(time.Now())
+	suspension.LiftedBy = adminID
+	suspension.UpdatedAt = primitive.NewDateTimeFromTime(time.Now())
+
+	_, err = uu.suspensionRepository.Update(&suspension)
+	if err != nil {
+		return Domain{}, errors.New("failed to lift suspension")
+	}
+
 	return unsuspendedUser, nil
 }
 
+func (uu *UserUseCase) RefreshToken(refreshToken string) (string, string, error) {
+	hashedToken := util.HashToken(refreshToken)
+
+	tokenData, err := uu.refreshTokenRepository.GetByToken(hashedToken)
+	if err != nil {
+		// a rotated token being presented again means it has leaked, so the whole session is revoked
+		reusedToken, err := uu.refreshTokenRepository.GetByPreviousToken(hashedToken)
+		if err == nil {
+			reusedToken.IsRevoked = true
+			reusedToken.UpdatedAt = primitive.NewDateTimeFromTime(time.Now())
+
+			_, err = uu.refreshTokenRepository.Update(&reusedToken)
+			if err != nil {
+				return "", "", errors.New("failed to revoke refresh token")
+			}
+
+			return "", "", errors.New("refresh token has been revoked")
+		}
+
+		return "", "", errors.New("failed to get refresh token")
+	}
+
+	if tokenData.IsRevoked {
+		return "", "", errors.New("refresh token has been revoked")
+	}
+
+	if tokenData.ExpiredAt.Time().Before(time.Now()) {
+		return "", "", errors.New("refresh token has expired")
+	}
+
+	user, err := uu.userRepository.GetByID(tokenData.UserID)
+	if err != nil {
+		return "", "", errors.New("failed to get user")
+	}
+
+	if !user.IsActive {
+		return "", "", errors.New("user is suspended")
+	}
+
+	newRefreshToken, err := util.GenerateSecureRandomString(80)
+	if err != nil {
+		return "", "", errors.New("failed to generate refresh token")
+	}
+
+	tokenData.PreviousToken = tokenData.Token
+	tokenData.Token = util.HashToken(newRefreshToken)
+	tokenData.AccessTokenID = util.GenerateUUID()
+	tokenData.LastSeenAt = primitive.NewDateTimeFromTime(time.Now())
+	tokenData.ExpiredAt = primitive.NewDateTimeFromTime(time.Now().Add(refreshTokenDuration))
+	tokenData.UpdatedAt = primitive.NewDateTimeFromTime(time.Now())
+
+	_, err = uu.refreshTokenRepository.Update(&tokenData)
+	if err != nil {
+		return "", "", errors.New("failed to update refresh token")
+	}
+
+	token := util.GenerateToken(user.Id.Hex(), user.Role, tokenData.AccessTokenID)
+	return token, newRefreshToken, nil
+}
+
+func (uu *UserUseCase) Logout(accessTokenID string) error {
+	tokenData, err := uu.refreshTokenRepository.GetByAccessTokenID(accessTokenID)
+	if err != nil {
+		return errors.New("failed to get refresh token")
+	}
+
+	if tokenData.IsRevoked {
+		return errors.New("refresh token has been revoked")
+	}
+
+	tokenData.IsRevoked = true
+	tokenData.UpdatedAt = primitive.NewDateTimeFromTime(time.Now())
+
+	_, err = uu.refreshTokenRepository.Update(&tokenData)
+	if err != nil {
+		return errors.New("failed to revoke refresh token")
+	}
+
+	return nil
+}
+
+func (uu *UserUseCase) RevokeSession(userID primitive.ObjectID, sessionID primitive.ObjectID) error {
+	session, err := uu.refreshTokenRepository.GetByID(sessionID)
+	if err != nil {
+		return errors.New("failed to get session")
+	}
+
+	if session.UserID != userID {
+		return errors.New("user are not the owner of this session")
+	}
+
+	if session.IsRevoked {
+		return errors.New("session has been revoked")
+	}
+
+	session.IsRevoked = true
+	session.UpdatedAt = primitive.NewDateTimeFromTime(time.Now())
+
+	_, err = uu.refreshTokenRepository.Update(&session)
+	if err != nil {
+		return errors.New("failed to revoke session")
+	}
+
+	return nil
+}
+
+func (uu *UserUseCase) RevokeAllSessions(userID primitive.ObjectID) error {
+	err := uu.refreshTokenRepository.RevokeAllByUserID(userID)
+	if err != nil {
+		return errors.New("failed to revoke sessions")
+	}
+
+	return nil
+}
+
+// RequestDeletion schedules the account for deletion after the grace period and signs the user out everywhere
+func (uu *UserUseCase) RequestDeletion(id primitive.ObjectID, password string) (Domain, error) {
+	user, err := uu.userRepository.GetByID(id)
+	if err != nil {
+		return Domain{}, errors.New("failed to get user")
+	}
+
+	if user.Role == "admin" {
+		return Domain{}, errors.New("admin cannot be deleted")
+	}
+
+	err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password))
+	if err != nil {
+		return Domain{}, errors.New("wrong password")
+	}
+
+	if user.DeletionScheduledAt != 0 {
+		return Domain{}, errors.New("account deletion is already requested")
+	}
+
+	user.DeletionScheduledAt = primitive.NewDateTimeFromTime(time.Now().Add(deletionGracePeriod))
+	user.UpdatedAt = primitive.NewDateTimeFromTime(time.Now())
+	user, err = uu.userRepository.Update(&user)
+	if err != nil {
+		return Domain{}, errors.New("failed to request account deletion")
+	}
+
+	err = uu.refreshTokenRepository.RevokeAllByUserID(id)
+	if err != nil {
+		return Domain{}, errors.New("failed to revoke user tokens")
+	}
+
+	return user, nil
+}
+
+func (uu *UserUseCase) VerifyEmail(token string) (Domain, error) {
+	claims, err := util.GetEmailVerificationPayload(token)
+	if err != nil {
+		return Domain{}, errors.New("invalid or expired verification token")
+	}
+
+	userID, err := primitive.ObjectIDFromHex(claims.UID)
+	if err != nil {
+		return Domain{}, errors.New("invalid or expired verification token")
+	}
+
+	user, err := uu.userRepository.GetByID(userID)
+	if err != nil {
+		return Domain{}, errors.New("failed to get user")
+	}
+
+	if user.Email != claims.Email {
+		return Domain{}, errors.New("invalid or expired verification token")
+	}
+
+	if user.EmailVerified {
+		return Domain{}, errors.New("email is already verified")
+	}
+
+	user.EmailVerified = true
+	user.UpdatedAt = primitive.NewDateTimeFromTime(time.Now())
+
+	verifiedUser, err := uu.userRepository.Update(&user)
+	if err != nil {
+		return Domain{}, errors.New("failed to verify email")
+	}
+
+	return verifiedUser, nil
+}
+
+func (uu *UserUseCase) ResendVerificationEmail(id primitive.ObjectID) error {
+	user, err := uu.userRepository.GetByID(id)
+	if err != nil {
+		return errors.New("failed to get user")
+	}
+
+	if user.EmailVerified {
+		return errors.New("email is already verified")
+	}
+
+	_, err = uu.mailgun.SendVerificationMail(user.Email, util.GenerateEmailVerificationToken(user.Id.Hex(), user.Email))
+	if err != nil {
+		return errors.New("failed to send verification email")
+	}
+
+	return nil
+}
+
+func (uu *UserUseCase) EnrollTwoFactor(id primitive.ObjectID) (string, string, error) {
+	user, err := uu.userRepository.GetByID(id)
+	if err != nil {
+		return "", "", errors.New("failed to get user")
+	}
+
+	if user.TwoFactorEnabled {
+		return "", "", errors.New("two-factor authentication is already enabled")
+	}
+
+	secret, err := util.GenerateTOTPSecret()
+	if err != nil {
+		return "", "", errors.New("failed to generate two-factor secret")
+	}
+
+	user.TwoFactorSecret = secret
+	user.UpdatedAt = primitive.NewDateTimeFromTime(time.Now())
+
+	_, err = uu.userRepository.Update(&user)
+	if err != nil {
+		return "", "", errors.New("failed to update user")
+	}
+
+	return secret, util.GenerateTOTPURI(secret, user.Email), nil
+}
+
+func (uu *UserUseCase) ConfirmTwoFactor(id primitive.ObjectID, code string) ([]string, error) {
+	user, err := uu.userRepository.GetByID(id)
+	if err != nil {
+		return []string{}, errors.New("failed to get user")
+	}
+
+	if user.TwoFactorEnabled {
+		return []string{}, errors.New("two-factor authentication is already enabled")
+	}
+
+	if user.TwoFactorSecret == "" {
+		return []string{}, errors.New("two-factor authentication is not enrolled")
+	}
+
+	step, ok := util.ValidateTOTPCode(user.TwoFactorSecret, code, time.Now())
+	if !ok {
+		return []string{}, errors.New("invalid two-factor code")
+	}
+
+	recoveryCodes := make([]string, recoveryCodeCount)
+	hashedRecoveryCodes := make([]string, recoveryCodeCount)
+	for i := range recoveryCodes {
+		recoveryCode, err := util.GenerateSecureRandomString(10)
+		if err != nil {
+			return []string{}, errors.New("failed to generate recovery codes")
+		}
+
+		recoveryCodes[i] = recoveryCode
+		hashedRecoveryCodes[i] = util.HashToken(recoveryCode)
+	}
+
+	user.TwoFactorEnabled = true
+	user.RecoveryCodes = hashedRecoveryCodes
+	user.LastTOTPStep = step
+	user.UpdatedAt = primitive.NewDateTimeFromTime(time.Now())
+
+	_, err = uu.userRepository.Update(&user)
+	if err != nil {
+		return []string{}, errors.New("failed to enable two-factor authentication")
+	}
+
+	return recoveryCodes, nil
+}
+
+func (uu *UserUseCase) DisableTwoFactor(id primitive.ObjectID, code string) error {
+	user, err := uu.userRepository.GetByID(id)
+	if err != nil {
+		return errors.New("failed to get user")
+	}
+
+	if !user.TwoFactorEnabled {
+		return errors.New("two-factor authentication is not enabled")
+	}
+
+	if !uu.verifyTwoFactorCode(&user, code) {
+		return errors.New("invalid two-factor code")
+	}
+
+	user.TwoFactorEnabled = false
+	user.TwoFactorSecret = ""
+	user.RecoveryCodes = []string{}
+	user.LastTOTPStep = 0
+	user.UpdatedAt = primitive.NewDateTimeFromTime(time.Now())
+
+	_, err = uu.userRepository.Update(&user)
+	if err != nil {
+		return errors.New("failed to disable two-factor authentication")
+	}
+
+	return nil
+}
+
 /*
 Delete
 */
 
-func (uu *UserUseCase) Delete(id primitive.ObjectID) (Domain, error) {
-	deletedUser, err := uu.userRepository.GetByID(id)
+func (uu *UserUseCase) ClearLockout(id primitive.ObjectID) error {
+	_, err := uu.loginAttemptRepository.GetByID(id)
+	if err != nil {
+		return errors.New("failed to get lockout")
+	}
+
+	err = uu.loginAttemptRepository.Delete(id)
+	if err != nil {
+		return errors.New("failed to clear lockout")
+	}
+
+	return nil
+}
+
+// CheckDeletable returns the user when the account can be deleted, it is used before removing what the user created
+func (uu *UserUseCase) CheckDeletable(id primitive.ObjectID) (Domain, error) {
+	user, err := uu.userRepository.GetByID(id)
 	if err != nil {
 		return Domain{}, errors.New("failed to get user")
 	}
 
-	if deletedUser.Role == "admin" {
+	if user.Role == "admin" {
 		return Domain{}, errors.New("admin cannot be deleted")
 	}
 
+	return user, nil
+}
+
+// Delete removes the user record last, so a failed deletion can be retried while the record still exists
+func (uu *UserUseCase) Delete(id primitive.ObjectID) (Domain, error) {
+	deletedUser, err := uu.CheckDeletable(id)
+	if err != nil {
+		return Domain{}, err
+	}
+
 	if deletedUser.ProfilePictureURL != "" {
 		err = uu.cloudinary.Delete("profilePicture", util.GetFilenameWithoutExtension(deletedUser.ProfilePictureURL))
 		if err != nil {
@@ -294,6 +1240,11 @@ func (uu *UserUseCase) Delete(id primitive.ObjectID) (Domain, error) {
 		}
 	}
 
+	err = uu.refreshTokenRepository.RevokeAllByUserID(id)
+	if err != nil {
+		return Domain{}, errors.New("failed to revoke user tokens")
+	}
+
 	err = uu.userRepository.Delete(id)
 	if err != nil {
 		return Domain{}, errors.New("failed to delete user")
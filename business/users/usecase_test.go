@@ -1,13 +1,26 @@
 package users_test
 
 import (
+	"charum/business/login_attempts"
+	_loginAttemptMock "charum/business/login_attempts/mocks"
+	"charum/business/oidc_states"
+	_oidcStateMock "charum/business/oidc_states/mocks"
+	"charum/business/refresh_tokens"
+	_refreshTokenMock "charum/business/refresh_tokens/mocks"
+	"charum/business/suspensions"
+	_suspensionMock "charum/business/suspensions/mocks"
 	"charum/business/users"
 	_userMock "charum/business/users/mocks"
 	dtoPagination "charum/dto/pagination"
 	dtoQuery "charum/dto/query"
 	_cloudinaryMock "charum/helper/cloudinary/mocks"
+	_mailgunMock "charum/helper/mailgun/mocks"
+	"charum/helper/oidc"
+	_oidcMock "charum/helper/oidc/mocks"
+	"charum/util"
 	"errors"
 	"mime/multipart"
+	"strings"
 	"testing"
 	"time"
 
@@ -18,15 +31,24 @@ import (
 )
 
 var (
-	userRepository       _userMock.Repository
-	cloudinaryRepository _cloudinaryMock.Function
-	userUseCase          users.UseCase
-	userDomain           users.Domain
-	image                *multipart.FileHeader
+	userRepository         _userMock.Repository
+	refreshTokenRepository _refreshTokenMock.Repository
+	oidcStateRepository    _oidcStateMock.Repository
+	loginAttemptRepository _loginAttemptMock.Repository
+	suspensionRepository   _suspensionMock.Repository
+	cloudinaryRepository   _cloudinaryMock.Function
+	mailgun                _mailgunMock.Function
+	oidcProvider           _oidcMock.Function
+	userUseCase            users.UseCase
+	userDomain             users.Domain
+	refreshTokenDomain     refresh_tokens.Domain
+	oidcStateDomain        oidc_states.Domain
+	suspensionDomain       suspensions.Domain
+	image                  *multipart.FileHeader
 )
 
 func TestMain(m *testing.M) {
-	userUseCase = users.NewUserUseCase(&userRepository, &cloudinaryRepository)
+	userUseCase = users.NewUserUseCase(&userRepository, &refreshTokenRepository, &oidcStateRepository, &loginAttemptRepository, &suspensionRepository, &cloudinaryRepository, &mailgun, &oidcProvider)
 
 	userDomain = users.Domain{
 		Id:                primitive.NewObjectID(),
@@ -42,6 +64,40 @@ func TestMain(m *testing.M) {
 		UpdatedAt:         primitive.NewDateTimeFromTime(time.Now()),
 	}
 
+	refreshTokenDomain = refresh_tokens.Domain{
+		Id:            primitive.NewObjectID(),
+		UserID:        userDomain.Id,
+		Token:         util.HashToken("refreshToken"),
+		AccessTokenID: util.GenerateUUID(),
+		UserAgent:     "Mozilla/5.0",
+		IPAddress:     "127.0.0.1",
+		IsRevoked:     false,
+		LastSeenAt:    primitive.NewDateTimeFromTime(time.Now()),
+		ExpiredAt:     primitive.NewDateTimeFromTime(time.Now().Add(24 * time.Hour)),
+		CreatedAt:     primitive.NewDateTimeFromTime(time.Now()),
+		UpdatedAt:     primitive.NewDateTimeFromTime(time.Now()),
+	}
+
+	oidcStateDomain = oidc_states.Domain{
+		Id:           primitive.NewObjectID(),
+		State:        util.HashToken("state"),
+		CodeVerifier: "codeVerifier",
+		Nonce:        "nonce",
+		ExpiredAt:    primitive.NewDateTimeFromTime(time.Now().Add(10 * time.Minute)),
+		CreatedAt:    primitive.NewDateTimeFromTime(time.Now()),
+	}
+
+	suspensionDomain = suspensions.Domain{
+		Id:        primitive.NewObjectID(),
+		UserID:    userDomain.Id,
+		AdminID:   primitive.NewObjectID(),
+		Reason:    suspensions.ReasonSpam,
+		Detail:    "posting the same link in every thread",
+		EndAt:     primitive.NewDateTimeFromTime(time.Now().Add(24 * time.Hour)),
+		CreatedAt: primitive.NewDateTimeFromTime(time.Now()),
+		UpdatedAt: primitive.NewDateTimeFromTime(time.Now()),
+	}
+
 	image = &multipart.FileHeader{}
 
 	m.Run()
@@ -53,11 +109,14 @@ func TestUserRegister(t *testing.T) {
 		userRepository.On("GetByUsername", userDomain.UserName).Return(users.Domain{}, errors.New("not found")).Once()
 		cloudinaryRepository.On("Upload", mock.Anything, mock.Anything, mock.Anything).Return("image", nil).Once()
 		userRepository.On("Create", mock.Anything).Return(userDomain, nil).Once()
+		mailgun.On("SendVerificationMail", mock.Anything, mock.Anything).Return("", nil).Once()
+		refreshTokenRepository.On("Create", mock.Anything).Return(refreshTokenDomain, nil).Once()
 
-		actualUser, token, err := userUseCase.Register(&userDomain, image)
+		actualUser, token, refreshToken, err := userUseCase.Register(&userDomain, image, "Mozilla/5.0", "127.0.0.1")
 
 		assert.NotNil(t, actualUser)
 		assert.NotEmpty(t, token)
+		assert.NotEmpty(t, refreshToken)
 		assert.Nil(t, err)
 	})
 
@@ -66,10 +125,11 @@ func TestUserRegister(t *testing.T) {
 		copyDomain := userDomain
 		userRepository.On("GetByEmail", copyDomain.Email).Return(copyDomain, nil).Once()
 
-		actualUser, token, err := userUseCase.Register(&userDomain, image)
+		actualUser, token, refreshToken, err := userUseCase.Register(&userDomain, image, "Mozilla/5.0", "127.0.0.1")
 
 		assert.Equal(t, users.Domain{}, actualUser)
 		assert.Empty(t, token)
+		assert.Empty(t, refreshToken)
 		assert.Equal(t, expectedErr, err)
 	})
 
@@ -79,10 +139,11 @@ func TestUserRegister(t *testing.T) {
 		userRepository.On("GetByEmail", copyDomain.Email).Return(users.Domain{}, errors.New("not found")).Once()
 		userRepository.On("GetByUsername", copyDomain.UserName).Return(copyDomain, nil).Once()
 
-		actualUser, token, err := userUseCase.Register(&userDomain, image)
+		actualUser, token, refreshToken, err := userUseCase.Register(&userDomain, image, "Mozilla/5.0", "127.0.0.1")
 
 		assert.Equal(t, users.Domain{}, actualUser)
 		assert.Empty(t, token)
+		assert.Empty(t, refreshToken)
 		assert.Equal(t, expectedErr, err)
 	})
 
@@ -93,10 +154,11 @@ func TestUserRegister(t *testing.T) {
 		userRepository.On("GetByUsername", copyDomain.UserName).Return(users.Domain{}, errors.New("not found")).Once()
 		cloudinaryRepository.On("Upload", mock.Anything, mock.Anything, mock.Anything).Return("", expectedErr).Once()
 
-		actualUser, token, err := userUseCase.Register(&userDomain, image)
+		actualUser, token, refreshToken, err := userUseCase.Register(&userDomain, image, "Mozilla/5.0", "127.0.0.1")
 
 		assert.Equal(t, users.Domain{}, actualUser)
 		assert.Empty(t, token)
+		assert.Empty(t, refreshToken)
 		assert.Equal(t, expectedErr, err)
 	})
 
@@ -109,10 +171,11 @@ func TestUserRegister(t *testing.T) {
 		userRepository.On("Create", mock.Anything).Return(userDomain, expectedErr).Once()
 		cloudinaryRepository.On("Delete", mock.Anything, mock.Anything).Return(nil).Once()
 
-		actualUser, token, err := userUseCase.Register(&userDomain, image)
+		actualUser, token, refreshToken, err := userUseCase.Register(&userDomain, image, "Mozilla/5.0", "127.0.0.1")
 
 		assert.Equal(t, users.Domain{}, actualUser)
 		assert.Empty(t, token)
+		assert.Empty(t, refreshToken)
 		assert.Equal(t, expectedErr, err)
 	})
 
@@ -125,65 +188,256 @@ func TestUserRegister(t *testing.T) {
 		userRepository.On("Create", mock.Anything).Return(userDomain, errors.New("failed to register user")).Once()
 		cloudinaryRepository.On("Delete", mock.Anything, mock.Anything).Return(expectedErr).Once()
 
-		actualUser, token, err := userUseCase.Register(&userDomain, image)
+		actualUser, token, refreshToken, err := userUseCase.Register(&userDomain, image, "Mozilla/5.0", "127.0.0.1")
 
 		assert.Equal(t, users.Domain{}, actualUser)
 		assert.Empty(t, token)
+		assert.Empty(t, refreshToken)
 		assert.Equal(t, expectedErr, err)
 	})
 }
 
 func TestLogin(t *testing.T) {
+	notFound := errors.New("not found")
+	ipKey := "ip:127.0.0.1"
+	accountKey := "account:" + userDomain.Id.Hex()
+	encryptedPassword, _ := bcrypt.GenerateFromPassword([]byte(userDomain.Password), bcrypt.DefaultCost)
+
 	t.Run("Test Case 1 | Valid Login", func(t *testing.T) {
 		copyDomain := userDomain
-		encryptedPassword, _ := bcrypt.GenerateFromPassword([]byte(copyDomain.Password), bcrypt.DefaultCost)
 		copyDomain.Password = string(encryptedPassword)
+		loginAttemptRepository.On("GetByKey", ipKey).Return(login_attempts.Domain{}, notFound).Once()
 		userRepository.On("GetByEmail", copyDomain.Email).Return(copyDomain, nil).Once()
+		loginAttemptRepository.On("GetByKey", accountKey).Return(login_attempts.Domain{}, notFound).Twice()
+		refreshTokenRepository.On("Create", mock.Anything).Return(refreshTokenDomain, nil).Once()
 
-		actualUser, token, err := userUseCase.Login(copyDomain.Email, userDomain.Password)
+		actualUser, token, refreshToken, err := userUseCase.Login(copyDomain.Email, userDomain.Password, "Mozilla/5.0", "127.0.0.1")
 
 		assert.NotNil(t, actualUser)
 		assert.NotEmpty(t, token)
+		assert.NotEmpty(t, refreshToken)
 		assert.Nil(t, err)
 	})
 
-	t.Run("Test Case 2 | Invalid Login | Wrong Password", func(t *testing.T) {
-		expectedErr := errors.New("wrong password")
+	t.Run("Test Case 2 | Valid Login | Previous failed attempts are cleared", func(t *testing.T) {
+		copyDomain := userDomain
+		copyDomain.Password = string(encryptedPassword)
+		attempt := login_attempts.Domain{Id: primitive.NewObjectID(), Key: accountKey, FailedCount: 2}
+		loginAttemptRepository.On("GetByKey", ipKey).Return(login_attempts.Domain{}, notFound).Once()
+		userRepository.On("GetByEmail", copyDomain.Email).Return(copyDomain, nil).Once()
+		loginAttemptRepository.On("GetByKey", accountKey).Return(attempt, nil).Twice()
+		loginAttemptRepository.On("Delete", attempt.Id).Return(nil).Once()
+		refreshTokenRepository.On("Create", mock.Anything).Return(refreshTokenDomain, nil).Once()
+
+		actualUser, token, refreshToken, err := userUseCase.Login(copyDomain.Email, userDomain.Password, "Mozilla/5.0", "127.0.0.1")
+
+		assert.NotNil(t, actualUser)
+		assert.NotEmpty(t, token)
+		assert.NotEmpty(t, refreshToken)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test Case 3 | Invalid Login | Wrong Password", func(t *testing.T) {
+		expectedErr := errors.New("invalid email/username or password")
+		copyDomain := userDomain
+		copyDomain.Password = string(encryptedPassword)
+		loginAttemptRepository.On("GetByKey", ipKey).Return(login_attempts.Domain{}, notFound).Twice()
+		userRepository.On("GetByEmail", copyDomain.Email).Return(copyDomain, nil).Once()
+		loginAttemptRepository.On("GetByKey", accountKey).Return(login_attempts.Domain{}, notFound).Twice()
+		loginAttemptRepository.On("Create", mock.MatchedBy(func(attempt *login_attempts.Domain) bool {
+			return attempt.Key == accountKey && attempt.FailedCount == 1
+		})).Return(login_attempts.Domain{}, nil).Once()
+		loginAttemptRepository.On("Create", mock.MatchedBy(func(attempt *login_attempts.Domain) bool {
+			return attempt.Key == ipKey && attempt.FailedCount == 1
+		})).Return(login_attempts.Domain{}, nil).Once()
+
+		actualUser, token, refreshToken, err := userUseCase.Login(userDomain.Email, "wrong password", "Mozilla/5.0", "127.0.0.1")
+
+		assert.Equal(t, users.Domain{}, actualUser)
+		assert.Empty(t, token)
+		assert.Empty(t, refreshToken)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test Case 4 | Invalid Login | Unknown account gets the same generic error", func(t *testing.T) {
+		expectedErr := errors.New("invalid email/username or password")
+		unknownKey := "account:unknown@charum.com"
+		loginAttemptRepository.On("GetByKey", ipKey).Return(login_attempts.Domain{}, notFound).Twice()
+		userRepository.On("GetByEmail", "Unknown@charum.com").Return(users.Domain{}, notFound).Once()
+		userRepository.On("GetByUsername", "Unknown@charum.com").Return(users.Domain{}, notFound).Once()
+		loginAttemptRepository.On("GetByKey", unknownKey).Return(login_attempts.Domain{}, notFound).Twice()
+		loginAttemptRepository.On("Create", mock.Anything).Return(login_attempts.Domain{}, nil).Twice()
+
+		actualUser, token, refreshToken, err := userUseCase.Login("Unknown@charum.com", userDomain.Password, "Mozilla/5.0", "127.0.0.1")
+
+		assert.Equal(t, users.Domain{}, actualUser)
+		assert.Empty(t, token)
+		assert.Empty(t, refreshToken)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test Case 5 | Invalid Login | Account is locked after reaching the threshold", func(t *testing.T) {
+		expectedErr := errors.New("invalid email/username or password")
+		copyDomain := userDomain
+		copyDomain.Password = string(encryptedPassword)
+		attempt := login_attempts.Domain{
+			Id:           primitive.NewObjectID(),
+			Key:          accountKey,
+			FailedCount:  4,
+			LastFailedAt: primitive.NewDateTimeFromTime(time.Now()),
+		}
+		loginAttemptRepository.On("GetByKey", ipKey).Return(login_attempts.Domain{}, notFound).Twice()
+		userRepository.On("GetByEmail", copyDomain.Email).Return(copyDomain, nil).Once()
+		loginAttemptRepository.On("GetByKey", accountKey).Return(attempt, nil).Twice()
+		loginAttemptRepository.On("Update", mock.MatchedBy(func(updated *login_attempts.Domain) bool {
+			lockout := time.Until(updated.LockedUntil.Time())
+			return updated.FailedCount == 5 && lockout > 50*time.Second && lockout <= time.Minute
+		})).Return(attempt, nil).Once()
+		loginAttemptRepository.On("Create", mock.Anything).Return(login_attempts.Domain{}, nil).Once()
+
+		actualUser, token, refreshToken, err := userUseCase.Login(copyDomain.Email, "wrong password", "Mozilla/5.0", "127.0.0.1")
+
+		assert.Equal(t, users.Domain{}, actualUser)
+		assert.Empty(t, token)
+		assert.Empty(t, refreshToken)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test Case 6 | Invalid Login | Lockout doubles with every further failure", func(t *testing.T) {
+		copyDomain := userDomain
+		copyDomain.Password = string(encryptedPassword)
+		attempt := login_attempts.Domain{
+			Id:           primitive.NewObjectID(),
+			Key:          accountKey,
+			FailedCount:  7,
+			LastFailedAt: primitive.NewDateTimeFromTime(time.Now()),
+			LockedUntil:  primitive.NewDateTimeFromTime(time.Now().Add(-time.Second)),
+		}
+		loginAttemptRepository.On("GetByKey", ipKey).Return(login_attempts.Domain{}, notFound).Twice()
+		userRepository.On("GetByEmail", copyDomain.Email).Return(copyDomain, nil).Once()
+		loginAttemptRepository.On("GetByKey", accountKey).Return(attempt, nil).Twice()
+		loginAttemptRepository.On("Update", mock.MatchedBy(func(updated *login_attempts.Domain) bool {
+			lockout := time.Until(updated.LockedUntil.Time())
+			return updated.FailedCount == 8 && lockout > 7*time.Minute && lockout <= 8*time.Minute
+		})).Return(attempt, nil).Once()
+		loginAttemptRepository.On("Create", mock.Anything).Return(login_attempts.Domain{}, nil).Once()
+
+		_, _, _, err := userUseCase.Login(copyDomain.Email, "wrong password", "Mozilla/5.0", "127.0.0.1")
+
+		assert.NotNil(t, err)
+	})
+
+	t.Run("Test Case 7 | Invalid Login | Account is locked", func(t *testing.T) {
+		expectedErr := errors.New("too many failed login attempts, please try again later")
 		copyDomain := userDomain
-		copyDomain.Password = "wrong password"
+		copyDomain.Password = string(encryptedPassword)
+		attempt := login_attempts.Domain{
+			Id:          primitive.NewObjectID(),
+			Key:         accountKey,
+			FailedCount: 5,
+			LockedUntil: primitive.NewDateTimeFromTime(time.Now().Add(time.Minute)),
+		}
+		loginAttemptRepository.On("GetByKey", ipKey).Return(login_attempts.Domain{}, notFound).Once()
 		userRepository.On("GetByEmail", copyDomain.Email).Return(copyDomain, nil).Once()
+		loginAttemptRepository.On("GetByKey", accountKey).Return(attempt, nil).Once()
+
+		actualUser, token, refreshToken, err := userUseCase.Login(copyDomain.Email, userDomain.Password, "Mozilla/5.0", "127.0.0.1")
+
+		assert.Equal(t, users.Domain{}, actualUser)
+		assert.Empty(t, token)
+		assert.Empty(t, refreshToken)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test Case 8 | Invalid Login | IP address is locked", func(t *testing.T) {
+		expectedErr := errors.New("too many failed login attempts, please try again later")
+		attempt := login_attempts.Domain{
+			Id:          primitive.NewObjectID(),
+			Key:         ipKey,
+			FailedCount: 20,
+			LockedUntil: primitive.NewDateTimeFromTime(time.Now().Add(time.Minute)),
+		}
+		loginAttemptRepository.On("GetByKey", ipKey).Return(attempt, nil).Once()
 
-		actualUser, token, err := userUseCase.Login(userDomain.Email, userDomain.Password)
+		actualUser, token, refreshToken, err := userUseCase.Login(userDomain.Email, userDomain.Password, "Mozilla/5.0", "127.0.0.1")
 
 		assert.Equal(t, users.Domain{}, actualUser)
 		assert.Empty(t, token)
+		assert.Empty(t, refreshToken)
 		assert.Equal(t, expectedErr, err)
 	})
 
-	t.Run("Test Case 3 | Invalid Login | Email or username is not registered", func(t *testing.T) {
-		expectedErr := errors.New("email or username is not registered")
-		userRepository.On("GetByEmail", userDomain.Email).Return(users.Domain{}, expectedErr).Once()
-		userRepository.On("GetByUsername", userDomain.Email).Return(users.Domain{}, expectedErr).Once()
+	t.Run("Test Case 9 | Invalid Login | Failed to create refresh token", func(t *testing.T) {
+		expectedErr := errors.New("failed to create refresh token")
+		copyDomain := userDomain
+		copyDomain.Password = string(encryptedPassword)
+		loginAttemptRepository.On("GetByKey", ipKey).Return(login_attempts.Domain{}, notFound).Once()
+		userRepository.On("GetByEmail", copyDomain.Email).Return(copyDomain, nil).Once()
+		loginAttemptRepository.On("GetByKey", accountKey).Return(login_attempts.Domain{}, notFound).Twice()
+		refreshTokenRepository.On("Create", mock.Anything).Return(refresh_tokens.Domain{}, errors.New("failed")).Once()
 
-		actualUser, token, err := userUseCase.Login(userDomain.Email, userDomain.Password)
+		actualUser, token, refreshToken, err := userUseCase.Login(copyDomain.Email, userDomain.Password, "Mozilla/5.0", "127.0.0.1")
 
 		assert.Equal(t, users.Domain{}, actualUser)
 		assert.Empty(t, token)
+		assert.Empty(t, refreshToken)
 		assert.Equal(t, expectedErr, err)
 	})
 
-	t.Run("Test Case 4 | Invalid Login | User is suspended", func(t *testing.T) {
+	t.Run("Test Case 10 | Invalid Login | User is suspended", func(t *testing.T) {
 		expectedErr := errors.New("user is suspended")
 		copyDomain := userDomain
+		copyDomain.Password = string(encryptedPassword)
 		copyDomain.IsActive = false
-		userRepository.On("GetByEmail", userDomain.Email).Return(copyDomain, nil).Once()
+		loginAttemptRepository.On("GetByKey", ipKey).Return(login_attempts.Domain{}, notFound).Once()
+		userRepository.On("GetByEmail", copyDomain.Email).Return(copyDomain, nil).Once()
+		loginAttemptRepository.On("GetByKey", accountKey).Return(login_attempts.Domain{}, notFound).Twice()
 
-		actualUser, token, err := userUseCase.Login(userDomain.Email, userDomain.Password)
+		actualUser, token, refreshToken, err := userUseCase.Login(copyDomain.Email, userDomain.Password, "Mozilla/5.0", "127.0.0.1")
 
+		claims, claimsErr := util.GetAppealPayload(token)
+		assert.Nil(t, claimsErr)
+		assert.Equal(t, copyDomain.Id.Hex(), claims.UID)
 		assert.Equal(t, users.Domain{}, actualUser)
-		assert.Empty(t, token)
+		assert.Empty(t, refreshToken)
 		assert.Equal(t, expectedErr, err)
 	})
+
+	t.Run("Test Case 11 | Valid Login | Two-factor authentication is required", func(t *testing.T) {
+		copyDomain := userDomain
+		copyDomain.Password = string(encryptedPassword)
+		copyDomain.TwoFactorEnabled = true
+		loginAttemptRepository.On("GetByKey", ipKey).Return(login_attempts.Domain{}, notFound).Once()
+		userRepository.On("GetByEmail", copyDomain.Email).Return(copyDomain, nil).Once()
+		loginAttemptRepository.On("GetByKey", accountKey).Return(login_attempts.Domain{}, notFound).Twice()
+
+		actualUser, token, refreshToken, err := userUseCase.Login(copyDomain.Email, userDomain.Password, "Mozilla/5.0", "127.0.0.1")
+
+		assert.True(t, actualUser.TwoFactorEnabled)
+		assert.NotEmpty(t, token)
+		assert.Empty(t, refreshToken)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test Case 12 | Valid Login | Pending account deletion is cancelled", func(t *testing.T) {
+		copyDomain := userDomain
+		copyDomain.Password = string(encryptedPassword)
+		copyDomain.DeletionScheduledAt = primitive.NewDateTimeFromTime(time.Now().Add(24 * time.Hour))
+		loginAttemptRepository.On("GetByKey", ipKey).Return(login_attempts.Domain{}, notFound).Once()
+		userRepository.On("GetByEmail", copyDomain.Email).Return(copyDomain, nil).Once()
+		loginAttemptRepository.On("GetByKey", accountKey).Return(login_attempts.Domain{}, notFound).Twice()
+		userRepository.On("Update", mock.MatchedBy(func(user *users.Domain) bool {
+			return user.Id == copyDomain.Id && user.DeletionScheduledAt == 0
+		})).Return(userDomain, nil).Once()
+		refreshTokenRepository.On("Create", mock.Anything).Return(refreshTokenDomain, nil).Once()
+
+		actualUser, token, refreshToken, err := userUseCase.Login(copyDomain.Email, userDomain.Password, "Mozilla/5.0", "127.0.0.1")
+
+		assert.Equal(t, primitive.DateTime(0), actualUser.DeletionScheduledAt)
+		assert.NotEmpty(t, token)
+		assert.NotEmpty(t, refreshToken)
+		assert.Nil(t, err)
+	})
 }
 
 func TestGetWithSortAndOrder(t *testing.T) {
@@ -257,6 +511,29 @@ func TestGetByID(t *testing.T) {
 	})
 }
 
+func TestGetByUsername(t *testing.T) {
+	t.Run("Test Case 1 | Valid Get User By Username", func(t *testing.T) {
+		publicDomain := userDomain
+		publicDomain.UserName = "public-profile"
+		userRepository.On("GetByUsername", publicDomain.UserName).Return(publicDomain, nil).Once()
+
+		actualUser, actualErr := userUseCase.GetByUsername(publicDomain.UserName)
+
+		assert.Equal(t, publicDomain, actualUser)
+		assert.Nil(t, actualErr)
+	})
+
+	t.Run("Test Case 2 | Invalid Get User By Username | User not found", func(t *testing.T) {
+		expectedErr := errors.New("failed to get user")
+		userRepository.On("GetByUsername", "unknown-profile").Return(users.Domain{}, errors.New("not found")).Once()
+
+		actualUser, actualErr := userUseCase.GetByUsername("unknown-profile")
+
+		assert.Equal(t, users.Domain{}, actualUser)
+		assert.Equal(t, expectedErr, actualErr)
+	})
+}
+
 func TestUpdate(t *testing.T) {
 	t.Run("Test Case 1 | Valid Update", func(t *testing.T) {
 		copyDomain := userDomain
@@ -271,6 +548,7 @@ func TestUpdate(t *testing.T) {
 		cloudinaryRepository.On("Delete", mock.Anything, mock.Anything).Return(nil).Once()
 		cloudinaryRepository.On("Upload", mock.Anything, mock.Anything, mock.Anything).Return("image", nil).Once()
 		userRepository.On("Update", mock.Anything).Return(copyDomain, nil).Once()
+		mailgun.On("SendVerificationMail", copyDomain.Email, mock.Anything).Return("", nil).Once()
 
 		actualUser, actualErr := userUseCase.Update(&copyDomain, image)
 
@@ -356,6 +634,23 @@ func TestUpdate(t *testing.T) {
 		assert.Equal(t, users.Domain{}, actualUser)
 		assert.Equal(t, expectedErr, actualErr)
 	})
+
+	t.Run("Test Case 8 | Valid Update | Failed verification email does not fail the update", func(t *testing.T) {
+		copyDomain := userDomain
+		copyDomain.Email = "newEmail@charum.com"
+
+		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
+		userRepository.On("GetByEmail", copyDomain.Email).Return(users.Domain{}, errors.New("not found")).Once()
+		userRepository.On("Update", mock.MatchedBy(func(user *users.Domain) bool {
+			return user.Email == copyDomain.Email && !user.EmailVerified
+		})).Return(copyDomain, nil).Once()
+		mailgun.On("SendVerificationMail", copyDomain.Email, mock.Anything).Return("", errors.New("mailgun error")).Once()
+
+		actualUser, actualErr := userUseCase.Update(&copyDomain, nil)
+
+		assert.Equal(t, copyDomain, actualUser)
+		assert.Nil(t, actualErr)
+	})
 }
 
 func TestUpdatePassword(t *testing.T) {
@@ -368,7 +663,8 @@ func TestUpdatePassword(t *testing.T) {
 
 		userRepository.On("GetByID", userDomain.Id).Return(copyDomain, nil).Once()
 		userRepository.On("UpdatePassword", mock.Anything).Return(copyDomain, nil).Once()
-		actualUser, actualErr := userUseCase.UpdatePassword(&copyDomain)
+		refreshTokenRepository.On("RevokeOthersByUserID", userDomain.Id, "current-token-id").Return(nil).Once()
+		actualUser, actualErr := userUseCase.UpdatePassword(&copyDomain, "current-token-id")
 
 		assert.NotNil(t, actualUser)
 		assert.Nil(t, actualErr)
@@ -382,7 +678,7 @@ func TestUpdatePassword(t *testing.T) {
 
 		userRepository.On("GetByID", userDomain.Id).Return(users.Domain{}, expectedErr).Once()
 
-		actualUser, actualErr := userUseCase.UpdatePassword(&copyDomain)
+		actualUser, actualErr := userUseCase.UpdatePassword(&copyDomain, "current-token-id")
 
 		assert.Equal(t, users.Domain{}, actualUser)
 		assert.Equal(t, expectedErr, actualErr)
@@ -399,7 +695,7 @@ func TestUpdatePassword(t *testing.T) {
 		userRepository.On("GetByID", userDomain.Id).Return(copyDomain, nil).Once()
 		userRepository.On("UpdatePassword", mock.Anything).Return(users.Domain{}, expectedErr).Once()
 
-		actualUser, actualErr := userUseCase.UpdatePassword(&copyDomain)
+		actualUser, actualErr := userUseCase.UpdatePassword(&copyDomain, "current-token-id")
 
 		assert.Equal(t, users.Domain{}, actualUser)
 		assert.Equal(t, expectedErr, actualErr)
@@ -411,7 +707,25 @@ func TestUpdatePassword(t *testing.T) {
 		copyDomain.OldPassword = "wrong password"
 		userRepository.On("GetByID", copyDomain.Id).Return(copyDomain, nil).Once()
 
-		actualUser, actualErr := userUseCase.UpdatePassword(&copyDomain)
+		actualUser, actualErr := userUseCase.UpdatePassword(&copyDomain, "current-token-id")
+
+		assert.Equal(t, users.Domain{}, actualUser)
+		assert.Equal(t, expectedErr, actualErr)
+	})
+
+	t.Run("Test Case 5 | Invalid Update Password | Error when revoking other sessions", func(t *testing.T) {
+		expectedErr := errors.New("failed to revoke sessions")
+		copyDomain := userDomain
+		encryptedPassword, _ := bcrypt.GenerateFromPassword([]byte(userDomain.Password), bcrypt.DefaultCost)
+		copyDomain.Password = string(encryptedPassword)
+		copyDomain.OldPassword = userDomain.Password
+		copyDomain.NewPassword = "newpassword"
+
+		userRepository.On("GetByID", userDomain.Id).Return(copyDomain, nil).Once()
+		userRepository.On("UpdatePassword", mock.Anything).Return(copyDomain, nil).Once()
+		refreshTokenRepository.On("RevokeOthersByUserID", userDomain.Id, "current-token-id").Return(errors.New("unexpected error")).Once()
+
+		actualUser, actualErr := userUseCase.UpdatePassword(&copyDomain, "current-token-id")
 
 		assert.Equal(t, users.Domain{}, actualUser)
 		assert.Equal(t, expectedErr, actualErr)
@@ -423,6 +737,7 @@ func TestDelete(t *testing.T) {
 		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
 		cloudinaryRepository.On("Delete", mock.Anything, mock.Anything).Return(nil).Once()
 		userRepository.On("Delete", userDomain.Id).Return(nil).Once()
+		refreshTokenRepository.On("RevokeAllByUserID", userDomain.Id).Return(nil).Once()
 
 		actualUser, actualErr := userUseCase.Delete(userDomain.Id)
 
@@ -444,6 +759,7 @@ func TestDelete(t *testing.T) {
 		expectedErr := errors.New("failed to delete user")
 		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
 		cloudinaryRepository.On("Delete", mock.Anything, mock.Anything).Return(nil).Once()
+		refreshTokenRepository.On("RevokeAllByUserID", userDomain.Id).Return(nil).Once()
 		userRepository.On("Delete", userDomain.Id).Return(expectedErr).Once()
 
 		actualUser, actualErr := userUseCase.Delete(userDomain.Id)
@@ -464,47 +780,130 @@ func TestDelete(t *testing.T) {
 	})
 }
 
+func TestCheckDeletable(t *testing.T) {
+	t.Run("Test Case 1 | Valid Check Deletable", func(t *testing.T) {
+		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
+
+		actualUser, actualErr := userUseCase.CheckDeletable(userDomain.Id)
+
+		assert.Equal(t, userDomain, actualUser)
+		assert.Nil(t, actualErr)
+	})
+
+	t.Run("Test Case 2 | Invalid Check Deletable | Admin cannot be deleted", func(t *testing.T) {
+		expectedErr := errors.New("admin cannot be deleted")
+		copyDomain := userDomain
+		copyDomain.Role = "admin"
+		userRepository.On("GetByID", copyDomain.Id).Return(copyDomain, nil).Once()
+
+		actualUser, actualErr := userUseCase.CheckDeletable(copyDomain.Id)
+
+		assert.Equal(t, users.Domain{}, actualUser)
+		assert.Equal(t, expectedErr, actualErr)
+	})
+}
+
 func TestSuspend(t *testing.T) {
 	t.Run("Test Case 1 | Valid Suspend", func(t *testing.T) {
+		copySuspension := suspensionDomain
+		copySuspension.UserID = userDomain.Id
 		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
 		userRepository.On("Update", mock.Anything).Return(userDomain, nil).Once()
+		suspensionRepository.On("Create", mock.Anything).Return(suspensionDomain, nil).Once()
+		refreshTokenRepository.On("RevokeAllByUserID", userDomain.Id).Return(nil).Once()
 
-		actualUser, actualErr := userUseCase.Suspend(userDomain.Id)
+		actualUser, actualSuspension, actualErr := userUseCase.Suspend(&copySuspension)
 
 		assert.NotNil(t, actualUser)
+		assert.Equal(t, suspensionDomain, actualSuspension)
 		assert.Nil(t, actualErr)
 	})
 
 	t.Run("Test Case 2 | Invalid Suspend | Error when getting user", func(t *testing.T) {
 		expectedErr := errors.New("failed to get user")
+		copySuspension := suspensionDomain
+		copySuspension.UserID = userDomain.Id
 		userRepository.On("GetByID", userDomain.Id).Return(users.Domain{}, expectedErr).Once()
 
-		actualUser, actualErr := userUseCase.Suspend(userDomain.Id)
+		actualUser, actualSuspension, actualErr := userUseCase.Suspend(&copySuspension)
 
 		assert.Equal(t, users.Domain{}, actualUser)
+		assert.Equal(t, suspensions.Domain{}, actualSuspension)
 		assert.Equal(t, expectedErr, actualErr)
 	})
 
 	t.Run("Test Case 3 | Invalid Suspend | Error when suspending user", func(t *testing.T) {
 		expectedErr := errors.New("failed to suspend user")
+		copySuspension := suspensionDomain
+		copySuspension.UserID = userDomain.Id
 		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
 		userRepository.On("Update", mock.Anything).Return(users.Domain{}, expectedErr).Once()
 
-		actualUser, actualErr := userUseCase.Suspend(userDomain.Id)
+		actualUser, actualSuspension, actualErr := userUseCase.Suspend(&copySuspension)
 
 		assert.Equal(t, users.Domain{}, actualUser)
+		assert.Equal(t, suspensions.Domain{}, actualSuspension)
 		assert.Equal(t, expectedErr, actualErr)
 	})
 
 	t.Run("Test Case 4 | Invalid Suspend | User is already suspended", func(t *testing.T) {
 		expectedErr := errors.New("user is already suspended")
+		copySuspension := suspensionDomain
+		copySuspension.UserID = userDomain.Id
 		copyDomain := userDomain
 		copyDomain.IsActive = false
 		userRepository.On("GetByID", userDomain.Id).Return(copyDomain, nil).Once()
 
-		actualUser, actualErr := userUseCase.Suspend(userDomain.Id)
+		actualUser, actualSuspension, actualErr := userUseCase.Suspend(&copySuspension)
+
+		assert.Equal(t, users.Domain{}, actualUser)
+		assert.Equal(t, suspensions.Domain{}, actualSuspension)
+		assert.Equal(t, expectedErr, actualErr)
+	})
+
+	t.Run("Test Case 5 | Invalid Suspend | Invalid suspension reason", func(t *testing.T) {
+		expectedErr := errors.New("invalid suspension reason")
+		copySuspension := suspensionDomain
+		copySuspension.UserID = userDomain.Id
+		copySuspension.Reason = "being annoying"
+
+		actualUser, actualSuspension, actualErr := userUseCase.Suspend(&copySuspension)
+
+		assert.Equal(t, users.Domain{}, actualUser)
+		assert.Equal(t, suspensions.Domain{}, actualSuspension)
+		assert.Equal(t, expectedErr, actualErr)
+	})
+
+	t.Run("Test Case 6 | Invalid Suspend | End time is in the past", func(t *testing.T) {
+		expectedErr := errors.New("suspension end time must be in the future")
+		copySuspension := suspensionDomain
+		copySuspension.UserID = userDomain.Id
+		copySuspension.EndAt = primitive.NewDateTimeFromTime(time.Now().Add(-time.Hour))
+
+		actualUser, actualSuspension, actualErr := userUseCase.Suspend(&copySuspension)
 
 		assert.Equal(t, users.Domain{}, actualUser)
+		assert.Equal(t, suspensions.Domain{}, actualSuspension)
+		assert.Equal(t, expectedErr, actualErr)
+	})
+
+	t.Run("Test Case 7 | Invalid Suspend | Error when creating suspension", func(t *testing.T) {
+		expectedErr := errors.New("failed to create suspension")
+		copySuspension := suspensionDomain
+		copySuspension.UserID = userDomain.Id
+		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
+		userRepository.On("Update", mock.MatchedBy(func(user *users.Domain) bool {
+			return !user.IsActive
+		})).Return(userDomain, nil).Once()
+		suspensionRepository.On("Create", mock.Anything).Return(suspensions.Domain{}, errors.New("unexpected error")).Once()
+		userRepository.On("Update", mock.MatchedBy(func(user *users.Domain) bool {
+			return user.IsActive
+		})).Return(userDomain, nil).Once()
+
+		actualUser, actualSuspension, actualErr := userUseCase.Suspend(&copySuspension)
+
+		assert.Equal(t, users.Domain{}, actualUser)
+		assert.Equal(t, suspensions.Domain{}, actualSuspension)
 		assert.Equal(t, expectedErr, actualErr)
 	})
 }
@@ -515,8 +914,10 @@ func TestUnsuspend(t *testing.T) {
 		copyDomain.IsActive = false
 		userRepository.On("GetByID", userDomain.Id).Return(copyDomain, nil).Once()
 		userRepository.On("Update", mock.Anything).Return(userDomain, nil).Once()
+		suspensionRepository.On("GetActiveByUserID", userDomain.Id).Return(suspensionDomain, nil).Once()
+		suspensionRepository.On("Update", mock.Anything).Return(suspensionDomain, nil).Once()
 
-		actualUser, actualErr := userUseCase.Unsuspend(userDomain.Id)
+		actualUser, actualErr := userUseCase.Unsuspend(userDomain.Id, suspensionDomain.AdminID)
 
 		assert.NotNil(t, actualUser)
 		assert.Nil(t, actualErr)
@@ -526,7 +927,7 @@ func TestUnsuspend(t *testing.T) {
 		expectedErr := errors.New("failed to get user")
 		userRepository.On("GetByID", userDomain.Id).Return(users.Domain{}, expectedErr).Once()
 
-		actualUser, actualErr := userUseCase.Unsuspend(userDomain.Id)
+		actualUser, actualErr := userUseCase.Unsuspend(userDomain.Id, suspensionDomain.AdminID)
 
 		assert.Equal(t, users.Domain{}, actualUser)
 		assert.Equal(t, expectedErr, actualErr)
@@ -539,7 +940,7 @@ func TestUnsuspend(t *testing.T) {
 		userRepository.On("GetByID", userDomain.Id).Return(copyDomain, nil).Once()
 		userRepository.On("Update", mock.Anything).Return(users.Domain{}, expectedErr).Once()
 
-		actualUser, actualErr := userUseCase.Unsuspend(userDomain.Id)
+		actualUser, actualErr := userUseCase.Unsuspend(userDomain.Id, suspensionDomain.AdminID)
 
 		assert.Equal(t, users.Domain{}, actualUser)
 		assert.Equal(t, expectedErr, actualErr)
@@ -549,30 +950,1241 @@ func TestUnsuspend(t *testing.T) {
 		expectedErr := errors.New("user is not suspended")
 		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
 
-		actualUser, actualErr := userUseCase.Unsuspend(userDomain.Id)
+		actualUser, actualErr := userUseCase.Unsuspend(userDomain.Id, suspensionDomain.AdminID)
+
+		assert.Equal(t, users.Domain{}, actualUser)
+		assert.Equal(t, expectedErr, actualErr)
+	})
+
+	t.Run("Test Case 5 | Valid Unsuspend | User has no suspension record", func(t *testing.T) {
+		copyDomain := userDomain
+		copyDomain.IsActive = false
+		userRepository.On("GetByID", userDomain.Id).Return(copyDomain, nil).Once()
+		userRepository.On("Update", mock.Anything).Return(userDomain, nil).Once()
+		suspensionRepository.On("GetActiveByUserID", userDomain.Id).Return(suspensions.Domain{}, errors.New("not found")).Once()
+
+		actualUser, actualErr := userUseCase.Unsuspend(userDomain.Id, suspensionDomain.AdminID)
+
+		assert.Equal(t, userDomain, actualUser)
+		assert.Nil(t, actualErr)
+	})
+
+	t.Run("Test Case 6 | Invalid Unsuspend | Error when lifting suspension", func(t *testing.T) {
+		expectedErr := errors.New("failed to lift suspension")
+		copyDomain := userDomain
+		copyDomain.IsActive = false
+		userRepository.On("GetByID", userDomain.Id).Return(copyDomain, nil).Once()
+		userRepository.On("Update", mock.Anything).Return(userDomain, nil).Once()
+		suspensionRepository.On("GetActiveByUserID", userDomain.Id).Return(suspensionDomain, nil).Once()
+		suspensionRepository.On("Update", mock.Anything).Return(suspensions.Domain{}, errors.New("unexpected error")).Once()
+
+		actualUser, actualErr := userUseCase.Unsuspend(userDomain.Id, suspensionDomain.AdminID)
 
 		assert.Equal(t, users.Domain{}, actualUser)
 		assert.Equal(t, expectedErr, actualErr)
 	})
 }
 
-func TestGetAll(t *testing.T) {
-	t.Run("Test Case 1 | Valid GetAll", func(t *testing.T) {
-		userRepository.On("GetAll").Return([]users.Domain{userDomain}, nil).Once()
+func TestGetSuspensions(t *testing.T) {
+	t.Run("Test Case 1 | Valid Get Suspensions", func(t *testing.T) {
+		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
+		suspensionRepository.On("GetAllByUserID", userDomain.Id).Return([]suspensions.Domain{suspensionDomain}, nil).Once()
 
-		actualUser, actualErr := userUseCase.GetAll()
+		actualSuspensions, actualErr := userUseCase.GetSuspensions(userDomain.Id)
 
-		assert.NotNil(t, actualUser)
+		assert.Equal(t, []suspensions.Domain{suspensionDomain}, actualSuspensions)
 		assert.Nil(t, actualErr)
 	})
 
-	t.Run("Test Case 2 | Invalid GetAll | Error when getting all users", func(t *testing.T) {
-		expectedErr := errors.New("failed to get all users")
-		userRepository.On("GetAll").Return([]users.Domain{}, expectedErr).Once()
+	t.Run("Test Case 2 | Invalid Get Suspensions | Error when getting user", func(t *testing.T) {
+		expectedErr := errors.New("failed to get user")
+		userRepository.On("GetByID", userDomain.Id).Return(users.Domain{}, errors.New("not found")).Once()
 
-		actualUser, actualErr := userUseCase.GetAll()
+		actualSuspensions, actualErr := userUseCase.GetSuspensions(userDomain.Id)
 
-		assert.Equal(t, 0, actualUser)
+		assert.Equal(t, []suspensions.Domain{}, actualSuspensions)
+		assert.Equal(t, expectedErr, actualErr)
+	})
+
+	t.Run("Test Case 3 | Invalid Get Suspensions | Error when getting suspensions", func(t *testing.T) {
+		expectedErr := errors.New("failed to get suspensions")
+		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
+		suspensionRepository.On("GetAllByUserID", userDomain.Id).Return([]suspensions.Domain{}, errors.New("unexpected error")).Once()
+
+		actualSuspensions, actualErr := userUseCase.GetSuspensions(userDomain.Id)
+
+		assert.Equal(t, []suspensions.Domain{}, actualSuspensions)
+		assert.Equal(t, expectedErr, actualErr)
+	})
+}
+
+func TestLiftExpiredSuspensions(t *testing.T) {
+	t.Run("Test Case 1 | Valid Lift Expired Suspensions", func(t *testing.T) {
+		copySuspension := suspensionDomain
+		copySuspension.UserID = userDomain.Id
+		copyDomain := userDomain
+		copyDomain.IsActive = false
+		suspensionRepository.On("GetAllExpired", mock.Anything).Return([]suspensions.Domain{copySuspension}, nil).Once()
+		userRepository.On("GetByID", userDomain.Id).Return(copyDomain, nil).Once()
+		userRepository.On("Update", mock.Anything).Return(userDomain, nil).Once()
+		suspensionRepository.On("GetActiveByUserID", userDomain.Id).Return(suspensionDomain, nil).Once()
+		suspensionRepository.On("Update", mock.MatchedBy(func(suspension *suspensions.Domain) bool {
+			return suspension.LiftedAt != 0 && suspension.LiftedBy == primitive.NilObjectID
+		})).Return(suspensionDomain, nil).Once()
+
+		actualUsers, actualErr := userUseCase.LiftExpiredSuspensions()
+
+		assert.Equal(t, []users.Domain{userDomain}, actualUsers)
+		assert.Nil(t, actualErr)
+	})
+
+	t.Run("Test Case 2 | Invalid Lift Expired Suspensions | Error when getting expired suspensions", func(t *testing.T) {
+		expectedErr := errors.New("failed to get expired suspensions")
+		suspensionRepository.On("GetAllExpired", mock.Anything).Return([]suspensions.Domain{}, errors.New("unexpected error")).Once()
+
+		actualUsers, actualErr := userUseCase.LiftExpiredSuspensions()
+
+		assert.Equal(t, []users.Domain{}, actualUsers)
+		assert.Equal(t, expectedErr, actualErr)
+	})
+
+	t.Run("Test Case 3 | Invalid Lift Expired Suspensions | Error when getting user", func(t *testing.T) {
+		expectedErr := errors.New("failed to lift expired suspensions")
+		copySuspension := suspensionDomain
+		copySuspension.UserID = userDomain.Id
+		suspensionRepository.On("GetAllExpired", mock.Anything).Return([]suspensions.Domain{copySuspension}, nil).Once()
+		userRepository.On("GetByID", userDomain.Id).Return(users.Domain{}, errors.New("not found")).Once()
+
+		actualUsers, actualErr := userUseCase.LiftExpiredSuspensions()
+
+		assert.Equal(t, []users.Domain{}, actualUsers)
+		assert.Equal(t, expectedErr, actualErr)
+	})
+}
+
+func TestRefreshToken(t *testing.T) {
+	t.Run("Test Case 1 | Valid Refresh Token", func(t *testing.T) {
+		refreshTokenRepository.On("GetByToken", util.HashToken("refreshToken")).Return(refreshTokenDomain, nil).Once()
+		userRepository.On("GetByID", refreshTokenDomain.UserID).Return(userDomain, nil).Once()
+		refreshTokenRepository.On("Update", mock.Anything).Return(refreshTokenDomain, nil).Once()
+
+		token, refreshToken, err := userUseCase.RefreshToken("refreshToken")
+
+		assert.NotEmpty(t, token)
+		assert.NotEmpty(t, refreshToken)
+		assert.NotEqual(t, "refreshToken", refreshToken)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test Case 2 | Invalid Refresh Token | Token not found", func(t *testing.T) {
+		expectedErr := errors.New("failed to get refresh token")
+		refreshTokenRepository.On("GetByToken", util.HashToken("refreshToken")).Return(refresh_tokens.Domain{}, errors.New("not found")).Once()
+		refreshTokenRepository.On("GetByPreviousToken", util.HashToken("refreshToken")).Return(refresh_tokens.Domain{}, errors.New("not found")).Once()
+
+		token, refreshToken, err := userUseCase.RefreshToken("refreshToken")
+
+		assert.Empty(t, token)
+		assert.Empty(t, refreshToken)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test Case 3 | Invalid Refresh Token | Reused rotated token", func(t *testing.T) {
+		expectedErr := errors.New("refresh token has been revoked")
+		refreshTokenRepository.On("GetByToken", util.HashToken("refreshToken")).Return(refresh_tokens.Domain{}, errors.New("not found")).Once()
+		refreshTokenRepository.On("GetByPreviousToken", util.HashToken("refreshToken")).Return(refreshTokenDomain, nil).Once()
+		refreshTokenRepository.On("Update", mock.Anything).Return(refreshTokenDomain, nil).Once()
+
+		token, refreshToken, err := userUseCase.RefreshToken("refreshToken")
+
+		assert.Empty(t, token)
+		assert.Empty(t, refreshToken)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test Case 4 | Invalid Refresh Token | Token is revoked", func(t *testing.T) {
+		expectedErr := errors.New("refresh token has been revoked")
+		copyDomain := refreshTokenDomain
+		copyDomain.IsRevoked = true
+		refreshTokenRepository.On("GetByToken", util.HashToken("refreshToken")).Return(copyDomain, nil).Once()
+
+		token, refreshToken, err := userUseCase.RefreshToken("refreshToken")
+
+		assert.Empty(t, token)
+		assert.Empty(t, refreshToken)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test Case 5 | Invalid Refresh Token | Token has expired", func(t *testing.T) {
+		expectedErr := errors.New("refresh token has expired")
+		copyDomain := refreshTokenDomain
+		copyDomain.ExpiredAt = primitive.NewDateTimeFromTime(time.Now().Add(-time.Hour))
+		refreshTokenRepository.On("GetByToken", util.HashToken("refreshToken")).Return(copyDomain, nil).Once()
+
+		token, refreshToken, err := userUseCase.RefreshToken("refreshToken")
+
+		assert.Empty(t, token)
+		assert.Empty(t, refreshToken)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test Case 6 | Invalid Refresh Token | User is suspended", func(t *testing.T) {
+		expectedErr := errors.New("user is suspended")
+		copyDomain := userDomain
+		copyDomain.IsActive = false
+		refreshTokenRepository.On("GetByToken", util.HashToken("refreshToken")).Return(refreshTokenDomain, nil).Once()
+		userRepository.On("GetByID", refreshTokenDomain.UserID).Return(copyDomain, nil).Once()
+
+		token, refreshToken, err := userUseCase.RefreshToken("refreshToken")
+
+		assert.Empty(t, token)
+		assert.Empty(t, refreshToken)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test Case 7 | Invalid Refresh Token | Error when updating token", func(t *testing.T) {
+		expectedErr := errors.New("failed to update refresh token")
+		refreshTokenRepository.On("GetByToken", util.HashToken("refreshToken")).Return(refreshTokenDomain, nil).Once()
+		userRepository.On("GetByID", refreshTokenDomain.UserID).Return(userDomain, nil).Once()
+		refreshTokenRepository.On("Update", mock.Anything).Return(refresh_tokens.Domain{}, errors.New("failed")).Once()
+
+		token, refreshToken, err := userUseCase.RefreshToken("refreshToken")
+
+		assert.Empty(t, token)
+		assert.Empty(t, refreshToken)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestLogout(t *testing.T) {
+	t.Run("Test Case 1 | Valid Logout", func(t *testing.T) {
+		refreshTokenRepository.On("GetByAccessTokenID", refreshTokenDomain.AccessTokenID).Return(refreshTokenDomain, nil).Once()
+		refreshTokenRepository.On("Update", mock.Anything).Return(refreshTokenDomain, nil).Once()
+
+		err := userUseCase.Logout(refreshTokenDomain.AccessTokenID)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test Case 2 | Invalid Logout | Token not found", func(t *testing.T) {
+		expectedErr := errors.New("failed to get refresh token")
+		refreshTokenRepository.On("GetByAccessTokenID", refreshTokenDomain.AccessTokenID).Return(refresh_tokens.Domain{}, errors.New("not found")).Once()
+
+		err := userUseCase.Logout(refreshTokenDomain.AccessTokenID)
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test Case 3 | Invalid Logout | Token already revoked", func(t *testing.T) {
+		expectedErr := errors.New("refresh token has been revoked")
+		copyDomain := refreshTokenDomain
+		copyDomain.IsRevoked = true
+		refreshTokenRepository.On("GetByAccessTokenID", refreshTokenDomain.AccessTokenID).Return(copyDomain, nil).Once()
+
+		err := userUseCase.Logout(refreshTokenDomain.AccessTokenID)
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestGetSessions(t *testing.T) {
+	t.Run("Test Case 1 | Valid Get Sessions", func(t *testing.T) {
+		refreshTokenRepository.On("GetAllActiveByUserID", refreshTokenDomain.UserID).Return([]refresh_tokens.Domain{refreshTokenDomain}, nil).Once()
+
+		actualSessions, err := userUseCase.GetSessions(refreshTokenDomain.UserID)
+
+		assert.NotNil(t, actualSessions)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test Case 2 | Invalid Get Sessions | Error when getting sessions", func(t *testing.T) {
+		expectedErr := errors.New("failed to get sessions")
+		refreshTokenRepository.On("GetAllActiveByUserID", refreshTokenDomain.UserID).Return([]refresh_tokens.Domain{}, expectedErr).Once()
+
+		actualSessions, err := userUseCase.GetSessions(refreshTokenDomain.UserID)
+
+		assert.Equal(t, []refresh_tokens.Domain{}, actualSessions)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestRevokeSession(t *testing.T) {
+	t.Run("Test Case 1 | Valid Revoke Session", func(t *testing.T) {
+		copyDomain := refreshTokenDomain
+		refreshTokenRepository.On("GetByID", refreshTokenDomain.Id).Return(copyDomain, nil).Once()
+		refreshTokenRepository.On("Update", mock.Anything).Return(refreshTokenDomain, nil).Once()
+
+		err := userUseCase.RevokeSession(refreshTokenDomain.UserID, refreshTokenDomain.Id)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test Case 2 | Invalid Revoke Session | Session not found", func(t *testing.T) {
+		expectedErr := errors.New("failed to get session")
+		refreshTokenRepository.On("GetByID", refreshTokenDomain.Id).Return(refresh_tokens.Domain{}, expectedErr).Once()
+
+		err := userUseCase.RevokeSession(refreshTokenDomain.UserID, refreshTokenDomain.Id)
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test Case 3 | Invalid Revoke Session | User are not the owner of this session", func(t *testing.T) {
+		expectedErr := errors.New("user are not the owner of this session")
+		refreshTokenRepository.On("GetByID", refreshTokenDomain.Id).Return(refreshTokenDomain, nil).Once()
+
+		err := userUseCase.RevokeSession(primitive.NewObjectID(), refreshTokenDomain.Id)
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test Case 4 | Invalid Revoke Session | Session has been revoked", func(t *testing.T) {
+		expectedErr := errors.New("session has been revoked")
+		copyDomain := refreshTokenDomain
+		copyDomain.IsRevoked = true
+		refreshTokenRepository.On("GetByID", refreshTokenDomain.Id).Return(copyDomain, nil).Once()
+
+		err := userUseCase.RevokeSession(refreshTokenDomain.UserID, refreshTokenDomain.Id)
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test Case 5 | Invalid Revoke Session | Error when revoking session", func(t *testing.T) {
+		expectedErr := errors.New("failed to revoke session")
+		copyDomain := refreshTokenDomain
+		refreshTokenRepository.On("GetByID", refreshTokenDomain.Id).Return(copyDomain, nil).Once()
+		refreshTokenRepository.On("Update", mock.Anything).Return(refresh_tokens.Domain{}, expectedErr).Once()
+
+		err := userUseCase.RevokeSession(refreshTokenDomain.UserID, refreshTokenDomain.Id)
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestRevokeAllSessions(t *testing.T) {
+	t.Run("Test Case 1 | Valid Revoke All Sessions", func(t *testing.T) {
+		refreshTokenRepository.On("RevokeAllByUserID", refreshTokenDomain.UserID).Return(nil).Once()
+
+		err := userUseCase.RevokeAllSessions(refreshTokenDomain.UserID)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test Case 2 | Invalid Revoke All Sessions | Error when revoking sessions", func(t *testing.T) {
+		expectedErr := errors.New("failed to revoke sessions")
+		refreshTokenRepository.On("RevokeAllByUserID", refreshTokenDomain.UserID).Return(expectedErr).Once()
+
+		err := userUseCase.RevokeAllSessions(refreshTokenDomain.UserID)
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestVerifyEmail(t *testing.T) {
+	t.Run("Test Case 1 | Valid Verify Email", func(t *testing.T) {
+		copyDomain := userDomain
+		copyDomain.EmailVerified = false
+		token := util.GenerateEmailVerificationToken(copyDomain.Id.Hex(), copyDomain.Email)
+		userRepository.On("GetByID", copyDomain.Id).Return(copyDomain, nil).Once()
+		userRepository.On("Update", mock.Anything).Return(copyDomain, nil).Once()
+
+		actualUser, err := userUseCase.VerifyEmail(token)
+
+		assert.NotNil(t, actualUser)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test Case 2 | Invalid Verify Email | Invalid token", func(t *testing.T) {
+		expectedErr := errors.New("invalid or expired verification token")
+
+		actualUser, err := userUseCase.VerifyEmail("invalid")
+
+		assert.Equal(t, users.Domain{}, actualUser)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test Case 3 | Invalid Verify Email | Access token is not a verification token", func(t *testing.T) {
+		expectedErr := errors.New("invalid or expired verification token")
+		token := strings.Replace(util.GenerateToken(userDomain.Id.Hex(), userDomain.Role, util.GenerateUUID()), "Bearer ", "", 1)
+
+		actualUser, err := userUseCase.VerifyEmail(token)
+
+		assert.Equal(t, users.Domain{}, actualUser)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test Case 4 | Invalid Verify Email | User not found", func(t *testing.T) {
+		expectedErr := errors.New("failed to get user")
+		token := util.GenerateEmailVerificationToken(userDomain.Id.Hex(), userDomain.Email)
+		userRepository.On("GetByID", userDomain.Id).Return(users.Domain{}, expectedErr).Once()
+
+		actualUser, err := userUseCase.VerifyEmail(token)
+
+		assert.Equal(t, users.Domain{}, actualUser)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test Case 5 | Invalid Verify Email | Email has changed since the token was issued", func(t *testing.T) {
+		expectedErr := errors.New("invalid or expired verification token")
+		copyDomain := userDomain
+		copyDomain.Email = "changed@charum.com"
+		token := util.GenerateEmailVerificationToken(userDomain.Id.Hex(), userDomain.Email)
+		userRepository.On("GetByID", userDomain.Id).Return(copyDomain, nil).Once()
+
+		actualUser, err := userUseCase.VerifyEmail(token)
+
+		assert.Equal(t, users.Domain{}, actualUser)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test Case 6 | Invalid Verify Email | Email is already verified", func(t *testing.T) {
+		expectedErr := errors.New("email is already verified")
+		copyDomain := userDomain
+		copyDomain.EmailVerified = true
+		token := util.GenerateEmailVerificationToken(copyDomain.Id.Hex(), copyDomain.Email)
+		userRepository.On("GetByID", copyDomain.Id).Return(copyDomain, nil).Once()
+
+		actualUser, err := userUseCase.VerifyEmail(token)
+
+		assert.Equal(t, users.Domain{}, actualUser)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test Case 7 | Invalid Verify Email | Failed to verify email", func(t *testing.T) {
+		expectedErr := errors.New("failed to verify email")
+		copyDomain := userDomain
+		copyDomain.EmailVerified = false
+		token := util.GenerateEmailVerificationToken(copyDomain.Id.Hex(), copyDomain.Email)
+		userRepository.On("GetByID", copyDomain.Id).Return(copyDomain, nil).Once()
+		userRepository.On("Update", mock.Anything).Return(users.Domain{}, expectedErr).Once()
+
+		actualUser, err := userUseCase.VerifyEmail(token)
+
+		assert.Equal(t, users.Domain{}, actualUser)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestResendVerificationEmail(t *testing.T) {
+	t.Run("Test Case 1 | Valid Resend Verification Email", func(t *testing.T) {
+		copyDomain := userDomain
+		copyDomain.EmailVerified = false
+		userRepository.On("GetByID", copyDomain.Id).Return(copyDomain, nil).Once()
+		mailgun.On("SendVerificationMail", copyDomain.Email, mock.Anything).Return("", nil).Once()
+
+		err := userUseCase.ResendVerificationEmail(copyDomain.Id)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test Case 2 | Invalid Resend Verification Email | User not found", func(t *testing.T) {
+		expectedErr := errors.New("failed to get user")
+		userRepository.On("GetByID", userDomain.Id).Return(users.Domain{}, expectedErr).Once()
+
+		err := userUseCase.ResendVerificationEmail(userDomain.Id)
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test Case 3 | Invalid Resend Verification Email | Email is already verified", func(t *testing.T) {
+		expectedErr := errors.New("email is already verified")
+		copyDomain := userDomain
+		copyDomain.EmailVerified = true
+		userRepository.On("GetByID", copyDomain.Id).Return(copyDomain, nil).Once()
+
+		err := userUseCase.ResendVerificationEmail(copyDomain.Id)
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test Case 4 | Invalid Resend Verification Email | Failed to send email", func(t *testing.T) {
+		expectedErr := errors.New("failed to send verification email")
+		copyDomain := userDomain
+		copyDomain.EmailVerified = false
+		userRepository.On("GetByID", copyDomain.Id).Return(copyDomain, nil).Once()
+		mailgun.On("SendVerificationMail", copyDomain.Email, mock.Anything).Return("", errors.New("mailgun error")).Once()
+
+		err := userUseCase.ResendVerificationEmail(copyDomain.Id)
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestStartOIDCLogin(t *testing.T) {
+	t.Run("Test Case 1 | Valid Start OIDC Login", func(t *testing.T) {
+		oidcStateRepository.On("Create", mock.Anything).Return(oidcStateDomain, nil).Once()
+		oidcProvider.On("AuthCodeURL", mock.Anything, mock.Anything, mock.Anything).Return("https://issuer/authorize", nil).Once()
+
+		authURL, err := userUseCase.StartOIDCLogin()
+
+		assert.Equal(t, "https://issuer/authorize", authURL)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test Case 2 | Invalid Start OIDC Login | Failed to create oidc state", func(t *testing.T) {
+		expectedErr := errors.New("failed to create oidc state")
+		oidcStateRepository.On("Create", mock.Anything).Return(oidc_states.Domain{}, expectedErr).Once()
+
+		authURL, err := userUseCase.StartOIDCLogin()
+
+		assert.Empty(t, authURL)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test Case 3 | Invalid Start OIDC Login | Failed to get authorization url", func(t *testing.T) {
+		expectedErr := errors.New("failed to get authorization url")
+		oidcStateRepository.On("Create", mock.Anything).Return(oidcStateDomain, nil).Once()
+		oidcProvider.On("AuthCodeURL", mock.Anything, mock.Anything, mock.Anything).Return("", errors.New("discovery failed")).Once()
+
+		authURL, err := userUseCase.StartOIDCLogin()
+
+		assert.Empty(t, authURL)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestOIDCCallback(t *testing.T) {
+	claims := oidc.Claims{
+		Subject:       "external-subject",
+		Email:         "oidc@charum.com",
+		EmailVerified: true,
+		Name:          "OIDC User",
+	}
+
+	t.Run("Test Case 1 | Valid OIDC Callback | Existing linked account", func(t *testing.T) {
+		copyDomain := userDomain
+		copyDomain.TwoFactorEnabled = false
+		oidcStateRepository.On("GetByState", util.HashToken("state")).Return(oidcStateDomain, nil).Once()
+		oidcStateRepository.On("Delete", oidcStateDomain.Id).Return(nil).Once()
+		oidcProvider.On("Exchange", "code", oidcStateDomain.CodeVerifier, oidcStateDomain.Nonce).Return(claims, nil).Once()
+		oidcProvider.On("Issuer").Return("https://issuer").Once()
+		userRepository.On("GetByOIDCSubject", "https://issuer", claims.Subject).Return(copyDomain, nil).Once()
+		refreshTokenRepository.On("Create", mock.Anything).Return(refreshTokenDomain, nil).Once()
+
+		actualUser, token, refreshToken, err := userUseCase.OIDCCallback("state", "code", "Mozilla/5.0", "127.0.0.1")
+
+		assert.Equal(t, copyDomain, actualUser)
+		assert.NotEmpty(t, token)
+		assert.NotEmpty(t, refreshToken)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test Case 2 | Valid OIDC Callback | Link existing account by verified email", func(t *testing.T) {
+		copyDomain := userDomain
+		copyDomain.Email = claims.Email
+		copyDomain.OIDCSubject = ""
+		copyDomain.EmailVerified = true
+		copyDomain.TwoFactorEnabled = false
+		oidcStateRepository.On("GetByState", util.HashToken("state")).Return(oidcStateDomain, nil).Once()
+		oidcStateRepository.On("Delete", oidcStateDomain.Id).Return(nil).Once()
+		oidcProvider.On("Exchange", "code", oidcStateDomain.CodeVerifier, oidcStateDomain.Nonce).Return(claims, nil).Once()
+		oidcProvider.On("Issuer").Return("https://issuer").Twice()
+		userRepository.On("GetByOIDCSubject", "https://issuer", claims.Subject).Return(users.Domain{}, errors.New("not found")).Once()
+		userRepository.On("GetByEmail", claims.Email).Return(copyDomain, nil).Once()
+		userRepository.On("Update", mock.MatchedBy(func(user *users.Domain) bool {
+			return user.OIDCSubject == claims.Subject && user.EmailVerified
+		})).Return(copyDomain, nil).Once()
+		refreshTokenRepository.On("Create", mock.Anything).Return(refreshTokenDomain, nil).Once()
+
+		actualUser, token, refreshToken, err := userUseCase.OIDCCallback("state", "code", "Mozilla/5.0", "127.0.0.1")
+
+		assert.NotNil(t, actualUser)
+		assert.NotEmpty(t, token)
+		assert.NotEmpty(t, refreshToken)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test Case 3 | Valid OIDC Callback | Create new account", func(t *testing.T) {
+		copyDomain := userDomain
+		copyDomain.TwoFactorEnabled = false
+		oidcStateRepository.On("GetByState", util.HashToken("state")).Return(oidcStateDomain, nil).Once()
+		oidcStateRepository.On("Delete", oidcStateDomain.Id).Return(nil).Once()
+		oidcProvider.On("Exchange", "code", oidcStateDomain.CodeVerifier, oidcStateDomain.Nonce).Return(claims, nil).Once()
+		oidcProvider.On("Issuer").Return("https://issuer").Twice()
+		userRepository.On("GetByOIDCSubject", "https://issuer", claims.Subject).Return(users.Domain{}, errors.New("not found")).Once()
+		userRepository.On("GetByEmail", claims.Email).Return(users.Domain{}, errors.New("not found")).Once()
+		userRepository.On("GetByUsername", "oidc").Return(users.Domain{}, errors.New("not found")).Once()
+		userRepository.On("Create", mock.MatchedBy(func(user *users.Domain) bool {
+			return user.UserName == "oidc" && user.OIDCSubject == claims.Subject && user.EmailVerified && user.Role == "user"
+		})).Return(copyDomain, nil).Once()
+		refreshTokenRepository.On("Create", mock.Anything).Return(refreshTokenDomain, nil).Once()
+
+		actualUser, token, refreshToken, err := userUseCase.OIDCCallback("state", "code", "Mozilla/5.0", "127.0.0.1")
+
+		assert.NotNil(t, actualUser)
+		assert.NotEmpty(t, token)
+		assert.NotEmpty(t, refreshToken)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test Case 4 | Invalid OIDC Callback | State not found", func(t *testing.T) {
+		expectedErr := errors.New("invalid or expired oidc state")
+		oidcStateRepository.On("GetByState", util.HashToken("state")).Return(oidc_states.Domain{}, errors.New("not found")).Once()
+
+		actualUser, token, refreshToken, err := userUseCase.OIDCCallback("state", "code", "Mozilla/5.0", "127.0.0.1")
+
+		assert.Equal(t, users.Domain{}, actualUser)
+		assert.Empty(t, token)
+		assert.Empty(t, refreshToken)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test Case 5 | Invalid OIDC Callback | State has expired", func(t *testing.T) {
+		expectedErr := errors.New("invalid or expired oidc state")
+		copyState := oidcStateDomain
+		copyState.ExpiredAt = primitive.NewDateTimeFromTime(time.Now().Add(-time.Minute))
+		oidcStateRepository.On("GetByState", util.HashToken("state")).Return(copyState, nil).Once()
+		oidcStateRepository.On("Delete", copyState.Id).Return(nil).Once()
+
+		actualUser, token, refreshToken, err := userUseCase.OIDCCallback("state", "code", "Mozilla/5.0", "127.0.0.1")
+
+		assert.Equal(t, users.Domain{}, actualUser)
+		assert.Empty(t, token)
+		assert.Empty(t, refreshToken)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test Case 6 | Invalid OIDC Callback | Failed to verify oidc login", func(t *testing.T) {
+		expectedErr := errors.New("failed to verify oidc login")
+		oidcStateRepository.On("GetByState", util.HashToken("state")).Return(oidcStateDomain, nil).Once()
+		oidcStateRepository.On("Delete", oidcStateDomain.Id).Return(nil).Once()
+		oidcProvider.On("Exchange", "code", oidcStateDomain.CodeVerifier, oidcStateDomain.Nonce).Return(oidc.Claims{}, errors.New("invalid id token")).Once()
+
+		actualUser, token, refreshToken, err := userUseCase.OIDCCallback("state", "code", "Mozilla/5.0", "127.0.0.1")
+
+		assert.Equal(t, users.Domain{}, actualUser)
+		assert.Empty(t, token)
+		assert.Empty(t, refreshToken)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test Case 7 | Invalid OIDC Callback | Email is not verified by the provider", func(t *testing.T) {
+		expectedErr := errors.New("oidc provider did not return a verified email")
+		unverifiedClaims := claims
+		unverifiedClaims.EmailVerified = false
+		oidcStateRepository.On("GetByState", util.HashToken("state")).Return(oidcStateDomain, nil).Once()
+		oidcStateRepository.On("Delete", oidcStateDomain.Id).Return(nil).Once()
+		oidcProvider.On("Exchange", "code", oidcStateDomain.CodeVerifier, oidcStateDomain.Nonce).Return(unverifiedClaims, nil).Once()
+		oidcProvider.On("Issuer").Return("https://issuer").Once()
+		userRepository.On("GetByOIDCSubject", "https://issuer", claims.Subject).Return(users.Domain{}, errors.New("not found")).Once()
+
+		actualUser, token, refreshToken, err := userUseCase.OIDCCallback("state", "code", "Mozilla/5.0", "127.0.0.1")
+
+		assert.Equal(t, users.Domain{}, actualUser)
+		assert.Empty(t, token)
+		assert.Empty(t, refreshToken)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test Case 8 | Invalid OIDC Callback | Email is already linked to another oidc account", func(t *testing.T) {
+		expectedErr := errors.New("email is already linked to another oidc account")
+		copyDomain := userDomain
+		copyDomain.Email = claims.Email
+		copyDomain.OIDCSubject = "another-subject"
+		oidcStateRepository.On("GetByState", util.HashToken("state")).Return(oidcStateDomain, nil).Once()
+		oidcStateRepository.On("Delete", oidcStateDomain.Id).Return(nil).Once()
+		oidcProvider.On("Exchange", "code", oidcStateDomain.CodeVerifier, oidcStateDomain.Nonce).Return(claims, nil).Once()
+		oidcProvider.On("Issuer").Return("https://issuer").Once()
+		userRepository.On("GetByOIDCSubject", "https://issuer", claims.Subject).Return(users.Domain{}, errors.New("not found")).Once()
+		userRepository.On("GetByEmail", claims.Email).Return(copyDomain, nil).Once()
+
+		actualUser, token, refreshToken, err := userUseCase.OIDCCallback("state", "code", "Mozilla/5.0", "127.0.0.1")
+
+		assert.Equal(t, users.Domain{}, actualUser)
+		assert.Empty(t, token)
+		assert.Empty(t, refreshToken)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test Case 9 | Invalid OIDC Callback | User is suspended", func(t *testing.T) {
+		expectedErr := errors.New("user is suspended")
+		copyDomain := userDomain
+		copyDomain.IsActive = false
+		oidcStateRepository.On("GetByState", util.HashToken("state")).Return(oidcStateDomain, nil).Once()
+		oidcStateRepository.On("Delete", oidcStateDomain.Id).Return(nil).Once()
+		oidcProvider.On("Exchange", "code", oidcStateDomain.CodeVerifier, oidcStateDomain.Nonce).Return(claims, nil).Once()
+		oidcProvider.On("Issuer").Return("https://issuer").Once()
+		userRepository.On("GetByOIDCSubject", "https://issuer", claims.Subject).Return(copyDomain, nil).Once()
+
+		actualUser, token, refreshToken, err := userUseCase.OIDCCallback("state", "code", "Mozilla/5.0", "127.0.0.1")
+
+		assert.Equal(t, users.Domain{}, actualUser)
+		appealClaims, claimsErr := util.GetAppealPayload(token)
+		assert.Nil(t, claimsErr)
+		assert.Equal(t, copyDomain.Id.Hex(), appealClaims.UID)
+		assert.Empty(t, refreshToken)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test Case 10 | Valid OIDC Callback | Link unverified account takes it over", func(t *testing.T) {
+		copyDomain := userDomain
+		copyDomain.Email = claims.Email
+		copyDomain.OIDCSubject = ""
+		copyDomain.EmailVerified = false
+		copyDomain.TwoFactorEnabled = true
+		copyDomain.TwoFactorSecret = "attacker secret"
+		oidcStateRepository.On("GetByState", util.HashToken("state")).Return(oidcStateDomain, nil).Once()
+		oidcStateRepository.On("Delete", oidcStateDomain.Id).Return(nil).Once()
+		oidcProvider.On("Exchange", "code", oidcStateDomain.CodeVerifier, oidcStateDomain.Nonce).Return(claims, nil).Once()
+		oidcProvider.On("Issuer").Return("https://issuer").Twice()
+		userRepository.On("GetByOIDCSubject", "https://issuer", claims.Subject).Return(users.Domain{}, errors.New("not found")).Once()
+		userRepository.On("GetByEmail", claims.Email).Return(copyDomain, nil).Once()
+		refreshTokenRepository.On("RevokeAllByUserID", copyDomain.Id).Return(nil).Once()
+		userRepository.On("Update", mock.MatchedBy(func(user *users.Domain) bool {
+			return user.OIDCSubject == claims.Subject && user.EmailVerified && user.Password != copyDomain.Password && !user.TwoFactorEnabled && user.TwoFactorSecret == ""
+		})).Return(users.Domain{Id: copyDomain.Id, Email: claims.Email, IsActive: true, EmailVerified: true}, nil).Once()
+		refreshTokenRepository.On("Create", mock.Anything).Return(refreshTokenDomain, nil).Once()
+
+		actualUser, token, refreshToken, err := userUseCase.OIDCCallback("state", "code", "Mozilla/5.0", "127.0.0.1")
+
+		assert.True(t, actualUser.EmailVerified)
+		assert.NotEmpty(t, token)
+		assert.NotEmpty(t, refreshToken)
+		assert.Nil(t, err)
+	})
+}
+
+func TestVerifyTwoFactor(t *testing.T) {
+	secret, _ := util.GenerateTOTPSecret()
+
+	t.Run("Test Case 1 | Valid Verify Two Factor | TOTP code", func(t *testing.T) {
+		copyDomain := userDomain
+		copyDomain.TwoFactorEnabled = true
+		copyDomain.TwoFactorSecret = secret
+		copyDomain.LastTOTPStep = 0
+		code, _ := util.GenerateTOTPCode(secret, time.Now())
+		challengeToken := util.GenerateTwoFactorChallengeToken(copyDomain.Id.Hex())
+		userRepository.On("GetByID", copyDomain.Id).Return(copyDomain, nil).Once()
+		loginAttemptRepository.On("GetByKey", "account:"+copyDomain.Id.Hex()).Return(login_attempts.Domain{}, errors.New("not found")).Twice()
+		userRepository.On("Update", mock.Anything).Return(copyDomain, nil).Once()
+		refreshTokenRepository.On("Create", mock.Anything).Return(refreshTokenDomain, nil).Once()
+
+		actualUser, token, refreshToken, err := userUseCase.VerifyTwoFactor(challengeToken, code, "Mozilla/5.0", "127.0.0.1")
+
+		assert.NotNil(t, actualUser)
+		assert.NotEmpty(t, token)
+		assert.NotEmpty(t, refreshToken)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test Case 2 | Valid Verify Two Factor | Recovery code", func(t *testing.T) {
+		copyDomain := userDomain
+		copyDomain.TwoFactorEnabled = true
+		copyDomain.TwoFactorSecret = secret
+		copyDomain.RecoveryCodes = []string{util.HashToken("recovery01"), util.HashToken("recovery02")}
+		challengeToken := util.GenerateTwoFactorChallengeToken(copyDomain.Id.Hex())
+		userRepository.On("GetByID", copyDomain.Id).Return(copyDomain, nil).Once()
+		loginAttemptRepository.On("GetByKey", "account:"+copyDomain.Id.Hex()).Return(login_attempts.Domain{}, errors.New("not found")).Twice()
+		userRepository.On("Update", mock.MatchedBy(func(user *users.Domain) bool {
+			return len(user.RecoveryCodes) == 1 && user.RecoveryCodes[0] == util.HashToken("recovery02")
+		})).Return(copyDomain, nil).Once()
+		refreshTokenRepository.On("Create", mock.Anything).Return(refreshTokenDomain, nil).Once()
+
+		actualUser, token, refreshToken, err := userUseCase.VerifyTwoFactor(challengeToken, "recovery01", "Mozilla/5.0", "127.0.0.1")
+
+		assert.NotNil(t, actualUser)
+		assert.NotEmpty(t, token)
+		assert.NotEmpty(t, refreshToken)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test Case 3 | Invalid Verify Two Factor | Invalid challenge token", func(t *testing.T) {
+		expectedErr := errors.New("invalid or expired challenge token")
+
+		actualUser, token, refreshToken, err := userUseCase.VerifyTwoFactor("invalid", "123456", "Mozilla/5.0", "127.0.0.1")
+
+		assert.Equal(t, users.Domain{}, actualUser)
+		assert.Empty(t, token)
+		assert.Empty(t, refreshToken)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test Case 4 | Invalid Verify Two Factor | User not found", func(t *testing.T) {
+		expectedErr := errors.New("failed to get user")
+		challengeToken := util.GenerateTwoFactorChallengeToken(userDomain.Id.Hex())
+		userRepository.On("GetByID", userDomain.Id).Return(users.Domain{}, expectedErr).Once()
+
+		actualUser, token, refreshToken, err := userUseCase.VerifyTwoFactor(challengeToken, "123456", "Mozilla/5.0", "127.0.0.1")
+
+		assert.Equal(t, users.Domain{}, actualUser)
+		assert.Empty(t, token)
+		assert.Empty(t, refreshToken)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test Case 5 | Invalid Verify Two Factor | Code has already been used", func(t *testing.T) {
+		expectedErr := errors.New("invalid two-factor code")
+		copyDomain := userDomain
+		copyDomain.TwoFactorEnabled = true
+		copyDomain.TwoFactorSecret = secret
+		copyDomain.LastTOTPStep = time.Now().Unix()/30 + 1
+		code, _ := util.GenerateTOTPCode(secret, time.Now())
+		challengeToken := util.GenerateTwoFactorChallengeToken(copyDomain.Id.Hex())
+		userRepository.On("GetByID", copyDomain.Id).Return(copyDomain, nil).Once()
+		loginAttemptRepository.On("GetByKey", "account:"+copyDomain.Id.Hex()).Return(login_attempts.Domain{}, errors.New("not found")).Twice()
+		loginAttemptRepository.On("Create", mock.Anything).Return(login_attempts.Domain{}, nil).Once()
+
+		actualUser, token, refreshToken, err := userUseCase.VerifyTwoFactor(challengeToken, code, "Mozilla/5.0", "127.0.0.1")
+
+		assert.Equal(t, users.Domain{}, actualUser)
+		assert.Empty(t, token)
+		assert.Empty(t, refreshToken)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test Case 6 | Invalid Verify Two Factor | Two-factor authentication is not enabled", func(t *testing.T) {
+		expectedErr := errors.New("two-factor authentication is not enabled")
+		copyDomain := userDomain
+		copyDomain.TwoFactorEnabled = false
+		challengeToken := util.GenerateTwoFactorChallengeToken(copyDomain.Id.Hex())
+		userRepository.On("GetByID", copyDomain.Id).Return(copyDomain, nil).Once()
+
+		actualUser, token, refreshToken, err := userUseCase.VerifyTwoFactor(challengeToken, "123456", "Mozilla/5.0", "127.0.0.1")
+
+		assert.Equal(t, users.Domain{}, actualUser)
+		assert.Empty(t, token)
+		assert.Empty(t, refreshToken)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test Case 7 | Invalid Verify Two Factor | Account is locked", func(t *testing.T) {
+		expectedErr := errors.New("too many failed login attempts, please try again later")
+		copyDomain := userDomain
+		copyDomain.TwoFactorEnabled = true
+		copyDomain.TwoFactorSecret = secret
+		challengeToken := util.GenerateTwoFactorChallengeToken(copyDomain.Id.Hex())
+		userRepository.On("GetByID", copyDomain.Id).Return(copyDomain, nil).Once()
+		loginAttemptRepository.On("GetByKey", "account:"+copyDomain.Id.Hex()).Return(login_attempts.Domain{
+			LockedUntil: primitive.NewDateTimeFromTime(time.Now().Add(time.Minute)),
+		}, nil).Once()
+
+		actualUser, token, refreshToken, err := userUseCase.VerifyTwoFactor(challengeToken, "123456", "Mozilla/5.0", "127.0.0.1")
+
+		assert.Equal(t, users.Domain{}, actualUser)
+		assert.Empty(t, token)
+		assert.Empty(t, refreshToken)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test Case 8 | Invalid Verify Two Factor | User is suspended", func(t *testing.T) {
+		expectedErr := errors.New("user is suspended")
+		copyDomain := userDomain
+		copyDomain.IsActive = false
+		copyDomain.TwoFactorEnabled = true
+		copyDomain.TwoFactorSecret = secret
+		challengeToken := util.GenerateTwoFactorChallengeToken(copyDomain.Id.Hex())
+		userRepository.On("GetByID", copyDomain.Id).Return(copyDomain, nil).Once()
+
+		actualUser, token, refreshToken, err := userUseCase.VerifyTwoFactor(challengeToken, "123456", "Mozilla/5.0", "127.0.0.1")
+
+		claims, claimsErr := util.GetAppealPayload(token)
+		assert.Nil(t, claimsErr)
+		assert.Equal(t, copyDomain.Id.Hex(), claims.UID)
+		assert.Equal(t, users.Domain{}, actualUser)
+		assert.Empty(t, refreshToken)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestLoginWithMagicLink(t *testing.T) {
+	t.Run("Test Case 1 | Valid Login With Magic Link", func(t *testing.T) {
+		copyDomain := userDomain
+		copyDomain.IsActive = true
+		copyDomain.TwoFactorEnabled = false
+		userRepository.On("GetByID", copyDomain.Id).Return(copyDomain, nil).Once()
+		refreshTokenRepository.On("Create", mock.Anything).Return(refreshTokenDomain, nil).Once()
+
+		actualUser, token, refreshToken, err := userUseCase.LoginWithMagicLink(copyDomain.Id, "Mozilla/5.0", "127.0.0.1")
+
+		assert.Equal(t, copyDomain, actualUser)
+		assert.NotEmpty(t, token)
+		assert.NotEmpty(t, refreshToken)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test Case 2 | Valid Login With Magic Link | Two-factor authentication enabled", func(t *testing.T) {
+		copyDomain := userDomain
+		copyDomain.IsActive = true
+		copyDomain.TwoFactorEnabled = true
+		userRepository.On("GetByID", copyDomain.Id).Return(copyDomain, nil).Once()
+
+		actualUser, token, refreshToken, err := userUseCase.LoginWithMagicLink(copyDomain.Id, "Mozilla/5.0", "127.0.0.1")
+
+		payload, payloadErr := util.GetTwoFactorChallengePayload(token)
+		assert.Equal(t, copyDomain, actualUser)
+		assert.Equal(t, copyDomain.Id.Hex(), payload.UID)
+		assert.Nil(t, payloadErr)
+		assert.Empty(t, refreshToken)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test Case 3 | Invalid Login With Magic Link | User is suspended", func(t *testing.T) {
+		copyDomain := userDomain
+		copyDomain.IsActive = false
+		userRepository.On("GetByID", copyDomain.Id).Return(copyDomain, nil).Once()
+
+		actualUser, token, refreshToken, err := userUseCase.LoginWithMagicLink(copyDomain.Id, "Mozilla/5.0", "127.0.0.1")
+
+		assert.Equal(t, users.Domain{}, actualUser)
+		claims, claimsErr := util.GetAppealPayload(token)
+		assert.Nil(t, claimsErr)
+		assert.Equal(t, copyDomain.Id.Hex(), claims.UID)
+		assert.Empty(t, refreshToken)
+		assert.Equal(t, errors.New("user is suspended"), err)
+	})
+
+	t.Run("Test Case 4 | Invalid Login With Magic Link | User not found", func(t *testing.T) {
+		userRepository.On("GetByID", userDomain.Id).Return(users.Domain{}, errors.New("not found")).Once()
+
+		actualUser, token, refreshToken, err := userUseCase.LoginWithMagicLink(userDomain.Id, "Mozilla/5.0", "127.0.0.1")
+
+		assert.Equal(t, users.Domain{}, actualUser)
+		assert.Empty(t, token)
+		assert.Empty(t, refreshToken)
+		assert.Equal(t, errors.New("failed to get user"), err)
+	})
+}
+
+func TestEnrollTwoFactor(t *testing.T) {
+	t.Run("Test Case 1 | Valid Enroll Two Factor", func(t *testing.T) {
+		copyDomain := userDomain
+		copyDomain.TwoFactorEnabled = false
+		userRepository.On("GetByID", copyDomain.Id).Return(copyDomain, nil).Once()
+		userRepository.On("Update", mock.Anything).Return(copyDomain, nil).Once()
+
+		secret, uri, err := userUseCase.EnrollTwoFactor(copyDomain.Id)
+
+		assert.NotEmpty(t, secret)
+		assert.Contains(t, uri, "otpauth://totp/")
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test Case 2 | Invalid Enroll Two Factor | User not found", func(t *testing.T) {
+		expectedErr := errors.New("failed to get user")
+		userRepository.On("GetByID", userDomain.Id).Return(users.Domain{}, expectedErr).Once()
+
+		secret, uri, err := userUseCase.EnrollTwoFactor(userDomain.Id)
+
+		assert.Empty(t, secret)
+		assert.Empty(t, uri)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test Case 3 | Invalid Enroll Two Factor | Two-factor authentication is already enabled", func(t *testing.T) {
+		expectedErr := errors.New("two-factor authentication is already enabled")
+		copyDomain := userDomain
+		copyDomain.TwoFactorEnabled = true
+		userRepository.On("GetByID", copyDomain.Id).Return(copyDomain, nil).Once()
+
+		secret, uri, err := userUseCase.EnrollTwoFactor(copyDomain.Id)
+
+		assert.Empty(t, secret)
+		assert.Empty(t, uri)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test Case 4 | Invalid Enroll Two Factor | Failed to update user", func(t *testing.T) {
+		expectedErr := errors.New("failed to update user")
+		copyDomain := userDomain
+		copyDomain.TwoFactorEnabled = false
+		userRepository.On("GetByID", copyDomain.Id).Return(copyDomain, nil).Once()
+		userRepository.On("Update", mock.Anything).Return(users.Domain{}, expectedErr).Once()
+
+		secret, uri, err := userUseCase.EnrollTwoFactor(copyDomain.Id)
+
+		assert.Empty(t, secret)
+		assert.Empty(t, uri)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestConfirmTwoFactor(t *testing.T) {
+	secret, _ := util.GenerateTOTPSecret()
+
+	t.Run("Test Case 1 | Valid Confirm Two Factor", func(t *testing.T) {
+		copyDomain := userDomain
+		copyDomain.TwoFactorEnabled = false
+		copyDomain.TwoFactorSecret = secret
+		code, _ := util.GenerateTOTPCode(secret, time.Now())
+		userRepository.On("GetByID", copyDomain.Id).Return(copyDomain, nil).Once()
+		userRepository.On("Update", mock.Anything).Return(copyDomain, nil).Once()
+
+		recoveryCodes, err := userUseCase.ConfirmTwoFactor(copyDomain.Id, code)
+
+		assert.Len(t, recoveryCodes, 10)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test Case 2 | Invalid Confirm Two Factor | Two-factor authentication is not enrolled", func(t *testing.T) {
+		expectedErr := errors.New("two-factor authentication is not enrolled")
+		copyDomain := userDomain
+		copyDomain.TwoFactorEnabled = false
+		copyDomain.TwoFactorSecret = ""
+		userRepository.On("GetByID", copyDomain.Id).Return(copyDomain, nil).Once()
+
+		recoveryCodes, err := userUseCase.ConfirmTwoFactor(copyDomain.Id, "123456")
+
+		assert.Equal(t, []string{}, recoveryCodes)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test Case 3 | Invalid Confirm Two Factor | Invalid two-factor code", func(t *testing.T) {
+		expectedErr := errors.New("invalid two-factor code")
+		copyDomain := userDomain
+		copyDomain.TwoFactorEnabled = false
+		copyDomain.TwoFactorSecret = secret
+		userRepository.On("GetByID", copyDomain.Id).Return(copyDomain, nil).Once()
+
+		recoveryCodes, err := userUseCase.ConfirmTwoFactor(copyDomain.Id, "abcdef")
+
+		assert.Equal(t, []string{}, recoveryCodes)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test Case 4 | Invalid Confirm Two Factor | Failed to enable two-factor authentication", func(t *testing.T) {
+		expectedErr := errors.New("failed to enable two-factor authentication")
+		copyDomain := userDomain
+		copyDomain.TwoFactorEnabled = false
+		copyDomain.TwoFactorSecret = secret
+		code, _ := util.GenerateTOTPCode(secret, time.Now())
+		userRepository.On("GetByID", copyDomain.Id).Return(copyDomain, nil).Once()
+		userRepository.On("Update", mock.Anything).Return(users.Domain{}, expectedErr).Once()
+
+		recoveryCodes, err := userUseCase.ConfirmTwoFactor(copyDomain.Id, code)
+
+		assert.Equal(t, []string{}, recoveryCodes)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestDisableTwoFactor(t *testing.T) {
+	secret, _ := util.GenerateTOTPSecret()
+
+	t.Run("Test Case 1 | Valid Disable Two Factor", func(t *testing.T) {
+		copyDomain := userDomain
+		copyDomain.TwoFactorEnabled = true
+		copyDomain.TwoFactorSecret = secret
+		copyDomain.LastTOTPStep = 0
+		code, _ := util.GenerateTOTPCode(secret, time.Now())
+		userRepository.On("GetByID", copyDomain.Id).Return(copyDomain, nil).Once()
+		userRepository.On("Update", mock.Anything).Return(copyDomain, nil).Once()
+
+		err := userUseCase.DisableTwoFactor(copyDomain.Id, code)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test Case 2 | Invalid Disable Two Factor | Two-factor authentication is not enabled", func(t *testing.T) {
+		expectedErr := errors.New("two-factor authentication is not enabled")
+		copyDomain := userDomain
+		copyDomain.TwoFactorEnabled = false
+		userRepository.On("GetByID", copyDomain.Id).Return(copyDomain, nil).Once()
+
+		err := userUseCase.DisableTwoFactor(copyDomain.Id, "123456")
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test Case 3 | Invalid Disable Two Factor | Invalid two-factor code", func(t *testing.T) {
+		expectedErr := errors.New("invalid two-factor code")
+		copyDomain := userDomain
+		copyDomain.TwoFactorEnabled = true
+		copyDomain.TwoFactorSecret = secret
+		userRepository.On("GetByID", copyDomain.Id).Return(copyDomain, nil).Once()
+
+		err := userUseCase.DisableTwoFactor(copyDomain.Id, "abcdef")
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test Case 4 | Invalid Disable Two Factor | Failed to disable two-factor authentication", func(t *testing.T) {
+		expectedErr := errors.New("failed to disable two-factor authentication")
+		copyDomain := userDomain
+		copyDomain.TwoFactorEnabled = true
+		copyDomain.TwoFactorSecret = secret
+		copyDomain.LastTOTPStep = 0
+		code, _ := util.GenerateTOTPCode(secret, time.Now())
+		userRepository.On("GetByID", copyDomain.Id).Return(copyDomain, nil).Once()
+		userRepository.On("Update", mock.Anything).Return(users.Domain{}, expectedErr).Once()
+
+		err := userUseCase.DisableTwoFactor(copyDomain.Id, code)
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestGetLockouts(t *testing.T) {
+	t.Run("Test Case 1 | Valid Get Lockouts", func(t *testing.T) {
+		lockout := login_attempts.Domain{
+			Id:          primitive.NewObjectID(),
+			Key:         "ip:127.0.0.1",
+			FailedCount: 20,
+			LockedUntil: primitive.NewDateTimeFromTime(time.Now().Add(time.Minute)),
+		}
+		loginAttemptRepository.On("GetAllLocked").Return([]login_attempts.Domain{lockout}, nil).Once()
+
+		actualLockouts, err := userUseCase.GetLockouts()
+
+		assert.Equal(t, []login_attempts.Domain{lockout}, actualLockouts)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test Case 2 | Invalid Get Lockouts | Error when getting lockouts", func(t *testing.T) {
+		expectedErr := errors.New("failed to get lockouts")
+		loginAttemptRepository.On("GetAllLocked").Return([]login_attempts.Domain{}, errors.New("failed")).Once()
+
+		actualLockouts, err := userUseCase.GetLockouts()
+
+		assert.Equal(t, []login_attempts.Domain{}, actualLockouts)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestClearLockout(t *testing.T) {
+	lockoutID := primitive.NewObjectID()
+
+	t.Run("Test Case 1 | Valid Clear Lockout", func(t *testing.T) {
+		loginAttemptRepository.On("GetByID", lockoutID).Return(login_attempts.Domain{Id: lockoutID}, nil).Once()
+		loginAttemptRepository.On("Delete", lockoutID).Return(nil).Once()
+
+		err := userUseCase.ClearLockout(lockoutID)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test Case 2 | Invalid Clear Lockout | Lockout not found", func(t *testing.T) {
+		expectedErr := errors.New("failed to get lockout")
+		loginAttemptRepository.On("GetByID", lockoutID).Return(login_attempts.Domain{}, errors.New("not found")).Once()
+
+		err := userUseCase.ClearLockout(lockoutID)
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test Case 3 | Invalid Clear Lockout | Failed to clear lockout", func(t *testing.T) {
+		expectedErr := errors.New("failed to clear lockout")
+		loginAttemptRepository.On("GetByID", lockoutID).Return(login_attempts.Domain{Id: lockoutID}, nil).Once()
+		loginAttemptRepository.On("Delete", lockoutID).Return(errors.New("failed")).Once()
+
+		err := userUseCase.ClearLockout(lockoutID)
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestGetAll(t *testing.T) {
+	t.Run("Test Case 1 | Valid GetAll", func(t *testing.T) {
+		userRepository.On("GetAll").Return([]users.Domain{userDomain}, nil).Once()
+
+		actualUser, actualErr := userUseCase.GetAll()
+
+		assert.NotNil(t, actualUser)
+		assert.Nil(t, actualErr)
+	})
+
+	t.Run("Test Case 2 | Invalid GetAll | Error when getting all users", func(t *testing.T) {
+		expectedErr := errors.New("failed to get all users")
+		userRepository.On("GetAll").Return([]users.Domain{}, expectedErr).Once()
+
+		actualUser, actualErr := userUseCase.GetAll()
+
+		assert.Equal(t, 0, actualUser)
+		assert.Equal(t, expectedErr, actualErr)
+	})
+}
+
+func TestGetAllDueForDeletion(t *testing.T) {
+	t.Run("Test Case 1 | Valid GetAllDueForDeletion", func(t *testing.T) {
+		userRepository.On("GetAllDueForDeletion", mock.Anything).Return([]users.Domain{userDomain}, nil).Once()
+
+		actualUsers, actualErr := userUseCase.GetAllDueForDeletion()
+
+		assert.Equal(t, []users.Domain{userDomain}, actualUsers)
+		assert.Nil(t, actualErr)
+	})
+
+	t.Run("Test Case 2 | Invalid GetAllDueForDeletion | Error when getting users", func(t *testing.T) {
+		expectedErr := errors.New("failed to get users")
+		userRepository.On("GetAllDueForDeletion", mock.Anything).Return([]users.Domain{}, errors.New("unexpected error")).Once()
+
+		actualUsers, actualErr := userUseCase.GetAllDueForDeletion()
+
+		assert.Equal(t, []users.Domain{}, actualUsers)
+		assert.Equal(t, expectedErr, actualErr)
+	})
+}
+
+func TestRequestDeletion(t *testing.T) {
+	encryptedPassword, _ := bcrypt.GenerateFromPassword([]byte(userDomain.Password), bcrypt.DefaultCost)
+	deletionDomain := userDomain
+	deletionDomain.Id = primitive.NewObjectID()
+	deletionDomain.Password = string(encryptedPassword)
+
+	t.Run("Test Case 1 | Valid RequestDeletion", func(t *testing.T) {
+		userRepository.On("GetByID", deletionDomain.Id).Return(deletionDomain, nil).Once()
+		userRepository.On("Update", mock.MatchedBy(func(user *users.Domain) bool {
+			return user.Id == deletionDomain.Id && user.DeletionScheduledAt.Time().After(time.Now().Add(13*24*time.Hour))
+		})).Return(deletionDomain, nil).Once()
+		refreshTokenRepository.On("RevokeAllByUserID", deletionDomain.Id).Return(nil).Once()
+
+		actualUser, actualErr := userUseCase.RequestDeletion(deletionDomain.Id, userDomain.Password)
+
+		assert.Equal(t, deletionDomain, actualUser)
+		assert.Nil(t, actualErr)
+	})
+
+	t.Run("Test Case 2 | Invalid RequestDeletion | Error when getting user", func(t *testing.T) {
+		expectedErr := errors.New("failed to get user")
+		userRepository.On("GetByID", deletionDomain.Id).Return(users.Domain{}, errors.New("not found")).Once()
+
+		actualUser, actualErr := userUseCase.RequestDeletion(deletionDomain.Id, userDomain.Password)
+
+		assert.Equal(t, users.Domain{}, actualUser)
+		assert.Equal(t, expectedErr, actualErr)
+	})
+
+	t.Run("Test Case 3 | Invalid RequestDeletion | Admin cannot be deleted", func(t *testing.T) {
+		expectedErr := errors.New("admin cannot be deleted")
+		copyDomain := deletionDomain
+		copyDomain.Role = "admin"
+		userRepository.On("GetByID", deletionDomain.Id).Return(copyDomain, nil).Once()
+
+		actualUser, actualErr := userUseCase.RequestDeletion(deletionDomain.Id, userDomain.Password)
+
+		assert.Equal(t, users.Domain{}, actualUser)
+		assert.Equal(t, expectedErr, actualErr)
+	})
+
+	t.Run("Test Case 4 | Invalid RequestDeletion | Wrong password", func(t *testing.T) {
+		expectedErr := errors.New("wrong password")
+		userRepository.On("GetByID", deletionDomain.Id).Return(deletionDomain, nil).Once()
+
+		actualUser, actualErr := userUseCase.RequestDeletion(deletionDomain.Id, "wrong password")
+
+		assert.Equal(t, users.Domain{}, actualUser)
+		assert.Equal(t, expectedErr, actualErr)
+	})
+
+	t.Run("Test Case 5 | Invalid RequestDeletion | Deletion is already requested", func(t *testing.T) {
+		expectedErr := errors.New("account deletion is already requested")
+		copyDomain := deletionDomain
+		copyDomain.DeletionScheduledAt = primitive.NewDateTimeFromTime(time.Now().Add(24 * time.Hour))
+		userRepository.On("GetByID", deletionDomain.Id).Return(copyDomain, nil).Once()
+
+		actualUser, actualErr := userUseCase.RequestDeletion(deletionDomain.Id, userDomain.Password)
+
+		assert.Equal(t, users.Domain{}, actualUser)
+		assert.Equal(t, expectedErr, actualErr)
+	})
+
+	t.Run("Test Case 6 | Invalid RequestDeletion | Error when updating user", func(t *testing.T) {
+		expectedErr := errors.New("failed to request account deletion")
+		userRepository.On("GetByID", deletionDomain.Id).Return(deletionDomain, nil).Once()
+		userRepository.On("Update", mock.MatchedBy(func(user *users.Domain) bool {
+			return user.Id == deletionDomain.Id
+		})).Return(users.Domain{}, errors.New("unexpected error")).Once()
+
+		actualUser, actualErr := userUseCase.RequestDeletion(deletionDomain.Id, userDomain.Password)
+
+		assert.Equal(t, users.Domain{}, actualUser)
 		assert.Equal(t, expectedErr, actualErr)
 	})
 }
@@ -0,0 +1,66 @@
+package warnings
+
+import (
+	"charum/business/suspensions"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	TargetThread  = "thread"
+	TargetComment = "comment"
+)
+
+// Domain is a warning issued to a user, it counts as an active strike until ExpiredAt
+type Domain struct {
+	Id         primitive.ObjectID `json:"_id" bson:"_id"`
+	UserID     primitive.ObjectID `json:"userID" bson:"userID"`
+	IssuerID   primitive.ObjectID `json:"issuerID" bson:"issuerID"`
+	Reason     string             `json:"reason" bson:"reason"`
+	Detail     string             `json:"detail" bson:"detail"`
+	TargetType string             `json:"targetType,omitempty" bson:"targetType,omitempty"`
+	TargetID   primitive.ObjectID `json:"targetID,omitempty" bson:"targetID,omitempty"`
+	ExpiredAt  primitive.DateTime `json:"expiredAt" bson:"expiredAt"`
+	CreatedAt  primitive.DateTime `json:"createdAt" bson:"createdAt"`
+	UpdatedAt  primitive.DateTime `json:"updatedAt" bson:"updatedAt"`
+}
+
+// Escalation suspends a user once the user's active strikes reach Strikes, a zero Duration suspends until an admin lifts it
+type Escalation struct {
+	Strikes  int
+	Duration time.Duration
+}
+
+type Config struct {
+	StrikeDuration time.Duration
+	Escalations    []Escalation
+}
+
+// IsActive reports whether the warning still counts as a strike
+func (domain Domain) IsActive() bool {
+	return domain.ExpiredAt.Time().After(time.Now())
+}
+
+type Repository interface {
+	// Create
+	Create(domain *Domain) (Domain, error)
+	// Read
+	GetByID(id primitive.ObjectID) (Domain, error)
+	GetAllByUserID(userID primitive.ObjectID) ([]Domain, error)
+	CountActiveByUserID(userID primitive.ObjectID, now primitive.DateTime) (int, error)
+	// Delete
+	Delete(id primitive.ObjectID) error
+	DeleteAllByUserID(userID primitive.ObjectID) error
+}
+
+type UseCase interface {
+	// Create
+	Create(domain *Domain) (Domain, suspensions.Domain, error)
+	// Read
+	GetAllByUserID(userID primitive.ObjectID) ([]Domain, error)
+	CountActiveByUserID(userID primitive.ObjectID) (int, error)
+	// Delete
+	Delete(id primitive.ObjectID) (Domain, error)
+	DeleteAllByUserID(userID primitive.ObjectID) error
+}
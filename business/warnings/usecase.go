@@ -0,0 +1,293 @@
+package warnings
+
+import (
+	"charum/business/comments"
+	"charum/business/permissions"
+	"charum/business/suspensions"
+	"charum/business/threads"
+	"charum/business/topics"
+	"charum/business/users"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	defaultStrikeDuration = 90 * 24 * time.Hour
+	defaultEscalations    = "3:24h,5:168h,7:0"
+)
+
+type WarningUseCase struct {
+	warningRepository    Repository
+	userRepository       users.Repository
+	threadRepository     threads.Repository
+	commentRepository    comments.Repository
+	topicRepository      topics.Repository
+	permissionRepository permissions.Repository
+	userUseCase          users.UseCase
+	config               Config
+}
+
+func NewWarningUseCase(wr Repository, ur users.Repository, tr threads.Repository, cr comments.Repository, tor topics.Repository, pr permissions.Repository, uu users.UseCase, config Config) UseCase {
+	return &WarningUseCase{
+		warningRepository:    wr,
+		userRepository:       ur,
+		threadRepository:     tr,
+		commentRepository:    cr,
+		topicRepository:      tor,
+		permissionRepository: pr,
+		userUseCase:          uu,
+		config:               config,
+	}
+}
+
+// ParseConfig reads how long a strike stays active and the escalation thresholds written as "strikes:duration" pairs
+// separated by commas (e.g. "3:24h,5:168h,7:0", a zero duration suspends until lifted), empty values fall back to the defaults
+func ParseConfig(strikeDuration string, escalations string) (Config, error) {
+	config := Config{
+		StrikeDuration: defaultStrikeDuration,
+	}
+
+	if strikeDuration != "" {
+		duration, err := time.ParseDuration(strikeDuration)
+		if err != nil || duration <= 0 {
+			return Config{}, errors.New("invalid strike duration")
+		}
+		config.StrikeDuration = duration
+	}
+
+	if escalations == "" {
+		escalations = defaultEscalations
+	}
+
+	for _, pair := range strings.Split(escalations, ",") {
+		parts := strings.Split(strings.TrimSpace(pair), ":")
+		if len(parts) != 2 {
+			return Config{}, errors.New("invalid escalation " + pair)
+		}
+
+		strikes, err := strconv.Atoi(parts[0])
+		if err != nil || strikes <= 0 {
+			return Config{}, errors.New("invalid escalation " + pair)
+		}
+
+		duration, err := time.ParseDuration(parts[1])
+		if err != nil || duration < 0 {
+			return Config{}, errors.New("invalid escalation " + pair)
+		}
+
+		config.Escalations = append(config.Escalations, Escalation{
+			Strikes:  strikes,
+			Duration: duration,
+		})
+	}
+
+	return config, nil
+}
+
+/*
+Create
+*/
+
+// Create issues a warning and suspends the user when the user's active strikes reach an escalation threshold
+func (wu *WarningUseCase) Create(domain *Domain) (Domain, suspensions.Domain, error) {
+	if !suspensions.IsValidReason(domain.Reason) {
+		return Domain{}, suspensions.Domain{}, errors.New("invalid warning reason")
+	}
+
+	if domain.UserID == domain.IssuerID {
+		return Domain{}, suspensions.Domain{}, errors.New("user cannot warn themselves")
+	}
+
+	user, err := wu.userRepository.GetByID(domain.UserID)
+	if err != nil {
+		return Domain{}, suspensions.Domain{}, errors.New("failed to get user")
+	}
+
+	if user.Role == "admin" {
+		return Domain{}, suspensions.Domain{}, errors.New("admin cannot be warned")
+	}
+
+	err = wu.checkIssuer(domain)
+	if err != nil {
+		return Domain{}, suspensions.Domain{}, err
+	}
+
+	domain.Id = primitive.NewObjectID()
+	domain.ExpiredAt = primitive.NewDateTimeFromTime(time.Now().Add(wu.config.StrikeDuration))
+	domain.CreatedAt = primitive.NewDateTimeFromTime(time.Now())
+	domain.UpdatedAt = primitive.NewDateTimeFromTime(time.Now())
+
+	warning, err := wu.warningRepository.Create(domain)
+	if err != nil {
+		return Domain{}, suspensions.Domain{}, errors.New("failed to create warning")
+	}
+
+	activeStrikes, err := wu.warningRepository.CountActiveByUserID(domain.UserID, primitive.NewDateTimeFromTime(time.Now()))
+	if err != nil {
+		return Domain{}, suspensions.Domain{}, errors.New("failed to count active strikes")
+	}
+
+	// only the warning that reaches a new threshold escalates, the warnings after it wait for the next threshold
+	escalation, ok := wu.escalationFor(activeStrikes)
+	previous, _ := wu.escalationFor(activeStrikes - 1)
+	if !ok || escalation == previous || !user.IsActive {
+		return warning, suspensions.Domain{}, nil
+	}
+
+	suspension := &suspensions.Domain{
+		UserID:  domain.UserID,
+		AdminID: domain.IssuerID,
+		Reason:  domain.Reason,
+		Detail:  fmt.Sprintf("automatically suspended after %d active strikes", activeStrikes),
+	}
+	if escalation.Duration > 0 {
+		suspension.EndAt = primitive.NewDateTimeFromTime(time.Now().Add(escalation.Duration))
+	}
+
+	_, createdSuspension, err := wu.userUseCase.Suspend(suspension)
+	if err != nil {
+		return Domain{}, suspensions.Domain{}, err
+	}
+
+	return warning, createdSuspension, nil
+}
+
+// checkIssuer makes sure the target belongs to the warned user and is in a topic the issuer moderates, only admins may warn without a target
+func (wu *WarningUseCase) checkIssuer(domain *Domain) error {
+	issuer, err := wu.userRepository.GetByID(domain.IssuerID)
+	if err != nil {
+		return errors.New("failed to get issuer")
+	}
+
+	policy, err := wu.permissionRepository.GetByRole(issuer.Role)
+	if err != nil {
+		return errors.New("failed to get permissions")
+	}
+
+	var topicID primitive.ObjectID
+	switch domain.TargetType {
+	case "":
+		if !policy.Allows(permissions.UserManage) {
+			return errors.New("warning must be linked to a thread or comment")
+		}
+
+		return nil
+	case TargetThread:
+		thread, err := wu.threadRepository.GetByID(domain.TargetID)
+		if err != nil {
+			return errors.New("failed to get thread")
+		}
+
+		if thread.CreatorID != domain.UserID {
+			return errors.New("thread is not created by the user")
+		}
+
+		topicID = thread.TopicID
+	case TargetComment:
+		comment, err := wu.commentRepository.GetByID(domain.TargetID)
+		if err != nil {
+			return errors.New("failed to get comment")
+		}
+
+		if comment.UserID != domain.UserID {
+			return errors.New("comment is not created by the user")
+		}
+
+		thread, err := wu.threadRepository.GetByID(comment.ThreadID)
+		if err != nil {
+			return errors.New("failed to get thread")
+		}
+
+		topicID = thread.TopicID
+	default:
+		return errors.New("target type must be thread or comment")
+	}
+
+	if policy.Allows(permissions.UserManage) || policy.Allows(permissions.ContentModerateAny) {
+		return nil
+	}
+
+	topic, err := wu.topicRepository.GetByID(topicID)
+	if err != nil {
+		return errors.New("failed to get topic")
+	}
+
+	if !policy.Allows(permissions.ContentModerateAssigned) || !topic.IsModeratedBy(domain.IssuerID) {
+		return errors.New("user is not a moderator of this topic")
+	}
+
+	return nil
+}
+
+// escalationFor picks the highest threshold the active strikes have reached
+func (wu *WarningUseCase) escalationFor(activeStrikes int) (Escalation, bool) {
+	var result Escalation
+	found := false
+	for _, escalation := range wu.config.Escalations {
+		if escalation.Strikes <= activeStrikes && escalation.Strikes > result.Strikes {
+			result = escalation
+			found = true
+		}
+	}
+
+	return result, found
+}
+
+/*
+Read
+*/
+
+func (wu *WarningUseCase) GetAllByUserID(userID primitive.ObjectID) ([]Domain, error) {
+	_, err := wu.userRepository.GetByID(userID)
+	if err != nil {
+		return []Domain{}, errors.New("failed to get user")
+	}
+
+	result, err := wu.warningRepository.GetAllByUserID(userID)
+	if err != nil {
+		return []Domain{}, errors.New("failed to get warnings")
+	}
+
+	return result, nil
+}
+
+func (wu *WarningUseCase) CountActiveByUserID(userID primitive.ObjectID) (int, error) {
+	result, err := wu.warningRepository.CountActiveByUserID(userID, primitive.NewDateTimeFromTime(time.Now()))
+	if err != nil {
+		return 0, errors.New("failed to count active strikes")
+	}
+
+	return result, nil
+}
+
+/*
+Delete
+*/
+
+func (wu *WarningUseCase) Delete(id primitive.ObjectID) (Domain, error) {
+	warning, err := wu.warningRepository.GetByID(id)
+	if err != nil {
+		return Domain{}, errors.New("failed to get warning")
+	}
+
+	err = wu.warningRepository.Delete(id)
+	if err != nil {
+		return Domain{}, errors.New("failed to delete warning")
+	}
+
+	return warning, nil
+}
+
+func (wu *WarningUseCase) DeleteAllByUserID(userID primitive.ObjectID) error {
+	err := wu.warningRepository.DeleteAllByUserID(userID)
+	if err != nil {
+		return errors.New("failed to delete all warnings")
+	}
+
+	return nil
+}
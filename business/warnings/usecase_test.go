@@ -0,0 +1,507 @@
+package warnings_test
+
+import (
+	"charum/business/comments"
+	_commentMock "charum/business/comments/mocks"
+	"charum/business/permissions"
+	_permissionMock "charum/business/permissions/mocks"
+	"charum/business/suspensions"
+	"charum/business/threads"
+	_threadMock "charum/business/threads/mocks"
+	"charum/business/topics"
+	_topicMock "charum/business/topics/mocks"
+	"charum/business/users"
+	_userMock "charum/business/users/mocks"
+	"charum/business/warnings"
+	_warningMock "charum/business/warnings/mocks"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var (
+	warningRepository    _warningMock.Repository
+	userRepository       _userMock.Repository
+	threadRepository     _threadMock.Repository
+	commentRepository    _commentMock.Repository
+	topicRepository      _topicMock.Repository
+	permissionRepository _permissionMock.Repository
+	userUseCase          _userMock.UseCase
+	warningUseCase       warnings.UseCase
+	warningDomain        warnings.Domain
+	suspensionDomain     suspensions.Domain
+	userDomain           users.Domain
+	adminDomain          users.Domain
+	moderatorDomain      users.Domain
+	topicDomain          topics.Domain
+	threadDomain         threads.Domain
+	commentDomain        comments.Domain
+	adminPolicy          permissions.Domain
+	moderatorPolicy      permissions.Domain
+)
+
+func TestMain(m *testing.M) {
+	warningUseCase = warnings.NewWarningUseCase(&warningRepository, &userRepository, &threadRepository, &commentRepository, &topicRepository, &permissionRepository, &userUseCase, warnings.Config{
+		StrikeDuration: 24 * time.Hour,
+		Escalations: []warnings.Escalation{
+			{Strikes: 3, Duration: 24 * time.Hour},
+			{Strikes: 5, Duration: 0},
+		},
+	})
+
+	userDomain = users.Domain{
+		Id:        primitive.NewObjectID(),
+		Email:     "user@charum.com",
+		UserName:  "user",
+		Role:      "user",
+		IsActive:  true,
+		CreatedAt: primitive.NewDateTimeFromTime(time.Now()),
+		UpdatedAt: primitive.NewDateTimeFromTime(time.Now()),
+	}
+
+	adminDomain = users.Domain{
+		Id:        primitive.NewObjectID(),
+		Email:     "admin@charum.com",
+		UserName:  "admin",
+		Role:      "admin",
+		IsActive:  true,
+		CreatedAt: primitive.NewDateTimeFromTime(time.Now()),
+		UpdatedAt: primitive.NewDateTimeFromTime(time.Now()),
+	}
+
+	moderatorDomain = users.Domain{
+		Id:        primitive.NewObjectID(),
+		Email:     "moderator@charum.com",
+		UserName:  "moderator",
+		Role:      "moderator",
+		IsActive:  true,
+		CreatedAt: primitive.NewDateTimeFromTime(time.Now()),
+		UpdatedAt: primitive.NewDateTimeFromTime(time.Now()),
+	}
+
+	topicDomain = topics.Domain{
+		Id:           primitive.NewObjectID(),
+		Topic:        "topic",
+		ModeratorIDs: []primitive.ObjectID{moderatorDomain.Id},
+		CreatedAt:    primitive.NewDateTimeFromTime(time.Now()),
+		UpdatedAt:    primitive.NewDateTimeFromTime(time.Now()),
+	}
+
+	threadDomain = threads.Domain{
+		Id:        primitive.NewObjectID(),
+		TopicID:   topicDomain.Id,
+		CreatorID: userDomain.Id,
+		Title:     "thread",
+		CreatedAt: primitive.NewDateTimeFromTime(time.Now()),
+		UpdatedAt: primitive.NewDateTimeFromTime(time.Now()),
+	}
+
+	commentDomain = comments.Domain{
+		Id:        primitive.NewObjectID(),
+		ThreadID:  threadDomain.Id,
+		UserID:    userDomain.Id,
+		Comment:   "comment",
+		CreatedAt: primitive.NewDateTimeFromTime(time.Now()),
+		UpdatedAt: primitive.NewDateTimeFromTime(time.Now()),
+	}
+
+	adminPolicy = permissions.Domain{
+		Id:          primitive.NewObjectID(),
+		Role:        "admin",
+		Permissions: permissions.Defaults["admin"],
+	}
+
+	moderatorPolicy = permissions.Domain{
+		Id:          primitive.NewObjectID(),
+		Role:        "moderator",
+		Permissions: permissions.Defaults["moderator"],
+	}
+
+	warningDomain = warnings.Domain{
+		Id:         primitive.NewObjectID(),
+		UserID:     userDomain.Id,
+		IssuerID:   moderatorDomain.Id,
+		Reason:     suspensions.ReasonSpam,
+		Detail:     "posting the same link in every thread",
+		TargetType: warnings.TargetThread,
+		TargetID:   threadDomain.Id,
+		ExpiredAt:  primitive.NewDateTimeFromTime(time.Now().Add(24 * time.Hour)),
+		CreatedAt:  primitive.NewDateTimeFromTime(time.Now()),
+		UpdatedAt:  primitive.NewDateTimeFromTime(time.Now()),
+	}
+
+	suspensionDomain = suspensions.Domain{
+		Id:        primitive.NewObjectID(),
+		UserID:    userDomain.Id,
+		AdminID:   moderatorDomain.Id,
+		Reason:    suspensions.ReasonSpam,
+		EndAt:     primitive.NewDateTimeFromTime(time.Now().Add(24 * time.Hour)),
+		CreatedAt: primitive.NewDateTimeFromTime(time.Now()),
+		UpdatedAt: primitive.NewDateTimeFromTime(time.Now()),
+	}
+
+	m.Run()
+}
+
+func TestParseConfig(t *testing.T) {
+	t.Run("Test case 1 | Valid parse config", func(t *testing.T) {
+		result, err := warnings.ParseConfig("48h", "2:12h, 4:0")
+
+		assert.Equal(t, warnings.Config{
+			StrikeDuration: 48 * time.Hour,
+			Escalations: []warnings.Escalation{
+				{Strikes: 2, Duration: 12 * time.Hour},
+				{Strikes: 4, Duration: 0},
+			},
+		}, result)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Valid parse config | Empty values fall back to the defaults", func(t *testing.T) {
+		result, err := warnings.ParseConfig("", "")
+
+		assert.Equal(t, 90*24*time.Hour, result.StrikeDuration)
+		assert.Len(t, result.Escalations, 3)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 3 | Invalid parse config | Invalid strike duration", func(t *testing.T) {
+		_, err := warnings.ParseConfig("a month", "")
+
+		assert.Equal(t, errors.New("invalid strike duration"), err)
+	})
+
+	t.Run("Test case 4 | Invalid parse config | Invalid escalation", func(t *testing.T) {
+		_, err := warnings.ParseConfig("", "3-24h")
+
+		assert.Equal(t, errors.New("invalid escalation 3-24h"), err)
+	})
+}
+
+func TestCreate(t *testing.T) {
+	t.Run("Test case 1 | Valid warn user on a thread by the topic moderator", func(t *testing.T) {
+		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
+		userRepository.On("GetByID", moderatorDomain.Id).Return(moderatorDomain, nil).Once()
+		permissionRepository.On("GetByRole", "moderator").Return(moderatorPolicy, nil).Once()
+		threadRepository.On("GetByID", threadDomain.Id).Return(threadDomain, nil).Once()
+		topicRepository.On("GetByID", topicDomain.Id).Return(topicDomain, nil).Once()
+		warningRepository.On("Create", mock.Anything).Return(warningDomain, nil).Once()
+		warningRepository.On("CountActiveByUserID", userDomain.Id, mock.Anything).Return(1, nil).Once()
+
+		result, suspension, err := warningUseCase.Create(&warnings.Domain{UserID: userDomain.Id, IssuerID: moderatorDomain.Id, Reason: suspensions.ReasonSpam, TargetType: warnings.TargetThread, TargetID: threadDomain.Id})
+
+		assert.Equal(t, warningDomain, result)
+		assert.Equal(t, suspensions.Domain{}, suspension)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Valid warn user on a comment by an admin", func(t *testing.T) {
+		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
+		userRepository.On("GetByID", adminDomain.Id).Return(adminDomain, nil).Once()
+		permissionRepository.On("GetByRole", "admin").Return(adminPolicy, nil).Once()
+		commentRepository.On("GetByID", commentDomain.Id).Return(commentDomain, nil).Once()
+		threadRepository.On("GetByID", threadDomain.Id).Return(threadDomain, nil).Once()
+		warningRepository.On("Create", mock.Anything).Return(warningDomain, nil).Once()
+		warningRepository.On("CountActiveByUserID", userDomain.Id, mock.Anything).Return(1, nil).Once()
+
+		result, suspension, err := warningUseCase.Create(&warnings.Domain{UserID: userDomain.Id, IssuerID: adminDomain.Id, Reason: suspensions.ReasonSpam, TargetType: warnings.TargetComment, TargetID: commentDomain.Id})
+
+		assert.Equal(t, warningDomain, result)
+		assert.Equal(t, suspensions.Domain{}, suspension)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 3 | Valid warn user | Reaching a threshold suspends the user for its duration", func(t *testing.T) {
+		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
+		userRepository.On("GetByID", adminDomain.Id).Return(adminDomain, nil).Once()
+		permissionRepository.On("GetByRole", "admin").Return(adminPolicy, nil).Once()
+		warningRepository.On("Create", mock.Anything).Return(warningDomain, nil).Once()
+		warningRepository.On("CountActiveByUserID", userDomain.Id, mock.Anything).Return(3, nil).Once()
+		userUseCase.On("Suspend", mock.MatchedBy(func(suspension *suspensions.Domain) bool {
+			return suspension.UserID == userDomain.Id && suspension.EndAt != 0
+		})).Return(users.Domain{}, suspensionDomain, nil).Once()
+
+		result, suspension, err := warningUseCase.Create(&warnings.Domain{UserID: userDomain.Id, IssuerID: adminDomain.Id, Reason: suspensions.ReasonSpam})
+
+		assert.Equal(t, warningDomain, result)
+		assert.Equal(t, suspensionDomain, suspension)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 4 | Valid warn user | Reaching the last threshold suspends the user until lifted", func(t *testing.T) {
+		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
+		userRepository.On("GetByID", adminDomain.Id).Return(adminDomain, nil).Once()
+		permissionRepository.On("GetByRole", "admin").Return(adminPolicy, nil).Once()
+		warningRepository.On("Create", mock.Anything).Return(warningDomain, nil).Once()
+		warningRepository.On("CountActiveByUserID", userDomain.Id, mock.Anything).Return(5, nil).Once()
+		userUseCase.On("Suspend", mock.MatchedBy(func(suspension *suspensions.Domain) bool {
+			return suspension.UserID == userDomain.Id && suspension.EndAt == 0
+		})).Return(users.Domain{}, suspensionDomain, nil).Once()
+
+		result, suspension, err := warningUseCase.Create(&warnings.Domain{UserID: userDomain.Id, IssuerID: adminDomain.Id, Reason: suspensions.ReasonSpam})
+
+		assert.Equal(t, warningDomain, result)
+		assert.Equal(t, suspensionDomain, suspension)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 5 | Valid warn user | Already suspended user is not suspended again", func(t *testing.T) {
+		copyDomain := userDomain
+		copyDomain.IsActive = false
+		userRepository.On("GetByID", userDomain.Id).Return(copyDomain, nil).Once()
+		userRepository.On("GetByID", adminDomain.Id).Return(adminDomain, nil).Once()
+		permissionRepository.On("GetByRole", "admin").Return(adminPolicy, nil).Once()
+		warningRepository.On("Create", mock.Anything).Return(warningDomain, nil).Once()
+		warningRepository.On("CountActiveByUserID", userDomain.Id, mock.Anything).Return(3, nil).Once()
+
+		result, suspension, err := warningUseCase.Create(&warnings.Domain{UserID: userDomain.Id, IssuerID: adminDomain.Id, Reason: suspensions.ReasonSpam})
+
+		assert.Equal(t, warningDomain, result)
+		assert.Equal(t, suspensions.Domain{}, suspension)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 6 | Invalid warn user | Invalid reason", func(t *testing.T) {
+		expectedErr := errors.New("invalid warning reason")
+
+		result, suspension, err := warningUseCase.Create(&warnings.Domain{UserID: userDomain.Id, IssuerID: adminDomain.Id, Reason: "being annoying"})
+
+		assert.Equal(t, warnings.Domain{}, result)
+		assert.Equal(t, suspensions.Domain{}, suspension)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 7 | Invalid warn user | User warns themselves", func(t *testing.T) {
+		expectedErr := errors.New("user cannot warn themselves")
+
+		result, suspension, err := warningUseCase.Create(&warnings.Domain{UserID: adminDomain.Id, IssuerID: adminDomain.Id, Reason: suspensions.ReasonSpam})
+
+		assert.Equal(t, warnings.Domain{}, result)
+		assert.Equal(t, suspensions.Domain{}, suspension)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 8 | Invalid warn user | Admin cannot be warned", func(t *testing.T) {
+		expectedErr := errors.New("admin cannot be warned")
+		userRepository.On("GetByID", adminDomain.Id).Return(adminDomain, nil).Once()
+
+		result, suspension, err := warningUseCase.Create(&warnings.Domain{UserID: adminDomain.Id, IssuerID: moderatorDomain.Id, Reason: suspensions.ReasonSpam})
+
+		assert.Equal(t, warnings.Domain{}, result)
+		assert.Equal(t, suspensions.Domain{}, suspension)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 9 | Invalid warn user | Moderator warns without a target", func(t *testing.T) {
+		expectedErr := errors.New("warning must be linked to a thread or comment")
+		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
+		userRepository.On("GetByID", moderatorDomain.Id).Return(moderatorDomain, nil).Once()
+		permissionRepository.On("GetByRole", "moderator").Return(moderatorPolicy, nil).Once()
+
+		result, suspension, err := warningUseCase.Create(&warnings.Domain{UserID: userDomain.Id, IssuerID: moderatorDomain.Id, Reason: suspensions.ReasonSpam})
+
+		assert.Equal(t, warnings.Domain{}, result)
+		assert.Equal(t, suspensions.Domain{}, suspension)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 10 | Invalid warn user | Moderator of another topic", func(t *testing.T) {
+		expectedErr := errors.New("user is not a moderator of this topic")
+		copyTopic := topicDomain
+		copyTopic.ModeratorIDs = []primitive.ObjectID{}
+		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
+		userRepository.On("GetByID", moderatorDomain.Id).Return(moderatorDomain, nil).Once()
+		permissionRepository.On("GetByRole", "moderator").Return(moderatorPolicy, nil).Once()
+		threadRepository.On("GetByID", threadDomain.Id).Return(threadDomain, nil).Once()
+		topicRepository.On("GetByID", topicDomain.Id).Return(copyTopic, nil).Once()
+
+		result, suspension, err := warningUseCase.Create(&warnings.Domain{UserID: userDomain.Id, IssuerID: moderatorDomain.Id, Reason: suspensions.ReasonSpam, TargetType: warnings.TargetThread, TargetID: threadDomain.Id})
+
+		assert.Equal(t, warnings.Domain{}, result)
+		assert.Equal(t, suspensions.Domain{}, suspension)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 11 | Invalid warn user | Thread is created by another user", func(t *testing.T) {
+		expectedErr := errors.New("thread is not created by the user")
+		copyThread := threadDomain
+		copyThread.CreatorID = primitive.NewObjectID()
+		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
+		userRepository.On("GetByID", moderatorDomain.Id).Return(moderatorDomain, nil).Once()
+		permissionRepository.On("GetByRole", "moderator").Return(moderatorPolicy, nil).Once()
+		threadRepository.On("GetByID", threadDomain.Id).Return(copyThread, nil).Once()
+
+		result, suspension, err := warningUseCase.Create(&warnings.Domain{UserID: userDomain.Id, IssuerID: moderatorDomain.Id, Reason: suspensions.ReasonSpam, TargetType: warnings.TargetThread, TargetID: threadDomain.Id})
+
+		assert.Equal(t, warnings.Domain{}, result)
+		assert.Equal(t, suspensions.Domain{}, suspension)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 12 | Invalid warn user | Invalid target type", func(t *testing.T) {
+		expectedErr := errors.New("target type must be thread or comment")
+		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
+		userRepository.On("GetByID", adminDomain.Id).Return(adminDomain, nil).Once()
+		permissionRepository.On("GetByRole", "admin").Return(adminPolicy, nil).Once()
+
+		result, suspension, err := warningUseCase.Create(&warnings.Domain{UserID: userDomain.Id, IssuerID: adminDomain.Id, Reason: suspensions.ReasonSpam, TargetType: "topic", TargetID: topicDomain.Id})
+
+		assert.Equal(t, warnings.Domain{}, result)
+		assert.Equal(t, suspensions.Domain{}, suspension)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 13 | Invalid warn user | Error when creating warning", func(t *testing.T) {
+		expectedErr := errors.New("failed to create warning")
+		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
+		userRepository.On("GetByID", adminDomain.Id).Return(adminDomain, nil).Once()
+		permissionRepository.On("GetByRole", "admin").Return(adminPolicy, nil).Once()
+		warningRepository.On("Create", mock.Anything).Return(warnings.Domain{}, errors.New("unexpected error")).Once()
+
+		result, suspension, err := warningUseCase.Create(&warnings.Domain{UserID: userDomain.Id, IssuerID: adminDomain.Id, Reason: suspensions.ReasonSpam})
+
+		assert.Equal(t, warnings.Domain{}, result)
+		assert.Equal(t, suspensions.Domain{}, suspension)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 14 | Invalid warn user | Error when suspending user", func(t *testing.T) {
+		expectedErr := errors.New("failed to suspend user")
+		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
+		userRepository.On("GetByID", adminDomain.Id).Return(adminDomain, nil).Once()
+		permissionRepository.On("GetByRole", "admin").Return(adminPolicy, nil).Once()
+		warningRepository.On("Create", mock.Anything).Return(warningDomain, nil).Once()
+		warningRepository.On("CountActiveByUserID", userDomain.Id, mock.Anything).Return(3, nil).Once()
+		userUseCase.On("Suspend", mock.Anything).Return(users.Domain{}, suspensions.Domain{}, expectedErr).Once()
+
+		result, suspension, err := warningUseCase.Create(&warnings.Domain{UserID: userDomain.Id, IssuerID: adminDomain.Id, Reason: suspensions.ReasonSpam})
+
+		assert.Equal(t, warnings.Domain{}, result)
+		assert.Equal(t, suspensions.Domain{}, suspension)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 15 | Valid warn user | Warning after a reached threshold does not suspend again", func(t *testing.T) {
+		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
+		userRepository.On("GetByID", adminDomain.Id).Return(adminDomain, nil).Once()
+		permissionRepository.On("GetByRole", "admin").Return(adminPolicy, nil).Once()
+		warningRepository.On("Create", mock.Anything).Return(warningDomain, nil).Once()
+		warningRepository.On("CountActiveByUserID", userDomain.Id, mock.Anything).Return(4, nil).Once()
+
+		result, suspension, err := warningUseCase.Create(&warnings.Domain{UserID: userDomain.Id, IssuerID: adminDomain.Id, Reason: suspensions.ReasonSpam})
+
+		assert.Equal(t, warningDomain, result)
+		assert.Equal(t, suspensions.Domain{}, suspension)
+		assert.Nil(t, err)
+	})
+}
+
+func TestGetAllByUserID(t *testing.T) {
+	t.Run("Test case 1 | Valid get warnings", func(t *testing.T) {
+		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
+		warningRepository.On("GetAllByUserID", userDomain.Id).Return([]warnings.Domain{warningDomain}, nil).Once()
+
+		result, err := warningUseCase.GetAllByUserID(userDomain.Id)
+
+		assert.Equal(t, []warnings.Domain{warningDomain}, result)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Invalid get warnings | Error when getting user", func(t *testing.T) {
+		expectedErr := errors.New("failed to get user")
+		userRepository.On("GetByID", userDomain.Id).Return(users.Domain{}, errors.New("not found")).Once()
+
+		result, err := warningUseCase.GetAllByUserID(userDomain.Id)
+
+		assert.Equal(t, []warnings.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 3 | Invalid get warnings | Error when getting warnings", func(t *testing.T) {
+		expectedErr := errors.New("failed to get warnings")
+		userRepository.On("GetByID", userDomain.Id).Return(userDomain, nil).Once()
+		warningRepository.On("GetAllByUserID", userDomain.Id).Return([]warnings.Domain{}, errors.New("unexpected error")).Once()
+
+		result, err := warningUseCase.GetAllByUserID(userDomain.Id)
+
+		assert.Equal(t, []warnings.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestCountActiveByUserID(t *testing.T) {
+	t.Run("Test case 1 | Valid count active strikes", func(t *testing.T) {
+		warningRepository.On("CountActiveByUserID", userDomain.Id, mock.Anything).Return(2, nil).Once()
+
+		result, err := warningUseCase.CountActiveByUserID(userDomain.Id)
+
+		assert.Equal(t, 2, result)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Invalid count active strikes | Error when counting", func(t *testing.T) {
+		expectedErr := errors.New("failed to count active strikes")
+		warningRepository.On("CountActiveByUserID", userDomain.Id, mock.Anything).Return(0, errors.New("unexpected error")).Once()
+
+		result, err := warningUseCase.CountActiveByUserID(userDomain.Id)
+
+		assert.Equal(t, 0, result)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestDelete(t *testing.T) {
+	t.Run("Test case 1 | Valid delete warning", func(t *testing.T) {
+		warningRepository.On("GetByID", warningDomain.Id).Return(warningDomain, nil).Once()
+		warningRepository.On("Delete", warningDomain.Id).Return(nil).Once()
+
+		result, err := warningUseCase.Delete(warningDomain.Id)
+
+		assert.Equal(t, warningDomain, result)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Invalid delete warning | Error when getting warning", func(t *testing.T) {
+		expectedErr := errors.New("failed to get warning")
+		warningRepository.On("GetByID", warningDomain.Id).Return(warnings.Domain{}, errors.New("not found")).Once()
+
+		result, err := warningUseCase.Delete(warningDomain.Id)
+
+		assert.Equal(t, warnings.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("Test case 3 | Invalid delete warning | Error when deleting warning", func(t *testing.T) {
+		expectedErr := errors.New("failed to delete warning")
+		warningRepository.On("GetByID", warningDomain.Id).Return(warningDomain, nil).Once()
+		warningRepository.On("Delete", warningDomain.Id).Return(errors.New("unexpected error")).Once()
+
+		result, err := warningUseCase.Delete(warningDomain.Id)
+
+		assert.Equal(t, warnings.Domain{}, result)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestDeleteAllByUserID(t *testing.T) {
+	t.Run("Test case 1 | Valid delete all warnings", func(t *testing.T) {
+		warningRepository.On("DeleteAllByUserID", userDomain.Id).Return(nil).Once()
+
+		err := warningUseCase.DeleteAllByUserID(userDomain.Id)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test case 2 | Invalid delete all warnings | Error when deleting", func(t *testing.T) {
+		expectedErr := errors.New("failed to delete all warnings")
+		warningRepository.On("DeleteAllByUserID", userDomain.Id).Return(errors.New("unexpected error")).Once()
+
+		err := warningUseCase.DeleteAllByUserID(userDomain.Id)
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
@@ -0,0 +1,332 @@
+package appeals
+
+import (
+	"charum/business/appeals"
+	auditLogs "charum/business/audit_logs"
+	"charum/controller/appeals/request"
+	"charum/controller/appeals/response"
+	dtoPagination "charum/dto/pagination"
+	"charum/helper"
+	"charum/util"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type AppealController struct {
+	appealUseCase   appeals.UseCase
+	auditLogUseCase auditLogs.UseCase
+}
+
+func NewAppealController(appealUC appeals.UseCase, auditLogUC auditLogs.UseCase) *AppealController {
+	return &AppealController{
+		appealUseCase:   appealUC,
+		auditLogUseCase: auditLogUC,
+	}
+}
+
+/*
+Create
+*/
+
+func (ac *AppealController) Create(c echo.Context) error {
+	userInput := request.Appeal{}
+	c.Bind(&userInput)
+
+	if err := userInput.Validate(); err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "validation failed",
+			Data:    err,
+		})
+	}
+
+	appeal, err := ac.appealUseCase.Create(userInput.AppealToken, userInput.Message)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if strings.Contains(err.Error(), "invalid or expired") {
+			statusCode = http.StatusUnauthorized
+		} else if strings.Contains(err.Error(), "failed to get") {
+			statusCode = http.StatusNotFound
+		} else if strings.Contains(err.Error(), "already been appealed") || strings.Contains(err.Error(), "not suspended") {
+			statusCode = http.StatusConflict
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusCreated, helper.BaseResponse{
+		Status:  http.StatusCreated,
+		Message: "success to submit appeal",
+		Data: map[string]interface{}{
+			"appeal": response.FromDomain(appeal),
+		},
+	})
+}
+
+/*
+Read
+*/
+
+func (ac *AppealController) GetManyWithPagination(c echo.Context) error {
+	page, err := strconv.Atoi(c.Param("page"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:     http.StatusBadRequest,
+			Message:    "page must be a number",
+			Data:       nil,
+			Pagination: helper.Page{},
+		})
+	} else if page < 1 {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:     http.StatusBadRequest,
+			Message:    "page must be greater than 0",
+			Data:       nil,
+			Pagination: helper.Page{},
+		})
+	}
+
+	limit := c.QueryParam("limit")
+	if limit == "" {
+		limit = "25"
+	}
+	limitNumber, err := strconv.Atoi(limit)
+	if err != nil || limitNumber < 1 {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:     http.StatusBadRequest,
+			Message:    "limit must be a number and greater than 0",
+			Data:       nil,
+			Pagination: helper.Page{},
+		})
+	}
+
+	sort := c.QueryParam("sort")
+	if sort == "" {
+		sort = "createdAt"
+	} else if !(sort == "_id" || sort == "createdAt" || sort == "updatedAt") {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:     http.StatusBadRequest,
+			Message:    "sort must be _id, createdAt, or updatedAt",
+			Data:       nil,
+			Pagination: helper.Page{},
+		})
+	}
+
+	// the queue is worked through from the oldest appeal by default
+	order := c.QueryParam("order")
+	if order == "" {
+		order = "asc"
+	} else if !(order == "asc" || order == "desc") {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:     http.StatusBadRequest,
+			Message:    "order must be asc or desc",
+			Data:       nil,
+			Pagination: helper.Page{},
+		})
+	}
+
+	status := c.QueryParam("status")
+	if !(status == "" || status == appeals.StatusPending || status == appeals.StatusAccepted || status == appeals.StatusRejected) {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:     http.StatusBadRequest,
+			Message:    "status must be pending, accepted, or rejected",
+			Data:       nil,
+			Pagination: helper.Page{},
+		})
+	}
+
+	appealInputDomain := appeals.Domain{
+		Status: status,
+	}
+
+	if userID := c.QueryParam("user-id"); userID != "" {
+		appealInputDomain.UserID, err = primitive.ObjectIDFromHex(userID)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+				Status:     http.StatusBadRequest,
+				Message:    "invalid user id",
+				Data:       nil,
+				Pagination: helper.Page{},
+			})
+		}
+	}
+
+	pagination := dtoPagination.Request{
+		Page:  page,
+		Limit: limitNumber,
+		Sort:  sort,
+		Order: order,
+	}
+
+	appealList, totalPage, totalData, err := ac.appealUseCase.GetManyWithPagination(pagination, &appealInputDomain)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to get appeals",
+		Data: map[string]interface{}{
+			"appeals": response.FromDomainArray(appealList),
+		},
+		Pagination: helper.Page{
+			Size:        limitNumber,
+			TotalData:   totalData,
+			TotalPage:   totalPage,
+			CurrentPage: page,
+		},
+	})
+}
+
+func (ac *AppealController) GetByID(c echo.Context) error {
+	appealID, err := primitive.ObjectIDFromHex(c.Param("appeal-id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "invalid appeal id",
+			Data:    nil,
+		})
+	}
+
+	appeal, err := ac.appealUseCase.GetByID(appealID)
+	if err != nil {
+		return c.JSON(http.StatusNotFound, helper.BaseResponse{
+			Status:  http.StatusNotFound,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to get appeal",
+		Data: map[string]interface{}{
+			"appeal": response.FromDomain(appeal),
+		},
+	})
+}
+
+/*
+Update
+*/
+
+func (ac *AppealController) Accept(c echo.Context) error {
+	return ac.review(c, appeals.StatusAccepted)
+}
+
+func (ac *AppealController) Reject(c echo.Context) error {
+	return ac.review(c, appeals.StatusRejected)
+}
+
+func (ac *AppealController) review(c echo.Context, status string) error {
+	appealID, err := primitive.ObjectIDFromHex(c.Param("appeal-id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "invalid appeal id",
+			Data:    nil,
+		})
+	}
+
+	reviewerID, err := util.GetUIDFromToken(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
+			Status:  http.StatusUnauthorized,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	userInput := request.Review{}
+	c.Bind(&userInput)
+
+	if err := userInput.Validate(); err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "validation failed",
+			Data:    err,
+		})
+	}
+
+	appealBefore, err := ac.appealUseCase.GetByID(appealID)
+	if err != nil {
+		return c.JSON(http.StatusNotFound, helper.BaseResponse{
+			Status:  http.StatusNotFound,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	var appeal appeals.Domain
+	if status == appeals.StatusAccepted {
+		appeal, err = ac.appealUseCase.Accept(appealID, reviewerID, userInput.Reply)
+	} else {
+		appeal, err = ac.appealUseCase.Reject(appealID, reviewerID, userInput.Reply)
+	}
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if strings.Contains(err.Error(), "failed to get") {
+			statusCode = http.StatusNotFound
+		} else if strings.Contains(err.Error(), "already been reviewed") {
+			statusCode = http.StatusConflict
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	action := auditLogs.ActionAppealReject
+	if status == appeals.StatusAccepted {
+		action = auditLogs.ActionAppealAccept
+	}
+
+	err = ac.audit(c, action, appealID, response.FromDomain(appealBefore), response.FromDomain(appeal))
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to " + strings.TrimSuffix(status, "ed") + " appeal",
+		Data: map[string]interface{}{
+			"appeal": response.FromDomain(appeal),
+		},
+	})
+}
+
+// audit records the review of an appeal, an accepted appeal also lifts the user's suspension
+func (ac *AppealController) audit(c echo.Context, action string, appealID primitive.ObjectID, before interface{}, after interface{}) error {
+	actorID, err := util.GetUIDFromToken(c)
+	if err != nil {
+		return err
+	}
+
+	_, err = ac.auditLogUseCase.Create(&auditLogs.Domain{
+		ActorID:    actorID,
+		Action:     action,
+		TargetType: auditLogs.TargetAppeal,
+		TargetID:   appealID,
+		Before:     before,
+		After:      after,
+		IP:         c.RealIP(),
+	})
+	return err
+}
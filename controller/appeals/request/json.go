@@ -0,0 +1,81 @@
+package request
+
+import (
+	"charum/helper"
+	"errors"
+	"strings"
+
+	"github.com/fatih/structs"
+	"github.com/go-playground/validator/v10"
+)
+
+type Appeal struct {
+	AppealToken string `json:"appealToken" validate:"required" bson:"appealToken" form:"appealToken"`
+	Message     string `json:"message" validate:"required,max=1000" bson:"message" form:"message"`
+}
+
+func (req *Appeal) Validate() []helper.ValidationError {
+	var ve validator.ValidationErrors
+
+	if err := validator.New().Struct(req); err != nil {
+		if errors.As(err, &ve) {
+			fields := structs.Fields(req)
+			out := make([]helper.ValidationError, len(ve))
+
+			for i, e := range ve {
+				out[i] = helper.ValidationError{
+					Field:   e.Field(),
+					Message: helper.MessageForTag(e.Tag()),
+				}
+
+				out[i].Message = strings.Replace(out[i].Message, "[PARAM]", e.Param(), 1)
+
+				// Get field tag
+				for _, f := range fields {
+					if f.Name() == e.Field() {
+						out[i].Field = f.Tag("json")
+						break
+					}
+				}
+			}
+			return out
+		}
+	}
+
+	return nil
+}
+
+type Review struct {
+	Reply string `json:"reply" validate:"required,max=1000" bson:"reply" form:"reply"`
+}
+
+func (req *Review) Validate() []helper.ValidationError {
+	var ve validator.ValidationErrors
+
+	if err := validator.New().Struct(req); err != nil {
+		if errors.As(err, &ve) {
+			fields := structs.Fields(req)
+			out := make([]helper.ValidationError, len(ve))
+
+			for i, e := range ve {
+				out[i] = helper.ValidationError{
+					Field:   e.Field(),
+					Message: helper.MessageForTag(e.Tag()),
+				}
+
+				out[i].Message = strings.Replace(out[i].Message, "[PARAM]", e.Param(), 1)
+
+				// Get field tag
+				for _, f := range fields {
+					if f.Name() == e.Field() {
+						out[i].Field = f.Tag("json")
+						break
+					}
+				}
+			}
+			return out
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package response
+
+import (
+	"charum/business/appeals"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type Appeal struct {
+	Id           primitive.ObjectID `json:"_id" bson:"_id"`
+	UserID       primitive.ObjectID `json:"userID" bson:"userID"`
+	SuspensionID primitive.ObjectID `json:"suspensionID" bson:"suspensionID"`
+	Message      string             `json:"message" bson:"message"`
+	Status       string             `json:"status" bson:"status"`
+	Reply        string             `json:"reply,omitempty" bson:"reply,omitempty"`
+	ReviewerID   primitive.ObjectID `json:"reviewerID,omitempty" bson:"reviewerID,omitempty"`
+	ReviewedAt   primitive.DateTime `json:"reviewedAt,omitempty" bson:"reviewedAt,omitempty"`
+	CreatedAt    primitive.DateTime `json:"createdAt" bson:"createdAt"`
+}
+
+func FromDomain(domain appeals.Domain) Appeal {
+	return Appeal{
+		Id:           domain.Id,
+		UserID:       domain.UserID,
+		SuspensionID: domain.SuspensionID,
+		Message:      domain.Message,
+		Status:       domain.Status,
+		Reply:        domain.Reply,
+		ReviewerID:   domain.ReviewerID,
+		ReviewedAt:   domain.ReviewedAt,
+		CreatedAt:    domain.CreatedAt,
+	}
+}
+
+func FromDomainArray(data []appeals.Domain) []Appeal {
+	var array []Appeal
+	for _, v := range data {
+		array = append(array, FromDomain(v))
+	}
+	return array
+}
@@ -0,0 +1,153 @@
+package audit_logs
+
+import (
+	"charum/business/audit_logs"
+	"charum/controller/audit_logs/response"
+	dtoPagination "charum/dto/pagination"
+	"charum/helper"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type AuditLogController struct {
+	auditLogUseCase audit_logs.UseCase
+}
+
+func NewAuditLogController(auditLogUC audit_logs.UseCase) *AuditLogController {
+	return &AuditLogController{
+		auditLogUseCase: auditLogUC,
+	}
+}
+
+/*
+Read
+*/
+
+func (alc *AuditLogController) GetManyWithPagination(c echo.Context) error {
+	page, err := strconv.Atoi(c.Param("page"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:     http.StatusBadRequest,
+			Message:    "page must be a number",
+			Data:       nil,
+			Pagination: helper.Page{},
+		})
+	} else if page < 1 {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:     http.StatusBadRequest,
+			Message:    "page must be greater than 0",
+			Data:       nil,
+			Pagination: helper.Page{},
+		})
+	}
+
+	limit := c.QueryParam("limit")
+	if limit == "" {
+		limit = "25"
+	}
+	limitNumber, err := strconv.Atoi(limit)
+	if err != nil || limitNumber < 1 {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:     http.StatusBadRequest,
+			Message:    "limit must be a number and greater than 0",
+			Data:       nil,
+			Pagination: helper.Page{},
+		})
+	}
+
+	sort := c.QueryParam("sort")
+	if sort == "" {
+		sort = "createdAt"
+	} else if !(sort == "_id" || sort == "createdAt" || sort == "action") {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:     http.StatusBadRequest,
+			Message:    "sort must be _id, createdAt, or action",
+			Data:       nil,
+			Pagination: helper.Page{},
+		})
+	}
+
+	order := c.QueryParam("order")
+	if order == "" {
+		order = "desc"
+	} else if !(order == "asc" || order == "desc") {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:     http.StatusBadRequest,
+			Message:    "order must be asc or desc",
+			Data:       nil,
+			Pagination: helper.Page{},
+		})
+	}
+
+	targetType := c.QueryParam("target-type")
+	if !(targetType == "" || targetType == audit_logs.TargetUser || targetType == audit_logs.TargetThread || targetType == audit_logs.TargetTopic || targetType == audit_logs.TargetTag || targetType == audit_logs.TargetAppeal || targetType == audit_logs.TargetPermission) {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:     http.StatusBadRequest,
+			Message:    "target type must be user, thread, topic, or tag",
+			Data:       nil,
+			Pagination: helper.Page{},
+		})
+	}
+
+	auditLogInputDomain := audit_logs.Domain{
+		Action:     c.QueryParam("action"),
+		TargetType: targetType,
+	}
+
+	if actorID := c.QueryParam("actor-id"); actorID != "" {
+		auditLogInputDomain.ActorID, err = primitive.ObjectIDFromHex(actorID)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+				Status:     http.StatusBadRequest,
+				Message:    "invalid actor id",
+				Data:       nil,
+				Pagination: helper.Page{},
+			})
+		}
+	}
+
+	if targetID := c.QueryParam("target-id"); targetID != "" {
+		auditLogInputDomain.TargetID, err = primitive.ObjectIDFromHex(targetID)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+				Status:     http.StatusBadRequest,
+				Message:    "invalid target id",
+				Data:       nil,
+				Pagination: helper.Page{},
+			})
+		}
+	}
+
+	pagination := dtoPagination.Request{
+		Page:  page,
+		Limit: limitNumber,
+		Sort:  sort,
+		Order: order,
+	}
+
+	auditLogList, totalPage, totalData, err := alc.auditLogUseCase.GetManyWithPagination(pagination, &auditLogInputDomain)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to get audit logs",
+		Data: map[string]interface{}{
+			"auditLogs": response.FromDomainArray(auditLogList),
+		},
+		Pagination: helper.Page{
+			Size:        limitNumber,
+			TotalData:   totalData,
+			TotalPage:   totalPage,
+			CurrentPage: page,
+		},
+	})
+}
@@ -0,0 +1,41 @@
+package response
+
+import (
+	"charum/business/audit_logs"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type AuditLog struct {
+	Id         primitive.ObjectID `json:"_id" bson:"_id"`
+	ActorID    primitive.ObjectID `json:"actorID" bson:"actorID"`
+	Action     string             `json:"action" bson:"action"`
+	TargetType string             `json:"targetType" bson:"targetType"`
+	TargetID   primitive.ObjectID `json:"targetID" bson:"targetID"`
+	Before     interface{}        `json:"before,omitempty" bson:"before,omitempty"`
+	After      interface{}        `json:"after,omitempty" bson:"after,omitempty"`
+	IP         string             `json:"ip" bson:"ip"`
+	CreatedAt  primitive.DateTime `json:"createdAt" bson:"createdAt"`
+}
+
+func FromDomain(domain audit_logs.Domain) AuditLog {
+	return AuditLog{
+		Id:         domain.Id,
+		ActorID:    domain.ActorID,
+		Action:     domain.Action,
+		TargetType: domain.TargetType,
+		TargetID:   domain.TargetID,
+		Before:     domain.Before,
+		After:      domain.After,
+		IP:         domain.IP,
+		CreatedAt:  domain.CreatedAt,
+	}
+}
+
+func FromDomainArray(data []audit_logs.Domain) []AuditLog {
+	var array []AuditLog
+	for _, v := range data {
+		array = append(array, FromDomain(v))
+	}
+	return array
+}
@@ -100,6 +100,8 @@ func (cc *CommentController) Create(c echo.Context) error {
 		statusCode := http.StatusInternalServerError
 		if strings.Contains(err.Error(), "failed to get") {
 			statusCode = http.StatusNotFound
+		} else if err.Error() == "user is blocked by the thread creator" || strings.Contains(err.Error(), "thread is locked") {
+			statusCode = http.StatusForbidden
 		}
 
 		return c.JSON(statusCode, helper.BaseResponse{
@@ -288,3 +290,177 @@ func (cc *CommentController) Delete(c echo.Context) error {
 		},
 	})
 }
+
+func (cc *CommentController) ModeratorSuspend(c echo.Context) error {
+	moderatorID, err := util.GetUIDFromToken(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
+			Status:  http.StatusUnauthorized,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	commentID, err := primitive.ObjectIDFromHex(c.Param("comment-id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "invalid comment id",
+			Data:    nil,
+		})
+	}
+
+	suspendInput := request.Suspend{}
+	c.Bind(&suspendInput)
+
+	if err := suspendInput.Validate(); err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "validation failed",
+			Data:    err,
+		})
+	}
+
+	comment, err := cc.CommentUseCase.ModeratorSuspend(moderatorID, commentID, suspendInput.SuspendDetail)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if strings.Contains(err.Error(), "failed to get") {
+			statusCode = http.StatusNotFound
+		} else if strings.Contains(err.Error(), "not a moderator") {
+			statusCode = http.StatusForbidden
+		} else if strings.Contains(err.Error(), "already suspended") {
+			statusCode = http.StatusConflict
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	responseComment, err := cc.CommentUseCase.DomainToResponse(comment)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success suspend comment",
+		Data: map[string]interface{}{
+			"comment": responseComment,
+		},
+	})
+}
+
+func (cc *CommentController) ModeratorUnsuspend(c echo.Context) error {
+	moderatorID, err := util.GetUIDFromToken(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
+			Status:  http.StatusUnauthorized,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	commentID, err := primitive.ObjectIDFromHex(c.Param("comment-id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "invalid comment id",
+			Data:    nil,
+		})
+	}
+
+	comment, err := cc.CommentUseCase.ModeratorUnsuspend(moderatorID, commentID)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if strings.Contains(err.Error(), "failed to get") {
+			statusCode = http.StatusNotFound
+		} else if strings.Contains(err.Error(), "not a moderator") {
+			statusCode = http.StatusForbidden
+		} else if strings.Contains(err.Error(), "not suspended") {
+			statusCode = http.StatusConflict
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	responseComment, err := cc.CommentUseCase.DomainToResponse(comment)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success unsuspend comment",
+		Data: map[string]interface{}{
+			"comment": responseComment,
+		},
+	})
+}
+
+func (cc *CommentController) ModeratorDelete(c echo.Context) error {
+	moderatorID, err := util.GetUIDFromToken(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
+			Status:  http.StatusUnauthorized,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	commentID, err := primitive.ObjectIDFromHex(c.Param("comment-id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "invalid comment id",
+			Data:    nil,
+		})
+	}
+
+	comment, err := cc.CommentUseCase.ModeratorDelete(moderatorID, commentID)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if strings.Contains(err.Error(), "failed to get") {
+			statusCode = http.StatusNotFound
+		} else if strings.Contains(err.Error(), "not a moderator") {
+			statusCode = http.StatusForbidden
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	responseComment, err := cc.CommentUseCase.DomainToResponse(comment)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success delete comment",
+		Data: map[string]interface{}{
+			"comment": responseComment,
+		},
+	})
+}
@@ -0,0 +1,117 @@
+package data_exports
+
+import (
+	"charum/business/data_exports"
+	"charum/controller/data_exports/response"
+	"charum/helper"
+	"charum/util"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+type DataExportController struct {
+	dataExportUseCase data_exports.UseCase
+}
+
+func NewDataExportController(dataExportUC data_exports.UseCase) *DataExportController {
+	return &DataExportController{
+		dataExportUseCase: dataExportUC,
+	}
+}
+
+/*
+Create
+*/
+
+func (dataExportCtrl *DataExportController) Request(c echo.Context) error {
+	userID, err := util.GetUIDFromToken(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
+			Status:  http.StatusUnauthorized,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	dataExport, err := dataExportCtrl.dataExportUseCase.Request(userID)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if strings.Contains(err.Error(), "failed to get user") {
+			statusCode = http.StatusNotFound
+		} else if strings.Contains(err.Error(), "in progress") {
+			statusCode = http.StatusConflict
+		} else if strings.Contains(err.Error(), "please wait") {
+			statusCode = http.StatusTooManyRequests
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusAccepted, helper.BaseResponse{
+		Status:  http.StatusAccepted,
+		Message: "success to request data export, the download link will be sent to your email",
+		Data: map[string]interface{}{
+			"dataExport": response.FromDomain(dataExport),
+		},
+	})
+}
+
+/*
+Read
+*/
+
+func (dataExportCtrl *DataExportController) GetLatest(c echo.Context) error {
+	userID, err := util.GetUIDFromToken(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
+			Status:  http.StatusUnauthorized,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	dataExport, err := dataExportCtrl.dataExportUseCase.GetLatestByUserID(userID)
+	if err != nil {
+		return c.JSON(http.StatusNotFound, helper.BaseResponse{
+			Status:  http.StatusNotFound,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to get data export",
+		Data: map[string]interface{}{
+			"dataExport": response.FromDomain(dataExport),
+		},
+	})
+}
+
+func (dataExportCtrl *DataExportController) Download(c echo.Context) error {
+	token := c.Param("token")
+
+	dataExport, err := dataExportCtrl.dataExportUseCase.Download(token)
+	if err != nil {
+		statusCode := http.StatusNotFound
+		if strings.Contains(err.Error(), "expired") {
+			statusCode = http.StatusGone
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=charum-export-%s.zip", dataExport.CreatedAt.Time().Format("20060102")))
+	return c.Blob(http.StatusOK, "application/zip", dataExport.Archive)
+}
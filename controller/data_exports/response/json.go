@@ -0,0 +1,25 @@
+package response
+
+import (
+	"charum/business/data_exports"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type DataExport struct {
+	Id        primitive.ObjectID `json:"_id" bson:"_id"`
+	Status    string             `json:"status" bson:"status"`
+	ExpiredAt primitive.DateTime `json:"expiredAt,omitempty" bson:"expiredAt"`
+	CreatedAt primitive.DateTime `json:"createdAt" bson:"createdAt"`
+	UpdatedAt primitive.DateTime `json:"updatedAt" bson:"updatedAt"`
+}
+
+func FromDomain(domain data_exports.Domain) DataExport {
+	return DataExport{
+		Id:        domain.Id,
+		Status:    domain.Status,
+		ExpiredAt: domain.ExpiredAt,
+		CreatedAt: domain.CreatedAt,
+		UpdatedAt: domain.UpdatedAt,
+	}
+}
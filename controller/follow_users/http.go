@@ -0,0 +1,190 @@
+package follow_users
+
+import (
+	followUsers "charum/business/follow_users"
+	dtoUser "charum/dto/users"
+	"charum/helper"
+	"charum/util"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type FollowUserController struct {
+	followUserUseCase followUsers.UseCase
+}
+
+func NewFollowUserController(followUserUC followUsers.UseCase) *FollowUserController {
+	return &FollowUserController{
+		followUserUseCase: followUserUC,
+	}
+}
+
+/*
+Create
+*/
+
+func (fuc *FollowUserController) Create(c echo.Context) error {
+	followingID, err := primitive.ObjectIDFromHex(c.Param("user-id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "invalid user id",
+			Data:    nil,
+		})
+	}
+
+	userID, err := util.GetUIDFromToken(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
+			Status:  http.StatusUnauthorized,
+			Message: "unauthorized",
+			Data:    nil,
+		})
+	}
+
+	domain := followUsers.Domain{
+		FollowerID:  userID,
+		FollowingID: followingID,
+	}
+
+	result, err := fuc.followUserUseCase.Create(&domain)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if err.Error() == "user already follow this user" {
+			statusCode = http.StatusConflict
+		} else if err.Error() == "user cannot follow themselves" {
+			statusCode = http.StatusBadRequest
+		} else if err.Error() == "failed to get user" || err.Error() == "failed to get followed user" {
+			statusCode = http.StatusNotFound
+		} else if err.Error() == "user is blocked by this user" {
+			statusCode = http.StatusForbidden
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusCreated, helper.BaseResponse{
+		Status:  http.StatusCreated,
+		Message: "success to follow user",
+		Data: map[string]interface{}{
+			"followUser": result,
+		},
+	})
+}
+
+/*
+Read
+*/
+
+func (fuc *FollowUserController) GetFollowers(c echo.Context) error {
+	userID, err := primitive.ObjectIDFromHex(c.Param("user-id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "invalid user id",
+			Data:    nil,
+		})
+	}
+
+	followers, err := fuc.followUserUseCase.GetFollowers(userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to get followers",
+		Data: map[string]interface{}{
+			"followers": dtoUser.FromDomainArray(followers),
+		},
+	})
+}
+
+func (fuc *FollowUserController) GetFollowing(c echo.Context) error {
+	userID, err := primitive.ObjectIDFromHex(c.Param("user-id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "invalid user id",
+			Data:    nil,
+		})
+	}
+
+	following, err := fuc.followUserUseCase.GetFollowing(userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to get following",
+		Data: map[string]interface{}{
+			"following": dtoUser.FromDomainArray(following),
+		},
+	})
+}
+
+/*
+Delete
+*/
+
+func (fuc *FollowUserController) Delete(c echo.Context) error {
+	followingID, err := primitive.ObjectIDFromHex(c.Param("user-id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "invalid user id",
+			Data:    nil,
+		})
+	}
+
+	userID, err := util.GetUIDFromToken(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
+			Status:  http.StatusUnauthorized,
+			Message: "unauthorized",
+			Data:    nil,
+		})
+	}
+
+	domain := followUsers.Domain{
+		FollowerID:  userID,
+		FollowingID: followingID,
+	}
+
+	result, err := fuc.followUserUseCase.Delete(&domain)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if err.Error() == "user does not follow this user" {
+			statusCode = http.StatusNotFound
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to unfollow user",
+		Data: map[string]interface{}{
+			"unfollowUser": result,
+		},
+	})
+}
@@ -40,6 +40,8 @@ func (ctrl *ForgotPasswordController) Generate(c echo.Context) error {
 		statusCode := http.StatusInternalServerError
 		if strings.Contains(err.Error(), "not registered") {
 			statusCode = http.StatusNotFound
+		} else if strings.Contains(err.Error(), "please wait") {
+			statusCode = http.StatusTooManyRequests
 		}
 
 		return c.JSON(statusCode, helper.BaseResponse{
@@ -115,3 +117,96 @@ func (ctrl *ForgotPasswordController) ValidateToken(c echo.Context) error {
 		Data:    nil,
 	})
 }
+
+func (ctrl *ForgotPasswordController) GenerateMagicLink(c echo.Context) error {
+	userInput := request.Generate{}
+	c.Bind(&userInput)
+
+	if err := userInput.Validate(); err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "validation failed",
+			Data:    err,
+		})
+	}
+
+	_, err := ctrl.forgotPasswordUseCase.GenerateMagicLink(userInput.ToDomain())
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if strings.Contains(err.Error(), "not registered") {
+			statusCode = http.StatusNotFound
+		} else if strings.Contains(err.Error(), "please wait") {
+			statusCode = http.StatusTooManyRequests
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusCreated, helper.BaseResponse{
+		Status:  http.StatusCreated,
+		Message: "success to send login link",
+		Data:    nil,
+	})
+}
+
+func (ctrl *ForgotPasswordController) MagicLinkLogin(c echo.Context) error {
+	token := c.Param("token")
+	user, err := ctrl.forgotPasswordUseCase.RedeemMagicLink(token)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if strings.Contains(err.Error(), "failed to get") {
+			statusCode = http.StatusNotFound
+		} else if strings.Contains(err.Error(), "token has") || strings.Contains(err.Error(), "not registered") {
+			statusCode = http.StatusUnauthorized
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	user, accessToken, refreshToken, err := ctrl.userUseCase.LoginWithMagicLink(user.Id, c.Request().UserAgent(), c.RealIP())
+	if err != nil {
+		if strings.Contains(err.Error(), "user is suspended") {
+			return c.JSON(http.StatusForbidden, helper.BaseResponse{
+				Status:  http.StatusForbidden,
+				Message: err.Error(),
+				Data: map[string]interface{}{
+					"appealToken": accessToken,
+				},
+			})
+		}
+
+		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
+			Status:  http.StatusUnauthorized,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	if user.TwoFactorEnabled {
+		return c.JSON(http.StatusOK, helper.BaseResponse{
+			Status:  http.StatusOK,
+			Message: "two-factor authentication is required",
+			Data: map[string]interface{}{
+				"twoFactorRequired": true,
+				"challengeToken":    accessToken,
+			},
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to login",
+		Data: map[string]interface{}{
+			"token":        accessToken,
+			"refreshToken": refreshToken,
+		},
+	})
+}
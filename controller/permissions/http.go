@@ -0,0 +1,146 @@
+package permissions
+
+import (
+	auditLogs "charum/business/audit_logs"
+	"charum/business/permissions"
+	"charum/controller/permissions/request"
+	"charum/helper"
+	"charum/util"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+type PermissionController struct {
+	PermissionUseCase permissions.UseCase
+	auditLogUseCase   auditLogs.UseCase
+}
+
+func NewPermissionController(permissionUC permissions.UseCase, auditLogUC auditLogs.UseCase) *PermissionController {
+	return &PermissionController{
+		PermissionUseCase: permissionUC,
+		auditLogUseCase:   auditLogUC,
+	}
+}
+
+/*
+Read
+*/
+
+func (permissionCtrl *PermissionController) GetAll(c echo.Context) error {
+	roles, err := permissionCtrl.PermissionUseCase.GetAll()
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success get permissions",
+		Data: map[string]interface{}{
+			"roles":       roles,
+			"permissions": permissions.All,
+		},
+	})
+}
+
+func (permissionCtrl *PermissionController) GetByRole(c echo.Context) error {
+	role, err := permissionCtrl.PermissionUseCase.GetByRole(c.Param("role"))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, helper.BaseResponse{
+			Status:  http.StatusNotFound,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success get role permissions",
+		Data: map[string]interface{}{
+			"role": role,
+		},
+	})
+}
+
+/*
+Update
+*/
+
+func (permissionCtrl *PermissionController) Update(c echo.Context) error {
+	userInput := request.Permission{}
+	c.Bind(&userInput)
+
+	if err := userInput.Validate(); err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "validation failed",
+			Data:    err,
+		})
+	}
+
+	roleBefore, err := permissionCtrl.PermissionUseCase.GetByRole(c.Param("role"))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, helper.BaseResponse{
+			Status:  http.StatusNotFound,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	role, err := permissionCtrl.PermissionUseCase.Update(userInput.ToDomain(c.Param("role")))
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if strings.Contains(err.Error(), "failed to get") {
+			statusCode = http.StatusNotFound
+		} else if strings.Contains(err.Error(), "unknown permission") || strings.Contains(err.Error(), "must keep") {
+			statusCode = http.StatusBadRequest
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	err = permissionCtrl.audit(c, auditLogs.ActionPermissionUpdate, roleBefore, role)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success update role permissions",
+		Data: map[string]interface{}{
+			"role": role,
+		},
+	})
+}
+
+// audit records a change of the permissions granted to a role
+func (permissionCtrl *PermissionController) audit(c echo.Context, action string, before permissions.Domain, after permissions.Domain) error {
+	actorID, err := util.GetUIDFromToken(c)
+	if err != nil {
+		return err
+	}
+
+	_, err = permissionCtrl.auditLogUseCase.Create(&auditLogs.Domain{
+		ActorID:    actorID,
+		Action:     action,
+		TargetType: auditLogs.TargetPermission,
+		TargetID:   before.Id,
+		Before:     before,
+		After:      after,
+		IP:         c.RealIP(),
+	})
+	return err
+}
@@ -0,0 +1,53 @@
+package request
+
+import (
+	"charum/business/permissions"
+	"charum/helper"
+	"errors"
+	"strings"
+
+	"github.com/fatih/structs"
+	"github.com/go-playground/validator/v10"
+)
+
+type Permission struct {
+	Permissions []string `json:"permissions" validate:"required"`
+}
+
+func (req *Permission) ToDomain(role string) *permissions.Domain {
+	return &permissions.Domain{
+		Role:        role,
+		Permissions: req.Permissions,
+	}
+}
+
+func (req *Permission) Validate() []helper.ValidationError {
+	var ve validator.ValidationErrors
+
+	if err := validator.New().Struct(req); err != nil {
+		if errors.As(err, &ve) {
+			fields := structs.Fields(req)
+			out := make([]helper.ValidationError, len(ve))
+
+			for i, e := range ve {
+				out[i] = helper.ValidationError{
+					Field:   e.Field(),
+					Message: helper.MessageForTag(e.Tag()),
+				}
+
+				out[i].Message = strings.Replace(out[i].Message, "[PARAM]", e.Param(), 1)
+
+				// Get field tag
+				for _, f := range fields {
+					if f.Name() == e.Field() {
+						out[i].Field = f.Tag("json")
+						break
+					}
+				}
+			}
+			return out
+		}
+	}
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"charum/helper"
 	"charum/util"
 	"net/http"
+	"strings"
 
 	"github.com/labstack/echo/v4"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -55,6 +56,8 @@ func (ctrl *ReportController) ReportUser(c echo.Context) error {
 		statusCode := http.StatusNotFound
 		if err.Error() == "already reported" {
 			statusCode = http.StatusConflict
+		} else if err.Error() == "user is blocked by this user" {
+			statusCode = http.StatusForbidden
 		}
 		return c.JSON(statusCode, helper.BaseResponse{
 			Status:  statusCode,
@@ -302,3 +305,78 @@ func (ctrl *ReportController) CountAllData(c echo.Context) error {
 		},
 	})
 }
+
+func (ctrl *ReportController) ModeratorGetAllReportedThreads(c echo.Context) error {
+	moderatorID, err := util.GetUIDFromToken(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
+			Status:  http.StatusUnauthorized,
+			Message: "unauthorized",
+			Data:    nil,
+		})
+	}
+
+	reportData, err := ctrl.ReportUseCase.ModeratorGetAllReportedThreads(moderatorID)
+	if err != nil {
+		statusCode := http.StatusNotFound
+		if strings.Contains(err.Error(), "not a moderator") {
+			statusCode = http.StatusForbidden
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success get report",
+		Data: map[string]interface{}{
+			"total_reported_threads": reportData,
+		},
+	})
+}
+
+func (ctrl *ReportController) ModeratorGetThreadReportedID(c echo.Context) error {
+	moderatorID, err := util.GetUIDFromToken(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
+			Status:  http.StatusUnauthorized,
+			Message: "unauthorized",
+			Data:    nil,
+		})
+	}
+
+	ReportedID, err := primitive.ObjectIDFromHex(c.Param("thread-id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "invalid id",
+			Data:    nil,
+		})
+	}
+
+	reportData, err := ctrl.ReportUseCase.ModeratorGetByReportedThreadID(moderatorID, ReportedID)
+	if err != nil {
+		statusCode := http.StatusNotFound
+		if strings.Contains(err.Error(), "not a moderator") {
+			statusCode = http.StatusForbidden
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success get report",
+		Data: map[string]interface{}{
+			"total_reports": reportData,
+		},
+	})
+}
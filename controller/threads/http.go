@@ -1,11 +1,14 @@
 package threads
 
 import (
+	auditLogs "charum/business/audit_logs"
 	"charum/business/bookmarks"
 	"charum/business/comments"
 	followThreads "charum/business/follow_threads"
+	followUsers "charum/business/follow_users"
 	"charum/business/reports"
 	"charum/business/threads"
+	userBlocks "charum/business/user_blocks"
 	"charum/business/users"
 	"charum/controller/threads/request"
 	dtoPagination "charum/dto/pagination"
@@ -25,19 +28,25 @@ type ThreadController struct {
 	threadUseCase       threads.UseCase
 	commentUseCase      comments.UseCase
 	followThreadUseCase followThreads.UseCase
+	followUserUseCase   followUsers.UseCase
 	userUseCase         users.UseCase
+	userBlockUseCase    userBlocks.UseCase
 	bookmarkUseCase     bookmarks.UseCase
 	reportUseCase       reports.UseCase
+	auditLogUseCase     auditLogs.UseCase
 }
 
-func NewThreadController(threadUC threads.UseCase, commentUC comments.UseCase, followThreadUC followThreads.UseCase, userUC users.UseCase, bookmarkUC bookmarks.UseCase, reportUC reports.UseCase) *ThreadController {
+func NewThreadController(threadUC threads.UseCase, commentUC comments.UseCase, followThreadUC followThreads.UseCase, followUserUC followUsers.UseCase, userUC users.UseCase, userBlockUC userBlocks.UseCase, bookmarkUC bookmarks.UseCase, reportUC reports.UseCase, auditLogUC auditLogs.UseCase) *ThreadController {
 	return &ThreadController{
 		threadUseCase:       threadUC,
 		commentUseCase:      commentUC,
 		followThreadUseCase: followThreadUC,
+		followUserUseCase:   followUserUC,
 		userUseCase:         userUC,
+		userBlockUseCase:    userBlockUC,
 		bookmarkUseCase:     bookmarkUC,
 		reportUseCase:       reportUC,
+		auditLogUseCase:     auditLogUC,
 	}
 }
 
@@ -115,6 +124,8 @@ func (tc *ThreadController) Create(c echo.Context) error {
 		statusCode := http.StatusInternalServerError
 		if strings.Contains(err.Error(), "failed to get") {
 			statusCode = http.StatusNotFound
+		} else if strings.Contains(err.Error(), "invalid tag") || strings.Contains(err.Error(), "at most") || strings.Contains(err.Error(), "is banned") {
+			statusCode = http.StatusBadRequest
 		}
 
 		return c.JSON(statusCode, helper.BaseResponse{
@@ -142,6 +153,109 @@ func (tc *ThreadController) Create(c echo.Context) error {
 	})
 }
 
+func (tc *ThreadController) CreateDraft(c echo.Context) error {
+	userID, err := util.GetUIDFromToken(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
+			Status:  http.StatusUnauthorized,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	var validationErr []helper.ValidationError
+	image, _ := c.FormFile("image")
+	if image != nil {
+		imageExt := filepath.Ext(image.Filename)
+		availableExt := []string{".jpg", ".jpeg", ".png"}
+
+		flagExt := false
+		for _, ext := range availableExt {
+			if imageExt == ext {
+				flagExt = true
+			}
+		}
+
+		if !flagExt {
+			validationErr = append(validationErr, helper.ValidationError{
+				Field:   "image",
+				Message: "This field must be a file with .jpg, .jpeg, or .png extension",
+			})
+		}
+
+		if image.Size > 10000000 {
+			validationErr = append(validationErr, helper.ValidationError{
+				Field:   "image",
+				Message: "This field must be a file with size less than 10 MB",
+			})
+		}
+	}
+
+	draftInput := request.Draft{}
+	c.Bind(&draftInput)
+
+	inputErr := draftInput.Validate()
+	if inputErr != nil {
+		validationErr = append(validationErr, inputErr...)
+	}
+
+	if validationErr != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "validation failed",
+			Data:    validationErr,
+		})
+	}
+
+	topicID, err := primitive.ObjectIDFromHex(draftInput.TopicID)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "invalid topic id",
+			Data:    nil,
+		})
+	}
+
+	draftDomain := draftInput.ToDomain()
+	draftDomain.CreatorID = userID
+	draftDomain.TopicID = topicID
+
+	result, err := tc.threadUseCase.CreateDraft(draftDomain, image)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if strings.Contains(err.Error(), "failed to get") {
+			statusCode = http.StatusNotFound
+		} else if strings.Contains(err.Error(), "publish time must be") || strings.Contains(err.Error(), "draft must have") || strings.Contains(err.Error(), "invalid tag") || strings.Contains(err.Error(), "at most") || strings.Contains(err.Error(), "is banned") {
+			statusCode = http.StatusBadRequest
+		} else if strings.Contains(err.Error(), "email is not verified") {
+			statusCode = http.StatusForbidden
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	responseThread, err := tc.threadUseCase.DomainToResponse(result, userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusCreated, helper.BaseResponse{
+		Status:  http.StatusCreated,
+		Message: "success to create draft",
+		Data: map[string]interface{}{
+			"draft": responseThread,
+		},
+	})
+}
+
 /*
 Read
 */
@@ -202,6 +316,23 @@ func (tc *ThreadController) GetManyWithPagination(c echo.Context) error {
 		})
 	}
 
+	tagMatch := c.QueryParam("tag-match")
+	if tagMatch == "" {
+		tagMatch = "any"
+	} else if !(tagMatch == "any" || tagMatch == "all") {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:     http.StatusBadRequest,
+			Message:    "tag-match must be any or all",
+			Data:       nil,
+			Pagination: helper.Page{},
+		})
+	}
+
+	tags := []string{}
+	if c.QueryParam("tags") != "" {
+		tags = strings.Split(c.QueryParam("tags"), ",")
+	}
+
 	var topicID primitive.ObjectID
 	if c.QueryParam("topic-id") != "" {
 		topicID, err = primitive.ObjectIDFromHex(c.QueryParam("topic-id"))
@@ -218,6 +349,7 @@ func (tc *ThreadController) GetManyWithPagination(c echo.Context) error {
 	userInputDomain := threads.Domain{
 		TopicID: topicID,
 		Title:   c.QueryParam("title"),
+		Tags:    tags,
 	}
 
 	if err != nil {
@@ -236,7 +368,21 @@ func (tc *ThreadController) GetManyWithPagination(c echo.Context) error {
 		Order: order,
 	}
 
-	threads, totalPage, totalData, err := tc.threadUseCase.GetManyWithPagination(pagination, &userInputDomain)
+	hiddenUserIDs := []primitive.ObjectID{}
+	uid, uidErr := util.GetUIDFromToken(c)
+	if uidErr == nil {
+		hiddenUserIDs, err = tc.userBlockUseCase.GetHiddenUserIDs(uid)
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+				Status:     http.StatusInternalServerError,
+				Message:    err.Error(),
+				Data:       nil,
+				Pagination: helper.Page{},
+			})
+		}
+	}
+
+	threads, totalPage, totalData, err := tc.threadUseCase.GetManyWithPagination(pagination, &userInputDomain, hiddenUserIDs, tagMatch == "all")
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
 			Status:     http.StatusInternalServerError,
@@ -247,8 +393,7 @@ func (tc *ThreadController) GetManyWithPagination(c echo.Context) error {
 	}
 
 	var responseThreads []dtoThread.Response
-	uid, err := util.GetUIDFromToken(c)
-	if err == nil {
+	if uidErr == nil {
 		responseThreads, err = tc.threadUseCase.DomainsToResponseArray(threads, uid)
 		if err != nil {
 			return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
@@ -463,6 +608,142 @@ func (tc *ThreadController) GetManyByToken(c echo.Context) error {
 	})
 }
 
+func (tc *ThreadController) GetFeed(c echo.Context) error {
+	page, err := strconv.Atoi(c.Param("page"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:     http.StatusBadRequest,
+			Message:    "page must be a number",
+			Data:       nil,
+			Pagination: helper.Page{},
+		})
+	} else if page < 1 {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:     http.StatusBadRequest,
+			Message:    "page must be greater than 0",
+			Data:       nil,
+			Pagination: helper.Page{},
+		})
+	}
+
+	limit := c.QueryParam("limit")
+	if limit == "" {
+		limit = "25"
+	}
+	limitNumber, err := strconv.Atoi(limit)
+	if err != nil || limitNumber < 1 {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:     http.StatusBadRequest,
+			Message:    "limit must be a number and greater than 0",
+			Data:       nil,
+			Pagination: helper.Page{},
+		})
+	}
+
+	uid, err := util.GetUIDFromToken(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
+			Status:     http.StatusUnauthorized,
+			Message:    err.Error(),
+			Data:       nil,
+			Pagination: helper.Page{},
+		})
+	}
+
+	pagination := dtoPagination.Request{
+		Page:  page,
+		Limit: limitNumber,
+		Sort:  "createdAt",
+		Order: "desc",
+	}
+
+	threads, totalPage, totalData, err := tc.followUserUseCase.GetFeed(pagination, uid)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:     http.StatusInternalServerError,
+			Message:    err.Error(),
+			Data:       nil,
+			Pagination: helper.Page{},
+		})
+	}
+
+	responseThreads, err := tc.threadUseCase.DomainsToResponseArray(threads, uid)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:     http.StatusInternalServerError,
+			Message:    err.Error(),
+			Data:       nil,
+			Pagination: helper.Page{},
+		})
+	}
+
+	for i, thread := range responseThreads {
+		responseThreads[i].IsFollowed, err = tc.followThreadUseCase.CheckFollowedThread(uid, thread.Id)
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+				Status:     http.StatusInternalServerError,
+				Message:    err.Error(),
+				Data:       nil,
+				Pagination: helper.Page{},
+			})
+		}
+
+		responseThreads[i].IsBookmarked, err = tc.bookmarkUseCase.CheckBookmarkedThread(uid, thread.Id)
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+				Status:     http.StatusInternalServerError,
+				Message:    err.Error(),
+				Data:       nil,
+				Pagination: helper.Page{},
+			})
+		}
+
+		responseThreads[i].TotalFollow, err = tc.followThreadUseCase.CountByThreadID(thread.Id)
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+				Status:     http.StatusInternalServerError,
+				Message:    err.Error(),
+				Data:       nil,
+				Pagination: helper.Page{},
+			})
+		}
+
+		responseThreads[i].TotalComment, err = tc.commentUseCase.CountByThreadID(thread.Id)
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+				Status:     http.StatusInternalServerError,
+				Message:    err.Error(),
+				Data:       nil,
+				Pagination: helper.Page{},
+			})
+		}
+
+		responseThreads[i].TotalBookmark, err = tc.bookmarkUseCase.CountByThreadID(thread.Id)
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+				Status:     http.StatusInternalServerError,
+				Message:    err.Error(),
+				Data:       nil,
+				Pagination: helper.Page{},
+			})
+		}
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to get following feed",
+		Data: map[string]interface{}{
+			"threads": responseThreads,
+		},
+		Pagination: helper.Page{
+			Size:        limitNumber,
+			TotalData:   totalData,
+			TotalPage:   totalPage,
+			CurrentPage: page,
+		},
+	})
+}
+
 func (tc *ThreadController) GetByID(c echo.Context) error {
 	threadID, err := primitive.ObjectIDFromHex(c.Param("thread-id"))
 	if err != nil {
@@ -491,6 +772,32 @@ func (tc *ThreadController) GetByID(c echo.Context) error {
 		})
 	}
 
+	// comments of the users blocked or muted by the viewer are left out silently
+	uid, uidErr := util.GetUIDFromToken(c)
+	if uidErr == nil {
+		hiddenUserIDs, err := tc.userBlockUseCase.GetHiddenUserIDs(uid)
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+				Status:  http.StatusInternalServerError,
+				Message: err.Error(),
+				Data:    nil,
+			})
+		}
+
+		hiddenUsers := map[primitive.ObjectID]bool{}
+		for _, hiddenUserID := range hiddenUserIDs {
+			hiddenUsers[hiddenUserID] = true
+		}
+
+		visibleComments := []comments.Domain{}
+		for _, threadComment := range comment {
+			if !hiddenUsers[threadComment.UserID] {
+				visibleComments = append(visibleComments, threadComment)
+			}
+		}
+		comment = visibleComments
+	}
+
 	totalFollow, err := tc.followThreadUseCase.CountByThreadID(threadID)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
@@ -528,8 +835,7 @@ func (tc *ThreadController) GetByID(c echo.Context) error {
 	}
 
 	var responseThread dtoThread.Response
-	uid, err := util.GetUIDFromToken(c)
-	if err == nil {
+	if uidErr == nil {
 		responseThread, err = tc.threadUseCase.DomainToResponse(thread, uid)
 		if err != nil {
 			return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
@@ -557,45 +863,1208 @@ func (tc *ThreadController) GetByID(c echo.Context) error {
 			})
 		}
 
-		err = tc.followThreadUseCase.ResetNotification(threadID, uid)
-		if err != nil {
-			return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
-				Status:  http.StatusInternalServerError,
-				Message: err.Error(),
-				Data:    nil,
-			})
-		}
-	} else {
-		responseThread, err = tc.threadUseCase.DomainToResponse(thread, primitive.NilObjectID)
-		if err != nil {
-			return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
-				Status:  http.StatusInternalServerError,
-				Message: err.Error(),
-				Data:    nil,
-			})
+		err = tc.followThreadUseCase.ResetNotification(threadID, uid)
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+				Status:  http.StatusInternalServerError,
+				Message: err.Error(),
+				Data:    nil,
+			})
+		}
+	} else {
+		responseThread, err = tc.threadUseCase.DomainToResponse(thread, primitive.NilObjectID)
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+				Status:  http.StatusInternalServerError,
+				Message: err.Error(),
+				Data:    nil,
+			})
+		}
+	}
+
+	responseThread.TotalComment = len(responseComment)
+	responseThread.TotalFollow = totalFollow
+	responseThread.TotalBookmark = totalBookmark
+	responseThread.TotalReported = totalReported
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to get thread",
+		Data: map[string]interface{}{
+			"thread":   responseThread,
+			"comments": responseComment,
+		},
+	})
+}
+
+func (tc *ThreadController) GetTrash(c echo.Context) error {
+	page, err := strconv.Atoi(c.Param("page"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:     http.StatusBadRequest,
+			Message:    "page must be a number",
+			Data:       nil,
+			Pagination: helper.Page{},
+		})
+	} else if page < 1 {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:     http.StatusBadRequest,
+			Message:    "page must be greater than 0",
+			Data:       nil,
+			Pagination: helper.Page{},
+		})
+	}
+
+	limit := c.QueryParam("limit")
+	if limit == "" {
+		limit = "25"
+	}
+	limitNumber, err := strconv.Atoi(limit)
+	if err != nil || limitNumber < 1 {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:     http.StatusBadRequest,
+			Message:    "limit must be a number and greater than 0",
+			Data:       nil,
+			Pagination: helper.Page{},
+		})
+	}
+
+	sort := c.QueryParam("sort")
+	if sort == "" {
+		sort = "deletedAt"
+	} else if !(sort == "_id" || sort == "title" || sort == "createdAt" || sort == "deletedAt") {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:     http.StatusBadRequest,
+			Message:    "sort must be _id, title, createdAt, or deletedAt",
+			Data:       nil,
+			Pagination: helper.Page{},
+		})
+	}
+
+	order := c.QueryParam("order")
+	if order == "" {
+		order = "desc"
+	} else if !(order == "asc" || order == "desc") {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:     http.StatusBadRequest,
+			Message:    "order must be asc or desc",
+			Data:       nil,
+			Pagination: helper.Page{},
+		})
+	}
+
+	pagination := dtoPagination.Request{
+		Page:  page,
+		Limit: limitNumber,
+		Sort:  sort,
+		Order: order,
+	}
+
+	threads, totalPage, totalData, err := tc.threadUseCase.GetTrashWithPagination(pagination)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	responseThreads, err := tc.threadUseCase.DomainsToResponseArray(threads, primitive.NilObjectID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to get trashed threads",
+		Data: map[string]interface{}{
+			"threads": responseThreads,
+		},
+		Pagination: helper.Page{
+			Size:        limitNumber,
+			TotalData:   totalData,
+			TotalPage:   totalPage,
+			CurrentPage: page,
+		},
+	})
+}
+
+func (tc *ThreadController) GetTags(c echo.Context) error {
+	limit := c.QueryParam("limit")
+	if limit == "" {
+		limit = "25"
+	}
+	limitNumber, err := strconv.Atoi(limit)
+	if err != nil || limitNumber < 1 {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "limit must be a number and greater than 0",
+			Data:    nil,
+		})
+	}
+
+	tags, err := tc.threadUseCase.GetTags(c.QueryParam("prefix"), limitNumber)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to get tags",
+		Data: map[string]interface{}{
+			"tags": tags,
+		},
+	})
+}
+
+func (tc *ThreadController) GetBannedTags(c echo.Context) error {
+	tags, err := tc.threadUseCase.GetBannedTags()
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to get banned tags",
+		Data: map[string]interface{}{
+			"bannedTags": tags,
+		},
+	})
+}
+
+func (tc *ThreadController) GetDrafts(c echo.Context) error {
+	userID, err := util.GetUIDFromToken(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
+			Status:  http.StatusUnauthorized,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	drafts, err := tc.threadUseCase.GetDrafts(userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	responseDrafts, err := tc.threadUseCase.DomainsToResponseArray(drafts, userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to get drafts",
+		Data: map[string]interface{}{
+			"drafts": responseDrafts,
+		},
+	})
+}
+
+func (tc *ThreadController) GetDraftByID(c echo.Context) error {
+	userID, err := util.GetUIDFromToken(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
+			Status:  http.StatusUnauthorized,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	threadID, err := primitive.ObjectIDFromHex(c.Param("thread-id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "invalid thread id",
+			Data:    nil,
+		})
+	}
+
+	draft, err := tc.threadUseCase.GetDraftByID(userID, threadID)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if strings.Contains(err.Error(), "failed to get") {
+			statusCode = http.StatusNotFound
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	responseThread, err := tc.threadUseCase.DomainToResponse(draft, userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to get draft",
+		Data: map[string]interface{}{
+			"draft": responseThread,
+		},
+	})
+}
+
+func (tc *ThreadController) GetRevisions(c echo.Context) error {
+	userID, err := util.GetUIDFromToken(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
+			Status:  http.StatusUnauthorized,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	threadID, err := primitive.ObjectIDFromHex(c.Param("thread-id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "invalid thread id",
+			Data:    nil,
+		})
+	}
+
+	revisions, err := tc.threadUseCase.GetRevisions(userID, threadID)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if strings.Contains(err.Error(), "failed to get thread") || strings.Contains(err.Error(), "failed to get topic") {
+			statusCode = http.StatusNotFound
+		} else if strings.Contains(err.Error(), "not a moderator") {
+			statusCode = http.StatusForbidden
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to get thread revisions",
+		Data: map[string]interface{}{
+			"revisions": revisions,
+		},
+	})
+}
+
+func (tc *ThreadController) CompareRevisions(c echo.Context) error {
+	userID, err := util.GetUIDFromToken(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
+			Status:  http.StatusUnauthorized,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	threadID, err := primitive.ObjectIDFromHex(c.Param("thread-id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "invalid thread id",
+			Data:    nil,
+		})
+	}
+
+	fromID, err := primitive.ObjectIDFromHex(c.QueryParam("from"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "invalid from revision id",
+			Data:    nil,
+		})
+	}
+
+	// without a to revision the diff is made against the current version of the thread
+	toID := primitive.NilObjectID
+	if to := c.QueryParam("to"); to != "" {
+		toID, err = primitive.ObjectIDFromHex(to)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+				Status:  http.StatusBadRequest,
+				Message: "invalid to revision id",
+				Data:    nil,
+			})
+		}
+	}
+
+	diff, err := tc.threadUseCase.CompareRevisions(userID, threadID, fromID, toID)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if strings.Contains(err.Error(), "failed to get thread") || strings.Contains(err.Error(), "failed to get topic") {
+			statusCode = http.StatusNotFound
+		} else if strings.Contains(err.Error(), "not a moderator") {
+			statusCode = http.StatusForbidden
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to compare thread revisions",
+		Data: map[string]interface{}{
+			"diff": diff,
+		},
+	})
+}
+
+/*
+Update
+*/
+
+func (tc *ThreadController) UserUpdate(c echo.Context) error {
+	userID, err := util.GetUIDFromToken(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
+			Status:  http.StatusUnauthorized,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	threadID, err := primitive.ObjectIDFromHex(c.Param("thread-id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "invalid thread id",
+			Data:    nil,
+		})
+	}
+
+	var validationErr []helper.ValidationError
+	image, _ := c.FormFile("image")
+	if image != nil {
+		imageExt := filepath.Ext(image.Filename)
+		availableExt := []string{".jpg", ".jpeg", ".png"}
+
+		flagExt := false
+		for _, ext := range availableExt {
+			if imageExt == ext {
+				flagExt = true
+			}
+		}
+
+		if !flagExt {
+			validationErr = append(validationErr, helper.ValidationError{
+				Field:   "image",
+				Message: "This field must be a file with .jpg, .jpeg, or .png extension",
+			})
+		}
+
+		if image.Size > 10000000 {
+			validationErr = append(validationErr, helper.ValidationError{
+				Field:   "image",
+				Message: "This field must be a file with size less than 10 MB",
+			})
+		}
+	}
+
+	threadInput := request.Thread{}
+	c.Bind(&threadInput)
+
+	inputErr := threadInput.Validate()
+	if inputErr != nil {
+		validationErr = append(validationErr, inputErr...)
+	}
+
+	if validationErr != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "validation failed",
+			Data:    validationErr,
+		})
+	}
+
+	topicID, err := primitive.ObjectIDFromHex(threadInput.TopicID)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "invalid topic id",
+			Data:    nil,
+		})
+	}
+
+	threadDomain := threadInput.ToDomain()
+	threadDomain.Id = threadID
+	threadDomain.TopicID = topicID
+	threadDomain.CreatorID = userID
+
+	result, err := tc.threadUseCase.UserUpdate(threadDomain, image)
+
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if strings.Contains(err.Error(), "failed to get") {
+			statusCode = http.StatusNotFound
+		} else if strings.Contains(err.Error(), "user are not the thread creator") {
+			statusCode = http.StatusForbidden
+		} else if strings.Contains(err.Error(), "invalid tag") || strings.Contains(err.Error(), "at most") || strings.Contains(err.Error(), "is banned") {
+			statusCode = http.StatusBadRequest
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	responseThread, err := tc.threadUseCase.DomainToResponse(result, userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to update thread",
+		Data: map[string]interface{}{
+			"thread": responseThread,
+		},
+	})
+}
+
+func (tc *ThreadController) AdminUpdate(c echo.Context) error {
+	threadID, err := primitive.ObjectIDFromHex(c.Param("thread-id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "invalid thread id",
+			Data:    nil,
+		})
+	}
+
+	var validationErr []helper.ValidationError
+	image, _ := c.FormFile("image")
+	if image != nil {
+		imageExt := filepath.Ext(image.Filename)
+		availableExt := []string{".jpg", ".jpeg", ".png"}
+
+		flagExt := false
+		for _, ext := range availableExt {
+			if imageExt == ext {
+				flagExt = true
+			}
+		}
+
+		if !flagExt {
+			validationErr = append(validationErr, helper.ValidationError{
+				Field:   "image",
+				Message: "This field must be a file with .jpg, .jpeg, or .png extension",
+			})
+		}
+
+		if image.Size > 10000000 {
+			validationErr = append(validationErr, helper.ValidationError{
+				Field:   "image",
+				Message: "This field must be a file with size less than 10 MB",
+			})
+		}
+	}
+
+	threadInput := request.Thread{}
+	c.Bind(&threadInput)
+
+	inputErr := threadInput.Validate()
+	if inputErr != nil {
+		validationErr = append(validationErr, inputErr...)
+	}
+
+	if validationErr != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "validation failed",
+			Data:    validationErr,
+		})
+	}
+
+	topicID, err := primitive.ObjectIDFromHex(threadInput.TopicID)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "invalid topic id",
+			Data:    nil,
+		})
+	}
+
+	threadBefore, err := tc.threadUseCase.GetByID(threadID)
+	if err != nil {
+		return c.JSON(http.StatusNotFound, helper.BaseResponse{
+			Status:  http.StatusNotFound,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	adminID, err := util.GetUIDFromToken(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
+			Status:  http.StatusUnauthorized,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	threadDomain := threadInput.ToDomain()
+	threadDomain.Id = threadID
+	threadDomain.TopicID = topicID
+	threadDomain.CreatorID = adminID
+
+	result, err := tc.threadUseCase.AdminUpdate(threadDomain, image)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if strings.Contains(err.Error(), "failed to get") {
+			statusCode = http.StatusNotFound
+		} else if strings.Contains(err.Error(), "invalid tag") || strings.Contains(err.Error(), "at most") || strings.Contains(err.Error(), "is banned") {
+			statusCode = http.StatusBadRequest
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	responseThread, err := tc.threadUseCase.DomainToResponse(result, primitive.NilObjectID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	err = tc.audit(c, auditLogs.ActionThreadUpdate, threadID, threadBefore, result)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to update thread",
+		Data: map[string]interface{}{
+			"thread": responseThread,
+		},
+	})
+}
+
+func (tc *ThreadController) ModeratorSuspend(c echo.Context) error {
+	moderatorID, err := util.GetUIDFromToken(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
+			Status:  http.StatusUnauthorized,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	threadID, err := primitive.ObjectIDFromHex(c.Param("thread-id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "invalid thread id",
+			Data:    nil,
+		})
+	}
+
+	suspendInput := request.Suspend{}
+	c.Bind(&suspendInput)
+
+	if err := suspendInput.Validate(); err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "validation failed",
+			Data:    err,
+		})
+	}
+
+	result, err := tc.threadUseCase.ModeratorSuspend(moderatorID, threadID, suspendInput.SuspendDetail)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if strings.Contains(err.Error(), "failed to get") {
+			statusCode = http.StatusNotFound
+		} else if strings.Contains(err.Error(), "not a moderator") {
+			statusCode = http.StatusForbidden
+		} else if strings.Contains(err.Error(), "already suspended") {
+			statusCode = http.StatusConflict
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	responseThread, err := tc.threadUseCase.DomainToResponse(result, primitive.NilObjectID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to suspend thread",
+		Data: map[string]interface{}{
+			"thread": responseThread,
+		},
+	})
+}
+
+func (tc *ThreadController) ModeratorUnsuspend(c echo.Context) error {
+	moderatorID, err := util.GetUIDFromToken(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
+			Status:  http.StatusUnauthorized,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	threadID, err := primitive.ObjectIDFromHex(c.Param("thread-id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "invalid thread id",
+			Data:    nil,
+		})
+	}
+
+	result, err := tc.threadUseCase.ModeratorUnsuspend(moderatorID, threadID)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if strings.Contains(err.Error(), "failed to get") {
+			statusCode = http.StatusNotFound
+		} else if strings.Contains(err.Error(), "not a moderator") {
+			statusCode = http.StatusForbidden
+		} else if strings.Contains(err.Error(), "not suspended") {
+			statusCode = http.StatusConflict
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	responseThread, err := tc.threadUseCase.DomainToResponse(result, primitive.NilObjectID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to unsuspend thread",
+		Data: map[string]interface{}{
+			"thread": responseThread,
+		},
+	})
+}
+
+func (tc *ThreadController) Pin(c echo.Context) error {
+	moderatorID, err := util.GetUIDFromToken(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
+			Status:  http.StatusUnauthorized,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	threadID, err := primitive.ObjectIDFromHex(c.Param("thread-id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "invalid thread id",
+			Data:    nil,
+		})
+	}
+
+	pinInput := request.Pin{}
+	c.Bind(&pinInput)
+
+	if err := pinInput.Validate(); err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "validation failed",
+			Data:    err,
+		})
+	}
+
+	result, err := tc.threadUseCase.Pin(moderatorID, threadID, pinInput.Scope)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if strings.Contains(err.Error(), "failed to get") {
+			statusCode = http.StatusNotFound
+		} else if strings.Contains(err.Error(), "not a moderator") || strings.Contains(err.Error(), "only admins") {
+			statusCode = http.StatusForbidden
+		} else if strings.Contains(err.Error(), "already pinned") {
+			statusCode = http.StatusConflict
+		} else if strings.Contains(err.Error(), "pin scope") {
+			statusCode = http.StatusBadRequest
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	responseThread, err := tc.threadUseCase.DomainToResponse(result, primitive.NilObjectID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to pin thread",
+		Data: map[string]interface{}{
+			"thread": responseThread,
+		},
+	})
+}
+
+func (tc *ThreadController) Unpin(c echo.Context) error {
+	moderatorID, err := util.GetUIDFromToken(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
+			Status:  http.StatusUnauthorized,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	threadID, err := primitive.ObjectIDFromHex(c.Param("thread-id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "invalid thread id",
+			Data:    nil,
+		})
+	}
+
+	result, err := tc.threadUseCase.Unpin(moderatorID, threadID)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if strings.Contains(err.Error(), "failed to get") {
+			statusCode = http.StatusNotFound
+		} else if strings.Contains(err.Error(), "not a moderator") || strings.Contains(err.Error(), "only admins") {
+			statusCode = http.StatusForbidden
+		} else if strings.Contains(err.Error(), "not pinned") {
+			statusCode = http.StatusConflict
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	responseThread, err := tc.threadUseCase.DomainToResponse(result, primitive.NilObjectID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to unpin thread",
+		Data: map[string]interface{}{
+			"thread": responseThread,
+		},
+	})
+}
+
+func (tc *ThreadController) Lock(c echo.Context) error {
+	moderatorID, err := util.GetUIDFromToken(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
+			Status:  http.StatusUnauthorized,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	threadID, err := primitive.ObjectIDFromHex(c.Param("thread-id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "invalid thread id",
+			Data:    nil,
+		})
+	}
+
+	result, err := tc.threadUseCase.Lock(moderatorID, threadID)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if strings.Contains(err.Error(), "failed to get") {
+			statusCode = http.StatusNotFound
+		} else if strings.Contains(err.Error(), "not a moderator") || strings.Contains(err.Error(), "only admins") {
+			statusCode = http.StatusForbidden
+		} else if strings.Contains(err.Error(), "already locked") {
+			statusCode = http.StatusConflict
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	responseThread, err := tc.threadUseCase.DomainToResponse(result, primitive.NilObjectID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to lock thread",
+		Data: map[string]interface{}{
+			"thread": responseThread,
+		},
+	})
+}
+
+func (tc *ThreadController) Unlock(c echo.Context) error {
+	moderatorID, err := util.GetUIDFromToken(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
+			Status:  http.StatusUnauthorized,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	threadID, err := primitive.ObjectIDFromHex(c.Param("thread-id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "invalid thread id",
+			Data:    nil,
+		})
+	}
+
+	result, err := tc.threadUseCase.Unlock(moderatorID, threadID)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if strings.Contains(err.Error(), "failed to get") {
+			statusCode = http.StatusNotFound
+		} else if strings.Contains(err.Error(), "not a moderator") || strings.Contains(err.Error(), "only admins") {
+			statusCode = http.StatusForbidden
+		} else if strings.Contains(err.Error(), "not locked") {
+			statusCode = http.StatusConflict
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	responseThread, err := tc.threadUseCase.DomainToResponse(result, primitive.NilObjectID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to unlock thread",
+		Data: map[string]interface{}{
+			"thread": responseThread,
+		},
+	})
+}
+
+func (tc *ThreadController) Restore(c echo.Context) error {
+	threadID, err := primitive.ObjectIDFromHex(c.Param("thread-id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "invalid thread id",
+			Data:    nil,
+		})
+	}
+
+	restoredThread, err := tc.threadUseCase.Restore(threadID)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if strings.Contains(err.Error(), "failed to get") {
+			statusCode = http.StatusNotFound
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	err = tc.commentUseCase.RestoreAllByThreadID(threadID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	err = tc.followThreadUseCase.RestoreAllByThreadID(threadID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	err = tc.bookmarkUseCase.RestoreAllByThreadID(threadID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	responseThread, err := tc.threadUseCase.DomainToResponse(restoredThread, primitive.NilObjectID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	err = tc.audit(c, auditLogs.ActionThreadRestore, threadID, nil, restoredThread)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to restore thread",
+		Data: map[string]interface{}{
+			"thread": responseThread,
+		},
+	})
+}
+
+func (tc *ThreadController) RenameTag(c echo.Context) error {
+	tagInput := request.TagRename{}
+	c.Bind(&tagInput)
+
+	if err := tagInput.Validate(); err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "validation failed",
+			Data:    err,
+		})
+	}
+
+	err := tc.threadUseCase.RenameTag(tagInput.From, tagInput.To)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if strings.Contains(err.Error(), "failed to get") {
+			statusCode = http.StatusNotFound
+		} else if !strings.Contains(err.Error(), "failed to") {
+			statusCode = http.StatusBadRequest
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	err = tc.auditTarget(c, auditLogs.ActionTagRename, auditLogs.TargetTag, primitive.NilObjectID, tagInput.From, tagInput.To)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to rename tag",
+		Data:    nil,
+	})
+}
+
+func (tc *ThreadController) MergeTag(c echo.Context) error {
+	tagInput := request.TagRename{}
+	c.Bind(&tagInput)
+
+	if err := tagInput.Validate(); err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "validation failed",
+			Data:    err,
+		})
+	}
+
+	err := tc.threadUseCase.MergeTag(tagInput.From, tagInput.To)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if strings.Contains(err.Error(), "failed to get") {
+			statusCode = http.StatusNotFound
+		} else if !strings.Contains(err.Error(), "failed to") {
+			statusCode = http.StatusBadRequest
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	err = tc.auditTarget(c, auditLogs.ActionTagMerge, auditLogs.TargetTag, primitive.NilObjectID, tagInput.From, tagInput.To)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to merge tags",
+		Data:    nil,
+	})
+}
+
+func (tc *ThreadController) BanTag(c echo.Context) error {
+	adminID, err := util.GetUIDFromToken(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
+			Status:  http.StatusUnauthorized,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	tagInput := request.TagBan{}
+	c.Bind(&tagInput)
+
+	if err := tagInput.Validate(); err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "validation failed",
+			Data:    err,
+		})
+	}
+
+	bannedTag, err := tc.threadUseCase.BanTag(adminID, tagInput.Name)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if strings.Contains(err.Error(), "already banned") {
+			statusCode = http.StatusConflict
+		} else if strings.Contains(err.Error(), "invalid tag") {
+			statusCode = http.StatusBadRequest
 		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
 	}
 
-	responseThread.TotalComment = len(responseComment)
-	responseThread.TotalFollow = totalFollow
-	responseThread.TotalBookmark = totalBookmark
-	responseThread.TotalReported = totalReported
+	err = tc.auditTarget(c, auditLogs.ActionTagBan, auditLogs.TargetTag, bannedTag.Id, nil, bannedTag)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
 
-	return c.JSON(http.StatusOK, helper.BaseResponse{
-		Status:  http.StatusOK,
-		Message: "success to get thread",
+	return c.JSON(http.StatusCreated, helper.BaseResponse{
+		Status:  http.StatusCreated,
+		Message: "success to ban tag",
 		Data: map[string]interface{}{
-			"thread":   responseThread,
-			"comments": responseComment,
+			"bannedTag": bannedTag,
 		},
 	})
 }
 
-/*
-Update
-*/
-
-func (tc *ThreadController) UserUpdate(c echo.Context) error {
+func (tc *ThreadController) UpdateDraft(c echo.Context) error {
 	userID, err := util.GetUIDFromToken(c)
 	if err != nil {
 		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
@@ -642,10 +2111,10 @@ func (tc *ThreadController) UserUpdate(c echo.Context) error {
 		}
 	}
 
-	threadInput := request.Thread{}
-	c.Bind(&threadInput)
+	draftInput := request.Draft{}
+	c.Bind(&draftInput)
 
-	inputErr := threadInput.Validate()
+	inputErr := draftInput.Validate()
 	if inputErr != nil {
 		validationErr = append(validationErr, inputErr...)
 	}
@@ -658,7 +2127,7 @@ func (tc *ThreadController) UserUpdate(c echo.Context) error {
 		})
 	}
 
-	topicID, err := primitive.ObjectIDFromHex(threadInput.TopicID)
+	topicID, err := primitive.ObjectIDFromHex(draftInput.TopicID)
 	if err != nil {
 		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
 			Status:  http.StatusBadRequest,
@@ -667,18 +2136,19 @@ func (tc *ThreadController) UserUpdate(c echo.Context) error {
 		})
 	}
 
-	threadDomain := threadInput.ToDomain()
-	threadDomain.Id = threadID
-	threadDomain.TopicID = topicID
-	threadDomain.CreatorID = userID
-
-	result, err := tc.threadUseCase.UserUpdate(threadDomain, image)
+	draftDomain := draftInput.ToDomain()
+	draftDomain.Id = threadID
+	draftDomain.CreatorID = userID
+	draftDomain.TopicID = topicID
 
+	result, err := tc.threadUseCase.UpdateDraft(draftDomain, image)
 	if err != nil {
 		statusCode := http.StatusInternalServerError
 		if strings.Contains(err.Error(), "failed to get") {
 			statusCode = http.StatusNotFound
-		} else if strings.Contains(err.Error(), "user are not the thread creator") {
+		} else if strings.Contains(err.Error(), "publish time must be") || strings.Contains(err.Error(), "draft must have") || strings.Contains(err.Error(), "invalid tag") || strings.Contains(err.Error(), "at most") || strings.Contains(err.Error(), "is banned") {
+			statusCode = http.StatusBadRequest
+		} else if strings.Contains(err.Error(), "email is not verified") {
 			statusCode = http.StatusForbidden
 		}
 
@@ -700,85 +2170,39 @@ func (tc *ThreadController) UserUpdate(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, helper.BaseResponse{
 		Status:  http.StatusOK,
-		Message: "success to update thread",
+		Message: "success to update draft",
 		Data: map[string]interface{}{
-			"thread": responseThread,
+			"draft": responseThread,
 		},
 	})
 }
 
-func (tc *ThreadController) AdminUpdate(c echo.Context) error {
-	threadID, err := primitive.ObjectIDFromHex(c.Param("thread-id"))
+func (tc *ThreadController) PublishDraft(c echo.Context) error {
+	userID, err := util.GetUIDFromToken(c)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
-			Status:  http.StatusBadRequest,
-			Message: "invalid thread id",
+		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
+			Status:  http.StatusUnauthorized,
+			Message: err.Error(),
 			Data:    nil,
 		})
 	}
 
-	var validationErr []helper.ValidationError
-	image, _ := c.FormFile("image")
-	if image != nil {
-		imageExt := filepath.Ext(image.Filename)
-		availableExt := []string{".jpg", ".jpeg", ".png"}
-
-		flagExt := false
-		for _, ext := range availableExt {
-			if imageExt == ext {
-				flagExt = true
-			}
-		}
-
-		if !flagExt {
-			validationErr = append(validationErr, helper.ValidationError{
-				Field:   "image",
-				Message: "This field must be a file with .jpg, .jpeg, or .png extension",
-			})
-		}
-
-		if image.Size > 10000000 {
-			validationErr = append(validationErr, helper.ValidationError{
-				Field:   "image",
-				Message: "This field must be a file with size less than 10 MB",
-			})
-		}
-	}
-
-	threadInput := request.Thread{}
-	c.Bind(&threadInput)
-
-	inputErr := threadInput.Validate()
-	if inputErr != nil {
-		validationErr = append(validationErr, inputErr...)
-	}
-
-	if validationErr != nil {
-		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
-			Status:  http.StatusBadRequest,
-			Message: "validation failed",
-			Data:    validationErr,
-		})
-	}
-
-	topicID, err := primitive.ObjectIDFromHex(threadInput.TopicID)
+	threadID, err := primitive.ObjectIDFromHex(c.Param("thread-id"))
 	if err != nil {
 		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
 			Status:  http.StatusBadRequest,
-			Message: "invalid topic id",
+			Message: "invalid thread id",
 			Data:    nil,
 		})
 	}
 
-	threadDomain := threadInput.ToDomain()
-	threadDomain.Id = threadID
-	threadDomain.TopicID = topicID
-
-	result, err := tc.threadUseCase.AdminUpdate(threadDomain, image)
+	result, err := tc.threadUseCase.PublishDraft(userID, threadID)
 	if err != nil {
 		statusCode := http.StatusInternalServerError
 		if strings.Contains(err.Error(), "failed to get") {
 			statusCode = http.StatusNotFound
+		} else if strings.Contains(err.Error(), "publish time must be") || strings.Contains(err.Error(), "draft must have") || strings.Contains(err.Error(), "invalid tag") || strings.Contains(err.Error(), "at most") || strings.Contains(err.Error(), "is banned") {
+			statusCode = http.StatusBadRequest
 		}
 
 		return c.JSON(statusCode, helper.BaseResponse{
@@ -788,7 +2212,7 @@ func (tc *ThreadController) AdminUpdate(c echo.Context) error {
 		})
 	}
 
-	responseThread, err := tc.threadUseCase.DomainToResponse(result, primitive.NilObjectID)
+	responseThread, err := tc.threadUseCase.DomainToResponse(result, userID)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
 			Status:  http.StatusInternalServerError,
@@ -799,7 +2223,7 @@ func (tc *ThreadController) AdminUpdate(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, helper.BaseResponse{
 		Status:  http.StatusOK,
-		Message: "success to update thread",
+		Message: "success to publish draft",
 		Data: map[string]interface{}{
 			"thread": responseThread,
 		},
@@ -1128,16 +2552,42 @@ func (tc *ThreadController) UserDelete(c echo.Context) error {
 		})
 	}
 
-	err = tc.commentUseCase.DeleteAllByThreadID(threadID)
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to delete thread",
+		Data: map[string]interface{}{
+			"thread": responseThread,
+		},
+	})
+}
+
+func (tc *ThreadController) AdminDelete(c echo.Context) error {
+	threadID, err := primitive.ObjectIDFromHex(c.Param("thread-id"))
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
-			Status:  http.StatusInternalServerError,
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "invalid thread id",
+			Data:    nil,
+		})
+	}
+
+	deletedThread, err := tc.threadUseCase.AdminDelete(threadID)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if strings.Contains(err.Error(), "failed to get") {
+			statusCode = http.StatusNotFound
+		} else if strings.Contains(err.Error(), "user are not the thread creator") {
+			statusCode = http.StatusForbidden
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
 			Message: err.Error(),
 			Data:    nil,
 		})
 	}
 
-	err = tc.followThreadUseCase.DeleteAllByThreadID(threadID)
+	responseThread, err := tc.threadUseCase.DomainToResponse(deletedThread, primitive.NilObjectID)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
 			Status:  http.StatusInternalServerError,
@@ -1146,7 +2596,7 @@ func (tc *ThreadController) UserDelete(c echo.Context) error {
 		})
 	}
 
-	err = tc.bookmarkUseCase.DeleteAllByThreadID(threadID)
+	err = tc.audit(c, auditLogs.ActionThreadDelete, threadID, deletedThread, nil)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
 			Status:  http.StatusInternalServerError,
@@ -1164,7 +2614,16 @@ func (tc *ThreadController) UserDelete(c echo.Context) error {
 	})
 }
 
-func (tc *ThreadController) AdminDelete(c echo.Context) error {
+func (tc *ThreadController) ModeratorDelete(c echo.Context) error {
+	moderatorID, err := util.GetUIDFromToken(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
+			Status:  http.StatusUnauthorized,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
 	threadID, err := primitive.ObjectIDFromHex(c.Param("thread-id"))
 	if err != nil {
 		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
@@ -1174,12 +2633,12 @@ func (tc *ThreadController) AdminDelete(c echo.Context) error {
 		})
 	}
 
-	deletedThread, err := tc.threadUseCase.AdminDelete(threadID)
+	deletedThread, err := tc.threadUseCase.ModeratorDelete(moderatorID, threadID)
 	if err != nil {
 		statusCode := http.StatusInternalServerError
 		if strings.Contains(err.Error(), "failed to get") {
 			statusCode = http.StatusNotFound
-		} else if strings.Contains(err.Error(), "user are not the thread creator") {
+		} else if strings.Contains(err.Error(), "not a moderator") {
 			statusCode = http.StatusForbidden
 		}
 
@@ -1199,7 +2658,7 @@ func (tc *ThreadController) AdminDelete(c echo.Context) error {
 		})
 	}
 
-	err = tc.commentUseCase.DeleteAllByThreadID(threadID)
+	err = tc.audit(c, auditLogs.ActionThreadDelete, threadID, deletedThread, nil)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
 			Status:  http.StatusInternalServerError,
@@ -1208,7 +2667,49 @@ func (tc *ThreadController) AdminDelete(c echo.Context) error {
 		})
 	}
 
-	err = tc.followThreadUseCase.DeleteAllByThreadID(threadID)
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to delete thread",
+		Data: map[string]interface{}{
+			"thread": responseThread,
+		},
+	})
+}
+
+func (tc *ThreadController) DeleteDraft(c echo.Context) error {
+	userID, err := util.GetUIDFromToken(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
+			Status:  http.StatusUnauthorized,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	threadID, err := primitive.ObjectIDFromHex(c.Param("thread-id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "invalid thread id",
+			Data:    nil,
+		})
+	}
+
+	deletedDraft, err := tc.threadUseCase.DeleteDraft(userID, threadID)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if strings.Contains(err.Error(), "failed to get") {
+			statusCode = http.StatusNotFound
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	responseThread, err := tc.threadUseCase.DomainToResponse(deletedDraft, userID)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
 			Status:  http.StatusInternalServerError,
@@ -1217,7 +2718,31 @@ func (tc *ThreadController) AdminDelete(c echo.Context) error {
 		})
 	}
 
-	err = tc.bookmarkUseCase.DeleteAllByThreadID(threadID)
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to delete draft",
+		Data: map[string]interface{}{
+			"draft": responseThread,
+		},
+	})
+}
+
+func (tc *ThreadController) UnbanTag(c echo.Context) error {
+	bannedTag, err := tc.threadUseCase.UnbanTag(c.Param("name"))
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if strings.Contains(err.Error(), "failed to get") {
+			statusCode = http.StatusNotFound
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	err = tc.auditTarget(c, auditLogs.ActionTagUnban, auditLogs.TargetTag, bannedTag.Id, bannedTag, nil)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
 			Status:  http.StatusInternalServerError,
@@ -1228,9 +2753,57 @@ func (tc *ThreadController) AdminDelete(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, helper.BaseResponse{
 		Status:  http.StatusOK,
-		Message: "success to delete thread",
+		Message: "success to unban tag",
 		Data: map[string]interface{}{
-			"thread": responseThread,
+			"bannedTag": bannedTag,
 		},
 	})
 }
+
+// PurgeTrash is run periodically to permanently delete the expired threads in the trash along with their comments, follows and bookmarks
+func (tc *ThreadController) PurgeTrash() error {
+	// a failed purge still returns the threads it removed, they are gone for good so their dependents are purged first
+	purgedThreads, purgeErr := tc.threadUseCase.PurgeTrash()
+
+	for _, thread := range purgedThreads {
+		err := tc.commentUseCase.PurgeAllByThreadID(thread.Id)
+		if err != nil {
+			return err
+		}
+
+		err = tc.followThreadUseCase.DeleteAllByThreadID(thread.Id)
+		if err != nil {
+			return err
+		}
+
+		err = tc.bookmarkUseCase.DeleteAllByThreadID(thread.Id)
+		if err != nil {
+			return err
+		}
+	}
+
+	return purgeErr
+}
+
+// audit records an administrative action on a thread, the actor is taken from the token
+func (tc *ThreadController) audit(c echo.Context, action string, threadID primitive.ObjectID, before interface{}, after interface{}) error {
+	return tc.auditTarget(c, action, auditLogs.TargetThread, threadID, before, after)
+}
+
+func (tc *ThreadController) auditTarget(c echo.Context, action string, targetType string, targetID primitive.ObjectID, before interface{}, after interface{}) error {
+	actorID, err := util.GetUIDFromToken(c)
+	if err != nil {
+		return err
+	}
+
+	_, err = tc.auditLogUseCase.Create(&auditLogs.Domain{
+		ActorID:    actorID,
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   targetID,
+		Before:     before,
+		After:      after,
+		IP:         c.RealIP(),
+	})
+	return err
+}
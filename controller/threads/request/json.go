@@ -5,21 +5,25 @@ import (
 	"charum/helper"
 	"errors"
 	"strings"
+	"time"
 
 	"github.com/fatih/structs"
 	"github.com/go-playground/validator/v10"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 type Thread struct {
-	TopicID     string `json:"topicID" validate:"required" form:"topicID"`
-	Title       string `json:"title" validate:"required" form:"title"`
-	Description string `json:"description" validate:"required" form:"description"`
+	TopicID     string   `json:"topicID" validate:"required" form:"topicID"`
+	Title       string   `json:"title" validate:"required" form:"title"`
+	Description string   `json:"description" validate:"required" form:"description"`
+	Tags        []string `json:"tags" form:"tags"`
 }
 
 func (req *Thread) ToDomain() *threads.Domain {
 	return &threads.Domain{
 		Title:       req.Title,
 		Description: req.Description,
+		Tags:        req.Tags,
 	}
 }
 
@@ -53,3 +57,200 @@ func (req *Thread) Validate() []helper.ValidationError {
 
 	return nil
 }
+
+type Suspend struct {
+	SuspendDetail string `json:"suspendDetail" validate:"max=255"`
+}
+
+func (req *Suspend) Validate() []helper.ValidationError {
+	var ve validator.ValidationErrors
+
+	if err := validator.New().Struct(req); err != nil {
+		if errors.As(err, &ve) {
+			fields := structs.Fields(req)
+			out := make([]helper.ValidationError, len(ve))
+
+			for i, e := range ve {
+				out[i] = helper.ValidationError{
+					Field:   e.Field(),
+					Message: helper.MessageForTag(e.Tag()),
+				}
+
+				out[i].Message = strings.Replace(out[i].Message, "[PARAM]", e.Param(), 1)
+
+				// Get field tag
+				for _, f := range fields {
+					if f.Name() == e.Field() {
+						out[i].Field = f.Tag("json")
+						break
+					}
+				}
+			}
+			return out
+		}
+	}
+
+	return nil
+}
+
+// Pin scope is either global or topic
+type Pin struct {
+	Scope string `json:"scope" validate:"required"`
+}
+
+func (req *Pin) Validate() []helper.ValidationError {
+	var ve validator.ValidationErrors
+
+	if err := validator.New().Struct(req); err != nil {
+		if errors.As(err, &ve) {
+			fields := structs.Fields(req)
+			out := make([]helper.ValidationError, len(ve))
+
+			for i, e := range ve {
+				out[i] = helper.ValidationError{
+					Field:   e.Field(),
+					Message: helper.MessageForTag(e.Tag()),
+				}
+
+				out[i].Message = strings.Replace(out[i].Message, "[PARAM]", e.Param(), 1)
+
+				// Get field tag
+				for _, f := range fields {
+					if f.Name() == e.Field() {
+						out[i].Field = f.Tag("json")
+						break
+					}
+				}
+			}
+			return out
+		}
+	}
+
+	return nil
+}
+
+// Draft only requires the topic so an unfinished thread can be autosaved at any time
+type Draft struct {
+	TopicID     string   `json:"topicID" validate:"required" form:"topicID"`
+	Title       string   `json:"title" form:"title"`
+	Description string   `json:"description" form:"description"`
+	PublishAt   string   `json:"publishAt" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00" form:"publishAt"`
+	Tags        []string `json:"tags" form:"tags"`
+}
+
+func (req *Draft) ToDomain() *threads.Domain {
+	domain := &threads.Domain{
+		Title:       req.Title,
+		Description: req.Description,
+		Tags:        req.Tags,
+	}
+
+	if publishAt, err := time.Parse(time.RFC3339, req.PublishAt); err == nil {
+		domain.PublishAt = primitive.NewDateTimeFromTime(publishAt)
+	}
+
+	return domain
+}
+
+func (req *Draft) Validate() []helper.ValidationError {
+	var ve validator.ValidationErrors
+
+	if err := validator.New().Struct(req); err != nil {
+		if errors.As(err, &ve) {
+			fields := structs.Fields(req)
+			out := make([]helper.ValidationError, len(ve))
+
+			for i, e := range ve {
+				out[i] = helper.ValidationError{
+					Field:   e.Field(),
+					Message: helper.MessageForTag(e.Tag()),
+				}
+
+				out[i].Message = strings.Replace(out[i].Message, "[PARAM]", e.Param(), 1)
+
+				// Get field tag
+				for _, f := range fields {
+					if f.Name() == e.Field() {
+						out[i].Field = f.Tag("json")
+						break
+					}
+				}
+			}
+			return out
+		}
+	}
+
+	return nil
+}
+
+// TagRename is used to both rename and merge a tag
+type TagRename struct {
+	From string `json:"from" validate:"required"`
+	To   string `json:"to" validate:"required"`
+}
+
+func (req *TagRename) Validate() []helper.ValidationError {
+	var ve validator.ValidationErrors
+
+	if err := validator.New().Struct(req); err != nil {
+		if errors.As(err, &ve) {
+			fields := structs.Fields(req)
+			out := make([]helper.ValidationError, len(ve))
+
+			for i, e := range ve {
+				out[i] = helper.ValidationError{
+					Field:   e.Field(),
+					Message: helper.MessageForTag(e.Tag()),
+				}
+
+				out[i].Message = strings.Replace(out[i].Message, "[PARAM]", e.Param(), 1)
+
+				// Get field tag
+				for _, f := range fields {
+					if f.Name() == e.Field() {
+						out[i].Field = f.Tag("json")
+						break
+					}
+				}
+			}
+			return out
+		}
+	}
+
+	return nil
+}
+
+type TagBan struct {
+	Name string `json:"name" validate:"required"`
+}
+
+func (req *TagBan) Validate() []helper.ValidationError {
+	var ve validator.ValidationErrors
+
+	if err := validator.New().Struct(req); err != nil {
+		if errors.As(err, &ve) {
+			fields := structs.Fields(req)
+			out := make([]helper.ValidationError, len(ve))
+
+			for i, e := range ve {
+				out[i] = helper.ValidationError{
+					Field:   e.Field(),
+					Message: helper.MessageForTag(e.Tag()),
+				}
+
+				out[i].Message = strings.Replace(out[i].Message, "[PARAM]", e.Param(), 1)
+
+				// Get field tag
+				for _, f := range fields {
+					if f.Name() == e.Field() {
+						out[i].Field = f.Tag("json")
+						break
+					}
+				}
+			}
+			return out
+		}
+	}
+
+	return nil
+}
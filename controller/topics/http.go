@@ -1,6 +1,7 @@
 package topics
 
 import (
+	auditLogs "charum/business/audit_logs"
 	"charum/business/bookmarks"
 	"charum/business/comments"
 	followThreads "charum/business/follow_threads"
@@ -10,10 +11,12 @@ import (
 	"charum/controller/topics/response"
 	dtoPagination "charum/dto/pagination"
 	"charum/helper"
+	"charum/util"
 	"errors"
 	"net/http"
 	"path/filepath"
 	"strconv"
+	"strings"
 
 	"github.com/labstack/echo/v4"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -25,15 +28,17 @@ type TopicController struct {
 	CommentUseCase      comments.UseCase
 	FollowThreadUseCase followThreads.UseCase
 	bookmarkUseCase     bookmarks.UseCase
+	auditLogUseCase     auditLogs.UseCase
 }
 
-func NewTopicController(topicUC topics.UseCase, threadUC threads.UseCase, commentUC comments.UseCase, followThreadUC followThreads.UseCase, bookmarkUC bookmarks.UseCase) *TopicController {
+func NewTopicController(topicUC topics.UseCase, threadUC threads.UseCase, commentUC comments.UseCase, followThreadUC followThreads.UseCase, bookmarkUC bookmarks.UseCase, auditLogUC auditLogs.UseCase) *TopicController {
 	return &TopicController{
 		TopicUseCase:        topicUC,
 		ThreadUseCase:       threadUC,
 		CommentUseCase:      commentUC,
 		FollowThreadUseCase: followThreadUC,
 		bookmarkUseCase:     bookmarkUC,
+		auditLogUseCase:     auditLogUC,
 	}
 }
 
@@ -250,6 +255,34 @@ func (topicCtrl *TopicController) GetByTopic(c echo.Context) error {
 Update
 */
 
+func (topicCtrl *TopicController) GetModeratedTopics(c echo.Context) error {
+	userID, err := util.GetUIDFromToken(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
+			Status:  http.StatusUnauthorized,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	moderatedTopics, err := topicCtrl.TopicUseCase.GetAllByModeratorID(userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success get moderated topics",
+		Data: map[string]interface{}{
+			"topics": response.FromDomainArray(moderatedTopics),
+		},
+	})
+}
+
 func (topicCtrl *TopicController) Update(c echo.Context) error {
 	topicID, err := primitive.ObjectIDFromHex(c.Param("topic-id"))
 	if err != nil {
@@ -304,6 +337,15 @@ func (topicCtrl *TopicController) Update(c echo.Context) error {
 		})
 	}
 
+	topicBefore, err := topicCtrl.TopicUseCase.GetByID(topicID)
+	if err != nil {
+		return c.JSON(http.StatusNotFound, helper.BaseResponse{
+			Status:  http.StatusNotFound,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
 	userInputDomain := userInput.ToDomain()
 	userInputDomain.Id = topicID
 
@@ -321,6 +363,15 @@ func (topicCtrl *TopicController) Update(c echo.Context) error {
 		})
 	}
 
+	err = topicCtrl.audit(c, auditLogs.ActionTopicUpdate, topicID, response.FromDomain(topicBefore), response.FromDomain(topic))
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
 	return c.JSON(http.StatusOK, helper.BaseResponse{
 		Status:  http.StatusOK,
 		Message: "success update topic",
@@ -334,6 +385,130 @@ func (topicCtrl *TopicController) Update(c echo.Context) error {
 Delete
 */
 
+func (topicCtrl *TopicController) AssignModerator(c echo.Context) error {
+	topicID, err := primitive.ObjectIDFromHex(c.Param("topic-id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "invalid topic id",
+			Data:    nil,
+		})
+	}
+
+	userID, err := primitive.ObjectIDFromHex(c.Param("user-id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "invalid user id",
+			Data:    nil,
+		})
+	}
+
+	topicBefore, err := topicCtrl.TopicUseCase.GetByID(topicID)
+	if err != nil {
+		return c.JSON(http.StatusNotFound, helper.BaseResponse{
+			Status:  http.StatusNotFound,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	topic, err := topicCtrl.TopicUseCase.AssignModerator(topicID, userID)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if strings.Contains(err.Error(), "failed to get") {
+			statusCode = http.StatusNotFound
+		} else if strings.Contains(err.Error(), "already a moderator") {
+			statusCode = http.StatusConflict
+		} else if strings.Contains(err.Error(), "cannot be assigned") {
+			statusCode = http.StatusBadRequest
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	err = topicCtrl.audit(c, auditLogs.ActionTopicModeratorAssign, topicID, response.FromDomain(topicBefore), response.FromDomain(topic))
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success assign moderator",
+		Data: map[string]interface{}{
+			"topic": response.FromDomain(topic),
+		},
+	})
+}
+
+func (topicCtrl *TopicController) RemoveModerator(c echo.Context) error {
+	topicID, err := primitive.ObjectIDFromHex(c.Param("topic-id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "invalid topic id",
+			Data:    nil,
+		})
+	}
+
+	userID, err := primitive.ObjectIDFromHex(c.Param("user-id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "invalid user id",
+			Data:    nil,
+		})
+	}
+
+	topicBefore, err := topicCtrl.TopicUseCase.GetByID(topicID)
+	if err != nil {
+		return c.JSON(http.StatusNotFound, helper.BaseResponse{
+			Status:  http.StatusNotFound,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	topic, err := topicCtrl.TopicUseCase.RemoveModerator(topicID, userID)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if strings.Contains(err.Error(), "failed to get") || strings.Contains(err.Error(), "not a moderator") {
+			statusCode = http.StatusNotFound
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	err = topicCtrl.audit(c, auditLogs.ActionTopicModeratorRemove, topicID, response.FromDomain(topicBefore), response.FromDomain(topic))
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success remove moderator",
+		Data: map[string]interface{}{
+			"topic": response.FromDomain(topic),
+		},
+	})
+}
+
 func (topicCtrl *TopicController) Delete(c echo.Context) error {
 	topicID, err := primitive.ObjectIDFromHex(c.Param("topic-id"))
 	if err != nil {
@@ -400,6 +575,15 @@ func (topicCtrl *TopicController) Delete(c echo.Context) error {
 		}
 	}
 
+	err = topicCtrl.audit(c, auditLogs.ActionTopicDelete, topicID, response.FromDomain(deletedTopic), nil)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
 	return c.JSON(http.StatusOK, helper.BaseResponse{
 		Status:  http.StatusOK,
 		Message: "success delete topic",
@@ -408,3 +592,22 @@ func (topicCtrl *TopicController) Delete(c echo.Context) error {
 		},
 	})
 }
+
+// audit keeps a trace of who changed a topic and from where
+func (topicCtrl *TopicController) audit(c echo.Context, action string, topicID primitive.ObjectID, before interface{}, after interface{}) error {
+	actorID, err := util.GetUIDFromToken(c)
+	if err != nil {
+		return err
+	}
+
+	_, err = topicCtrl.auditLogUseCase.Create(&auditLogs.Domain{
+		ActorID:    actorID,
+		Action:     action,
+		TargetType: auditLogs.TargetTopic,
+		TargetID:   topicID,
+		Before:     before,
+		After:      after,
+		IP:         c.RealIP(),
+	})
+	return err
+}
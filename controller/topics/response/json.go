@@ -7,22 +7,24 @@ import (
 )
 
 type Topic struct {
-	Id          primitive.ObjectID `json:"_id" bson:"_id"`
-	Topic       string             `json:"topic" bson:"topic"`
-	Description string             `json:"description" bson:"description"`
-	ImageURL    string             `json:"imageURL" bson:"imageURL"`
-	CreatedAt   primitive.DateTime `json:"createdAt" bson:"createdAt"`
-	UpdatedAt   primitive.DateTime `json:"updatedAt" bson:"updatedAt"`
+	Id           primitive.ObjectID   `json:"_id" bson:"_id"`
+	Topic        string               `json:"topic" bson:"topic"`
+	Description  string               `json:"description" bson:"description"`
+	ImageURL     string               `json:"imageURL" bson:"imageURL"`
+	ModeratorIDs []primitive.ObjectID `json:"moderatorIDs" bson:"moderatorIDs"`
+	CreatedAt    primitive.DateTime   `json:"createdAt" bson:"createdAt"`
+	UpdatedAt    primitive.DateTime   `json:"updatedAt" bson:"updatedAt"`
 }
 
 func FromDomain(domain topics.Domain) Topic {
 	return Topic{
-		Id:          domain.Id,
-		Topic:       domain.Topic,
-		Description: domain.Description,
-		ImageURL:    domain.ImageURL,
-		CreatedAt:   domain.CreatedAt,
-		UpdatedAt:   domain.UpdatedAt,
+		Id:           domain.Id,
+		Topic:        domain.Topic,
+		Description:  domain.Description,
+		ImageURL:     domain.ImageURL,
+		ModeratorIDs: domain.ModeratorIDs,
+		CreatedAt:    domain.CreatedAt,
+		UpdatedAt:    domain.UpdatedAt,
 	}
 }
 
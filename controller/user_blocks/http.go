@@ -0,0 +1,202 @@
+package user_blocks
+
+import (
+	followUsers "charum/business/follow_users"
+	userBlocks "charum/business/user_blocks"
+	dtoUser "charum/dto/users"
+	"charum/helper"
+	"charum/util"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type UserBlockController struct {
+	userBlockUseCase  userBlocks.UseCase
+	followUserUseCase followUsers.UseCase
+}
+
+func NewUserBlockController(userBlockUC userBlocks.UseCase, followUserUC followUsers.UseCase) *UserBlockController {
+	return &UserBlockController{
+		userBlockUseCase:  userBlockUC,
+		followUserUseCase: followUserUC,
+	}
+}
+
+/*
+Create
+*/
+
+func (ubc *UserBlockController) Block(c echo.Context) error {
+	return ubc.create(c, userBlocks.TypeBlock)
+}
+
+func (ubc *UserBlockController) Mute(c echo.Context) error {
+	return ubc.create(c, userBlocks.TypeMute)
+}
+
+func (ubc *UserBlockController) create(c echo.Context, blockType string) error {
+	targetID, err := primitive.ObjectIDFromHex(c.Param("user-id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "invalid user id",
+			Data:    nil,
+		})
+	}
+
+	userID, err := util.GetUIDFromToken(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
+			Status:  http.StatusUnauthorized,
+			Message: "unauthorized",
+			Data:    nil,
+		})
+	}
+
+	domain := userBlocks.Domain{
+		UserID:   userID,
+		TargetID: targetID,
+		Type:     blockType,
+	}
+
+	result, err := ubc.userBlockUseCase.Create(&domain)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if strings.Contains(err.Error(), "already") {
+			statusCode = http.StatusConflict
+		} else if strings.Contains(err.Error(), "themselves") {
+			statusCode = http.StatusBadRequest
+		} else if strings.Contains(err.Error(), "failed to get") {
+			statusCode = http.StatusNotFound
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	// a blocked user can no longer follow the blocker, so the follows between both users are dropped
+	if blockType == userBlocks.TypeBlock {
+		err = ubc.followUserUseCase.DeleteBetweenUsers(userID, targetID)
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+				Status:  http.StatusInternalServerError,
+				Message: err.Error(),
+				Data:    nil,
+			})
+		}
+	}
+
+	return c.JSON(http.StatusCreated, helper.BaseResponse{
+		Status:  http.StatusCreated,
+		Message: "success to " + blockType + " user",
+		Data: map[string]interface{}{
+			blockType: result,
+		},
+	})
+}
+
+/*
+Read
+*/
+
+func (ubc *UserBlockController) GetBlocked(c echo.Context) error {
+	return ubc.getAll(c, userBlocks.TypeBlock, "blockedUsers")
+}
+
+func (ubc *UserBlockController) GetMuted(c echo.Context) error {
+	return ubc.getAll(c, userBlocks.TypeMute, "mutedUsers")
+}
+
+func (ubc *UserBlockController) getAll(c echo.Context, blockType string, key string) error {
+	userID, err := util.GetUIDFromToken(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
+			Status:  http.StatusUnauthorized,
+			Message: "unauthorized",
+			Data:    nil,
+		})
+	}
+
+	result, err := ubc.userBlockUseCase.GetAllByUserIDAndType(userID, blockType)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to get " + blockType + " list",
+		Data: map[string]interface{}{
+			key: dtoUser.FromDomainArray(result),
+		},
+	})
+}
+
+/*
+Delete
+*/
+
+func (ubc *UserBlockController) Unblock(c echo.Context) error {
+	return ubc.delete(c, userBlocks.TypeBlock)
+}
+
+func (ubc *UserBlockController) Unmute(c echo.Context) error {
+	return ubc.delete(c, userBlocks.TypeMute)
+}
+
+func (ubc *UserBlockController) delete(c echo.Context, blockType string) error {
+	targetID, err := primitive.ObjectIDFromHex(c.Param("user-id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "invalid user id",
+			Data:    nil,
+		})
+	}
+
+	userID, err := util.GetUIDFromToken(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
+			Status:  http.StatusUnauthorized,
+			Message: "unauthorized",
+			Data:    nil,
+		})
+	}
+
+	domain := userBlocks.Domain{
+		UserID:   userID,
+		TargetID: targetID,
+		Type:     blockType,
+	}
+
+	result, err := ubc.userBlockUseCase.Delete(&domain)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if strings.Contains(err.Error(), "has not") {
+			statusCode = http.StatusNotFound
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to un" + blockType + " user",
+		Data: map[string]interface{}{
+			"un" + blockType: result,
+		},
+	})
+}
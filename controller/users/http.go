@@ -1,14 +1,21 @@
 package users
 
 import (
+	auditLogs "charum/business/audit_logs"
 	"charum/business/bookmarks"
 	"charum/business/comments"
+	dataExports "charum/business/data_exports"
 	followThreads "charum/business/follow_threads"
+	followUsers "charum/business/follow_users"
+	forgotPassword "charum/business/forgot_password"
 	"charum/business/threads"
+	userBlocks "charum/business/user_blocks"
 	"charum/business/users"
+	"charum/business/warnings"
 	"charum/controller/users/request"
 	"charum/controller/users/response"
 	dtoPagination "charum/dto/pagination"
+	dtoUser "charum/dto/users"
 	"charum/helper"
 	"charum/util"
 	"errors"
@@ -22,20 +29,32 @@ import (
 )
 
 type UserController struct {
-	userUseCase         users.UseCase
-	threadUseCase       threads.UseCase
-	commentUseCase      comments.UseCase
-	followThreadUseCase followThreads.UseCase
-	bookmarksUseCase    bookmarks.UseCase
+	userUseCase           users.UseCase
+	threadUseCase         threads.UseCase
+	commentUseCase        comments.UseCase
+	followThreadUseCase   followThreads.UseCase
+	followUserUseCase     followUsers.UseCase
+	userBlockUseCase      userBlocks.UseCase
+	warningUseCase        warnings.UseCase
+	bookmarksUseCase      bookmarks.UseCase
+	forgotPasswordUseCase forgotPassword.UseCase
+	auditLogUseCase       auditLogs.UseCase
+	dataExportUseCase     dataExports.UseCase
 }
 
-func NewUserController(userUC users.UseCase, threadUC threads.UseCase, commentUC comments.UseCase, followThreadUC followThreads.UseCase, bookmarkUC bookmarks.UseCase) *UserController {
+func NewUserController(userUC users.UseCase, threadUC threads.UseCase, commentUC comments.UseCase, followThreadUC followThreads.UseCase, followUserUC followUsers.UseCase, userBlockUC userBlocks.UseCase, warningUC warnings.UseCase, bookmarkUC bookmarks.UseCase, forgotPasswordUC forgotPassword.UseCase, auditLogUC auditLogs.UseCase, dataExportUC dataExports.UseCase) *UserController {
 	return &UserController{
-		userUseCase:         userUC,
-		threadUseCase:       threadUC,
-		commentUseCase:      commentUC,
-		followThreadUseCase: followThreadUC,
-		bookmarksUseCase:    bookmarkUC,
+		userUseCase:           userUC,
+		threadUseCase:         threadUC,
+		commentUseCase:        commentUC,
+		followThreadUseCase:   followThreadUC,
+		followUserUseCase:     followUserUC,
+		userBlockUseCase:      userBlockUC,
+		warningUseCase:        warningUC,
+		bookmarksUseCase:      bookmarkUC,
+		forgotPasswordUseCase: forgotPasswordUC,
+		auditLogUseCase:       auditLogUC,
+		dataExportUseCase:     dataExportUC,
 	}
 }
 
@@ -95,7 +114,7 @@ func (userCtrl *UserController) Register(c echo.Context) error {
 		})
 	}
 
-	user, token, err := userCtrl.userUseCase.Register(userInput.ToDomain(), profilePicture)
+	user, token, refreshToken, err := userCtrl.userUseCase.Register(userInput.ToDomain(), profilePicture, c.Request().UserAgent(), c.RealIP())
 
 	if err != nil {
 		statusCode := http.StatusInternalServerError
@@ -114,8 +133,9 @@ func (userCtrl *UserController) Register(c echo.Context) error {
 		Status:  http.StatusCreated,
 		Message: "success to register",
 		Data: map[string]interface{}{
-			"token": token,
-			"user":  response.FromDomain(user),
+			"token":        token,
+			"refreshToken": refreshToken,
+			"user":         response.FromDomain(user),
 		},
 	})
 }
@@ -136,10 +156,161 @@ func (userCtrl *UserController) Login(c echo.Context) error {
 		})
 	}
 
-	_, token, err := userCtrl.userUseCase.Login(userInput.Key, userInput.Password)
+	user, token, refreshToken, err := userCtrl.userUseCase.Login(userInput.Key, userInput.Password, c.Request().UserAgent(), c.RealIP())
 	if err != nil {
+		statusCode := http.StatusUnauthorized
+		if strings.Contains(err.Error(), "too many failed login attempts") {
+			statusCode = http.StatusTooManyRequests
+		} else if strings.Contains(err.Error(), "user is suspended") {
+			return c.JSON(http.StatusForbidden, helper.BaseResponse{
+				Status:  http.StatusForbidden,
+				Message: err.Error(),
+				Data: map[string]interface{}{
+					"appealToken": token,
+				},
+			})
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	if user.TwoFactorEnabled {
+		return c.JSON(http.StatusOK, helper.BaseResponse{
+			Status:  http.StatusOK,
+			Message: "two-factor authentication is required",
+			Data: map[string]interface{}{
+				"twoFactorRequired": true,
+				"challengeToken":    token,
+			},
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to login",
+		Data: map[string]interface{}{
+			"token":        token,
+			"refreshToken": refreshToken,
+		},
+	})
+}
+
+func (userCtrl *UserController) OIDCLogin(c echo.Context) error {
+	authURL, err := userCtrl.userUseCase.StartOIDCLogin()
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to get authorization url",
+		Data: map[string]interface{}{
+			"authorizationURL": authURL,
+		},
+	})
+}
+
+func (userCtrl *UserController) OIDCCallback(c echo.Context) error {
+	if c.QueryParam("error") != "" {
 		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
 			Status:  http.StatusUnauthorized,
+			Message: c.QueryParam("error"),
+			Data:    nil,
+		})
+	}
+
+	if c.QueryParam("state") == "" || c.QueryParam("code") == "" {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "state and code are required",
+			Data:    nil,
+		})
+	}
+
+	user, token, refreshToken, err := userCtrl.userUseCase.OIDCCallback(c.QueryParam("state"), c.QueryParam("code"), c.Request().UserAgent(), c.RealIP())
+	if err != nil {
+		statusCode := http.StatusUnauthorized
+		if strings.Contains(err.Error(), "already linked") {
+			statusCode = http.StatusConflict
+		} else if strings.Contains(err.Error(), "failed to delete") || strings.Contains(err.Error(), "failed to link") || strings.Contains(err.Error(), "failed to register") || strings.Contains(err.Error(), "failed to generate") || strings.Contains(err.Error(), "failed to create") {
+			statusCode = http.StatusInternalServerError
+		} else if strings.Contains(err.Error(), "user is suspended") {
+			return c.JSON(http.StatusForbidden, helper.BaseResponse{
+				Status:  http.StatusForbidden,
+				Message: err.Error(),
+				Data: map[string]interface{}{
+					"appealToken": token,
+				},
+			})
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	if user.TwoFactorEnabled {
+		return c.JSON(http.StatusOK, helper.BaseResponse{
+			Status:  http.StatusOK,
+			Message: "two-factor authentication is required",
+			Data: map[string]interface{}{
+				"twoFactorRequired": true,
+				"challengeToken":    token,
+			},
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to login",
+		Data: map[string]interface{}{
+			"token":        token,
+			"refreshToken": refreshToken,
+		},
+	})
+}
+
+func (userCtrl *UserController) VerifyTwoFactor(c echo.Context) error {
+	userInput := request.TwoFactorChallenge{}
+	c.Bind(&userInput)
+
+	if err := userInput.Validate(); err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "validation failed",
+			Data:    err,
+		})
+	}
+
+	_, token, refreshToken, err := userCtrl.userUseCase.VerifyTwoFactor(userInput.ChallengeToken, userInput.Code, c.Request().UserAgent(), c.RealIP())
+	if err != nil {
+		statusCode := http.StatusUnauthorized
+		if strings.Contains(err.Error(), "too many failed login attempts") {
+			statusCode = http.StatusTooManyRequests
+		} else if strings.Contains(err.Error(), "failed to update") || strings.Contains(err.Error(), "failed to create") || strings.Contains(err.Error(), "failed to generate") {
+			statusCode = http.StatusInternalServerError
+		} else if strings.Contains(err.Error(), "user is suspended") {
+			return c.JSON(http.StatusForbidden, helper.BaseResponse{
+				Status:  http.StatusForbidden,
+				Message: err.Error(),
+				Data: map[string]interface{}{
+					"appealToken": token,
+				},
+			})
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
 			Message: err.Error(),
 			Data:    nil,
 		})
@@ -149,11 +320,81 @@ func (userCtrl *UserController) Login(c echo.Context) error {
 		Status:  http.StatusOK,
 		Message: "success to login",
 		Data: map[string]interface{}{
-			"token": token,
+			"token":        token,
+			"refreshToken": refreshToken,
+		},
+	})
+}
+
+func (userCtrl *UserController) RefreshToken(c echo.Context) error {
+	userInput := request.RefreshToken{}
+	c.Bind(&userInput)
+
+	if err := userInput.Validate(); err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "validation failed",
+			Data:    err,
+		})
+	}
+
+	token, refreshToken, err := userCtrl.userUseCase.RefreshToken(userInput.RefreshToken)
+	if err != nil {
+		statusCode := http.StatusUnauthorized
+		if strings.Contains(err.Error(), "failed to update") || strings.Contains(err.Error(), "failed to generate") || strings.Contains(err.Error(), "failed to revoke") {
+			statusCode = http.StatusInternalServerError
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to refresh token",
+		Data: map[string]interface{}{
+			"token":        token,
+			"refreshToken": refreshToken,
 		},
 	})
 }
 
+func (userCtrl *UserController) Logout(c echo.Context) error {
+	claims, err := util.GetClaimsFromToken(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
+			Status:  http.StatusUnauthorized,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	err = userCtrl.userUseCase.Logout(claims.ID)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if strings.Contains(err.Error(), "failed to get") {
+			statusCode = http.StatusNotFound
+		} else if strings.Contains(err.Error(), "has been revoked") {
+			statusCode = http.StatusConflict
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to logout",
+		Data:    nil,
+	})
+}
+
 func (userCtrl *UserController) GetManyWithPagination(c echo.Context) error {
 	page, err := strconv.Atoi(c.Param("page"))
 	if err != nil {
@@ -266,11 +507,20 @@ func (userCtrl *UserController) GetByID(c echo.Context) error {
 		})
 	}
 
+	profile, err := userCtrl.toProfile(user)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
 	return c.JSON(http.StatusOK, helper.BaseResponse{
 		Status:  http.StatusOK,
 		Message: "success to get user by id",
 		Data: map[string]interface{}{
-			"user": response.FromDomain(user),
+			"user": profile,
 		},
 	})
 }
@@ -294,89 +544,972 @@ func (userCtrl *UserController) GetProfile(c echo.Context) error {
 		})
 	}
 
+	profile, err := userCtrl.toProfile(user)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	userWarnings, err := userCtrl.warningUseCase.GetAllByUserID(user.Id)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	activeStrikes, err := userCtrl.warningUseCase.CountActiveByUserID(user.Id)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
 	return c.JSON(http.StatusOK, helper.BaseResponse{
 		Status:  http.StatusOK,
 		Message: "success to get user profile",
 		Data: map[string]interface{}{
-			"user": response.FromDomain(user),
+			"user":          profile,
+			"warnings":      response.FromWarningDomainArray(userWarnings),
+			"activeStrikes": activeStrikes,
 		},
 	})
 }
 
-/*
-Update
-*/
-
-func (userCtrl *UserController) AdminUpdate(c echo.Context) error {
-	userID, err := primitive.ObjectIDFromHex(c.Param("user-id"))
+func (userCtrl *UserController) toProfile(user users.Domain) (response.Profile, error) {
+	totalFollowers, err := userCtrl.followUserUseCase.CountFollowers(user.Id)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
-			Status:  http.StatusBadRequest,
-			Message: "invalid user id",
-			Data:    nil,
-		})
+		return response.Profile{}, err
 	}
 
-	var validationErr []helper.ValidationError
-	profilePicture, _ := c.FormFile("profilePicture")
-	if profilePicture != nil {
-		profilePictureExt := filepath.Ext(profilePicture.Filename)
-		availableExt := []string{".jpg", ".jpeg", ".png"}
+	totalFollowing, err := userCtrl.followUserUseCase.CountFollowing(user.Id)
+	if err != nil {
+		return response.Profile{}, err
+	}
 
-		flagExt := false
-		for _, ext := range availableExt {
-			if profilePictureExt == ext {
-				flagExt = true
-			}
-		}
+	return response.Profile{
+		User:           response.FromDomain(user),
+		TotalFollowers: totalFollowers,
+		TotalFollowing: totalFollowing,
+	}, nil
+}
 
-		if !flagExt {
-			validationErr = append(validationErr, helper.ValidationError{
-				Field:   "profilePicture",
-				Message: "This field must be a file with .jpg, .jpeg, or .png extension",
-			})
-		}
+func (userCtrl *UserController) GetPublicProfile(c echo.Context) error {
+	user, err := userCtrl.userUseCase.GetByUsername(c.Param("username"))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, helper.BaseResponse{
+			Status:  http.StatusNotFound,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
 
-		if profilePicture.Size > 10000000 {
-			validationErr = append(validationErr, helper.ValidationError{
-				Field:   "profilePicture",
-				Message: "This field must be a file with size less than 10 MB",
-			})
-		}
+	totalThreads, err := userCtrl.threadUseCase.CountByUserID(user.Id)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
 	}
 
-	userInput := request.Update{}
-	c.Bind(&userInput)
+	totalComments, err := userCtrl.commentUseCase.CountByUserID(user.Id)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
 
-	if strings.Contains(userInput.UserName, " ") {
-		validationErr = append(validationErr, helper.ValidationError{
-			Field:   "userName",
-			Message: "This field must not contain any space",
+	totalLikes, err := userCtrl.threadUseCase.CountLikesByUserID(user.Id)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
 		})
 	}
 
-	inputErr := userInput.Validate()
-	if inputErr != nil {
-		validationErr = append(validationErr, inputErr...)
+	profile, err := userCtrl.toProfile(user)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
 	}
 
-	if validationErr != nil {
-		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
-			Status:  http.StatusBadRequest,
-			Message: "validation failed",
-			Data:    validationErr,
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to get user profile",
+		Data: map[string]interface{}{
+			"user": response.PublicProfile{
+				Public:         dtoUser.FromDomain(user),
+				SocialMedia:    user.SocialMedia,
+				CreatedAt:      user.CreatedAt,
+				TotalThreads:   totalThreads,
+				TotalComments:  totalComments,
+				TotalLikes:     totalLikes,
+				TotalFollowers: profile.TotalFollowers,
+				TotalFollowing: profile.TotalFollowing,
+			},
+		},
+	})
+}
+
+func (userCtrl *UserController) GetPublicThreads(c echo.Context) error {
+	pagination, err := getPublicPagination(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:     http.StatusBadRequest,
+			Message:    err.Error(),
+			Data:       nil,
+			Pagination: helper.Page{},
+		})
+	}
+
+	user, err := userCtrl.userUseCase.GetByUsername(c.Param("username"))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, helper.BaseResponse{
+			Status:     http.StatusNotFound,
+			Message:    err.Error(),
+			Data:       nil,
+			Pagination: helper.Page{},
+		})
+	}
+
+	threads, totalPage, totalData, err := userCtrl.threadUseCase.GetManyByUserID(pagination, user.Id)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:     http.StatusInternalServerError,
+			Message:    err.Error(),
+			Data:       nil,
+			Pagination: helper.Page{},
+		})
+	}
+
+	// guests are not logged in, their user id is left empty
+	uid, _ := util.GetUIDFromToken(c)
+
+	responseThreads, err := userCtrl.threadUseCase.DomainsToResponseArray(threads, uid)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:     http.StatusInternalServerError,
+			Message:    err.Error(),
+			Data:       nil,
+			Pagination: helper.Page{},
+		})
+	}
+
+	for i, thread := range responseThreads {
+		responseThreads[i].TotalComment, err = userCtrl.commentUseCase.CountByThreadID(thread.Id)
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+				Status:     http.StatusInternalServerError,
+				Message:    err.Error(),
+				Data:       nil,
+				Pagination: helper.Page{},
+			})
+		}
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to get user threads",
+		Data: map[string]interface{}{
+			"threads": responseThreads,
+		},
+		Pagination: helper.Page{
+			Size:        pagination.Limit,
+			TotalData:   totalData,
+			TotalPage:   totalPage,
+			CurrentPage: pagination.Page,
+		},
+	})
+}
+
+func (userCtrl *UserController) GetPublicComments(c echo.Context) error {
+	pagination, err := getPublicPagination(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:     http.StatusBadRequest,
+			Message:    err.Error(),
+			Data:       nil,
+			Pagination: helper.Page{},
+		})
+	}
+
+	user, err := userCtrl.userUseCase.GetByUsername(c.Param("username"))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, helper.BaseResponse{
+			Status:     http.StatusNotFound,
+			Message:    err.Error(),
+			Data:       nil,
+			Pagination: helper.Page{},
+		})
+	}
+
+	comments, totalPage, totalData, err := userCtrl.commentUseCase.GetManyByUserID(pagination, user.Id)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:     http.StatusInternalServerError,
+			Message:    err.Error(),
+			Data:       nil,
+			Pagination: helper.Page{},
+		})
+	}
+
+	responseComments, err := userCtrl.commentUseCase.DomainToResponseArray(comments)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:     http.StatusInternalServerError,
+			Message:    err.Error(),
+			Data:       nil,
+			Pagination: helper.Page{},
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to get user comments",
+		Data: map[string]interface{}{
+			"comments": responseComments,
+		},
+		Pagination: helper.Page{
+			Size:        pagination.Limit,
+			TotalData:   totalData,
+			TotalPage:   totalPage,
+			CurrentPage: pagination.Page,
+		},
+	})
+}
+
+// getPublicPagination reads the page and limit of the public profile listings, newest first
+func getPublicPagination(c echo.Context) (dtoPagination.Request, error) {
+	page, err := strconv.Atoi(c.Param("page"))
+	if err != nil {
+		return dtoPagination.Request{}, errors.New("page must be a number")
+	} else if page < 1 {
+		return dtoPagination.Request{}, errors.New("page must be greater than 0")
+	}
+
+	limit := c.QueryParam("limit")
+	if limit == "" {
+		limit = "25"
+	}
+	limitNumber, err := strconv.Atoi(limit)
+	if err != nil || limitNumber < 1 {
+		return dtoPagination.Request{}, errors.New("limit must be a number and greater than 0")
+	}
+
+	return dtoPagination.Request{
+		Page:  page,
+		Limit: limitNumber,
+		Sort:  "createdAt",
+		Order: "desc",
+	}, nil
+}
+
+func (userCtrl *UserController) GetSessions(c echo.Context) error {
+	claims, err := util.GetClaimsFromToken(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
+			Status:  http.StatusUnauthorized,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	userID, err := primitive.ObjectIDFromHex(claims.UID)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
+			Status:  http.StatusUnauthorized,
+			Message: "invalid token",
+			Data:    nil,
+		})
+	}
+
+	sessions, err := userCtrl.userUseCase.GetSessions(userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to get sessions",
+		Data: map[string]interface{}{
+			"sessions": response.FromSessionDomainArray(sessions, claims.ID),
+		},
+	})
+}
+
+func (userCtrl *UserController) GetLockouts(c echo.Context) error {
+	lockouts, err := userCtrl.userUseCase.GetLockouts()
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to get lockouts",
+		Data: map[string]interface{}{
+			"lockouts": response.FromLockoutDomainArray(lockouts),
+		},
+	})
+}
+
+func (userCtrl *UserController) GetSuspensions(c echo.Context) error {
+	userID, err := primitive.ObjectIDFromHex(c.Param("user-id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "invalid user id",
+			Data:    nil,
+		})
+	}
+
+	suspensions, err := userCtrl.userUseCase.GetSuspensions(userID)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if strings.Contains(err.Error(), "failed to get user") {
+			statusCode = http.StatusNotFound
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to get suspensions",
+		Data: map[string]interface{}{
+			"suspensions": response.FromSuspensionDomainArray(suspensions),
+		},
+	})
+}
+
+/*
+Update
+*/
+
+func (userCtrl *UserController) AdminUpdate(c echo.Context) error {
+	userID, err := primitive.ObjectIDFromHex(c.Param("user-id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "invalid user id",
+			Data:    nil,
+		})
+	}
+
+	var validationErr []helper.ValidationError
+	profilePicture, _ := c.FormFile("profilePicture")
+	if profilePicture != nil {
+		profilePictureExt := filepath.Ext(profilePicture.Filename)
+		availableExt := []string{".jpg", ".jpeg", ".png"}
+
+		flagExt := false
+		for _, ext := range availableExt {
+			if profilePictureExt == ext {
+				flagExt = true
+			}
+		}
+
+		if !flagExt {
+			validationErr = append(validationErr, helper.ValidationError{
+				Field:   "profilePicture",
+				Message: "This field must be a file with .jpg, .jpeg, or .png extension",
+			})
+		}
+
+		if profilePicture.Size > 10000000 {
+			validationErr = append(validationErr, helper.ValidationError{
+				Field:   "profilePicture",
+				Message: "This field must be a file with size less than 10 MB",
+			})
+		}
+	}
+
+	userInput := request.Update{}
+	c.Bind(&userInput)
+
+	if strings.Contains(userInput.UserName, " ") {
+		validationErr = append(validationErr, helper.ValidationError{
+			Field:   "userName",
+			Message: "This field must not contain any space",
+		})
+	}
+
+	inputErr := userInput.Validate()
+	if inputErr != nil {
+		validationErr = append(validationErr, inputErr...)
+	}
+
+	if validationErr != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "validation failed",
+			Data:    validationErr,
+		})
+	}
+
+	userBefore, err := userCtrl.userUseCase.GetByID(userID)
+	if err != nil {
+		return c.JSON(http.StatusNotFound, helper.BaseResponse{
+			Status:  http.StatusNotFound,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	userDomain := userInput.ToDomain()
+	userDomain.Id = userID
+	user, err := userCtrl.userUseCase.Update(userDomain, profilePicture)
+
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if err == errors.New("failed to get user") {
+			statusCode = http.StatusNotFound
+		} else if strings.Contains(err.Error(), "already") {
+			statusCode = http.StatusConflict
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	err = userCtrl.audit(c, auditLogs.ActionUserUpdate, userID, response.FromDomain(userBefore), response.FromDomain(user))
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to update user",
+		Data: map[string]interface{}{
+			"user": response.FromDomain(user),
+		},
+	})
+}
+
+func (userCtrl *UserController) UserUpdate(c echo.Context) error {
+	userID, err := util.GetUIDFromToken(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
+			Status:  http.StatusUnauthorized,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	var validationErr []helper.ValidationError
+	profilePicture, _ := c.FormFile("profilePicture")
+	if profilePicture != nil {
+		profilePictureExt := filepath.Ext(profilePicture.Filename)
+		availableExt := []string{".jpg", ".jpeg", ".png"}
+
+		flagExt := false
+		for _, ext := range availableExt {
+			if profilePictureExt == ext {
+				flagExt = true
+			}
+		}
+
+		if !flagExt {
+			validationErr = append(validationErr, helper.ValidationError{
+				Field:   "profilePicture",
+				Message: "This field must be a file with .jpg, .jpeg, or .png extension",
+			})
+		}
+
+		if profilePicture.Size > 10000000 {
+			validationErr = append(validationErr, helper.ValidationError{
+				Field:   "profilePicture",
+				Message: "This field must be a file with size less than 10 MB",
+			})
+		}
+	}
+
+	userInput := request.Update{}
+	c.Bind(&userInput)
+
+	if strings.Contains(userInput.UserName, " ") {
+		validationErr = append(validationErr, helper.ValidationError{
+			Field:   "userName",
+			Message: "This field must not contain any space",
+		})
+	}
+
+	inputErr := userInput.Validate()
+	if inputErr != nil {
+		validationErr = append(validationErr, inputErr...)
+	}
+
+	if validationErr != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "validation failed",
+			Data:    validationErr,
+		})
+	}
+
+	userDomain := userInput.ToDomain()
+	userDomain.Id = userID
+	user, err := userCtrl.userUseCase.Update(userDomain, profilePicture)
+
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if err == errors.New("failed to get user") {
+			statusCode = http.StatusNotFound
+		} else if strings.Contains(err.Error(), "already") {
+			statusCode = http.StatusConflict
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to update user",
+		Data: map[string]interface{}{
+			"user": response.FromDomain(user),
+		},
+	})
+}
+
+func (userCtrl *UserController) UpdatePassword(c echo.Context) error {
+	claims, err := util.GetClaimsFromToken(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
+			Status:  http.StatusUnauthorized,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	userID, err := primitive.ObjectIDFromHex(claims.UID)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
+			Status:  http.StatusUnauthorized,
+			Message: "invalid token",
+			Data:    nil,
+		})
+	}
+
+	userInput := request.ChangePassword{}
+	c.Bind(&userInput)
+
+	if err := userInput.Validate(); err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "validation failed",
+			Data:    err,
+		})
+	}
+
+	userDomain := userInput.ToDomain()
+	userDomain.Id = userID
+	user, err := userCtrl.userUseCase.UpdatePassword(userDomain, claims.ID)
+
+	statusCode := http.StatusInternalServerError
+	if err == errors.New("failed to get user") {
+		statusCode = http.StatusNotFound
+	} else if err == errors.New("password is incorrect") {
+		statusCode = http.StatusForbidden
+	}
+
+	if err != nil {
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	err = userCtrl.forgotPasswordUseCase.InvalidateTokens(user.Email)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to change password",
+		Data: map[string]interface{}{
+			"user": response.FromDomain(user),
+		},
+	})
+}
+
+func (userCtrl *UserController) RequestDeletion(c echo.Context) error {
+	userID, err := util.GetUIDFromToken(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
+			Status:  http.StatusUnauthorized,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	userInput := request.DeleteAccount{}
+	c.Bind(&userInput)
+
+	if err := userInput.Validate(); err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "validation failed",
+			Data:    err,
+		})
+	}
+
+	user, err := userCtrl.userUseCase.RequestDeletion(userID, userInput.Password)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if strings.Contains(err.Error(), "failed to get user") {
+			statusCode = http.StatusNotFound
+		} else if strings.Contains(err.Error(), "wrong password") || strings.Contains(err.Error(), "cannot be deleted") {
+			statusCode = http.StatusForbidden
+		} else if strings.Contains(err.Error(), "already requested") {
+			statusCode = http.StatusConflict
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to request account deletion, log in before the scheduled date to cancel it",
+		Data: map[string]interface{}{
+			"user": response.FromDomain(user),
+		},
+	})
+}
+
+func (userCtrl *UserController) Suspend(c echo.Context) error {
+	userID, err := primitive.ObjectIDFromHex(c.Param("user-id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "invalid user id",
+			Data:    nil,
+		})
+	}
+
+	adminID, err := util.GetUIDFromToken(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
+			Status:  http.StatusUnauthorized,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	userInput := request.Suspend{}
+	c.Bind(&userInput)
+
+	if err := userInput.Validate(); err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "validation failed",
+			Data:    err,
+		})
+	}
+
+	userBefore, err := userCtrl.userUseCase.GetByID(userID)
+	if err != nil {
+		return c.JSON(http.StatusNotFound, helper.BaseResponse{
+			Status:  http.StatusNotFound,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	suspensionInput := userInput.ToDomain()
+	suspensionInput.UserID = userID
+	suspensionInput.AdminID = adminID
+
+	user, suspension, err := userCtrl.userUseCase.Suspend(suspensionInput)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if strings.Contains(err.Error(), "failed to get user") {
+			statusCode = http.StatusNotFound
+		} else if strings.Contains(err.Error(), "user is already suspended") {
+			statusCode = http.StatusConflict
+		} else if strings.Contains(err.Error(), "invalid suspension reason") || strings.Contains(err.Error(), "must be in the future") {
+			statusCode = http.StatusBadRequest
+		} else if strings.Contains(err.Error(), "admin cannot be suspended") {
+			statusCode = http.StatusForbidden
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	err = userCtrl.threadUseCase.SuspendByUserID(userID, suspension.Reason)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	err = userCtrl.commentUseCase.DeleteAllByUserID(userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	err = userCtrl.followThreadUseCase.DeleteAllByUserID(userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	err = userCtrl.threadUseCase.RemoveUserFromAllLikes(userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	err = userCtrl.bookmarksUseCase.DeleteAllByUserID(userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	err = userCtrl.audit(c, auditLogs.ActionUserSuspend, userID, response.FromDomain(userBefore), response.FromDomain(user))
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to suspend user",
+		Data: map[string]interface{}{
+			"user":       response.FromDomain(user),
+			"suspension": response.FromSuspensionDomain(suspension),
+		},
+	})
+}
+
+func (userCtrl *UserController) Unsuspend(c echo.Context) error {
+	userID, err := primitive.ObjectIDFromHex(c.Param("user-id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "invalid user id",
+			Data:    nil,
+		})
+	}
+
+	adminID, err := util.GetUIDFromToken(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
+			Status:  http.StatusUnauthorized,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	userBefore, err := userCtrl.userUseCase.GetByID(userID)
+	if err != nil {
+		return c.JSON(http.StatusNotFound, helper.BaseResponse{
+			Status:  http.StatusNotFound,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	user, err := userCtrl.userUseCase.Unsuspend(userID, adminID)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if strings.Contains(err.Error(), "failed to get") {
+			statusCode = http.StatusNotFound
+		} else if strings.Contains(err.Error(), "user is not suspended") {
+			statusCode = http.StatusConflict
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	err = userCtrl.threadUseCase.UnsuspendByUserID(userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	err = userCtrl.audit(c, auditLogs.ActionUserUnsuspend, userID, response.FromDomain(userBefore), response.FromDomain(user))
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to unsuspend user",
+		Data: map[string]interface{}{
+			"user": response.FromDomain(user),
+		},
+	})
+}
+
+// LiftExpiredSuspensions reactivates users whose suspension is over together with their threads, it is run by a background job
+func (userCtrl *UserController) LiftExpiredSuspensions() error {
+	liftedUsers, liftErr := userCtrl.userUseCase.LiftExpiredSuspensions()
+
+	for _, user := range liftedUsers {
+		err := userCtrl.threadUseCase.UnsuspendByUserID(user.Id)
+		if err != nil {
+			liftErr = err
+		}
+	}
+
+	return liftErr
+}
+
+func (userCtrl *UserController) VerifyEmail(c echo.Context) error {
+	user, err := userCtrl.userUseCase.VerifyEmail(c.Param("token"))
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if strings.Contains(err.Error(), "invalid or expired") {
+			statusCode = http.StatusBadRequest
+		} else if strings.Contains(err.Error(), "failed to get") {
+			statusCode = http.StatusNotFound
+		} else if strings.Contains(err.Error(), "already verified") {
+			statusCode = http.StatusConflict
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to verify email",
+		Data: map[string]interface{}{
+			"user": response.FromDomain(user),
+		},
+	})
+}
+
+func (userCtrl *UserController) ResendVerificationEmail(c echo.Context) error {
+	userID, err := util.GetUIDFromToken(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
+			Status:  http.StatusUnauthorized,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	err = userCtrl.userUseCase.ResendVerificationEmail(userID)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if strings.Contains(err.Error(), "failed to get") {
+			statusCode = http.StatusNotFound
+		} else if strings.Contains(err.Error(), "already verified") {
+			statusCode = http.StatusConflict
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to send verification email",
+		Data:    nil,
+	})
+}
+
+func (userCtrl *UserController) EnrollTwoFactor(c echo.Context) error {
+	userID, err := util.GetUIDFromToken(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
+			Status:  http.StatusUnauthorized,
+			Message: err.Error(),
+			Data:    nil,
 		})
 	}
 
-	userDomain := userInput.ToDomain()
-	userDomain.Id = userID
-	user, err := userCtrl.userUseCase.Update(userDomain, profilePicture)
-
+	secret, uri, err := userCtrl.userUseCase.EnrollTwoFactor(userID)
 	if err != nil {
 		statusCode := http.StatusInternalServerError
-		if err == errors.New("failed to get user") {
+		if strings.Contains(err.Error(), "failed to get") {
 			statusCode = http.StatusNotFound
-		} else if strings.Contains(err.Error(), "already") {
+		} else if strings.Contains(err.Error(), "already enabled") {
 			statusCode = http.StatusConflict
 		}
 
@@ -389,14 +1522,15 @@ func (userCtrl *UserController) AdminUpdate(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, helper.BaseResponse{
 		Status:  http.StatusOK,
-		Message: "success to update user",
+		Message: "success to enroll two-factor authentication",
 		Data: map[string]interface{}{
-			"user": response.FromDomain(user),
+			"secret": secret,
+			"uri":    uri,
 		},
 	})
 }
 
-func (userCtrl *UserController) UserUpdate(c echo.Context) error {
+func (userCtrl *UserController) ConfirmTwoFactor(c echo.Context) error {
 	userID, err := util.GetUIDFromToken(c)
 	if err != nil {
 		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
@@ -406,67 +1540,26 @@ func (userCtrl *UserController) UserUpdate(c echo.Context) error {
 		})
 	}
 
-	var validationErr []helper.ValidationError
-	profilePicture, _ := c.FormFile("profilePicture")
-	if profilePicture != nil {
-		profilePictureExt := filepath.Ext(profilePicture.Filename)
-		availableExt := []string{".jpg", ".jpeg", ".png"}
-
-		flagExt := false
-		for _, ext := range availableExt {
-			if profilePictureExt == ext {
-				flagExt = true
-			}
-		}
-
-		if !flagExt {
-			validationErr = append(validationErr, helper.ValidationError{
-				Field:   "profilePicture",
-				Message: "This field must be a file with .jpg, .jpeg, or .png extension",
-			})
-		}
-
-		if profilePicture.Size > 10000000 {
-			validationErr = append(validationErr, helper.ValidationError{
-				Field:   "profilePicture",
-				Message: "This field must be a file with size less than 10 MB",
-			})
-		}
-	}
-
-	userInput := request.Update{}
+	userInput := request.TwoFactorCode{}
 	c.Bind(&userInput)
 
-	if strings.Contains(userInput.UserName, " ") {
-		validationErr = append(validationErr, helper.ValidationError{
-			Field:   "userName",
-			Message: "This field must not contain any space",
-		})
-	}
-
-	inputErr := userInput.Validate()
-	if inputErr != nil {
-		validationErr = append(validationErr, inputErr...)
-	}
-
-	if validationErr != nil {
+	if err := userInput.Validate(); err != nil {
 		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
 			Status:  http.StatusBadRequest,
 			Message: "validation failed",
-			Data:    validationErr,
+			Data:    err,
 		})
 	}
 
-	userDomain := userInput.ToDomain()
-	userDomain.Id = userID
-	user, err := userCtrl.userUseCase.Update(userDomain, profilePicture)
-
+	recoveryCodes, err := userCtrl.userUseCase.ConfirmTwoFactor(userID, userInput.Code)
 	if err != nil {
 		statusCode := http.StatusInternalServerError
-		if err == errors.New("failed to get user") {
+		if strings.Contains(err.Error(), "failed to get") {
 			statusCode = http.StatusNotFound
-		} else if strings.Contains(err.Error(), "already") {
+		} else if strings.Contains(err.Error(), "already enabled") {
 			statusCode = http.StatusConflict
+		} else if strings.Contains(err.Error(), "not enrolled") || strings.Contains(err.Error(), "invalid two-factor code") {
+			statusCode = http.StatusBadRequest
 		}
 
 		return c.JSON(statusCode, helper.BaseResponse{
@@ -478,14 +1571,14 @@ func (userCtrl *UserController) UserUpdate(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, helper.BaseResponse{
 		Status:  http.StatusOK,
-		Message: "success to update user",
+		Message: "success to enable two-factor authentication",
 		Data: map[string]interface{}{
-			"user": response.FromDomain(user),
+			"recoveryCodes": recoveryCodes,
 		},
 	})
 }
 
-func (userCtrl *UserController) UpdatePassword(c echo.Context) error {
+func (userCtrl *UserController) DisableTwoFactor(c echo.Context) error {
 	userID, err := util.GetUIDFromToken(c)
 	if err != nil {
 		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
@@ -495,7 +1588,7 @@ func (userCtrl *UserController) UpdatePassword(c echo.Context) error {
 		})
 	}
 
-	userInput := request.ChangePassword{}
+	userInput := request.TwoFactorCode{}
 	c.Bind(&userInput)
 
 	if err := userInput.Validate(); err != nil {
@@ -506,18 +1599,17 @@ func (userCtrl *UserController) UpdatePassword(c echo.Context) error {
 		})
 	}
 
-	userDomain := userInput.ToDomain()
-	userDomain.Id = userID
-	user, err := userCtrl.userUseCase.UpdatePassword(userDomain)
-
-	statusCode := http.StatusInternalServerError
-	if err == errors.New("failed to get user") {
-		statusCode = http.StatusNotFound
-	} else if err == errors.New("password is incorrect") {
-		statusCode = http.StatusForbidden
-	}
-
+	err = userCtrl.userUseCase.DisableTwoFactor(userID, userInput.Code)
 	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if strings.Contains(err.Error(), "failed to get") {
+			statusCode = http.StatusNotFound
+		} else if strings.Contains(err.Error(), "not enabled") {
+			statusCode = http.StatusConflict
+		} else if strings.Contains(err.Error(), "invalid two-factor code") {
+			statusCode = http.StatusBadRequest
+		}
+
 		return c.JSON(statusCode, helper.BaseResponse{
 			Status:  statusCode,
 			Message: err.Error(),
@@ -527,29 +1619,38 @@ func (userCtrl *UserController) UpdatePassword(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, helper.BaseResponse{
 		Status:  http.StatusOK,
-		Message: "success to change password",
-		Data: map[string]interface{}{
-			"user": response.FromDomain(user),
-		},
+		Message: "success to disable two-factor authentication",
+		Data:    nil,
 	})
 }
 
-func (userCtrl *UserController) Suspend(c echo.Context) error {
-	userID, err := primitive.ObjectIDFromHex(c.Param("user-id"))
+func (userCtrl *UserController) RevokeSession(c echo.Context) error {
+	userID, err := util.GetUIDFromToken(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
+			Status:  http.StatusUnauthorized,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	sessionID, err := primitive.ObjectIDFromHex(c.Param("session-id"))
 	if err != nil {
 		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
 			Status:  http.StatusBadRequest,
-			Message: "invalid user id",
+			Message: "invalid session id",
 			Data:    nil,
 		})
 	}
 
-	user, err := userCtrl.userUseCase.Suspend(userID)
+	err = userCtrl.userUseCase.RevokeSession(userID, sessionID)
 	if err != nil {
 		statusCode := http.StatusInternalServerError
-		if err == errors.New("failed to get user") {
+		if strings.Contains(err.Error(), "failed to get") {
 			statusCode = http.StatusNotFound
-		} else if err == errors.New("user is already suspended") {
+		} else if strings.Contains(err.Error(), "not the owner") {
+			statusCode = http.StatusForbidden
+		} else if strings.Contains(err.Error(), "has been revoked") {
 			statusCode = http.StatusConflict
 		}
 
@@ -560,16 +1661,24 @@ func (userCtrl *UserController) Suspend(c echo.Context) error {
 		})
 	}
 
-	err = userCtrl.threadUseCase.SuspendByUserID(userID)
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to revoke session",
+		Data:    nil,
+	})
+}
+
+func (userCtrl *UserController) RevokeAllSessions(c echo.Context) error {
+	userID, err := util.GetUIDFromToken(c)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
-			Status:  http.StatusInternalServerError,
+		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
+			Status:  http.StatusUnauthorized,
 			Message: err.Error(),
 			Data:    nil,
 		})
 	}
 
-	err = userCtrl.commentUseCase.DeleteAllByUserID(userID)
+	err = userCtrl.userUseCase.RevokeAllSessions(userID)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
 			Status:  http.StatusInternalServerError,
@@ -578,25 +1687,42 @@ func (userCtrl *UserController) Suspend(c echo.Context) error {
 		})
 	}
 
-	err = userCtrl.followThreadUseCase.DeleteAllByUserID(userID)
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to revoke all sessions",
+		Data:    nil,
+	})
+}
+
+/*
+Delete
+*/
+
+func (userCtrl *UserController) Delete(c echo.Context) error {
+	userID, err := primitive.ObjectIDFromHex(c.Param("user-id"))
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
-			Status:  http.StatusInternalServerError,
-			Message: err.Error(),
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "invalid user id",
 			Data:    nil,
 		})
 	}
 
-	err = userCtrl.threadUseCase.RemoveUserFromAllLikes(userID)
+	deletedUser, err := userCtrl.deleteAccount(userID)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
-			Status:  http.StatusInternalServerError,
+		statusCode := http.StatusInternalServerError
+		if strings.Contains(err.Error(), "failed to get user") {
+			statusCode = http.StatusNotFound
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
 			Message: err.Error(),
 			Data:    nil,
 		})
 	}
 
-	err = userCtrl.bookmarksUseCase.DeleteAllByUserID(userID)
+	err = userCtrl.audit(c, auditLogs.ActionUserDelete, userID, response.FromDomain(deletedUser), nil)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
 			Status:  http.StatusInternalServerError,
@@ -607,116 +1733,130 @@ func (userCtrl *UserController) Suspend(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, helper.BaseResponse{
 		Status:  http.StatusOK,
-		Message: "success to suspend user",
+		Message: "success to delete user",
 		Data: map[string]interface{}{
-			"user": response.FromDomain(user),
+			"user": response.FromDomain(deletedUser),
 		},
 	})
 }
 
-func (userCtrl *UserController) Unsuspend(c echo.Context) error {
-	userID, err := primitive.ObjectIDFromHex(c.Param("user-id"))
+// PurgePendingDeletions deletes every account whose deletion grace period is over, it is run by a background job
+func (userCtrl *UserController) PurgePendingDeletions() error {
+	dueUsers, err := userCtrl.userUseCase.GetAllDueForDeletion()
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
-			Status:  http.StatusBadRequest,
-			Message: "invalid user id",
-			Data:    nil,
-		})
+		return err
 	}
 
-	user, err := userCtrl.userUseCase.Unsuspend(userID)
-	if err != nil {
-		statusCode := http.StatusInternalServerError
-		if strings.Contains(err.Error(), "failed to get") {
-			statusCode = http.StatusNotFound
-		} else if strings.Contains(err.Error(), "user is not suspended") {
-			statusCode = http.StatusConflict
+	var purgeErr error
+	for _, user := range dueUsers {
+		_, err = userCtrl.deleteAccount(user.Id)
+		if err != nil {
+			purgeErr = err
 		}
+	}
 
-		return c.JSON(statusCode, helper.BaseResponse{
-			Status:  statusCode,
-			Message: err.Error(),
-			Data:    nil,
-		})
+	return purgeErr
+}
+
+// audit records an administrative action on a user together with the acting admin and their IP
+func (userCtrl *UserController) audit(c echo.Context, action string, userID primitive.ObjectID, before interface{}, after interface{}) error {
+	actorID, err := util.GetUIDFromToken(c)
+	if err != nil {
+		return err
 	}
 
-	return c.JSON(http.StatusOK, helper.BaseResponse{
-		Status:  http.StatusOK,
-		Message: "success to unsuspend user",
-		Data: map[string]interface{}{
-			"user": response.FromDomain(user),
-		},
+	_, err = userCtrl.auditLogUseCase.Create(&auditLogs.Domain{
+		ActorID:    actorID,
+		Action:     action,
+		TargetType: auditLogs.TargetUser,
+		TargetID:   userID,
+		Before:     before,
+		After:      after,
+		IP:         c.RealIP(),
 	})
+	return err
 }
 
-/*
-Delete
-*/
+// deleteAccount removes everything the user created before the user itself,
+// so a failed step leaves the account in place for PurgePendingDeletions to retry
+func (userCtrl *UserController) deleteAccount(userID primitive.ObjectID) (users.Domain, error) {
+	_, err := userCtrl.userUseCase.CheckDeletable(userID)
+	if err != nil {
+		return users.Domain{}, err
+	}
 
-func (userCtrl *UserController) Delete(c echo.Context) error {
-	userID, err := primitive.ObjectIDFromHex(c.Param("user-id"))
+	err = userCtrl.commentUseCase.DeleteAllByUserID(userID)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
-			Status:  http.StatusBadRequest,
-			Message: "invalid user id",
-			Data:    nil,
-		})
+		return users.Domain{}, err
 	}
 
-	deletedUser, err := userCtrl.userUseCase.Delete(userID)
+	err = userCtrl.followThreadUseCase.DeleteAllByUserID(userID)
 	if err != nil {
-		statusCode := http.StatusInternalServerError
-		if err == errors.New("failed to get user") {
-			statusCode = http.StatusNotFound
-		}
+		return users.Domain{}, err
+	}
 
-		return c.JSON(statusCode, helper.BaseResponse{
-			Status:  statusCode,
-			Message: err.Error(),
-			Data:    nil,
-		})
+	err = userCtrl.followUserUseCase.DeleteAllByUserID(userID)
+	if err != nil {
+		return users.Domain{}, err
 	}
 
-	err = userCtrl.commentUseCase.DeleteAllByUserID(userID)
+	err = userCtrl.userBlockUseCase.DeleteAllByUserID(userID)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
-			Status:  http.StatusInternalServerError,
-			Message: err.Error(),
-			Data:    nil,
-		})
+		return users.Domain{}, err
 	}
 
-	err = userCtrl.followThreadUseCase.DeleteAllByUserID(userID)
+	err = userCtrl.warningUseCase.DeleteAllByUserID(userID)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
-			Status:  http.StatusInternalServerError,
-			Message: err.Error(),
-			Data:    nil,
-		})
+		return users.Domain{}, err
 	}
 
 	err = userCtrl.threadUseCase.DeleteAllByUserID(userID)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
-			Status:  http.StatusInternalServerError,
-			Message: err.Error(),
-			Data:    nil,
-		})
+		return users.Domain{}, err
 	}
 
 	err = userCtrl.threadUseCase.RemoveUserFromAllLikes(userID)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
-			Status:  http.StatusInternalServerError,
-			Message: err.Error(),
+		return users.Domain{}, err
+	}
+
+	err = userCtrl.bookmarksUseCase.DeleteAllByUserID(userID)
+	if err != nil {
+		return users.Domain{}, err
+	}
+
+	err = userCtrl.dataExportUseCase.DeleteAllByUserID(userID)
+	if err != nil {
+		return users.Domain{}, err
+	}
+
+	deletedUser, err := userCtrl.userUseCase.Delete(userID)
+	if err != nil {
+		return users.Domain{}, err
+	}
+
+	return deletedUser, nil
+}
+
+func (userCtrl *UserController) ClearLockout(c echo.Context) error {
+	lockoutID, err := primitive.ObjectIDFromHex(c.Param("lockout-id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "invalid lockout id",
 			Data:    nil,
 		})
 	}
 
-	err = userCtrl.bookmarksUseCase.DeleteAllByUserID(userID)
+	err = userCtrl.userUseCase.ClearLockout(lockoutID)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
-			Status:  http.StatusInternalServerError,
+		statusCode := http.StatusInternalServerError
+		if strings.Contains(err.Error(), "failed to get") {
+			statusCode = http.StatusNotFound
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
 			Message: err.Error(),
 			Data:    nil,
 		})
@@ -724,9 +1864,7 @@ func (userCtrl *UserController) Delete(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, helper.BaseResponse{
 		Status:  http.StatusOK,
-		Message: "success to delete user",
-		Data: map[string]interface{}{
-			"user": response.FromDomain(deletedUser),
-		},
+		Message: "success to clear lockout",
+		Data:    nil,
 	})
 }
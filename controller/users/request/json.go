@@ -1,13 +1,16 @@
 package request
 
 import (
+	"charum/business/suspensions"
 	"charum/business/users"
 	"charum/helper"
 	"errors"
 	"strings"
+	"time"
 
 	"github.com/fatih/structs"
 	"github.com/go-playground/validator/v10"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 type Register struct {
@@ -195,3 +198,195 @@ func (req *ChangePassword) Validate() []helper.ValidationError {
 
 	return nil
 }
+
+type RefreshToken struct {
+	RefreshToken string `json:"refreshToken" validate:"required" bson:"refreshToken" form:"refreshToken"`
+}
+
+func (req *RefreshToken) Validate() []helper.ValidationError {
+	var ve validator.ValidationErrors
+
+	if err := validator.New().Struct(req); err != nil {
+		if errors.As(err, &ve) {
+			fields := structs.Fields(req)
+			out := make([]helper.ValidationError, len(ve))
+
+			for i, e := range ve {
+				out[i] = helper.ValidationError{
+					Field:   e.Field(),
+					Message: helper.MessageForTag(e.Tag()),
+				}
+
+				out[i].Message = strings.Replace(out[i].Message, "[PARAM]", e.Param(), 1)
+
+				// Get field tag
+				for _, f := range fields {
+					if f.Name() == e.Field() {
+						out[i].Field = f.Tag("json")
+						break
+					}
+				}
+			}
+			return out
+		}
+	}
+
+	return nil
+}
+
+type TwoFactorCode struct {
+	Code string `json:"code" validate:"required" bson:"code" form:"code"`
+}
+
+func (req *TwoFactorCode) Validate() []helper.ValidationError {
+	var ve validator.ValidationErrors
+
+	if err := validator.New().Struct(req); err != nil {
+		if errors.As(err, &ve) {
+			fields := structs.Fields(req)
+			out := make([]helper.ValidationError, len(ve))
+
+			for i, e := range ve {
+				out[i] = helper.ValidationError{
+					Field:   e.Field(),
+					Message: helper.MessageForTag(e.Tag()),
+				}
+
+				out[i].Message = strings.Replace(out[i].Message, "[PARAM]", e.Param(), 1)
+
+				// Get field tag
+				for _, f := range fields {
+					if f.Name() == e.Field() {
+						out[i].Field = f.Tag("json")
+						break
+					}
+				}
+			}
+			return out
+		}
+	}
+
+	return nil
+}
+
+type TwoFactorChallenge struct {
+	ChallengeToken string `json:"challengeToken" validate:"required" bson:"challengeToken" form:"challengeToken"`
+	Code           string `json:"code" validate:"required" bson:"code" form:"code"`
+}
+
+func (req *TwoFactorChallenge) Validate() []helper.ValidationError {
+	var ve validator.ValidationErrors
+
+	if err := validator.New().Struct(req); err != nil {
+		if errors.As(err, &ve) {
+			fields := structs.Fields(req)
+			out := make([]helper.ValidationError, len(ve))
+
+			for i, e := range ve {
+				out[i] = helper.ValidationError{
+					Field:   e.Field(),
+					Message: helper.MessageForTag(e.Tag()),
+				}
+
+				out[i].Message = strings.Replace(out[i].Message, "[PARAM]", e.Param(), 1)
+
+				// Get field tag
+				for _, f := range fields {
+					if f.Name() == e.Field() {
+						out[i].Field = f.Tag("json")
+						break
+					}
+				}
+			}
+			return out
+		}
+	}
+
+	return nil
+}
+
+type DeleteAccount struct {
+	Password string `json:"password" validate:"required" bson:"password" form:"password"`
+}
+
+func (req *DeleteAccount) Validate() []helper.ValidationError {
+	var ve validator.ValidationErrors
+
+	if err := validator.New().Struct(req); err != nil {
+		if errors.As(err, &ve) {
+			fields := structs.Fields(req)
+			out := make([]helper.ValidationError, len(ve))
+
+			for i, e := range ve {
+				out[i] = helper.ValidationError{
+					Field:   e.Field(),
+					Message: helper.MessageForTag(e.Tag()),
+				}
+
+				out[i].Message = strings.Replace(out[i].Message, "[PARAM]", e.Param(), 1)
+
+				// Get field tag
+				for _, f := range fields {
+					if f.Name() == e.Field() {
+						out[i].Field = f.Tag("json")
+						break
+					}
+				}
+			}
+			return out
+		}
+	}
+
+	return nil
+}
+
+type Suspend struct {
+	Reason string    `json:"reason" validate:"required" bson:"reason" form:"reason"`
+	Detail string    `json:"detail" validate:"max=255" bson:"detail" form:"detail"`
+	EndAt  time.Time `json:"endAt" bson:"endAt" form:"endAt"`
+}
+
+func (req *Suspend) ToDomain() *suspensions.Domain {
+	domain := &suspensions.Domain{
+		Reason: req.Reason,
+		Detail: req.Detail,
+	}
+
+	// without an end time the suspension lasts until an admin lifts it
+	if !req.EndAt.IsZero() {
+		domain.EndAt = primitive.NewDateTimeFromTime(req.EndAt)
+	}
+
+	return domain
+}
+
+func (req *Suspend) Validate() []helper.ValidationError {
+	var ve validator.ValidationErrors
+
+	if err := validator.New().Struct(req); err != nil {
+		if errors.As(err, &ve) {
+			fields := structs.Fields(req)
+			out := make([]helper.ValidationError, len(ve))
+
+			for i, e := range ve {
+				out[i] = helper.ValidationError{
+					Field:   e.Field(),
+					Message: helper.MessageForTag(e.Tag()),
+				}
+
+				out[i].Message = strings.Replace(out[i].Message, "[PARAM]", e.Param(), 1)
+
+				// Get field tag
+				for _, f := range fields {
+					if f.Name() == e.Field() {
+						out[i].Field = f.Tag("json")
+						break
+					}
+				}
+			}
+			return out
+		}
+	}
+
+	return nil
+}
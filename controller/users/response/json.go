@@ -1,41 +1,70 @@
 package response
 
 import (
+	"charum/business/login_attempts"
+	"charum/business/refresh_tokens"
+	"charum/business/suspensions"
 	"charum/business/users"
+	"charum/business/warnings"
+	dtoUser "charum/dto/users"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 type User struct {
-	Id                primitive.ObjectID `json:"_id" bson:"_id"`
-	Email             string             `json:"email" bson:"email"`
-	UserName          string             `json:"userName" bson:"userName"`
-	DisplayName       string             `json:"displayName" bson:"displayName"`
-	Biodata           string             `json:"biodata" bson:"biodata"`
-	SocialMedia       string             `json:"socialMedia" bson:"socialMedia"`
-	ProfilePictureURL string             `json:"profilePictureURL" bson:"profilePictureURL"`
-	IsActive          bool               `json:"isActive" bson:"isActive"`
-	Role              string             `json:"role" bson:"role"`
-	CreatedAt         primitive.DateTime `json:"createdAt" bson:"createdAt"`
-	UpdatedAt         primitive.DateTime `json:"updatedAt" bson:"updatedAt"`
+	Id                  primitive.ObjectID `json:"_id" bson:"_id"`
+	Email               string             `json:"email" bson:"email"`
+	UserName            string             `json:"userName" bson:"userName"`
+	DisplayName         string             `json:"displayName" bson:"displayName"`
+	Biodata             string             `json:"biodata" bson:"biodata"`
+	SocialMedia         string             `json:"socialMedia" bson:"socialMedia"`
+	ProfilePictureURL   string             `json:"profilePictureURL" bson:"profilePictureURL"`
+	IsActive            bool               `json:"isActive" bson:"isActive"`
+	EmailVerified       bool               `json:"emailVerified" bson:"emailVerified"`
+	TwoFactorEnabled    bool               `json:"twoFactorEnabled" bson:"twoFactorEnabled"`
+	Role                string             `json:"role" bson:"role"`
+	DeletionScheduledAt primitive.DateTime `json:"deletionScheduledAt,omitempty" bson:"deletionScheduledAt"`
+	CreatedAt           primitive.DateTime `json:"createdAt" bson:"createdAt"`
+	UpdatedAt           primitive.DateTime `json:"updatedAt" bson:"updatedAt"`
 }
 
 func FromDomain(domain users.Domain) User {
 	return User{
-		Id:                domain.Id,
-		Email:             domain.Email,
-		UserName:          domain.UserName,
-		DisplayName:       domain.DisplayName,
-		Biodata:           domain.Biodata,
-		SocialMedia:       domain.SocialMedia,
-		ProfilePictureURL: domain.ProfilePictureURL,
-		IsActive:          domain.IsActive,
-		Role:              domain.Role,
-		CreatedAt:         domain.CreatedAt,
-		UpdatedAt:         domain.UpdatedAt,
+		Id:                  domain.Id,
+		Email:               domain.Email,
+		UserName:            domain.UserName,
+		DisplayName:         domain.DisplayName,
+		Biodata:             domain.Biodata,
+		SocialMedia:         domain.SocialMedia,
+		ProfilePictureURL:   domain.ProfilePictureURL,
+		IsActive:            domain.IsActive,
+		EmailVerified:       domain.EmailVerified,
+		TwoFactorEnabled:    domain.TwoFactorEnabled,
+		Role:                domain.Role,
+		DeletionScheduledAt: domain.DeletionScheduledAt,
+		CreatedAt:           domain.CreatedAt,
+		UpdatedAt:           domain.UpdatedAt,
 	}
 }
 
+type Profile struct {
+	User
+	TotalFollowers int `json:"totalFollowers"`
+	TotalFollowing int `json:"totalFollowing"`
+}
+
+// PublicProfile is what anyone can see of a user, so it must never carry private fields such as the email
+type PublicProfile struct {
+	dtoUser.Public
+	SocialMedia    string             `json:"socialMedia"`
+	CreatedAt      primitive.DateTime `json:"createdAt"`
+	TotalThreads   int                `json:"totalThreads"`
+	TotalComments  int                `json:"totalComments"`
+	TotalLikes     int                `json:"totalLikes"`
+	TotalFollowers int                `json:"totalFollowers"`
+	TotalFollowing int                `json:"totalFollowing"`
+}
+
 func FromDomainArray(data []users.Domain) []User {
 	var array []User
 	for _, v := range data {
@@ -43,3 +72,123 @@ func FromDomainArray(data []users.Domain) []User {
 	}
 	return array
 }
+
+type Session struct {
+	Id         primitive.ObjectID `json:"_id" bson:"_id"`
+	UserAgent  string             `json:"userAgent" bson:"userAgent"`
+	IPAddress  string             `json:"ipAddress" bson:"ipAddress"`
+	IsCurrent  bool               `json:"isCurrent" bson:"isCurrent"`
+	LastSeenAt primitive.DateTime `json:"lastSeenAt" bson:"lastSeenAt"`
+	ExpiredAt  primitive.DateTime `json:"expiredAt" bson:"expiredAt"`
+	CreatedAt  primitive.DateTime `json:"createdAt" bson:"createdAt"`
+}
+
+func FromSessionDomain(domain refresh_tokens.Domain, currentAccessTokenID string) Session {
+	return Session{
+		Id:         domain.Id,
+		UserAgent:  domain.UserAgent,
+		IPAddress:  domain.IPAddress,
+		IsCurrent:  domain.AccessTokenID == currentAccessTokenID,
+		LastSeenAt: domain.LastSeenAt,
+		ExpiredAt:  domain.ExpiredAt,
+		CreatedAt:  domain.CreatedAt,
+	}
+}
+
+func FromSessionDomainArray(data []refresh_tokens.Domain, currentAccessTokenID string) []Session {
+	var array []Session
+	for _, v := range data {
+		array = append(array, FromSessionDomain(v, currentAccessTokenID))
+	}
+	return array
+}
+
+type Lockout struct {
+	Id           primitive.ObjectID `json:"_id" bson:"_id"`
+	Key          string             `json:"key" bson:"key"`
+	FailedCount  int                `json:"failedCount" bson:"failedCount"`
+	LastFailedAt primitive.DateTime `json:"lastFailedAt" bson:"lastFailedAt"`
+	LockedUntil  primitive.DateTime `json:"lockedUntil" bson:"lockedUntil"`
+}
+
+func FromLockoutDomain(domain login_attempts.Domain) Lockout {
+	return Lockout{
+		Id:           domain.Id,
+		Key:          domain.Key,
+		FailedCount:  domain.FailedCount,
+		LastFailedAt: domain.LastFailedAt,
+		LockedUntil:  domain.LockedUntil,
+	}
+}
+
+func FromLockoutDomainArray(data []login_attempts.Domain) []Lockout {
+	var array []Lockout
+	for _, v := range data {
+		array = append(array, FromLockoutDomain(v))
+	}
+	return array
+}
+
+type Suspension struct {
+	Id        primitive.ObjectID `json:"_id" bson:"_id"`
+	AdminID   primitive.ObjectID `json:"adminID" bson:"adminID"`
+	Reason    string             `json:"reason" bson:"reason"`
+	Detail    string             `json:"detail" bson:"detail"`
+	EndAt     primitive.DateTime `json:"endAt,omitempty" bson:"endAt,omitempty"`
+	LiftedAt  primitive.DateTime `json:"liftedAt,omitempty" bson:"liftedAt,omitempty"`
+	LiftedBy  primitive.ObjectID `json:"liftedBy,omitempty" bson:"liftedBy,omitempty"`
+	CreatedAt primitive.DateTime `json:"createdAt" bson:"createdAt"`
+}
+
+func FromSuspensionDomain(domain suspensions.Domain) Suspension {
+	return Suspension{
+		Id:        domain.Id,
+		AdminID:   domain.AdminID,
+		Reason:    domain.Reason,
+		Detail:    domain.Detail,
+		EndAt:     domain.EndAt,
+		LiftedAt:  domain.LiftedAt,
+		LiftedBy:  domain.LiftedBy,
+		CreatedAt: domain.CreatedAt,
+	}
+}
+
+func FromSuspensionDomainArray(data []suspensions.Domain) []Suspension {
+	var array []Suspension
+	for _, v := range data {
+		array = append(array, FromSuspensionDomain(v))
+	}
+	return array
+}
+
+type Warning struct {
+	Id         primitive.ObjectID `json:"_id" bson:"_id"`
+	Reason     string             `json:"reason" bson:"reason"`
+	Detail     string             `json:"detail" bson:"detail"`
+	TargetType string             `json:"targetType,omitempty" bson:"targetType,omitempty"`
+	TargetID   primitive.ObjectID `json:"targetID,omitempty" bson:"targetID,omitempty"`
+	IsActive   bool               `json:"isActive" bson:"isActive"`
+	ExpiredAt  primitive.DateTime `json:"expiredAt" bson:"expiredAt"`
+	CreatedAt  primitive.DateTime `json:"createdAt" bson:"createdAt"`
+}
+
+func FromWarningDomain(domain warnings.Domain) Warning {
+	return Warning{
+		Id:         domain.Id,
+		Reason:     domain.Reason,
+		Detail:     domain.Detail,
+		TargetType: domain.TargetType,
+		TargetID:   domain.TargetID,
+		IsActive:   domain.IsActive(),
+		ExpiredAt:  domain.ExpiredAt,
+		CreatedAt:  domain.CreatedAt,
+	}
+}
+
+func FromWarningDomainArray(data []warnings.Domain) []Warning {
+	var array []Warning
+	for _, v := range data {
+		array = append(array, FromWarningDomain(v))
+	}
+	return array
+}
@@ -0,0 +1,203 @@
+package warnings
+
+import (
+	"charum/business/threads"
+	"charum/business/warnings"
+	"charum/controller/warnings/request"
+	"charum/controller/warnings/response"
+	"charum/helper"
+	"charum/util"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type WarningController struct {
+	warningUseCase warnings.UseCase
+	threadUseCase  threads.UseCase
+}
+
+func NewWarningController(warningUC warnings.UseCase, threadUC threads.UseCase) *WarningController {
+	return &WarningController{
+		warningUseCase: warningUC,
+		threadUseCase:  threadUC,
+	}
+}
+
+/*
+Create
+*/
+
+func (wc *WarningController) Create(c echo.Context) error {
+	userID, err := primitive.ObjectIDFromHex(c.Param("user-id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "invalid user id",
+			Data:    nil,
+		})
+	}
+
+	issuerID, err := util.GetUIDFromToken(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, helper.BaseResponse{
+			Status:  http.StatusUnauthorized,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	userInput := request.Warning{}
+	c.Bind(&userInput)
+
+	if err := userInput.Validate(); err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "validation failed",
+			Data:    err,
+		})
+	}
+
+	warningInput := userInput.ToDomain()
+	warningInput.UserID = userID
+	warningInput.IssuerID = issuerID
+
+	if userInput.TargetID != "" {
+		warningInput.TargetID, err = primitive.ObjectIDFromHex(userInput.TargetID)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+				Status:  http.StatusBadRequest,
+				Message: "invalid target id",
+				Data:    nil,
+			})
+		}
+	}
+
+	warning, suspension, err := wc.warningUseCase.Create(warningInput)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if strings.Contains(err.Error(), "failed to get") {
+			statusCode = http.StatusNotFound
+		} else if strings.Contains(err.Error(), "invalid") || strings.Contains(err.Error(), "themselves") || strings.Contains(err.Error(), "must be") || strings.Contains(err.Error(), "is not created by the user") {
+			statusCode = http.StatusBadRequest
+		} else if strings.Contains(err.Error(), "cannot be warned") || strings.Contains(err.Error(), "not a moderator") {
+			statusCode = http.StatusForbidden
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	data := map[string]interface{}{
+		"warning": response.FromDomain(warning),
+	}
+
+	// the warning pushed the user over an escalation threshold, so the user's threads are suspended as well
+	if !suspension.Id.IsZero() {
+		err = wc.threadUseCase.SuspendByUserID(userID, suspension.Reason)
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+				Status:  http.StatusInternalServerError,
+				Message: err.Error(),
+				Data:    nil,
+			})
+		}
+
+		data["suspension"] = response.FromSuspensionDomain(suspension)
+	}
+
+	return c.JSON(http.StatusCreated, helper.BaseResponse{
+		Status:  http.StatusCreated,
+		Message: "success to warn user",
+		Data:    data,
+	})
+}
+
+/*
+Read
+*/
+
+func (wc *WarningController) GetAllByUserID(c echo.Context) error {
+	userID, err := primitive.ObjectIDFromHex(c.Param("user-id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "invalid user id",
+			Data:    nil,
+		})
+	}
+
+	result, err := wc.warningUseCase.GetAllByUserID(userID)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if strings.Contains(err.Error(), "failed to get user") {
+			statusCode = http.StatusNotFound
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	activeStrikes, err := wc.warningUseCase.CountActiveByUserID(userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, helper.BaseResponse{
+			Status:  http.StatusInternalServerError,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to get warnings",
+		Data: map[string]interface{}{
+			"warnings":      response.FromDomainArray(result),
+			"activeStrikes": activeStrikes,
+		},
+	})
+}
+
+/*
+Delete
+*/
+
+func (wc *WarningController) Delete(c echo.Context) error {
+	warningID, err := primitive.ObjectIDFromHex(c.Param("warning-id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, helper.BaseResponse{
+			Status:  http.StatusBadRequest,
+			Message: "invalid warning id",
+			Data:    nil,
+		})
+	}
+
+	result, err := wc.warningUseCase.Delete(warningID)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if strings.Contains(err.Error(), "failed to get") {
+			statusCode = http.StatusNotFound
+		}
+
+		return c.JSON(statusCode, helper.BaseResponse{
+			Status:  statusCode,
+			Message: err.Error(),
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, helper.BaseResponse{
+		Status:  http.StatusOK,
+		Message: "success to delete warning",
+		Data: map[string]interface{}{
+			"warning": response.FromDomain(result),
+		},
+	})
+}
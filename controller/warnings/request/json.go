@@ -0,0 +1,57 @@
+package request
+
+import (
+	"charum/business/warnings"
+	"charum/helper"
+	"errors"
+	"strings"
+
+	"github.com/fatih/structs"
+	"github.com/go-playground/validator/v10"
+)
+
+type Warning struct {
+	Reason     string `json:"reason" validate:"required" bson:"reason" form:"reason"`
+	Detail     string `json:"detail" validate:"max=255" bson:"detail" form:"detail"`
+	TargetType string `json:"targetType" bson:"targetType" form:"targetType"`
+	TargetID   string `json:"targetID" bson:"targetID" form:"targetID"`
+}
+
+func (req *Warning) ToDomain() *warnings.Domain {
+	return &warnings.Domain{
+		Reason:     req.Reason,
+		Detail:     req.Detail,
+		TargetType: req.TargetType,
+	}
+}
+
+func (req *Warning) Validate() []helper.ValidationError {
+	var ve validator.ValidationErrors
+
+	if err := validator.New().Struct(req); err != nil {
+		if errors.As(err, &ve) {
+			fields := structs.Fields(req)
+			out := make([]helper.ValidationError, len(ve))
+
+			for i, e := range ve {
+				out[i] = helper.ValidationError{
+					Field:   e.Field(),
+					Message: helper.MessageForTag(e.Tag()),
+				}
+
+				out[i].Message = strings.Replace(out[i].Message, "[PARAM]", e.Param(), 1)
+
+				// Get field tag
+				for _, f := range fields {
+					if f.Name() == e.Field() {
+						out[i].Field = f.Tag("json")
+						break
+					}
+				}
+			}
+			return out
+		}
+	}
+
+	return nil
+}
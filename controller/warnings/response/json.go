@@ -0,0 +1,66 @@
+package response
+
+import (
+	"charum/business/suspensions"
+	"charum/business/warnings"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type Warning struct {
+	Id         primitive.ObjectID `json:"_id" bson:"_id"`
+	UserID     primitive.ObjectID `json:"userID" bson:"userID"`
+	IssuerID   primitive.ObjectID `json:"issuerID" bson:"issuerID"`
+	Reason     string             `json:"reason" bson:"reason"`
+	Detail     string             `json:"detail" bson:"detail"`
+	TargetType string             `json:"targetType,omitempty" bson:"targetType,omitempty"`
+	TargetID   primitive.ObjectID `json:"targetID,omitempty" bson:"targetID,omitempty"`
+	IsActive   bool               `json:"isActive" bson:"isActive"`
+	ExpiredAt  primitive.DateTime `json:"expiredAt" bson:"expiredAt"`
+	CreatedAt  primitive.DateTime `json:"createdAt" bson:"createdAt"`
+}
+
+func FromDomain(domain warnings.Domain) Warning {
+	return Warning{
+		Id:         domain.Id,
+		UserID:     domain.UserID,
+		IssuerID:   domain.IssuerID,
+		Reason:     domain.Reason,
+		Detail:     domain.Detail,
+		TargetType: domain.TargetType,
+		TargetID:   domain.TargetID,
+		IsActive:   domain.IsActive(),
+		ExpiredAt:  domain.ExpiredAt,
+		CreatedAt:  domain.CreatedAt,
+	}
+}
+
+func FromDomainArray(data []warnings.Domain) []Warning {
+	var array []Warning
+	for _, v := range data {
+		array = append(array, FromDomain(v))
+	}
+	return array
+}
+
+type Suspension struct {
+	Id        primitive.ObjectID `json:"_id" bson:"_id"`
+	UserID    primitive.ObjectID `json:"userID" bson:"userID"`
+	AdminID   primitive.ObjectID `json:"adminID" bson:"adminID"`
+	Reason    string             `json:"reason" bson:"reason"`
+	Detail    string             `json:"detail" bson:"detail"`
+	EndAt     primitive.DateTime `json:"endAt,omitempty" bson:"endAt,omitempty"`
+	CreatedAt primitive.DateTime `json:"createdAt" bson:"createdAt"`
+}
+
+func FromSuspensionDomain(domain suspensions.Domain) Suspension {
+	return Suspension{
+		Id:        domain.Id,
+		UserID:    domain.UserID,
+		AdminID:   domain.AdminID,
+		Reason:    domain.Reason,
+		Detail:    domain.Detail,
+		EndAt:     domain.EndAt,
+		CreatedAt: domain.CreatedAt,
+	}
+}
@@ -1,23 +1,49 @@
 package driver
 
 import (
+	appealDomain "charum/business/appeals"
+	auditLogDomain "charum/business/audit_logs"
+	bannedTagDomain "charum/business/banned_tags"
 	bookmarkDomain "charum/business/bookmarks"
 	commentDomain "charum/business/comments"
+	dataExportDomain "charum/business/data_exports"
 	followThreadDomain "charum/business/follow_threads"
+	followUserDomain "charum/business/follow_users"
 	forgotPasswordDomain "charum/business/forgot_password"
+	loginAttemptDomain "charum/business/login_attempts"
+	oidcStateDomain "charum/business/oidc_states"
+	permissionDomain "charum/business/permissions"
+	refreshTokenDomain "charum/business/refresh_tokens"
 	reportDomain "charum/business/reports"
+	suspensionDomain "charum/business/suspensions"
+	threadRevisionDomain "charum/business/thread_revisions"
 	threadDomain "charum/business/threads"
 	topicDomain "charum/business/topics"
+	userBlockDomain "charum/business/user_blocks"
 	userDomain "charum/business/users"
+	warningDomain "charum/business/warnings"
 
+	appealDB "charum/driver/mongo/appeals"
+	auditLogDB "charum/driver/mongo/audit_logs"
+	bannedTagDB "charum/driver/mongo/banned_tags"
 	bookmarkDB "charum/driver/mongo/bookmarks"
 	commentDB "charum/driver/mongo/comments"
+	dataExportDB "charum/driver/mongo/data_exports"
 	followThreadDB "charum/driver/mongo/follow_threads"
+	followUserDB "charum/driver/mongo/follow_users"
 	forgotPasswordDB "charum/driver/mongo/forgot_password"
+	loginAttemptDB "charum/driver/mongo/login_attempts"
+	oidcStateDB "charum/driver/mongo/oidc_states"
+	permissionDB "charum/driver/mongo/permissions"
+	refreshTokenDB "charum/driver/mongo/refresh_tokens"
 	reportDB "charum/driver/mongo/reports"
+	suspensionDB "charum/driver/mongo/suspensions"
+	threadRevisionDB "charum/driver/mongo/thread_revisions"
 	threadDB "charum/driver/mongo/threads"
 	topicDB "charum/driver/mongo/topics"
+	userBlockDB "charum/driver/mongo/user_blocks"
 	userDB "charum/driver/mongo/users"
+	warningDB "charum/driver/mongo/warnings"
 
 	"go.mongodb.org/mongo-driver/mongo"
 )
@@ -53,3 +79,55 @@ func NewForgotPasswordRepository(db *mongo.Database) forgotPasswordDomain.Reposi
 func NewReportRepository(db *mongo.Database) reportDomain.Repository {
 	return reportDB.NewMongoRepository(db)
 }
+
+func NewRefreshTokenRepository(db *mongo.Database) refreshTokenDomain.Repository {
+	return refreshTokenDB.NewMongoRepository(db)
+}
+
+func NewOIDCStateRepository(db *mongo.Database) oidcStateDomain.Repository {
+	return oidcStateDB.NewMongoRepository(db)
+}
+
+func NewLoginAttemptRepository(db *mongo.Database) loginAttemptDomain.Repository {
+	return loginAttemptDB.NewMongoRepository(db)
+}
+
+func NewPermissionRepository(db *mongo.Database) permissionDomain.Repository {
+	return permissionDB.NewMongoRepository(db)
+}
+
+func NewDataExportRepository(db *mongo.Database) dataExportDomain.Repository {
+	return dataExportDB.NewMongoRepository(db)
+}
+
+func NewFollowUserRepository(db *mongo.Database) followUserDomain.Repository {
+	return followUserDB.NewMongoRepository(db)
+}
+
+func NewUserBlockRepository(db *mongo.Database) userBlockDomain.Repository {
+	return userBlockDB.NewMongoRepository(db)
+}
+
+func NewSuspensionRepository(db *mongo.Database) suspensionDomain.Repository {
+	return suspensionDB.NewMongoRepository(db)
+}
+
+func NewWarningRepository(db *mongo.Database) warningDomain.Repository {
+	return warningDB.NewMongoRepository(db)
+}
+
+func NewAppealRepository(db *mongo.Database) appealDomain.Repository {
+	return appealDB.NewMongoRepository(db)
+}
+
+func NewAuditLogRepository(db *mongo.Database) auditLogDomain.Repository {
+	return auditLogDB.NewMongoRepository(db)
+}
+
+func NewThreadRevisionRepository(db *mongo.Database) threadRevisionDomain.Repository {
+	return threadRevisionDB.NewMongoRepository(db)
+}
+
+func NewBannedTagRepository(db *mongo.Database) bannedTagDomain.Repository {
+	return bannedTagDB.NewMongoRepository(db)
+}
@@ -0,0 +1,143 @@
+package appeals
+
+import (
+	"charum/business/appeals"
+	dtoQuery "charum/dto/query"
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type appealRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoRepository(db *mongo.Database) appeals.Repository {
+	return &appealRepository{
+		collection: db.Collection("appeals"),
+	}
+}
+
+/*
+Create
+*/
+
+func (ar *appealRepository) Create(domain *appeals.Domain) (appeals.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	res, err := ar.collection.InsertOne(ctx, FromDomain(domain))
+	if err != nil {
+		return appeals.Domain{}, err
+	}
+
+	result, err := ar.GetByID(res.InsertedID.(primitive.ObjectID))
+	if err != nil {
+		return appeals.Domain{}, err
+	}
+
+	return result, nil
+}
+
+/*
+Read
+*/
+
+func (ar *appealRepository) GetByID(id primitive.ObjectID) (appeals.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	var result Model
+	err := ar.collection.FindOne(ctx, bson.M{
+		"_id": id,
+	}).Decode(&result)
+	if err != nil {
+		return appeals.Domain{}, err
+	}
+
+	return result.ToDomain(), nil
+}
+
+func (ar *appealRepository) GetBySuspensionID(suspensionID primitive.ObjectID) (appeals.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	var result Model
+	err := ar.collection.FindOne(ctx, bson.M{
+		"suspensionID": suspensionID,
+	}).Decode(&result)
+	if err != nil {
+		return appeals.Domain{}, err
+	}
+
+	return result.ToDomain(), nil
+}
+
+func (ar *appealRepository) GetManyWithPagination(query dtoQuery.Request, domain *appeals.Domain) ([]appeals.Domain, int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	skip64 := int64(query.Skip)
+	limit64 := int64(query.Limit)
+
+	var result []Model
+	filter := bson.M{}
+
+	if domain.Status != "" {
+		filter["status"] = domain.Status
+	}
+
+	if domain.UserID != primitive.NilObjectID {
+		filter["userID"] = domain.UserID
+	}
+
+	cursor, err := ar.collection.Find(ctx, filter, &options.FindOptions{
+		Skip:  &skip64,
+		Limit: &limit64,
+		Sort:  bson.M{query.Sort: query.Order},
+	})
+	if err != nil {
+		return []appeals.Domain{}, 0, err
+	}
+
+	// count total data in collection
+	totalData, err := ar.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return []appeals.Domain{}, 0, err
+	}
+
+	if err = cursor.All(ctx, &result); err != nil {
+		return []appeals.Domain{}, 0, err
+	}
+
+	return ToArrayDomain(result), int(totalData), nil
+}
+
+/*
+Update
+*/
+
+func (ar *appealRepository) Update(domain *appeals.Domain) (appeals.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	_, err := ar.collection.UpdateOne(ctx, bson.M{
+		"_id": domain.Id,
+	}, bson.M{
+		"$set": FromDomain(domain),
+	})
+	if err != nil {
+		return appeals.Domain{}, err
+	}
+
+	result, err := ar.GetByID(domain.Id)
+	if err != nil {
+		return appeals.Domain{}, err
+	}
+
+	return result, nil
+}
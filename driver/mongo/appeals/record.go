@@ -0,0 +1,58 @@
+package appeals
+
+import (
+	"charum/business/appeals"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type Model struct {
+	Id           primitive.ObjectID `json:"_id" bson:"_id"`
+	UserID       primitive.ObjectID `json:"userID" bson:"userID"`
+	SuspensionID primitive.ObjectID `json:"suspensionID" bson:"suspensionID"`
+	Message      string             `json:"message" bson:"message"`
+	Status       string             `json:"status" bson:"status"`
+	Reply        string             `json:"reply,omitempty" bson:"reply,omitempty"`
+	ReviewerID   primitive.ObjectID `json:"reviewerID,omitempty" bson:"reviewerID,omitempty"`
+	ReviewedAt   primitive.DateTime `json:"reviewedAt,omitempty" bson:"reviewedAt,omitempty"`
+	CreatedAt    primitive.DateTime `json:"createdAt" bson:"createdAt"`
+	UpdatedAt    primitive.DateTime `json:"updatedAt" bson:"updatedAt"`
+}
+
+func FromDomain(domain *appeals.Domain) *Model {
+	return &Model{
+		Id:           domain.Id,
+		UserID:       domain.UserID,
+		SuspensionID: domain.SuspensionID,
+		Message:      domain.Message,
+		Status:       domain.Status,
+		Reply:        domain.Reply,
+		ReviewerID:   domain.ReviewerID,
+		ReviewedAt:   domain.ReviewedAt,
+		CreatedAt:    domain.CreatedAt,
+		UpdatedAt:    domain.UpdatedAt,
+	}
+}
+
+func (appeal *Model) ToDomain() appeals.Domain {
+	return appeals.Domain{
+		Id:           appeal.Id,
+		UserID:       appeal.UserID,
+		SuspensionID: appeal.SuspensionID,
+		Message:      appeal.Message,
+		Status:       appeal.Status,
+		Reply:        appeal.Reply,
+		ReviewerID:   appeal.ReviewerID,
+		ReviewedAt:   appeal.ReviewedAt,
+		CreatedAt:    appeal.CreatedAt,
+		UpdatedAt:    appeal.UpdatedAt,
+	}
+}
+
+func ToArrayDomain(data []Model) []appeals.Domain {
+	var result []appeals.Domain
+	for _, v := range data {
+		result = append(result, v.ToDomain())
+	}
+	return result
+}
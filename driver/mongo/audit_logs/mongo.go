@@ -0,0 +1,99 @@
+package audit_logs
+
+import (
+	"charum/business/audit_logs"
+	dtoQuery "charum/dto/query"
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type auditLogRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoRepository(db *mongo.Database) audit_logs.Repository {
+	return &auditLogRepository{
+		collection: db.Collection("auditLogs"),
+	}
+}
+
+/*
+Create
+*/
+
+func (alr *auditLogRepository) Create(domain *audit_logs.Domain) (audit_logs.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	_, err := alr.collection.InsertOne(ctx, FromDomain(domain))
+	if err != nil {
+		return audit_logs.Domain{}, err
+	}
+
+	var result Model
+	err = alr.collection.FindOne(ctx, bson.M{
+		"_id": domain.Id,
+	}).Decode(&result)
+	if err != nil {
+		return audit_logs.Domain{}, err
+	}
+
+	return result.ToDomain(), nil
+}
+
+/*
+Read
+*/
+
+func (alr *auditLogRepository) GetManyWithPagination(query dtoQuery.Request, domain *audit_logs.Domain) ([]audit_logs.Domain, int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	skip64 := int64(query.Skip)
+	limit64 := int64(query.Limit)
+
+	var result []Model
+	filter := bson.M{}
+
+	if domain.ActorID != primitive.NilObjectID {
+		filter["actorID"] = domain.ActorID
+	}
+
+	if domain.Action != "" {
+		filter["action"] = domain.Action
+	}
+
+	if domain.TargetType != "" {
+		filter["targetType"] = domain.TargetType
+	}
+
+	if domain.TargetID != primitive.NilObjectID {
+		filter["targetID"] = domain.TargetID
+	}
+
+	cursor, err := alr.collection.Find(ctx, filter, &options.FindOptions{
+		Skip:  &skip64,
+		Limit: &limit64,
+		Sort:  bson.M{query.Sort: query.Order},
+	})
+	if err != nil {
+		return []audit_logs.Domain{}, 0, err
+	}
+
+	// count total data in collection
+	totalData, err := alr.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return []audit_logs.Domain{}, 0, err
+	}
+
+	if err = cursor.All(ctx, &result); err != nil {
+		return []audit_logs.Domain{}, 0, err
+	}
+
+	return ToArrayDomain(result), int(totalData), nil
+}
@@ -0,0 +1,83 @@
+package audit_logs
+
+import (
+	"charum/business/audit_logs"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type Model struct {
+	Id         primitive.ObjectID `json:"_id" bson:"_id"`
+	ActorID    primitive.ObjectID `json:"actorID" bson:"actorID"`
+	Action     string             `json:"action" bson:"action"`
+	TargetType string             `json:"targetType" bson:"targetType"`
+	TargetID   primitive.ObjectID `json:"targetID" bson:"targetID"`
+	Before     bson.M             `json:"before,omitempty" bson:"before,omitempty"`
+	After      bson.M             `json:"after,omitempty" bson:"after,omitempty"`
+	IP         string             `json:"ip" bson:"ip"`
+	CreatedAt  primitive.DateTime `json:"createdAt" bson:"createdAt"`
+}
+
+func FromDomain(domain *audit_logs.Domain) *Model {
+	return &Model{
+		Id:         domain.Id,
+		ActorID:    domain.ActorID,
+		Action:     domain.Action,
+		TargetType: domain.TargetType,
+		TargetID:   domain.TargetID,
+		Before:     toSnapshot(domain.Before),
+		After:      toSnapshot(domain.After),
+		IP:         domain.IP,
+		CreatedAt:  domain.CreatedAt,
+	}
+}
+
+func (auditLog *Model) ToDomain() audit_logs.Domain {
+	domain := audit_logs.Domain{
+		Id:         auditLog.Id,
+		ActorID:    auditLog.ActorID,
+		Action:     auditLog.Action,
+		TargetType: auditLog.TargetType,
+		TargetID:   auditLog.TargetID,
+		IP:         auditLog.IP,
+		CreatedAt:  auditLog.CreatedAt,
+	}
+
+	// keep a missing snapshot nil instead of an empty map so it is omitted from the response
+	if auditLog.Before != nil {
+		domain.Before = auditLog.Before
+	}
+	if auditLog.After != nil {
+		domain.After = auditLog.After
+	}
+
+	return domain
+}
+
+func ToArrayDomain(data []Model) []audit_logs.Domain {
+	var result []audit_logs.Domain
+	for _, v := range data {
+		result = append(result, v.ToDomain())
+	}
+	return result
+}
+
+// toSnapshot stores a snapshot as a plain document so it can be read back without knowing its type
+func toSnapshot(snapshot interface{}) bson.M {
+	if snapshot == nil {
+		return nil
+	}
+
+	raw, err := bson.Marshal(snapshot)
+	if err != nil {
+		return nil
+	}
+
+	var result bson.M
+	if err := bson.Unmarshal(raw, &result); err != nil {
+		return nil
+	}
+
+	return result
+}
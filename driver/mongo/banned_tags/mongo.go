@@ -0,0 +1,137 @@
+package banned_tags
+
+import (
+	"charum/business/banned_tags"
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type bannedTagRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoRepository(db *mongo.Database) banned_tags.Repository {
+	return &bannedTagRepository{
+		collection: db.Collection("bannedTags"),
+	}
+}
+
+/*
+Create
+*/
+
+func (btr *bannedTagRepository) Create(domain *banned_tags.Domain) (banned_tags.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	res, err := btr.collection.InsertOne(ctx, FromDomain(domain))
+	if err != nil {
+		return banned_tags.Domain{}, err
+	}
+
+	result, err := btr.GetByID(res.InsertedID.(primitive.ObjectID))
+	if err != nil {
+		return banned_tags.Domain{}, err
+	}
+
+	return result, nil
+}
+
+/*
+Read
+*/
+
+func (btr *bannedTagRepository) GetByID(id primitive.ObjectID) (banned_tags.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	var result Model
+	err := btr.collection.FindOne(ctx, bson.M{
+		"_id": id,
+	}).Decode(&result)
+	if err != nil {
+		return banned_tags.Domain{}, err
+	}
+
+	return result.ToDomain(), nil
+}
+
+func (btr *bannedTagRepository) GetByName(name string) (banned_tags.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	var result Model
+	err := btr.collection.FindOne(ctx, bson.M{
+		"name": name,
+	}).Decode(&result)
+	if err != nil {
+		return banned_tags.Domain{}, err
+	}
+
+	return result.ToDomain(), nil
+}
+
+func (btr *bannedTagRepository) GetByNames(names []string) ([]banned_tags.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	var result []Model
+	cursor, err := btr.collection.Find(ctx, bson.M{
+		"name": bson.M{
+			"$in": names,
+		},
+	})
+	if err != nil {
+		return []banned_tags.Domain{}, err
+	}
+
+	if err = cursor.All(ctx, &result); err != nil {
+		return []banned_tags.Domain{}, err
+	}
+
+	return ToArrayDomain(result), nil
+}
+
+func (btr *bannedTagRepository) GetAll() ([]banned_tags.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	var result []Model
+	cursor, err := btr.collection.Find(ctx, bson.M{}, &options.FindOptions{
+		Sort: bson.M{
+			"name": 1,
+		},
+	})
+	if err != nil {
+		return []banned_tags.Domain{}, err
+	}
+
+	if err = cursor.All(ctx, &result); err != nil {
+		return []banned_tags.Domain{}, err
+	}
+
+	return ToArrayDomain(result), nil
+}
+
+/*
+Delete
+*/
+
+func (btr *bannedTagRepository) Delete(id primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	_, err := btr.collection.DeleteOne(ctx, bson.M{
+		"_id": id,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package banned_tags
+
+import (
+	"charum/business/banned_tags"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type Model struct {
+	Id        primitive.ObjectID `json:"_id" bson:"_id"`
+	Name      string             `json:"name" bson:"name"`
+	BannedBy  primitive.ObjectID `json:"bannedBy" bson:"bannedBy"`
+	CreatedAt primitive.DateTime `json:"createdAt" bson:"createdAt"`
+}
+
+func FromDomain(domain *banned_tags.Domain) *Model {
+	return &Model{
+		Id:        domain.Id,
+		Name:      domain.Name,
+		BannedBy:  domain.BannedBy,
+		CreatedAt: domain.CreatedAt,
+	}
+}
+
+func (tag *Model) ToDomain() banned_tags.Domain {
+	return banned_tags.Domain{
+		Id:        tag.Id,
+		Name:      tag.Name,
+		BannedBy:  tag.BannedBy,
+		CreatedAt: tag.CreatedAt,
+	}
+}
+
+func ToArrayDomain(data []Model) []banned_tags.Domain {
+	var result []banned_tags.Domain
+	for _, v := range data {
+		result = append(result, v.ToDomain())
+	}
+	return result
+}
@@ -51,8 +51,9 @@ func (br *bookmarkRepository) GetByUserIDAndThreadID(UserID primitive.ObjectID,
 
 	var result Model
 	err := br.collection.FindOne(ctx, bson.M{
-		"userID":   UserID,
-		"threadID": ThreadID,
+		"userID":    UserID,
+		"threadID":  ThreadID,
+		"deletedAt": bson.M{"$exists": false},
 	}).Decode(&result)
 	if err != nil {
 		return bookmarks.Domain{}, err
@@ -66,7 +67,8 @@ func (br *bookmarkRepository) GetAllByUserID(UserID primitive.ObjectID) ([]bookm
 	defer cancel()
 
 	cursor, err := br.collection.Find(ctx, bson.M{
-		"userID": UserID,
+		"userID":    UserID,
+		"deletedAt": bson.M{"$exists": false},
 	})
 	if err != nil {
 		return []bookmarks.Domain{}, err
@@ -87,7 +89,8 @@ func (br *bookmarkRepository) CountByThreadID(threadID primitive.ObjectID) (int,
 	defer cancel()
 
 	count, err := br.collection.CountDocuments(ctx, bson.M{
-		"threadID": threadID,
+		"threadID":  threadID,
+		"deletedAt": bson.M{"$exists": false},
 	})
 	if err != nil {
 		return 0, err
@@ -96,6 +99,47 @@ func (br *bookmarkRepository) CountByThreadID(threadID primitive.ObjectID) (int,
 	return int(count), nil
 }
 
+/*
+Update
+*/
+
+func (br *bookmarkRepository) SoftDeleteAllByThreadID(threadID primitive.ObjectID, deletedAt primitive.DateTime) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	_, err := br.collection.UpdateMany(ctx, bson.M{
+		"threadID":  threadID,
+		"deletedAt": bson.M{"$exists": false},
+	}, bson.M{
+		"$set": bson.M{
+			"deletedAt": deletedAt,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (br *bookmarkRepository) RestoreAllByThreadID(threadID primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	_, err := br.collection.UpdateMany(ctx, bson.M{
+		"threadID": threadID,
+	}, bson.M{
+		"$unset": bson.M{
+			"deletedAt": "",
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 /*
 Delete
 */
@@ -2,6 +2,7 @@ package comments
 
 import (
 	"charum/business/comments"
+	dtoQuery "charum/dto/query"
 	"context"
 	"time"
 
@@ -52,7 +53,8 @@ func (cr *commentRepository) GetByID(id primitive.ObjectID) (comments.Domain, er
 
 	var result Model
 	err := cr.collection.FindOne(ctx, bson.M{
-		"_id": id,
+		"_id":       id,
+		"deletedAt": bson.M{"$exists": false},
 	}).Decode(&result)
 	if err != nil {
 		return comments.Domain{}, err
@@ -67,7 +69,8 @@ func (cr *commentRepository) GetByThreadID(threadID primitive.ObjectID) ([]comme
 
 	var result []Model
 	cursor, err := cr.collection.Find(ctx, bson.M{
-		"threadID": threadID,
+		"threadID":  threadID,
+		"deletedAt": bson.M{"$exists": false},
 	}, &options.FindOptions{
 		Sort: bson.M{
 			"createdAt": -1,
@@ -89,7 +92,8 @@ func (cr *commentRepository) CountByThreadID(threadID primitive.ObjectID) (int,
 	defer cancel()
 
 	count, err := cr.collection.CountDocuments(ctx, bson.M{
-		"threadID": threadID,
+		"threadID":  threadID,
+		"deletedAt": bson.M{"$exists": false},
 	})
 	if err != nil {
 		return 0, err
@@ -104,8 +108,9 @@ func (cr *commentRepository) GetByIDAndThreadID(id primitive.ObjectID, threadID
 
 	var result Model
 	err := cr.collection.FindOne(ctx, bson.M{
-		"_id":      id,
-		"threadID": threadID,
+		"_id":       id,
+		"threadID":  threadID,
+		"deletedAt": bson.M{"$exists": false},
 	}).Decode(&result)
 	if err != nil {
 		return comments.Domain{}, err
@@ -114,6 +119,7 @@ func (cr *commentRepository) GetByIDAndThreadID(id primitive.ObjectID, threadID
 	return result.ToDomain(), nil
 }
 
+// GetAllByUserID also returns the trashed comments since it is only used to clean up before a hard delete
 func (cr *commentRepository) GetAllByUserID(userID primitive.ObjectID) ([]comments.Domain, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
 	defer cancel()
@@ -133,6 +139,75 @@ func (cr *commentRepository) GetAllByUserID(userID primitive.ObjectID) ([]commen
 	return ToDomainArray(result), nil
 }
 
+func (cr *commentRepository) GetManyByUserID(query dtoQuery.Request, userID primitive.ObjectID) ([]comments.Domain, int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	skip64 := int64(query.Skip)
+	limit64 := int64(query.Limit)
+
+	var result []Model
+	filter := bson.M{
+		"userID":    userID,
+		"deletedAt": bson.M{"$exists": false},
+	}
+
+	cursor, err := cr.collection.Find(ctx, filter, &options.FindOptions{
+		Skip:  &skip64,
+		Limit: &limit64,
+		Sort:  bson.M{query.Sort: query.Order},
+	})
+	if err != nil {
+		return []comments.Domain{}, 0, err
+	}
+
+	totalData, err := cr.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return []comments.Domain{}, 0, err
+	}
+
+	if err = cursor.All(ctx, &result); err != nil {
+		return []comments.Domain{}, 0, err
+	}
+
+	return ToDomainArray(result), int(totalData), nil
+}
+
+func (cr *commentRepository) CountByUserID(userID primitive.ObjectID) (int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	count, err := cr.collection.CountDocuments(ctx, bson.M{
+		"userID":    userID,
+		"deletedAt": bson.M{"$exists": false},
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return int(count), nil
+}
+
+func (cr *commentRepository) GetTrashedByThreadID(threadID primitive.ObjectID) ([]comments.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	var result []Model
+	cursor, err := cr.collection.Find(ctx, bson.M{
+		"threadID":  threadID,
+		"deletedAt": bson.M{"$exists": true},
+	})
+	if err != nil {
+		return []comments.Domain{}, err
+	}
+
+	if err = cursor.All(ctx, &result); err != nil {
+		return []comments.Domain{}, err
+	}
+
+	return ToDomainArray(result), nil
+}
+
 /*
 Update
 */
@@ -158,6 +233,63 @@ func (cr *commentRepository) Update(domain *comments.Domain) (comments.Domain, e
 	return result, nil
 }
 
+func (cr *commentRepository) UpdateSuspendStatus(domain *comments.Domain) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	_, err := cr.collection.UpdateOne(ctx, bson.M{
+		"_id": domain.Id,
+	}, bson.M{
+		"$set": bson.M{
+			"suspendStatus": domain.SuspendStatus,
+			"suspendDetail": domain.SuspendDetail,
+			"updatedAt":     domain.UpdatedAt,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (cr *commentRepository) SoftDeleteAllByThreadID(threadID primitive.ObjectID, deletedAt primitive.DateTime) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	_, err := cr.collection.UpdateMany(ctx, bson.M{
+		"threadID":  threadID,
+		"deletedAt": bson.M{"$exists": false},
+	}, bson.M{
+		"$set": bson.M{
+			"deletedAt": deletedAt,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (cr *commentRepository) RestoreAllByThreadID(threadID primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	_, err := cr.collection.UpdateMany(ctx, bson.M{
+		"threadID": threadID,
+	}, bson.M{
+		"$unset": bson.M{
+			"deletedAt": "",
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 /*
 Delete
 */
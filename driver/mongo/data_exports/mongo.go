@@ -0,0 +1,295 @@
+package data_exports
+
+import (
+	"bytes"
+	"charum/business/data_exports"
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/gridfs"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type dataExportRepository struct {
+	database   *mongo.Database
+	collection *mongo.Collection
+}
+
+func NewMongoRepository(db *mongo.Database) data_exports.Repository {
+	return &dataExportRepository{
+		database:   db,
+		collection: db.Collection("dataExports"),
+	}
+}
+
+/*
+Create
+*/
+
+func (der *dataExportRepository) Create(domain *data_exports.Domain) (data_exports.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	res, err := der.collection.InsertOne(ctx, FromDomain(domain))
+	if err != nil {
+		return data_exports.Domain{}, err
+	}
+
+	result, err := der.GetByID(res.InsertedID.(primitive.ObjectID))
+	if err != nil {
+		return data_exports.Domain{}, err
+	}
+
+	return result, nil
+}
+
+/*
+Read
+*/
+
+func (der *dataExportRepository) GetByID(id primitive.ObjectID) (data_exports.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	var result Model
+	err := der.collection.FindOne(ctx, bson.M{
+		"_id": id,
+	}).Decode(&result)
+	if err != nil {
+		return data_exports.Domain{}, err
+	}
+
+	return result.ToDomain(), nil
+}
+
+func (der *dataExportRepository) GetByToken(token string) (data_exports.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	var result Model
+	err := der.collection.FindOne(ctx, bson.M{
+		"token": token,
+	}).Decode(&result)
+	if err != nil {
+		return data_exports.Domain{}, err
+	}
+
+	// the archive is only read back for the download, the other reads just need the status
+	domain := result.ToDomain()
+	if !result.ArchiveID.IsZero() {
+		domain.Archive, err = der.downloadArchive(result.ArchiveID)
+		if err != nil {
+			return data_exports.Domain{}, err
+		}
+	}
+
+	return domain, nil
+}
+
+func (der *dataExportRepository) GetLatestByUserID(userID primitive.ObjectID) (data_exports.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	var result Model
+	err := der.collection.FindOne(ctx, bson.M{
+		"userID": userID,
+	}, options.FindOne().SetSort(bson.M{"createdAt": -1})).Decode(&result)
+	if err != nil {
+		return data_exports.Domain{}, err
+	}
+
+	return result.ToDomain(), nil
+}
+
+func (der *dataExportRepository) GetAllByStatus(status string) ([]data_exports.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	cursor, err := der.collection.Find(ctx, bson.M{
+		"status": status,
+	})
+	if err != nil {
+		return []data_exports.Domain{}, err
+	}
+
+	var result []Model
+	if err = cursor.All(ctx, &result); err != nil {
+		return []data_exports.Domain{}, err
+	}
+
+	return ToDomainArray(result), nil
+}
+
+/*
+Update
+*/
+
+// Update uploads a newly built archive and drops the stored one once it is replaced or the export is no longer ready
+func (der *dataExportRepository) Update(domain *data_exports.Domain) (data_exports.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	var current Model
+	err := der.collection.FindOne(ctx, bson.M{
+		"_id": domain.Id,
+	}).Decode(&current)
+	if err != nil {
+		return data_exports.Domain{}, err
+	}
+
+	record := FromDomain(domain)
+	record.ArchiveID = current.ArchiveID
+	if domain.Archive != nil {
+		record.ArchiveID, err = der.uploadArchive(domain.Id, domain.Archive)
+		if err != nil {
+			return data_exports.Domain{}, err
+		}
+	} else if domain.Status != data_exports.StatusReady {
+		record.ArchiveID = primitive.NilObjectID
+	}
+
+	_, err = der.collection.UpdateOne(ctx, bson.M{
+		"_id": domain.Id,
+	}, bson.M{
+		"$set": record,
+	})
+	if err != nil {
+		return data_exports.Domain{}, err
+	}
+
+	if !current.ArchiveID.IsZero() && current.ArchiveID != record.ArchiveID {
+		err = der.deleteArchive(current.ArchiveID)
+		if err != nil {
+			return data_exports.Domain{}, err
+		}
+	}
+
+	result, err := der.GetByID(domain.Id)
+	if err != nil {
+		return data_exports.Domain{}, err
+	}
+
+	return result, nil
+}
+
+/*
+Delete
+*/
+
+func (der *dataExportRepository) DeleteExpired(now primitive.DateTime) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	filter := bson.M{
+		"status": data_exports.StatusReady,
+		"expiredAt": bson.M{
+			"$lte": now,
+		},
+	}
+
+	cursor, err := der.collection.Find(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	var expired []Model
+	if err = cursor.All(ctx, &expired); err != nil {
+		return err
+	}
+
+	for _, export := range expired {
+		if !export.ArchiveID.IsZero() {
+			err = der.deleteArchive(export.ArchiveID)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err = der.collection.DeleteMany(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (der *dataExportRepository) DeleteAllByUserID(userID primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	filter := bson.M{
+		"userID": userID,
+	}
+
+	cursor, err := der.collection.Find(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	var exports []Model
+	if err = cursor.All(ctx, &exports); err != nil {
+		return err
+	}
+
+	for _, export := range exports {
+		if !export.ArchiveID.IsZero() {
+			err = der.deleteArchive(export.ArchiveID)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err = der.collection.DeleteMany(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (der *dataExportRepository) bucket() (*gridfs.Bucket, error) {
+	return gridfs.NewBucket(der.database, options.GridFSBucket().SetName("dataExportArchives"))
+}
+
+func (der *dataExportRepository) uploadArchive(exportID primitive.ObjectID, archive []byte) (primitive.ObjectID, error) {
+	bucket, err := der.bucket()
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+
+	return bucket.UploadFromStream(exportID.Hex()+".zip", bytes.NewReader(archive))
+}
+
+func (der *dataExportRepository) downloadArchive(archiveID primitive.ObjectID) ([]byte, error) {
+	bucket, err := der.bucket()
+	if err != nil {
+		return nil, err
+	}
+
+	buffer := new(bytes.Buffer)
+	_, err = bucket.DownloadToStream(archiveID, buffer)
+	if err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func (der *dataExportRepository) deleteArchive(archiveID primitive.ObjectID) error {
+	bucket, err := der.bucket()
+	if err != nil {
+		return err
+	}
+
+	err = bucket.Delete(archiveID)
+	if err != nil && err != gridfs.ErrFileNotFound {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package data_exports
+
+import (
+	"charum/business/data_exports"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Model only points to the archive, the zip itself lives in GridFS since it can outgrow the document size limit
+type Model struct {
+	Id        primitive.ObjectID `json:"_id" bson:"_id"`
+	UserID    primitive.ObjectID `json:"userID" bson:"userID"`
+	Status    string             `json:"status" bson:"status"`
+	Token     string             `json:"token" bson:"token"`
+	ArchiveID primitive.ObjectID `json:"archiveID" bson:"archiveID"`
+	ExpiredAt primitive.DateTime `json:"expiredAt" bson:"expiredAt"`
+	CreatedAt primitive.DateTime `json:"createdAt" bson:"createdAt"`
+	UpdatedAt primitive.DateTime `json:"updatedAt" bson:"updatedAt"`
+}
+
+func FromDomain(domain *data_exports.Domain) *Model {
+	return &Model{
+		Id:        domain.Id,
+		UserID:    domain.UserID,
+		Status:    domain.Status,
+		Token:     domain.Token,
+		ExpiredAt: domain.ExpiredAt,
+		CreatedAt: domain.CreatedAt,
+		UpdatedAt: domain.UpdatedAt,
+	}
+}
+
+func (dataExport *Model) ToDomain() data_exports.Domain {
+	return data_exports.Domain{
+		Id:        dataExport.Id,
+		UserID:    dataExport.UserID,
+		Status:    dataExport.Status,
+		Token:     dataExport.Token,
+		ExpiredAt: dataExport.ExpiredAt,
+		CreatedAt: dataExport.CreatedAt,
+		UpdatedAt: dataExport.UpdatedAt,
+	}
+}
+
+func ToDomainArray(data []Model) []data_exports.Domain {
+	var result []data_exports.Domain
+	for _, v := range data {
+		result = append(result, v.ToDomain())
+	}
+	return result
+}
@@ -51,7 +51,8 @@ func (ftr *followThreadRepository) GetAllByUserID(userID primitive.ObjectID) ([]
 
 	var result []Model
 	cursor, err := ftr.collection.Find(ctx, bson.M{
-		"userID": userID,
+		"userID":    userID,
+		"deletedAt": bson.M{"$exists": false},
 	})
 	if err != nil {
 		return []followthreads.Domain{}, err
@@ -71,7 +72,8 @@ func (ftr *followThreadRepository) GetByID(id primitive.ObjectID) (followthreads
 
 	var result Model
 	err := ftr.collection.FindOne(ctx, bson.M{
-		"_id": id,
+		"_id":       id,
+		"deletedAt": bson.M{"$exists": false},
 	}).Decode(&result)
 	if err != nil {
 		return followthreads.Domain{}, err
@@ -86,8 +88,9 @@ func (ftr *followThreadRepository) GetByUserIDAndThreadID(userID primitive.Objec
 
 	var result Model
 	err := ftr.collection.FindOne(ctx, bson.M{
-		"userID":   userID,
-		"threadID": threadID,
+		"userID":    userID,
+		"threadID":  threadID,
+		"deletedAt": bson.M{"$exists": false},
 	}).Decode(&result)
 	if err != nil {
 		return followthreads.Domain{}, err
@@ -101,7 +104,8 @@ func (ftr *followThreadRepository) CountByThreadID(threadID primitive.ObjectID)
 	defer cancel()
 
 	count, err := ftr.collection.CountDocuments(ctx, bson.M{
-		"threadID": threadID,
+		"threadID":  threadID,
+		"deletedAt": bson.M{"$exists": false},
 	})
 	if err != nil {
 		return 0, err
@@ -153,6 +157,43 @@ func (ftr *followThreadRepository) ResetNotification(threadID primitive.ObjectID
 	return nil
 }
 
+func (ftr *followThreadRepository) SoftDeleteAllByThreadID(threadID primitive.ObjectID, deletedAt primitive.DateTime) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	_, err := ftr.collection.UpdateMany(ctx, bson.M{
+		"threadID":  threadID,
+		"deletedAt": bson.M{"$exists": false},
+	}, bson.M{
+		"$set": bson.M{
+			"deletedAt": deletedAt,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ftr *followThreadRepository) RestoreAllByThreadID(threadID primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	_, err := ftr.collection.UpdateMany(ctx, bson.M{
+		"threadID": threadID,
+	}, bson.M{
+		"$unset": bson.M{
+			"deletedAt": "",
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 /*
 Delete
 */
@@ -0,0 +1,198 @@
+package follow_users
+
+import (
+	followUsers "charum/business/follow_users"
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type followUserRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoRepository(db *mongo.Database) followUsers.Repository {
+	return &followUserRepository{
+		collection: db.Collection("followUsers"),
+	}
+}
+
+/*
+Create
+*/
+
+func (fur *followUserRepository) Create(domain *followUsers.Domain) (followUsers.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	res, err := fur.collection.InsertOne(ctx, FromDomain(domain))
+	if err != nil {
+		return followUsers.Domain{}, err
+	}
+
+	result, err := fur.GetByID(res.InsertedID.(primitive.ObjectID))
+	if err != nil {
+		return followUsers.Domain{}, err
+	}
+
+	return result, nil
+}
+
+/*
+Read
+*/
+
+func (fur *followUserRepository) GetByID(id primitive.ObjectID) (followUsers.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	var result Model
+	err := fur.collection.FindOne(ctx, bson.M{
+		"_id": id,
+	}).Decode(&result)
+	if err != nil {
+		return followUsers.Domain{}, err
+	}
+
+	return result.ToDomain(), nil
+}
+
+func (fur *followUserRepository) GetByFollowerIDAndFollowingID(followerID primitive.ObjectID, followingID primitive.ObjectID) (followUsers.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	var result Model
+	err := fur.collection.FindOne(ctx, bson.M{
+		"followerID":  followerID,
+		"followingID": followingID,
+	}).Decode(&result)
+	if err != nil {
+		return followUsers.Domain{}, err
+	}
+
+	return result.ToDomain(), nil
+}
+
+func (fur *followUserRepository) GetAllByFollowerID(followerID primitive.ObjectID) ([]followUsers.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	var result []Model
+	cursor, err := fur.collection.Find(ctx, bson.M{
+		"followerID": followerID,
+	})
+	if err != nil {
+		return []followUsers.Domain{}, err
+	}
+
+	err = cursor.All(ctx, &result)
+	if err != nil {
+		return []followUsers.Domain{}, err
+	}
+
+	return ToDomainArray(result), nil
+}
+
+func (fur *followUserRepository) GetAllByFollowingID(followingID primitive.ObjectID) ([]followUsers.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	var result []Model
+	cursor, err := fur.collection.Find(ctx, bson.M{
+		"followingID": followingID,
+	})
+	if err != nil {
+		return []followUsers.Domain{}, err
+	}
+
+	err = cursor.All(ctx, &result)
+	if err != nil {
+		return []followUsers.Domain{}, err
+	}
+
+	return ToDomainArray(result), nil
+}
+
+func (fur *followUserRepository) CountByFollowerID(followerID primitive.ObjectID) (int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	count, err := fur.collection.CountDocuments(ctx, bson.M{
+		"followerID": followerID,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return int(count), nil
+}
+
+func (fur *followUserRepository) CountByFollowingID(followingID primitive.ObjectID) (int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	count, err := fur.collection.CountDocuments(ctx, bson.M{
+		"followingID": followingID,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return int(count), nil
+}
+
+/*
+Delete
+*/
+
+func (fur *followUserRepository) Delete(id primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	_, err := fur.collection.DeleteOne(ctx, bson.M{
+		"_id": id,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (fur *followUserRepository) DeleteBetweenUsers(userID primitive.ObjectID, otherUserID primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	_, err := fur.collection.DeleteMany(ctx, bson.M{
+		"$or": []bson.M{
+			{"followerID": userID, "followingID": otherUserID},
+			{"followerID": otherUserID, "followingID": userID},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteAllByUserID removes the follows made by the user as well as the follows of the user
+func (fur *followUserRepository) DeleteAllByUserID(userID primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	_, err := fur.collection.DeleteMany(ctx, bson.M{
+		"$or": []bson.M{
+			{"followerID": userID},
+			{"followingID": userID},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package follow_users
+
+import (
+	followUsers "charum/business/follow_users"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type Model struct {
+	Id          primitive.ObjectID `json:"_id" bson:"_id"`
+	FollowerID  primitive.ObjectID `json:"followerID" bson:"followerID"`
+	FollowingID primitive.ObjectID `json:"followingID" bson:"followingID"`
+	CreatedAt   primitive.DateTime `json:"createdAt" bson:"createdAt"`
+	UpdatedAt   primitive.DateTime `json:"updatedAt" bson:"updatedAt"`
+}
+
+func FromDomain(domain *followUsers.Domain) *Model {
+	return &Model{
+		Id:          domain.Id,
+		FollowerID:  domain.FollowerID,
+		FollowingID: domain.FollowingID,
+		CreatedAt:   domain.CreatedAt,
+		UpdatedAt:   domain.UpdatedAt,
+	}
+}
+
+func (m *Model) ToDomain() followUsers.Domain {
+	return followUsers.Domain{
+		Id:          m.Id,
+		FollowerID:  m.FollowerID,
+		FollowingID: m.FollowingID,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
+
+func ToDomainArray(model []Model) []followUsers.Domain {
+	var domain []followUsers.Domain
+	for _, v := range model {
+		domain = append(domain, v.ToDomain())
+	}
+	return domain
+}
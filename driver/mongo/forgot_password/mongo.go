@@ -3,11 +3,13 @@ package forgot_password
 import (
 	"charum/business/forgot_password"
 	"context"
+	"errors"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 type forgotPasswordRepository struct {
@@ -75,6 +77,21 @@ func (fr *forgotPasswordRepository) GetByToken(token string) (forgot_password.Do
 	return result.ToDomain(), nil
 }
 
+func (fr *forgotPasswordRepository) GetLatestByEmail(email string) (forgot_password.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	var result Model
+	err := fr.collection.FindOne(ctx, bson.M{
+		"email": email,
+	}, options.FindOne().SetSort(bson.M{"createdAt": -1})).Decode(&result)
+	if err != nil {
+		return forgot_password.Domain{}, err
+	}
+
+	return result.ToDomain(), nil
+}
+
 /*
 Update
 */
@@ -100,6 +117,50 @@ func (fr *forgotPasswordRepository) Update(domain *forgot_password.Domain) (forg
 	return result, nil
 }
 
+func (fr *forgotPasswordRepository) MarkAsUsed(id primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	res, err := fr.collection.UpdateOne(ctx, bson.M{
+		"_id":    id,
+		"isUsed": false,
+	}, bson.M{
+		"$set": bson.M{
+			"isUsed":    true,
+			"updatedAt": primitive.NewDateTimeFromTime(time.Now()),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	if res.ModifiedCount == 0 {
+		return errors.New("token has been used")
+	}
+
+	return nil
+}
+
+func (fr *forgotPasswordRepository) InvalidateAllByEmail(email string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	_, err := fr.collection.UpdateMany(ctx, bson.M{
+		"email":  email,
+		"isUsed": false,
+	}, bson.M{
+		"$set": bson.M{
+			"isUsed":    true,
+			"updatedAt": primitive.NewDateTimeFromTime(time.Now()),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 /*
 Delete
 */
@@ -9,6 +9,7 @@ type Model struct {
 	Id        primitive.ObjectID `json:"_id" bson:"_id"`
 	Email     string             `json:"email" bson:"email"`
 	Token     string             `json:"token" bson:"token"`
+	Purpose   string             `json:"purpose" bson:"purpose"`
 	CreatedAt primitive.DateTime `json:"createdAt" bson:"createdAt"`
 	UpdatedAt primitive.DateTime `json:"updatedAt" bson:"updatedAt"`
 	ExpiredAt primitive.DateTime `json:"expiredAt" bson:"expiredAt"`
@@ -20,6 +21,7 @@ func FromDomain(domain *forgot_password.Domain) *Model {
 		Id:        domain.Id,
 		Email:     domain.Email,
 		Token:     domain.Token,
+		Purpose:   domain.Purpose,
 		CreatedAt: domain.CreatedAt,
 		UpdatedAt: domain.UpdatedAt,
 		ExpiredAt: domain.ExpiredAt,
@@ -32,6 +34,7 @@ func (user *Model) ToDomain() forgot_password.Domain {
 		Id:        user.Id,
 		Email:     user.Email,
 		Token:     user.Token,
+		Purpose:   user.Purpose,
 		CreatedAt: user.CreatedAt,
 		UpdatedAt: user.UpdatedAt,
 		ExpiredAt: user.ExpiredAt,
@@ -0,0 +1,143 @@
+package login_attempts
+
+import (
+	"charum/business/login_attempts"
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type loginAttemptRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoRepository(db *mongo.Database) login_attempts.Repository {
+	return &loginAttemptRepository{
+		collection: db.Collection("loginAttempts"),
+	}
+}
+
+/*
+Create
+*/
+
+func (lar *loginAttemptRepository) Create(domain *login_attempts.Domain) (login_attempts.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	res, err := lar.collection.InsertOne(ctx, FromDomain(domain))
+	if err != nil {
+		return login_attempts.Domain{}, err
+	}
+
+	result, err := lar.GetByID(res.InsertedID.(primitive.ObjectID))
+	if err != nil {
+		return login_attempts.Domain{}, err
+	}
+
+	return result, nil
+}
+
+/*
+Read
+*/
+
+func (lar *loginAttemptRepository) GetByID(id primitive.ObjectID) (login_attempts.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	var result Model
+	err := lar.collection.FindOne(ctx, bson.M{
+		"_id": id,
+	}).Decode(&result)
+	if err != nil {
+		return login_attempts.Domain{}, err
+	}
+
+	return result.ToDomain(), nil
+}
+
+func (lar *loginAttemptRepository) GetByKey(key string) (login_attempts.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	var result Model
+	err := lar.collection.FindOne(ctx, bson.M{
+		"key": key,
+	}).Decode(&result)
+	if err != nil {
+		return login_attempts.Domain{}, err
+	}
+
+	return result.ToDomain(), nil
+}
+
+func (lar *loginAttemptRepository) GetAllLocked() ([]login_attempts.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	var result []Model
+	cursor, err := lar.collection.Find(ctx, bson.M{
+		"lockedUntil": bson.M{
+			"$gt": primitive.NewDateTimeFromTime(time.Now()),
+		},
+	}, &options.FindOptions{
+		Sort: bson.M{"lockedUntil": -1},
+	})
+	if err != nil {
+		return []login_attempts.Domain{}, err
+	}
+
+	if err = cursor.All(ctx, &result); err != nil {
+		return []login_attempts.Domain{}, err
+	}
+
+	return ToArrayDomain(result), nil
+}
+
+/*
+Update
+*/
+
+func (lar *loginAttemptRepository) Update(domain *login_attempts.Domain) (login_attempts.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	_, err := lar.collection.UpdateOne(ctx, bson.M{
+		"_id": domain.Id,
+	}, bson.M{
+		"$set": FromDomain(domain),
+	})
+	if err != nil {
+		return login_attempts.Domain{}, err
+	}
+
+	result, err := lar.GetByID(domain.Id)
+	if err != nil {
+		return login_attempts.Domain{}, err
+	}
+
+	return result, nil
+}
+
+/*
+Delete
+*/
+
+func (lar *loginAttemptRepository) Delete(id primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	_, err := lar.collection.DeleteOne(ctx, bson.M{
+		"_id": id,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
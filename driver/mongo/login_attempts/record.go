@@ -0,0 +1,49 @@
+package login_attempts
+
+import (
+	"charum/business/login_attempts"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type Model struct {
+	Id           primitive.ObjectID `json:"_id" bson:"_id"`
+	Key          string             `json:"key" bson:"key"`
+	FailedCount  int                `json:"failedCount" bson:"failedCount"`
+	LastFailedAt primitive.DateTime `json:"lastFailedAt" bson:"lastFailedAt"`
+	LockedUntil  primitive.DateTime `json:"lockedUntil" bson:"lockedUntil"`
+	CreatedAt    primitive.DateTime `json:"createdAt" bson:"createdAt"`
+	UpdatedAt    primitive.DateTime `json:"updatedAt" bson:"updatedAt"`
+}
+
+func FromDomain(domain *login_attempts.Domain) *Model {
+	return &Model{
+		Id:           domain.Id,
+		Key:          domain.Key,
+		FailedCount:  domain.FailedCount,
+		LastFailedAt: domain.LastFailedAt,
+		LockedUntil:  domain.LockedUntil,
+		CreatedAt:    domain.CreatedAt,
+		UpdatedAt:    domain.UpdatedAt,
+	}
+}
+
+func (loginAttempt *Model) ToDomain() login_attempts.Domain {
+	return login_attempts.Domain{
+		Id:           loginAttempt.Id,
+		Key:          loginAttempt.Key,
+		FailedCount:  loginAttempt.FailedCount,
+		LastFailedAt: loginAttempt.LastFailedAt,
+		LockedUntil:  loginAttempt.LockedUntil,
+		CreatedAt:    loginAttempt.CreatedAt,
+		UpdatedAt:    loginAttempt.UpdatedAt,
+	}
+}
+
+func ToArrayDomain(data []Model) []login_attempts.Domain {
+	var result []login_attempts.Domain
+	for _, v := range data {
+		result = append(result, v.ToDomain())
+	}
+	return result
+}
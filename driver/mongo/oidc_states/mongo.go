@@ -0,0 +1,94 @@
+package oidc_states
+
+import (
+	"charum/business/oidc_states"
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type oidcStateRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoRepository(db *mongo.Database) oidc_states.Repository {
+	return &oidcStateRepository{
+		collection: db.Collection("oidcStates"),
+	}
+}
+
+/*
+Create
+*/
+
+func (osr *oidcStateRepository) Create(domain *oidc_states.Domain) (oidc_states.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	res, err := osr.collection.InsertOne(ctx, FromDomain(domain))
+	if err != nil {
+		return oidc_states.Domain{}, err
+	}
+
+	result, err := osr.GetByID(res.InsertedID.(primitive.ObjectID))
+	if err != nil {
+		return oidc_states.Domain{}, err
+	}
+
+	return result, nil
+}
+
+/*
+Read
+*/
+
+func (osr *oidcStateRepository) GetByID(id primitive.ObjectID) (oidc_states.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	var result Model
+	err := osr.collection.FindOne(ctx, bson.M{
+		"_id": id,
+	}).Decode(&result)
+	if err != nil {
+		return oidc_states.Domain{}, err
+	}
+
+	return result.ToDomain(), nil
+}
+
+func (osr *oidcStateRepository) GetByState(state string) (oidc_states.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	var result Model
+	err := osr.collection.FindOne(ctx, bson.M{
+		"state": state,
+	}).Decode(&result)
+	if err != nil {
+		return oidc_states.Domain{}, err
+	}
+
+	return result.ToDomain(), nil
+}
+
+/*
+Delete
+*/
+
+func (osr *oidcStateRepository) Delete(id primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	_, err := osr.collection.DeleteOne(ctx, bson.M{
+		"_id": id,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package oidc_states
+
+import (
+	"charum/business/oidc_states"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type Model struct {
+	Id           primitive.ObjectID `json:"_id" bson:"_id"`
+	State        string             `json:"state" bson:"state"`
+	CodeVerifier string             `json:"codeVerifier" bson:"codeVerifier"`
+	Nonce        string             `json:"nonce" bson:"nonce"`
+	ExpiredAt    primitive.DateTime `json:"expiredAt" bson:"expiredAt"`
+	CreatedAt    primitive.DateTime `json:"createdAt" bson:"createdAt"`
+}
+
+func FromDomain(domain *oidc_states.Domain) *Model {
+	return &Model{
+		Id:           domain.Id,
+		State:        domain.State,
+		CodeVerifier: domain.CodeVerifier,
+		Nonce:        domain.Nonce,
+		ExpiredAt:    domain.ExpiredAt,
+		CreatedAt:    domain.CreatedAt,
+	}
+}
+
+func (oidcState *Model) ToDomain() oidc_states.Domain {
+	return oidc_states.Domain{
+		Id:           oidcState.Id,
+		State:        oidcState.State,
+		CodeVerifier: oidcState.CodeVerifier,
+		Nonce:        oidcState.Nonce,
+		ExpiredAt:    oidcState.ExpiredAt,
+		CreatedAt:    oidcState.CreatedAt,
+	}
+}
@@ -0,0 +1,121 @@
+package permissions
+
+import (
+	"charum/business/permissions"
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type permissionRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoRepository(db *mongo.Database) permissions.Repository {
+	return &permissionRepository{
+		collection: db.Collection("permissions"),
+	}
+}
+
+/*
+Create
+*/
+
+func (pr *permissionRepository) Create(domain *permissions.Domain) (permissions.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	res, err := pr.collection.InsertOne(ctx, FromDomain(domain))
+	if err != nil {
+		return permissions.Domain{}, err
+	}
+
+	result, err := pr.GetByID(res.InsertedID.(primitive.ObjectID))
+	if err != nil {
+		return permissions.Domain{}, err
+	}
+
+	return result, nil
+}
+
+/*
+Read
+*/
+
+func (pr *permissionRepository) GetByID(id primitive.ObjectID) (permissions.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	var result Model
+	err := pr.collection.FindOne(ctx, bson.M{
+		"_id": id,
+	}).Decode(&result)
+	if err != nil {
+		return permissions.Domain{}, err
+	}
+
+	return result.ToDomain(), nil
+}
+
+func (pr *permissionRepository) GetByRole(role string) (permissions.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	var result Model
+	err := pr.collection.FindOne(ctx, bson.M{
+		"role": role,
+	}).Decode(&result)
+	if err != nil {
+		return permissions.Domain{}, err
+	}
+
+	return result.ToDomain(), nil
+}
+
+func (pr *permissionRepository) GetAll() ([]permissions.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	var result []Model
+	cursor, err := pr.collection.Find(ctx, bson.M{}, &options.FindOptions{
+		Sort: bson.M{"role": 1},
+	})
+	if err != nil {
+		return []permissions.Domain{}, err
+	}
+
+	if err = cursor.All(ctx, &result); err != nil {
+		return []permissions.Domain{}, err
+	}
+
+	return ToArrayDomain(result), nil
+}
+
+/*
+Update
+*/
+
+func (pr *permissionRepository) Update(domain *permissions.Domain) (permissions.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	_, err := pr.collection.UpdateOne(ctx, bson.M{
+		"_id": domain.Id,
+	}, bson.M{
+		"$set": FromDomain(domain),
+	})
+	if err != nil {
+		return permissions.Domain{}, err
+	}
+
+	result, err := pr.GetByID(domain.Id)
+	if err != nil {
+		return permissions.Domain{}, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,43 @@
+package permissions
+
+import (
+	"charum/business/permissions"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type Model struct {
+	Id          primitive.ObjectID `json:"_id" bson:"_id"`
+	Role        string             `json:"role" bson:"role"`
+	Permissions []string           `json:"permissions" bson:"permissions"`
+	CreatedAt   primitive.DateTime `json:"createdAt" bson:"createdAt"`
+	UpdatedAt   primitive.DateTime `json:"updatedAt" bson:"updatedAt"`
+}
+
+func FromDomain(domain *permissions.Domain) *Model {
+	return &Model{
+		Id:          domain.Id,
+		Role:        domain.Role,
+		Permissions: domain.Permissions,
+		CreatedAt:   domain.CreatedAt,
+		UpdatedAt:   domain.UpdatedAt,
+	}
+}
+
+func (permission *Model) ToDomain() permissions.Domain {
+	return permissions.Domain{
+		Id:          permission.Id,
+		Role:        permission.Role,
+		Permissions: permission.Permissions,
+		CreatedAt:   permission.CreatedAt,
+		UpdatedAt:   permission.UpdatedAt,
+	}
+}
+
+func ToArrayDomain(data []Model) []permissions.Domain {
+	var result []permissions.Domain
+	for _, v := range data {
+		result = append(result, v.ToDomain())
+	}
+	return result
+}
@@ -0,0 +1,217 @@
+package refresh_tokens
+
+import (
+	"charum/business/refresh_tokens"
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type refreshTokenRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoRepository(db *mongo.Database) refresh_tokens.Repository {
+	return &refreshTokenRepository{
+		collection: db.Collection("refreshTokens"),
+	}
+}
+
+/*
+Create
+*/
+
+func (rtr *refreshTokenRepository) Create(domain *refresh_tokens.Domain) (refresh_tokens.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	res, err := rtr.collection.InsertOne(ctx, FromDomain(domain))
+	if err != nil {
+		return refresh_tokens.Domain{}, err
+	}
+
+	result, err := rtr.GetByID(res.InsertedID.(primitive.ObjectID))
+	if err != nil {
+		return refresh_tokens.Domain{}, err
+	}
+
+	return result, nil
+}
+
+/*
+Read
+*/
+
+func (rtr *refreshTokenRepository) GetByID(id primitive.ObjectID) (refresh_tokens.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	var result Model
+	err := rtr.collection.FindOne(ctx, bson.M{
+		"_id": id,
+	}).Decode(&result)
+	if err != nil {
+		return refresh_tokens.Domain{}, err
+	}
+
+	return result.ToDomain(), nil
+}
+
+func (rtr *refreshTokenRepository) GetByToken(token string) (refresh_tokens.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	var result Model
+	err := rtr.collection.FindOne(ctx, bson.M{
+		"token": token,
+	}).Decode(&result)
+	if err != nil {
+		return refresh_tokens.Domain{}, err
+	}
+
+	return result.ToDomain(), nil
+}
+
+func (rtr *refreshTokenRepository) GetByPreviousToken(token string) (refresh_tokens.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	var result Model
+	err := rtr.collection.FindOne(ctx, bson.M{
+		"previousToken": token,
+	}).Decode(&result)
+	if err != nil {
+		return refresh_tokens.Domain{}, err
+	}
+
+	return result.ToDomain(), nil
+}
+
+func (rtr *refreshTokenRepository) GetByAccessTokenID(accessTokenID string) (refresh_tokens.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	var result Model
+	err := rtr.collection.FindOne(ctx, bson.M{
+		"accessTokenID": accessTokenID,
+	}).Decode(&result)
+	if err != nil {
+		return refresh_tokens.Domain{}, err
+	}
+
+	return result.ToDomain(), nil
+}
+
+func (rtr *refreshTokenRepository) GetAllActiveByUserID(userID primitive.ObjectID) ([]refresh_tokens.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	var result []Model
+	cursor, err := rtr.collection.Find(ctx, bson.M{
+		"userID":    userID,
+		"isRevoked": false,
+		"expiredAt": bson.M{
+			"$gt": primitive.NewDateTimeFromTime(time.Now()),
+		},
+	}, &options.FindOptions{
+		Sort: bson.M{"lastSeenAt": -1},
+	})
+	if err != nil {
+		return []refresh_tokens.Domain{}, err
+	}
+
+	if err = cursor.All(ctx, &result); err != nil {
+		return []refresh_tokens.Domain{}, err
+	}
+
+	return ToArrayDomain(result), nil
+}
+
+/*
+Update
+*/
+
+func (rtr *refreshTokenRepository) Update(domain *refresh_tokens.Domain) (refresh_tokens.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	_, err := rtr.collection.UpdateOne(ctx, bson.M{
+		"_id": domain.Id,
+	}, bson.M{
+		"$set": FromDomain(domain),
+	})
+	if err != nil {
+		return refresh_tokens.Domain{}, err
+	}
+
+	result, err := rtr.GetByID(domain.Id)
+	if err != nil {
+		return refresh_tokens.Domain{}, err
+	}
+
+	return result, nil
+}
+
+func (rtr *refreshTokenRepository) UpdateLastSeen(id primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	_, err := rtr.collection.UpdateOne(ctx, bson.M{
+		"_id": id,
+	}, bson.M{
+		"$set": bson.M{
+			"lastSeenAt": primitive.NewDateTimeFromTime(time.Now()),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RevokeOthersByUserID revokes every session of the user except the one the access token belongs to
+func (rtr *refreshTokenRepository) RevokeOthersByUserID(userID primitive.ObjectID, accessTokenID string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	_, err := rtr.collection.UpdateMany(ctx, bson.M{
+		"userID":        userID,
+		"isRevoked":     false,
+		"accessTokenID": bson.M{"$ne": accessTokenID},
+	}, bson.M{
+		"$set": bson.M{
+			"isRevoked": true,
+			"updatedAt": primitive.NewDateTimeFromTime(time.Now()),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (rtr *refreshTokenRepository) RevokeAllByUserID(userID primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	_, err := rtr.collection.UpdateMany(ctx, bson.M{
+		"userID":    userID,
+		"isRevoked": false,
+	}, bson.M{
+		"$set": bson.M{
+			"isRevoked": true,
+			"updatedAt": primitive.NewDateTimeFromTime(time.Now()),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
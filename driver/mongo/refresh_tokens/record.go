@@ -0,0 +1,64 @@
+package refresh_tokens
+
+import (
+	"charum/business/refresh_tokens"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type Model struct {
+	Id            primitive.ObjectID `json:"_id" bson:"_id"`
+	UserID        primitive.ObjectID `json:"userID" bson:"userID"`
+	Token         string             `json:"token" bson:"token"`
+	PreviousToken string             `json:"previousToken" bson:"previousToken"`
+	AccessTokenID string             `json:"accessTokenID" bson:"accessTokenID"`
+	UserAgent     string             `json:"userAgent" bson:"userAgent"`
+	IPAddress     string             `json:"ipAddress" bson:"ipAddress"`
+	IsRevoked     bool               `json:"isRevoked" bson:"isRevoked"`
+	LastSeenAt    primitive.DateTime `json:"lastSeenAt" bson:"lastSeenAt"`
+	ExpiredAt     primitive.DateTime `json:"expiredAt" bson:"expiredAt"`
+	CreatedAt     primitive.DateTime `json:"createdAt" bson:"createdAt"`
+	UpdatedAt     primitive.DateTime `json:"updatedAt" bson:"updatedAt"`
+}
+
+func FromDomain(domain *refresh_tokens.Domain) *Model {
+	return &Model{
+		Id:            domain.Id,
+		UserID:        domain.UserID,
+		Token:         domain.Token,
+		PreviousToken: domain.PreviousToken,
+		AccessTokenID: domain.AccessTokenID,
+		UserAgent:     domain.UserAgent,
+		IPAddress:     domain.IPAddress,
+		IsRevoked:     domain.IsRevoked,
+		LastSeenAt:    domain.LastSeenAt,
+		ExpiredAt:     domain.ExpiredAt,
+		CreatedAt:     domain.CreatedAt,
+		UpdatedAt:     domain.UpdatedAt,
+	}
+}
+
+func (refreshToken *Model) ToDomain() refresh_tokens.Domain {
+	return refresh_tokens.Domain{
+		Id:            refreshToken.Id,
+		UserID:        refreshToken.UserID,
+		Token:         refreshToken.Token,
+		PreviousToken: refreshToken.PreviousToken,
+		AccessTokenID: refreshToken.AccessTokenID,
+		UserAgent:     refreshToken.UserAgent,
+		IPAddress:     refreshToken.IPAddress,
+		IsRevoked:     refreshToken.IsRevoked,
+		LastSeenAt:    refreshToken.LastSeenAt,
+		ExpiredAt:     refreshToken.ExpiredAt,
+		CreatedAt:     refreshToken.CreatedAt,
+		UpdatedAt:     refreshToken.UpdatedAt,
+	}
+}
+
+func ToArrayDomain(data []Model) []refresh_tokens.Domain {
+	var result []refresh_tokens.Domain
+	for _, v := range data {
+		result = append(result, v.ToDomain())
+	}
+	return result
+}
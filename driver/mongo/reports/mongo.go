@@ -94,6 +94,26 @@ func (rr *reportRepository) CheckByUserID(UserID primitive.ObjectID, ReportedID
 	return result.ToDomain(), nil
 }
 
+func (rr *reportRepository) GetAllByUserID(userID primitive.ObjectID) ([]reports.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	cursor, err := rr.collection.Find(ctx, bson.M{
+		"userId": userID,
+	})
+	if err != nil {
+		return []reports.Domain{}, err
+	}
+
+	var result []Model
+	if err = cursor.All(ctx, &result); err != nil {
+		return []reports.Domain{}, err
+	}
+
+	domains := ToDomainArray(result)
+	return domains, nil
+}
+
 func (rr *reportRepository) GetAll() ([]reports.Domain, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
 	defer cancel()
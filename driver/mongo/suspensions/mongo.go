@@ -0,0 +1,153 @@
+package suspensions
+
+import (
+	"charum/business/suspensions"
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type suspensionRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoRepository(db *mongo.Database) suspensions.Repository {
+	return &suspensionRepository{
+		collection: db.Collection("suspensions"),
+	}
+}
+
+/*
+Create
+*/
+
+func (sr *suspensionRepository) Create(domain *suspensions.Domain) (suspensions.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	res, err := sr.collection.InsertOne(ctx, FromDomain(domain))
+	if err != nil {
+		return suspensions.Domain{}, err
+	}
+
+	result, err := sr.GetByID(res.InsertedID.(primitive.ObjectID))
+	if err != nil {
+		return suspensions.Domain{}, err
+	}
+
+	return result, nil
+}
+
+/*
+Read
+*/
+
+func (sr *suspensionRepository) GetByID(id primitive.ObjectID) (suspensions.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	var result Model
+	err := sr.collection.FindOne(ctx, bson.M{
+		"_id": id,
+	}).Decode(&result)
+	if err != nil {
+		return suspensions.Domain{}, err
+	}
+
+	return result.ToDomain(), nil
+}
+
+func (sr *suspensionRepository) GetActiveByUserID(userID primitive.ObjectID) (suspensions.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	var result Model
+	err := sr.collection.FindOne(ctx, bson.M{
+		"userID": userID,
+		"liftedAt": bson.M{
+			"$exists": false,
+		},
+	}, &options.FindOneOptions{
+		Sort: bson.M{"createdAt": -1},
+	}).Decode(&result)
+	if err != nil {
+		return suspensions.Domain{}, err
+	}
+
+	return result.ToDomain(), nil
+}
+
+func (sr *suspensionRepository) GetAllByUserID(userID primitive.ObjectID) ([]suspensions.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	var result []Model
+	cursor, err := sr.collection.Find(ctx, bson.M{
+		"userID": userID,
+	}, &options.FindOptions{
+		Sort: bson.M{"createdAt": -1},
+	})
+	if err != nil {
+		return []suspensions.Domain{}, err
+	}
+
+	if err = cursor.All(ctx, &result); err != nil {
+		return []suspensions.Domain{}, err
+	}
+
+	return ToArrayDomain(result), nil
+}
+
+func (sr *suspensionRepository) GetAllExpired(now primitive.DateTime) ([]suspensions.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	var result []Model
+	cursor, err := sr.collection.Find(ctx, bson.M{
+		"liftedAt": bson.M{
+			"$exists": false,
+		},
+		"endAt": bson.M{
+			"$exists": true,
+			"$lte":    now,
+		},
+	})
+	if err != nil {
+		return []suspensions.Domain{}, err
+	}
+
+	if err = cursor.All(ctx, &result); err != nil {
+		return []suspensions.Domain{}, err
+	}
+
+	return ToArrayDomain(result), nil
+}
+
+/*
+Update
+*/
+
+func (sr *suspensionRepository) Update(domain *suspensions.Domain) (suspensions.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	_, err := sr.collection.UpdateOne(ctx, bson.M{
+		"_id": domain.Id,
+	}, bson.M{
+		"$set": FromDomain(domain),
+	})
+	if err != nil {
+		return suspensions.Domain{}, err
+	}
+
+	result, err := sr.GetByID(domain.Id)
+	if err != nil {
+		return suspensions.Domain{}, err
+	}
+
+	return result, nil
+}
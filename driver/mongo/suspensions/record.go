@@ -0,0 +1,58 @@
+package suspensions
+
+import (
+	"charum/business/suspensions"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type Model struct {
+	Id        primitive.ObjectID `json:"_id" bson:"_id"`
+	UserID    primitive.ObjectID `json:"userID" bson:"userID"`
+	AdminID   primitive.ObjectID `json:"adminID" bson:"adminID"`
+	Reason    string             `json:"reason" bson:"reason"`
+	Detail    string             `json:"detail" bson:"detail"`
+	EndAt     primitive.DateTime `json:"endAt,omitempty" bson:"endAt,omitempty"`
+	LiftedAt  primitive.DateTime `json:"liftedAt,omitempty" bson:"liftedAt,omitempty"`
+	LiftedBy  primitive.ObjectID `json:"liftedBy,omitempty" bson:"liftedBy,omitempty"`
+	CreatedAt primitive.DateTime `json:"createdAt" bson:"createdAt"`
+	UpdatedAt primitive.DateTime `json:"updatedAt" bson:"updatedAt"`
+}
+
+func FromDomain(domain *suspensions.Domain) *Model {
+	return &Model{
+		Id:        domain.Id,
+		UserID:    domain.UserID,
+		AdminID:   domain.AdminID,
+		Reason:    domain.Reason,
+		Detail:    domain.Detail,
+		EndAt:     domain.EndAt,
+		LiftedAt:  domain.LiftedAt,
+		LiftedBy:  domain.LiftedBy,
+		CreatedAt: domain.CreatedAt,
+		UpdatedAt: domain.UpdatedAt,
+	}
+}
+
+func (suspension *Model) ToDomain() suspensions.Domain {
+	return suspensions.Domain{
+		Id:        suspension.Id,
+		UserID:    suspension.UserID,
+		AdminID:   suspension.AdminID,
+		Reason:    suspension.Reason,
+		Detail:    suspension.Detail,
+		EndAt:     suspension.EndAt,
+		LiftedAt:  suspension.LiftedAt,
+		LiftedBy:  suspension.LiftedBy,
+		CreatedAt: suspension.CreatedAt,
+		UpdatedAt: suspension.UpdatedAt,
+	}
+}
+
+func ToArrayDomain(data []Model) []suspensions.Domain {
+	var result []suspensions.Domain
+	for _, v := range data {
+		result = append(result, v.ToDomain())
+	}
+	return result
+}
@@ -0,0 +1,101 @@
+package thread_revisions
+
+import (
+	"charum/business/thread_revisions"
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type threadRevisionRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoRepository(db *mongo.Database) thread_revisions.Repository {
+	return &threadRevisionRepository{
+		collection: db.Collection("threadRevisions"),
+	}
+}
+
+/*
+Create
+*/
+
+func (trr *threadRevisionRepository) Create(domain *thread_revisions.Domain) (thread_revisions.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	res, err := trr.collection.InsertOne(ctx, FromDomain(domain))
+	if err != nil {
+		return thread_revisions.Domain{}, err
+	}
+
+	result, err := trr.GetByID(res.InsertedID.(primitive.ObjectID))
+	if err != nil {
+		return thread_revisions.Domain{}, err
+	}
+
+	return result, nil
+}
+
+/*
+Read
+*/
+
+func (trr *threadRevisionRepository) GetByID(id primitive.ObjectID) (thread_revisions.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	var result Model
+	err := trr.collection.FindOne(ctx, bson.M{
+		"_id": id,
+	}).Decode(&result)
+	if err != nil {
+		return thread_revisions.Domain{}, err
+	}
+
+	return result.ToDomain(), nil
+}
+
+func (trr *threadRevisionRepository) GetAllByThreadID(threadID primitive.ObjectID) ([]thread_revisions.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	var result []Model
+	cursor, err := trr.collection.Find(ctx, bson.M{
+		"threadID": threadID,
+	}, &options.FindOptions{
+		Sort: bson.M{"createdAt": 1},
+	})
+	if err != nil {
+		return []thread_revisions.Domain{}, err
+	}
+
+	if err = cursor.All(ctx, &result); err != nil {
+		return []thread_revisions.Domain{}, err
+	}
+
+	return ToArrayDomain(result), nil
+}
+
+/*
+Delete
+*/
+
+func (trr *threadRevisionRepository) DeleteAllByThreadID(threadID primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	_, err := trr.collection.DeleteMany(ctx, bson.M{
+		"threadID": threadID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
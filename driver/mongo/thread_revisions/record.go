@@ -0,0 +1,55 @@
+package thread_revisions
+
+import (
+	"charum/business/thread_revisions"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type Model struct {
+	Id          primitive.ObjectID `json:"_id" bson:"_id"`
+	ThreadID    primitive.ObjectID `json:"threadID" bson:"threadID"`
+	EditorID    primitive.ObjectID `json:"editorID" bson:"editorID"`
+	IsAdminEdit bool               `json:"isAdminEdit" bson:"isAdminEdit"`
+	TopicID     primitive.ObjectID `json:"topicID" bson:"topicID"`
+	Title       string             `json:"title" bson:"title"`
+	Description string             `json:"description" bson:"description"`
+	ImageURL    string             `json:"imageURL" bson:"imageURL"`
+	CreatedAt   primitive.DateTime `json:"createdAt" bson:"createdAt"`
+}
+
+func FromDomain(domain *thread_revisions.Domain) *Model {
+	return &Model{
+		Id:          domain.Id,
+		ThreadID:    domain.ThreadID,
+		EditorID:    domain.EditorID,
+		IsAdminEdit: domain.IsAdminEdit,
+		TopicID:     domain.TopicID,
+		Title:       domain.Title,
+		Description: domain.Description,
+		ImageURL:    domain.ImageURL,
+		CreatedAt:   domain.CreatedAt,
+	}
+}
+
+func (revision *Model) ToDomain() thread_revisions.Domain {
+	return thread_revisions.Domain{
+		Id:          revision.Id,
+		ThreadID:    revision.ThreadID,
+		EditorID:    revision.EditorID,
+		IsAdminEdit: revision.IsAdminEdit,
+		TopicID:     revision.TopicID,
+		Title:       revision.Title,
+		Description: revision.Description,
+		ImageURL:    revision.ImageURL,
+		CreatedAt:   revision.CreatedAt,
+	}
+}
+
+func ToArrayDomain(data []Model) []thread_revisions.Domain {
+	var result []thread_revisions.Domain
+	for _, v := range data {
+		result = append(result, v.ToDomain())
+	}
+	return result
+}
@@ -4,6 +4,7 @@ import (
 	"charum/business/threads"
 	dtoQuery "charum/dto/query"
 	"context"
+	"regexp"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -35,6 +36,10 @@ func (tr *threadRepository) Create(domain *threads.Domain) (threads.Domain, erro
 		return threads.Domain{}, err
 	}
 
+	if domain.IsDraft {
+		return tr.GetDraftByID(res.InsertedID.(primitive.ObjectID))
+	}
+
 	result, err := tr.GetByID(res.InsertedID.(primitive.ObjectID))
 	if err != nil {
 		return threads.Domain{}, err
@@ -47,7 +52,7 @@ func (tr *threadRepository) Create(domain *threads.Domain) (threads.Domain, erro
 Read
 */
 
-func (tr *threadRepository) GetManyWithPagination(query dtoQuery.Request, domain *threads.Domain) ([]threads.Domain, int, error) {
+func (tr *threadRepository) GetManyWithPagination(query dtoQuery.Request, domain *threads.Domain, excludedCreatorIDs []primitive.ObjectID, matchAllTags bool) ([]threads.Domain, int, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
 	defer cancel()
 
@@ -55,7 +60,10 @@ func (tr *threadRepository) GetManyWithPagination(query dtoQuery.Request, domain
 	limit64 := int64(query.Limit)
 
 	var result []Model
-	filter := bson.M{}
+	filter := bson.M{
+		"deletedAt": bson.M{"$exists": false},
+		"isDraft":   bson.M{"$ne": true},
+	}
 
 	if domain.TopicID != primitive.NilObjectID {
 		filter["topicId"] = domain.TopicID
@@ -65,6 +73,62 @@ func (tr *threadRepository) GetManyWithPagination(query dtoQuery.Request, domain
 		filter["title"] = bson.M{"$regex": domain.Title}
 	}
 
+	if len(domain.Tags) > 0 {
+		if matchAllTags {
+			filter["tags"] = bson.M{"$all": domain.Tags}
+		} else {
+			filter["tags"] = bson.M{"$in": domain.Tags}
+		}
+	}
+
+	if len(excludedCreatorIDs) > 0 {
+		filter["creatorId"] = bson.M{"$nin": excludedCreatorIDs}
+	}
+
+	// pinned threads always come first, the topic pins only within the threads of their topic
+	sort := bson.D{{Key: "isPinned", Value: -1}}
+	if domain.TopicID != primitive.NilObjectID {
+		sort = append(sort, bson.E{Key: "isTopicPinned", Value: -1})
+	}
+	sort = append(sort, bson.E{Key: query.Sort, Value: query.Order})
+
+	cursor, err := tr.collection.Find(ctx, filter, &options.FindOptions{
+		Skip:  &skip64,
+		Limit: &limit64,
+		Sort:  sort,
+	})
+	if err != nil {
+		return []threads.Domain{}, 0, err
+	}
+
+	totalData, err := tr.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return []threads.Domain{}, 0, err
+	}
+
+	if err = cursor.All(ctx, &result); err != nil {
+		return []threads.Domain{}, 0, err
+	}
+
+	return ToArrayDomain(result), int(totalData), nil
+}
+
+func (tr *threadRepository) GetManyByCreatorIDs(query dtoQuery.Request, creatorIDs []primitive.ObjectID) ([]threads.Domain, int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	skip64 := int64(query.Skip)
+	limit64 := int64(query.Limit)
+
+	var result []Model
+	filter := bson.M{
+		"creatorId": bson.M{
+			"$in": creatorIDs,
+		},
+		"deletedAt": bson.M{"$exists": false},
+		"isDraft":   bson.M{"$ne": true},
+	}
+
 	cursor, err := tr.collection.Find(ctx, filter, &options.FindOptions{
 		Skip:  &skip64,
 		Limit: &limit64,
@@ -92,7 +156,9 @@ func (tr *threadRepository) GetByID(id primitive.ObjectID) (threads.Domain, erro
 
 	var result Model
 	err := tr.collection.FindOne(ctx, bson.M{
-		"_id": id,
+		"_id":       id,
+		"deletedAt": bson.M{"$exists": false},
+		"isDraft":   bson.M{"$ne": true},
 	}).Decode(&result)
 	if err != nil {
 		return threads.Domain{}, err
@@ -107,7 +173,9 @@ func (tr *threadRepository) GetAllByTopicID(topicID primitive.ObjectID) ([]threa
 
 	var result []Model
 	cursor, err := tr.collection.Find(ctx, bson.M{
-		"topicId": topicID,
+		"topicId":   topicID,
+		"deletedAt": bson.M{"$exists": false},
+		"isDraft":   bson.M{"$ne": true},
 	})
 	if err != nil {
 		return []threads.Domain{}, err
@@ -127,6 +195,8 @@ func (tr *threadRepository) GetAllByUserID(userID primitive.ObjectID) ([]threads
 	var result []Model
 	cursor, err := tr.collection.Find(ctx, bson.M{
 		"creatorId": userID,
+		"deletedAt": bson.M{"$exists": false},
+		"isDraft":   bson.M{"$ne": true},
 	})
 	if err != nil {
 		return []threads.Domain{}, err
@@ -139,6 +209,52 @@ func (tr *threadRepository) GetAllByUserID(userID primitive.ObjectID) ([]threads
 	return ToArrayDomain(result), nil
 }
 
+func (tr *threadRepository) CountByUserID(userID primitive.ObjectID) (int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	count, err := tr.collection.CountDocuments(ctx, bson.M{
+		"creatorId": userID,
+		"deletedAt": bson.M{"$exists": false},
+		"isDraft":   bson.M{"$ne": true},
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return int(count), nil
+}
+
+// CountLikesByUserID sums the likes received by every thread created by the user
+func (tr *threadRepository) CountLikesByUserID(userID primitive.ObjectID) (int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	cursor, err := tr.collection.Aggregate(ctx, []bson.M{
+		{"$match": bson.M{"creatorId": userID, "deletedAt": bson.M{"$exists": false}, "isDraft": bson.M{"$ne": true}}},
+		{"$group": bson.M{
+			"_id":   nil,
+			"total": bson.M{"$sum": bson.M{"$size": bson.M{"$ifNull": []interface{}{"$likes", []interface{}{}}}}},
+		}},
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var result []struct {
+		Total int `bson:"total"`
+	}
+	if err = cursor.All(ctx, &result); err != nil {
+		return 0, err
+	}
+
+	if len(result) == 0 {
+		return 0, nil
+	}
+
+	return result[0].Total, nil
+}
+
 func (tr *threadRepository) GetLikedByUserID(userID primitive.ObjectID) ([]threads.Domain, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
 	defer cancel()
@@ -150,6 +266,8 @@ func (tr *threadRepository) GetLikedByUserID(userID primitive.ObjectID) ([]threa
 				"userID": userID,
 			},
 		},
+		"deletedAt": bson.M{"$exists": false},
+		"isDraft":   bson.M{"$ne": true},
 	})
 	if err != nil {
 		return []threads.Domain{}, err
@@ -174,6 +292,8 @@ func (tr *threadRepository) CheckLikedByUserID(userID primitive.ObjectID, thread
 				"userID": userID,
 			},
 		},
+		"deletedAt": bson.M{"$exists": false},
+		"isDraft":   bson.M{"$ne": true},
 	}).Decode(&result)
 	if err != nil {
 		return err
@@ -187,7 +307,200 @@ func (tr *threadRepository) GetAll() ([]threads.Domain, error) {
 	defer cancel()
 
 	var result []Model
-	cursor, err := tr.collection.Find(ctx, bson.M{})
+	cursor, err := tr.collection.Find(ctx, bson.M{
+		"deletedAt": bson.M{"$exists": false},
+		"isDraft":   bson.M{"$ne": true},
+	})
+	if err != nil {
+		return []threads.Domain{}, err
+	}
+
+	if err = cursor.All(ctx, &result); err != nil {
+		return []threads.Domain{}, err
+	}
+
+	return ToArrayDomain(result), nil
+}
+
+// GetTagCounts counts how many visible threads use each tag, most used first, prefix narrows the tags down for autocomplete
+func (tr *threadRepository) GetTagCounts(prefix string, limit int) ([]threads.TagCount, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	pipeline := []bson.M{
+		{"$match": bson.M{
+			"deletedAt": bson.M{"$exists": false},
+			"isDraft":   bson.M{"$ne": true},
+		}},
+		{"$unwind": "$tags"},
+	}
+
+	if prefix != "" {
+		pipeline = append(pipeline, bson.M{"$match": bson.M{
+			"tags": bson.M{"$regex": "^" + regexp.QuoteMeta(prefix)},
+		}})
+	}
+
+	pipeline = append(pipeline,
+		bson.M{"$group": bson.M{
+			"_id":   "$tags",
+			"count": bson.M{"$sum": 1},
+		}},
+		bson.M{"$sort": bson.D{{Key: "count", Value: -1}, {Key: "_id", Value: 1}}},
+		bson.M{"$limit": limit},
+	)
+
+	cursor, err := tr.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return []threads.TagCount{}, err
+	}
+
+	var result []threads.TagCount
+	if err = cursor.All(ctx, &result); err != nil {
+		return []threads.TagCount{}, err
+	}
+
+	return result, nil
+}
+
+// CountByTag also counts drafts and trashed threads since renaming or merging a tag applies to them as well
+func (tr *threadRepository) CountByTag(name string) (int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	count, err := tr.collection.CountDocuments(ctx, bson.M{
+		"tags": name,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return int(count), nil
+}
+
+func (tr *threadRepository) GetTrashedByID(id primitive.ObjectID) (threads.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	var result Model
+	err := tr.collection.FindOne(ctx, bson.M{
+		"_id":       id,
+		"deletedAt": bson.M{"$exists": true},
+	}).Decode(&result)
+	if err != nil {
+		return threads.Domain{}, err
+	}
+
+	return result.ToDomain(), nil
+}
+
+func (tr *threadRepository) GetTrashWithPagination(query dtoQuery.Request) ([]threads.Domain, int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	skip64 := int64(query.Skip)
+	limit64 := int64(query.Limit)
+
+	var result []Model
+	filter := bson.M{
+		"deletedAt": bson.M{"$exists": true},
+	}
+
+	cursor, err := tr.collection.Find(ctx, filter, &options.FindOptions{
+		Skip:  &skip64,
+		Limit: &limit64,
+		Sort:  bson.M{query.Sort: query.Order},
+	})
+	if err != nil {
+		return []threads.Domain{}, 0, err
+	}
+
+	totalData, err := tr.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return []threads.Domain{}, 0, err
+	}
+
+	if err = cursor.All(ctx, &result); err != nil {
+		return []threads.Domain{}, 0, err
+	}
+
+	return ToArrayDomain(result), int(totalData), nil
+}
+
+func (tr *threadRepository) GetAllTrashedBefore(before primitive.DateTime) ([]threads.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	var result []Model
+	cursor, err := tr.collection.Find(ctx, bson.M{
+		"deletedAt": bson.M{
+			"$exists": true,
+			"$lte":    before,
+		},
+	})
+	if err != nil {
+		return []threads.Domain{}, err
+	}
+
+	if err = cursor.All(ctx, &result); err != nil {
+		return []threads.Domain{}, err
+	}
+
+	return ToArrayDomain(result), nil
+}
+
+func (tr *threadRepository) GetDraftByID(id primitive.ObjectID) (threads.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	var result Model
+	err := tr.collection.FindOne(ctx, bson.M{
+		"_id":     id,
+		"isDraft": true,
+	}).Decode(&result)
+	if err != nil {
+		return threads.Domain{}, err
+	}
+
+	return result.ToDomain(), nil
+}
+
+func (tr *threadRepository) GetDraftsByCreatorID(creatorID primitive.ObjectID) ([]threads.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	var result []Model
+	cursor, err := tr.collection.Find(ctx, bson.M{
+		"creatorId": creatorID,
+		"isDraft":   true,
+	}, &options.FindOptions{
+		Sort: bson.M{
+			"updatedAt": -1,
+		},
+	})
+	if err != nil {
+		return []threads.Domain{}, err
+	}
+
+	if err = cursor.All(ctx, &result); err != nil {
+		return []threads.Domain{}, err
+	}
+
+	return ToArrayDomain(result), nil
+}
+
+func (tr *threadRepository) GetDraftsDueBefore(before primitive.DateTime) ([]threads.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	var result []Model
+	cursor, err := tr.collection.Find(ctx, bson.M{
+		"isDraft": true,
+		"publishAt": bson.M{
+			"$exists": true,
+			"$lte":    before,
+		},
+	})
 	if err != nil {
 		return []threads.Domain{}, err
 	}
@@ -224,12 +537,113 @@ func (tr *threadRepository) Update(domain *threads.Domain) (threads.Domain, erro
 	return result, nil
 }
 
+func (tr *threadRepository) UpdateDraft(domain *threads.Domain) (threads.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	update := bson.M{
+		"$set": FromDomain(domain),
+	}
+
+	// an empty publish time unschedules the draft
+	if domain.PublishAt == 0 {
+		update["$unset"] = bson.M{
+			"publishAt": "",
+		}
+	}
+
+	_, err := tr.collection.UpdateOne(ctx, bson.M{
+		"_id":     domain.Id,
+		"isDraft": true,
+	}, update)
+	if err != nil {
+		return threads.Domain{}, err
+	}
+
+	result, err := tr.GetDraftByID(domain.Id)
+	if err != nil {
+		return threads.Domain{}, err
+	}
+
+	return result, nil
+}
+
+func (tr *threadRepository) Publish(domain *threads.Domain) (threads.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	_, err := tr.collection.UpdateOne(ctx, bson.M{
+		"_id":     domain.Id,
+		"isDraft": true,
+	}, bson.M{
+		"$set": bson.M{
+			"createdAt": domain.CreatedAt,
+			"updatedAt": domain.UpdatedAt,
+		},
+		"$unset": bson.M{
+			"isDraft":   "",
+			"publishAt": "",
+		},
+	})
+	if err != nil {
+		return threads.Domain{}, err
+	}
+
+	result, err := tr.GetByID(domain.Id)
+	if err != nil {
+		return threads.Domain{}, err
+	}
+
+	return result, nil
+}
+
+// RenameTag replaces the tag on every thread, threads that already have the new tag simply lose the old one
+func (tr *threadRepository) RenameTag(from string, to string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	_, err := tr.collection.UpdateMany(ctx, bson.M{
+		"tags": from,
+	}, bson.M{
+		"$addToSet": bson.M{
+			"tags": to,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return tr.RemoveTag(from)
+}
+
+func (tr *threadRepository) RemoveTag(name string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	_, err := tr.collection.UpdateMany(ctx, bson.M{
+		"tags": name,
+	}, bson.M{
+		"$pull": bson.M{
+			"tags": name,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (tr *threadRepository) SuspendByUserID(domain *threads.Domain) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
 	defer cancel()
 
+	// threads already suspended by a moderator keep their own status
 	_, err := tr.collection.UpdateMany(ctx, bson.M{
 		"creatorId": domain.CreatorID,
+		"suspendStatus": bson.M{
+			"$in": []interface{}{nil, ""},
+		},
 	}, bson.M{
 		"$set": bson.M{
 			"suspendStatus": domain.SuspendStatus,
@@ -243,6 +657,85 @@ func (tr *threadRepository) SuspendByUserID(domain *threads.Domain) error {
 	return nil
 }
 
+func (tr *threadRepository) UnsuspendByUserID(domain *threads.Domain) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	_, err := tr.collection.UpdateMany(ctx, bson.M{
+		"creatorId":     domain.CreatorID,
+		"suspendStatus": domain.SuspendStatus,
+	}, bson.M{
+		"$set": bson.M{
+			"suspendStatus": "",
+			"suspendDetail": "",
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (tr *threadRepository) UpdateSuspendStatus(domain *threads.Domain) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	_, err := tr.collection.UpdateOne(ctx, bson.M{
+		"_id": domain.Id,
+	}, bson.M{
+		"$set": bson.M{
+			"suspendStatus": domain.SuspendStatus,
+			"suspendDetail": domain.SuspendDetail,
+			"updatedAt":     domain.UpdatedAt,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (tr *threadRepository) UpdatePinStatus(domain *threads.Domain) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	_, err := tr.collection.UpdateOne(ctx, bson.M{
+		"_id": domain.Id,
+	}, bson.M{
+		"$set": bson.M{
+			"isPinned":      domain.IsPinned,
+			"isTopicPinned": domain.IsTopicPinned,
+			"updatedAt":     domain.UpdatedAt,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (tr *threadRepository) UpdateLockStatus(domain *threads.Domain) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	_, err := tr.collection.UpdateOne(ctx, bson.M{
+		"_id": domain.Id,
+	}, bson.M{
+		"$set": bson.M{
+			"isLocked":  domain.IsLocked,
+			"updatedAt": domain.UpdatedAt,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (tr *threadRepository) AppendLike(userID primitive.ObjectID, threadID primitive.ObjectID) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
 	defer cancel()
@@ -304,6 +797,42 @@ func (tr *threadRepository) RemoveUserFromAllLikes(userID primitive.ObjectID) er
 	return nil
 }
 
+func (tr *threadRepository) SoftDelete(domain *threads.Domain) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	_, err := tr.collection.UpdateOne(ctx, bson.M{
+		"_id": domain.Id,
+	}, bson.M{
+		"$set": bson.M{
+			"deletedAt": domain.DeletedAt,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (tr *threadRepository) Restore(id primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	_, err := tr.collection.UpdateOne(ctx, bson.M{
+		"_id": id,
+	}, bson.M{
+		"$unset": bson.M{
+			"deletedAt": "",
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 /*
 Delete
 */
@@ -326,8 +855,10 @@ func (tr *threadRepository) DeleteAllByUserID(id primitive.ObjectID) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
 	defer cancel()
 
+	// trashed threads are left to the purge job so their images and dependents are cleaned up too
 	_, err := tr.collection.DeleteMany(ctx, bson.M{
 		"creatorId": id,
+		"deletedAt": bson.M{"$exists": false},
 	})
 	if err != nil {
 		return err
@@ -16,6 +16,14 @@ type Model struct {
 	ImageURL      string             `json:"imageURL" bson:"imageURL"`
 	SuspendStatus string             `json:"suspendStatus,omitempty" bson:"suspendStatus,omitempty"`
 	SuspendDetail string             `json:"suspendDetail,omitempty" bson:"suspendDetail,omitempty"`
+	EditedAt      primitive.DateTime `json:"editedAt,omitempty" bson:"editedAt,omitempty"`
+	DeletedAt     primitive.DateTime `json:"deletedAt,omitempty" bson:"deletedAt,omitempty"`
+	Tags          []string           `json:"tags" bson:"tags"`
+	IsPinned      bool               `json:"isPinned,omitempty" bson:"isPinned,omitempty"`
+	IsTopicPinned bool               `json:"isTopicPinned,omitempty" bson:"isTopicPinned,omitempty"`
+	IsLocked      bool               `json:"isLocked,omitempty" bson:"isLocked,omitempty"`
+	IsDraft       bool               `json:"isDraft,omitempty" bson:"isDraft,omitempty"`
+	PublishAt     primitive.DateTime `json:"publishAt,omitempty" bson:"publishAt,omitempty"`
 	CreatedAt     primitive.DateTime `json:"createdAt" bson:"createdAt"`
 	UpdatedAt     primitive.DateTime `json:"updatedAt" bson:"updatedAt"`
 }
@@ -31,6 +39,14 @@ func FromDomain(domain *threads.Domain) *Model {
 		ImageURL:      domain.ImageURL,
 		SuspendStatus: domain.SuspendStatus,
 		SuspendDetail: domain.SuspendDetail,
+		EditedAt:      domain.EditedAt,
+		DeletedAt:     domain.DeletedAt,
+		Tags:          domain.Tags,
+		IsPinned:      domain.IsPinned,
+		IsTopicPinned: domain.IsTopicPinned,
+		IsLocked:      domain.IsLocked,
+		IsDraft:       domain.IsDraft,
+		PublishAt:     domain.PublishAt,
 		CreatedAt:     domain.CreatedAt,
 		UpdatedAt:     domain.UpdatedAt,
 	}
@@ -47,6 +63,14 @@ func (thread *Model) ToDomain() threads.Domain {
 		ImageURL:      thread.ImageURL,
 		SuspendStatus: thread.SuspendStatus,
 		SuspendDetail: thread.SuspendDetail,
+		EditedAt:      thread.EditedAt,
+		DeletedAt:     thread.DeletedAt,
+		Tags:          thread.Tags,
+		IsPinned:      thread.IsPinned,
+		IsTopicPinned: thread.IsTopicPinned,
+		IsLocked:      thread.IsLocked,
+		IsDraft:       thread.IsDraft,
+		PublishAt:     thread.PublishAt,
 		CreatedAt:     thread.CreatedAt,
 		UpdatedAt:     thread.UpdatedAt,
 	}
@@ -111,6 +111,25 @@ func (tr *topicRepository) GetByTopic(topic string) (topics.Domain, error) {
 	return result.ToDomain(), nil
 }
 
+func (tr *topicRepository) GetAllByModeratorID(userID primitive.ObjectID) ([]topics.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	cursor, err := tr.collection.Find(ctx, bson.M{
+		"moderatorIDs": userID,
+	})
+	if err != nil {
+		return []topics.Domain{}, err
+	}
+
+	var result []Model
+	if err = cursor.All(ctx, &result); err != nil {
+		return []topics.Domain{}, err
+	}
+
+	return ToArrayDomain(result), nil
+}
+
 /*
 Update
 */
@@ -136,6 +155,42 @@ func (tr *topicRepository) Update(domain *topics.Domain) (topics.Domain, error)
 	return result, nil
 }
 
+func (tr *topicRepository) AddModerator(topicID primitive.ObjectID, userID primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	_, err := tr.collection.UpdateOne(ctx, bson.M{
+		"_id": topicID,
+	}, bson.M{
+		"$addToSet": bson.M{
+			"moderatorIDs": userID,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (tr *topicRepository) RemoveModerator(topicID primitive.ObjectID, userID primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	_, err := tr.collection.UpdateOne(ctx, bson.M{
+		"_id": topicID,
+	}, bson.M{
+		"$pull": bson.M{
+			"moderatorIDs": userID,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 /*
 Delete
 */
@@ -0,0 +1,155 @@
+package user_blocks
+
+import (
+	userBlocks "charum/business/user_blocks"
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type userBlockRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoRepository(db *mongo.Database) userBlocks.Repository {
+	return &userBlockRepository{
+		collection: db.Collection("userBlocks"),
+	}
+}
+
+/*
+Create
+*/
+
+func (ubr *userBlockRepository) Create(domain *userBlocks.Domain) (userBlocks.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	res, err := ubr.collection.InsertOne(ctx, FromDomain(domain))
+	if err != nil {
+		return userBlocks.Domain{}, err
+	}
+
+	result, err := ubr.GetByID(res.InsertedID.(primitive.ObjectID))
+	if err != nil {
+		return userBlocks.Domain{}, err
+	}
+
+	return result, nil
+}
+
+/*
+Read
+*/
+
+func (ubr *userBlockRepository) GetByID(id primitive.ObjectID) (userBlocks.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	var result Model
+	err := ubr.collection.FindOne(ctx, bson.M{
+		"_id": id,
+	}).Decode(&result)
+	if err != nil {
+		return userBlocks.Domain{}, err
+	}
+
+	return result.ToDomain(), nil
+}
+
+func (ubr *userBlockRepository) GetByUserIDTargetIDAndType(userID primitive.ObjectID, targetID primitive.ObjectID, blockType string) (userBlocks.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	var result Model
+	err := ubr.collection.FindOne(ctx, bson.M{
+		"userID":   userID,
+		"targetID": targetID,
+		"type":     blockType,
+	}).Decode(&result)
+	if err != nil {
+		return userBlocks.Domain{}, err
+	}
+
+	return result.ToDomain(), nil
+}
+
+func (ubr *userBlockRepository) GetAllByUserID(userID primitive.ObjectID) ([]userBlocks.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	var result []Model
+	cursor, err := ubr.collection.Find(ctx, bson.M{
+		"userID": userID,
+	})
+	if err != nil {
+		return []userBlocks.Domain{}, err
+	}
+
+	err = cursor.All(ctx, &result)
+	if err != nil {
+		return []userBlocks.Domain{}, err
+	}
+
+	return ToDomainArray(result), nil
+}
+
+func (ubr *userBlockRepository) GetAllByUserIDAndType(userID primitive.ObjectID, blockType string) ([]userBlocks.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	var result []Model
+	cursor, err := ubr.collection.Find(ctx, bson.M{
+		"userID": userID,
+		"type":   blockType,
+	})
+	if err != nil {
+		return []userBlocks.Domain{}, err
+	}
+
+	err = cursor.All(ctx, &result)
+	if err != nil {
+		return []userBlocks.Domain{}, err
+	}
+
+	return ToDomainArray(result), nil
+}
+
+/*
+Delete
+*/
+
+func (ubr *userBlockRepository) Delete(id primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	_, err := ubr.collection.DeleteOne(ctx, bson.M{
+		"_id": id,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteAllByUserID removes the blocks and mutes made by the user as well as the ones targeting the user
+func (ubr *userBlockRepository) DeleteAllByUserID(userID primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	_, err := ubr.collection.DeleteMany(ctx, bson.M{
+		"$or": []bson.M{
+			{"userID": userID},
+			{"targetID": userID},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
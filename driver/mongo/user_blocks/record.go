@@ -0,0 +1,46 @@
+package user_blocks
+
+import (
+	userBlocks "charum/business/user_blocks"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type Model struct {
+	Id        primitive.ObjectID `json:"_id" bson:"_id"`
+	UserID    primitive.ObjectID `json:"userID" bson:"userID"`
+	TargetID  primitive.ObjectID `json:"targetID" bson:"targetID"`
+	Type      string             `json:"type" bson:"type"`
+	CreatedAt primitive.DateTime `json:"createdAt" bson:"createdAt"`
+	UpdatedAt primitive.DateTime `json:"updatedAt" bson:"updatedAt"`
+}
+
+func FromDomain(domain *userBlocks.Domain) *Model {
+	return &Model{
+		Id:        domain.Id,
+		UserID:    domain.UserID,
+		TargetID:  domain.TargetID,
+		Type:      domain.Type,
+		CreatedAt: domain.CreatedAt,
+		UpdatedAt: domain.UpdatedAt,
+	}
+}
+
+func (m *Model) ToDomain() userBlocks.Domain {
+	return userBlocks.Domain{
+		Id:        m.Id,
+		UserID:    m.UserID,
+		TargetID:  m.TargetID,
+		Type:      m.Type,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
+
+func ToDomainArray(model []Model) []userBlocks.Domain {
+	var domain []userBlocks.Domain
+	for _, v := range model {
+		domain = append(domain, v.ToDomain())
+	}
+	return domain
+}
@@ -86,6 +86,19 @@ func (ur *userRepository) GetByUsername(username string) (users.Domain, error) {
 	return result.ToDomain(), err
 }
 
+func (ur *userRepository) GetByOIDCSubject(issuer string, subject string) (users.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	var result Model
+	err := ur.collection.FindOne(ctx, bson.M{
+		"oidcIssuer":  issuer,
+		"oidcSubject": subject,
+	}).Decode(&result)
+
+	return result.ToDomain(), err
+}
+
 func (ur *userRepository) GetManyWithPagination(query dtoQuery.Request, domain *users.Domain) ([]users.Domain, int, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
 	defer cancel()
@@ -147,6 +160,28 @@ func (ur *userRepository) GetAll() ([]users.Domain, error) {
 	return ToArrayDomain(result), nil
 }
 
+func (ur *userRepository) GetAllDueForDeletion(now primitive.DateTime) ([]users.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	var result []Model
+	cursor, err := ur.collection.Find(ctx, bson.M{
+		"deletionScheduledAt": bson.M{
+			"$gt":  primitive.DateTime(0),
+			"$lte": now,
+		},
+	})
+	if err != nil {
+		return []users.Domain{}, err
+	}
+
+	if err = cursor.All(ctx, &result); err != nil {
+		return []users.Domain{}, err
+	}
+
+	return ToArrayDomain(result), nil
+}
+
 /*
 Update
 */
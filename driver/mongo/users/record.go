@@ -7,51 +7,75 @@ import (
 )
 
 type Model struct {
-	Id                primitive.ObjectID `json:"_id" bson:"_id"`
-	Email             string             `json:"email" bson:"email"`
-	UserName          string             `json:"userName" bson:"userName"`
-	DisplayName       string             `json:"displayName" bson:"displayName"`
-	Biodata           string             `json:"biodata" bson:"biodata,omitempty"`
-	SocialMedia       string             `json:"socialMedia" bson:"socialMedia,omitempty"`
-	ProfilePictureURL string             `json:"profilePictureURL" bson:"profilePictureURL,omitempty"`
-	Password          string             `json:"password" bson:"password"`
-	IsActive          bool               `json:"isActive" bson:"isActive"`
-	Role              string             `json:"role" bson:"role"`
-	CreatedAt         primitive.DateTime `json:"createdAt" bson:"createdAt"`
-	UpdatedAt         primitive.DateTime `json:"updatedAt" bson:"updatedAt"`
+	Id                  primitive.ObjectID `json:"_id" bson:"_id"`
+	Email               string             `json:"email" bson:"email"`
+	UserName            string             `json:"userName" bson:"userName"`
+	DisplayName         string             `json:"displayName" bson:"displayName"`
+	Biodata             string             `json:"biodata" bson:"biodata,omitempty"`
+	SocialMedia         string             `json:"socialMedia" bson:"socialMedia,omitempty"`
+	ProfilePictureURL   string             `json:"profilePictureURL" bson:"profilePictureURL,omitempty"`
+	Password            string             `json:"password" bson:"password"`
+	IsActive            bool               `json:"isActive" bson:"isActive"`
+	EmailVerified       bool               `json:"emailVerified" bson:"emailVerified"`
+	Role                string             `json:"role" bson:"role"`
+	TwoFactorEnabled    bool               `json:"twoFactorEnabled" bson:"twoFactorEnabled"`
+	TwoFactorSecret     string             `json:"twoFactorSecret" bson:"twoFactorSecret"`
+	RecoveryCodes       []string           `json:"recoveryCodes" bson:"recoveryCodes"`
+	LastTOTPStep        int64              `json:"lastTOTPStep" bson:"lastTOTPStep"`
+	OIDCIssuer          string             `json:"oidcIssuer" bson:"oidcIssuer,omitempty"`
+	OIDCSubject         string             `json:"oidcSubject" bson:"oidcSubject,omitempty"`
+	DeletionScheduledAt primitive.DateTime `json:"deletionScheduledAt" bson:"deletionScheduledAt"`
+	CreatedAt           primitive.DateTime `json:"createdAt" bson:"createdAt"`
+	UpdatedAt           primitive.DateTime `json:"updatedAt" bson:"updatedAt"`
 }
 
 func FromDomain(domain *users.Domain) *Model {
 	return &Model{
-		Id:                domain.Id,
-		Email:             domain.Email,
-		UserName:          domain.UserName,
-		DisplayName:       domain.DisplayName,
-		Biodata:           domain.Biodata,
-		SocialMedia:       domain.SocialMedia,
-		ProfilePictureURL: domain.ProfilePictureURL,
-		Password:          domain.Password,
-		IsActive:          domain.IsActive,
-		Role:              domain.Role,
-		CreatedAt:         domain.CreatedAt,
-		UpdatedAt:         domain.UpdatedAt,
+		Id:                  domain.Id,
+		Email:               domain.Email,
+		UserName:            domain.UserName,
+		DisplayName:         domain.DisplayName,
+		Biodata:             domain.Biodata,
+		SocialMedia:         domain.SocialMedia,
+		ProfilePictureURL:   domain.ProfilePictureURL,
+		Password:            domain.Password,
+		IsActive:            domain.IsActive,
+		EmailVerified:       domain.EmailVerified,
+		Role:                domain.Role,
+		TwoFactorEnabled:    domain.TwoFactorEnabled,
+		TwoFactorSecret:     domain.TwoFactorSecret,
+		RecoveryCodes:       domain.RecoveryCodes,
+		LastTOTPStep:        domain.LastTOTPStep,
+		OIDCIssuer:          domain.OIDCIssuer,
+		OIDCSubject:         domain.OIDCSubject,
+		DeletionScheduledAt: domain.DeletionScheduledAt,
+		CreatedAt:           domain.CreatedAt,
+		UpdatedAt:           domain.UpdatedAt,
 	}
 }
 
 func (user *Model) ToDomain() users.Domain {
 	return users.Domain{
-		Id:                user.Id,
-		Email:             user.Email,
-		UserName:          user.UserName,
-		DisplayName:       user.DisplayName,
-		Biodata:           user.Biodata,
-		SocialMedia:       user.SocialMedia,
-		ProfilePictureURL: user.ProfilePictureURL,
-		Password:          user.Password,
-		IsActive:          user.IsActive,
-		Role:              user.Role,
-		CreatedAt:         user.CreatedAt,
-		UpdatedAt:         user.UpdatedAt,
+		Id:                  user.Id,
+		Email:               user.Email,
+		UserName:            user.UserName,
+		DisplayName:         user.DisplayName,
+		Biodata:             user.Biodata,
+		SocialMedia:         user.SocialMedia,
+		ProfilePictureURL:   user.ProfilePictureURL,
+		Password:            user.Password,
+		IsActive:            user.IsActive,
+		EmailVerified:       user.EmailVerified,
+		Role:                user.Role,
+		TwoFactorEnabled:    user.TwoFactorEnabled,
+		TwoFactorSecret:     user.TwoFactorSecret,
+		RecoveryCodes:       user.RecoveryCodes,
+		LastTOTPStep:        user.LastTOTPStep,
+		OIDCIssuer:          user.OIDCIssuer,
+		OIDCSubject:         user.OIDCSubject,
+		DeletionScheduledAt: user.DeletionScheduledAt,
+		CreatedAt:           user.CreatedAt,
+		UpdatedAt:           user.UpdatedAt,
 	}
 }
 
@@ -0,0 +1,132 @@
+package warnings
+
+import (
+	"charum/business/warnings"
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type warningRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoRepository(db *mongo.Database) warnings.Repository {
+	return &warningRepository{
+		collection: db.Collection("warnings"),
+	}
+}
+
+/*
+Create
+*/
+
+func (wr *warningRepository) Create(domain *warnings.Domain) (warnings.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	res, err := wr.collection.InsertOne(ctx, FromDomain(domain))
+	if err != nil {
+		return warnings.Domain{}, err
+	}
+
+	result, err := wr.GetByID(res.InsertedID.(primitive.ObjectID))
+	if err != nil {
+		return warnings.Domain{}, err
+	}
+
+	return result, nil
+}
+
+/*
+Read
+*/
+
+func (wr *warningRepository) GetByID(id primitive.ObjectID) (warnings.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	var result Model
+	err := wr.collection.FindOne(ctx, bson.M{
+		"_id": id,
+	}).Decode(&result)
+	if err != nil {
+		return warnings.Domain{}, err
+	}
+
+	return result.ToDomain(), nil
+}
+
+func (wr *warningRepository) GetAllByUserID(userID primitive.ObjectID) ([]warnings.Domain, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	var result []Model
+	cursor, err := wr.collection.Find(ctx, bson.M{
+		"userID": userID,
+	}, &options.FindOptions{
+		Sort: bson.M{"createdAt": -1},
+	})
+	if err != nil {
+		return []warnings.Domain{}, err
+	}
+
+	if err = cursor.All(ctx, &result); err != nil {
+		return []warnings.Domain{}, err
+	}
+
+	return ToArrayDomain(result), nil
+}
+
+func (wr *warningRepository) CountActiveByUserID(userID primitive.ObjectID, now primitive.DateTime) (int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	count, err := wr.collection.CountDocuments(ctx, bson.M{
+		"userID": userID,
+		"expiredAt": bson.M{
+			"$gt": now,
+		},
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return int(count), nil
+}
+
+/*
+Delete
+*/
+
+func (wr *warningRepository) Delete(id primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	_, err := wr.collection.DeleteOne(ctx, bson.M{
+		"_id": id,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (wr *warningRepository) DeleteAllByUserID(userID primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	_, err := wr.collection.DeleteMany(ctx, bson.M{
+		"userID": userID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
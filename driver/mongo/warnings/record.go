@@ -0,0 +1,58 @@
+package warnings
+
+import (
+	"charum/business/warnings"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type Model struct {
+	Id         primitive.ObjectID `json:"_id" bson:"_id"`
+	UserID     primitive.ObjectID `json:"userID" bson:"userID"`
+	IssuerID   primitive.ObjectID `json:"issuerID" bson:"issuerID"`
+	Reason     string             `json:"reason" bson:"reason"`
+	Detail     string             `json:"detail" bson:"detail"`
+	TargetType string             `json:"targetType,omitempty" bson:"targetType,omitempty"`
+	TargetID   primitive.ObjectID `json:"targetID,omitempty" bson:"targetID,omitempty"`
+	ExpiredAt  primitive.DateTime `json:"expiredAt" bson:"expiredAt"`
+	CreatedAt  primitive.DateTime `json:"createdAt" bson:"createdAt"`
+	UpdatedAt  primitive.DateTime `json:"updatedAt" bson:"updatedAt"`
+}
+
+func FromDomain(domain *warnings.Domain) *Model {
+	return &Model{
+		Id:         domain.Id,
+		UserID:     domain.UserID,
+		IssuerID:   domain.IssuerID,
+		Reason:     domain.Reason,
+		Detail:     domain.Detail,
+		TargetType: domain.TargetType,
+		TargetID:   domain.TargetID,
+		ExpiredAt:  domain.ExpiredAt,
+		CreatedAt:  domain.CreatedAt,
+		UpdatedAt:  domain.UpdatedAt,
+	}
+}
+
+func (warning *Model) ToDomain() warnings.Domain {
+	return warnings.Domain{
+		Id:         warning.Id,
+		UserID:     warning.UserID,
+		IssuerID:   warning.IssuerID,
+		Reason:     warning.Reason,
+		Detail:     warning.Detail,
+		TargetType: warning.TargetType,
+		TargetID:   warning.TargetID,
+		ExpiredAt:  warning.ExpiredAt,
+		CreatedAt:  warning.CreatedAt,
+		UpdatedAt:  warning.UpdatedAt,
+	}
+}
+
+func ToArrayDomain(data []Model) []warnings.Domain {
+	var result []warnings.Domain
+	for _, v := range data {
+		result = append(result, v.ToDomain())
+	}
+	return result
+}
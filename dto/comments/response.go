@@ -1,18 +1,20 @@
 package comments
 
 import (
-	"charum/business/users"
+	dtoUser "charum/dto/users"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 type Response struct {
-	Id        primitive.ObjectID `json:"_id"`
-	ThreadID  primitive.ObjectID `json:"threadID"`
-	ParentID  primitive.ObjectID `json:"parentID,omitempty"`
-	User      users.Domain       `json:"user"`
-	Comment   string             `json:"comment"`
-	ImageURL  string             `json:"imageURL,omitempty"`
-	CreatedAt primitive.DateTime `json:"createdAt"`
-	UpdatedAt primitive.DateTime `json:"updatedAt"`
+	Id            primitive.ObjectID `json:"_id"`
+	ThreadID      primitive.ObjectID `json:"threadID"`
+	ParentID      primitive.ObjectID `json:"parentID,omitempty"`
+	User          dtoUser.Public     `json:"user"`
+	Comment       string             `json:"comment"`
+	ImageURL      string             `json:"imageURL,omitempty"`
+	SuspendStatus string             `json:"suspendStatus,omitempty"`
+	SuspendDetail string             `json:"suspendDetail,omitempty"`
+	CreatedAt     primitive.DateTime `json:"createdAt"`
+	UpdatedAt     primitive.DateTime `json:"updatedAt"`
 }
@@ -1,8 +1,8 @@
 package follow_threads
 
 import (
-	"charum/business/users"
 	"charum/dto/threads"
+	dtoUser "charum/dto/users"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -10,7 +10,7 @@ import (
 
 type Response struct {
 	Id           primitive.ObjectID `json:"_id"`
-	User         users.Domain       `json:"user"`
+	User         dtoUser.Public     `json:"user"`
 	Thread       threads.Response   `json:"thread"`
 	Notification int                `json:"notification"`
 	CreatedAt    time.Time          `json:"createdAt"`
@@ -2,7 +2,7 @@ package threads
 
 import (
 	"charum/business/topics"
-	"charum/business/users"
+	dtoUser "charum/dto/users"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
@@ -10,7 +10,7 @@ import (
 type Response struct {
 	Id            primitive.ObjectID `json:"_id"`
 	Topic         topics.Domain      `json:"topic"`
-	Creator       users.Domain       `json:"creator"`
+	Creator       dtoUser.Public     `json:"creator"`
 	Title         string             `json:"title"`
 	Description   string             `json:"description"`
 	Likes         []Like             `json:"likes"`
@@ -25,11 +25,20 @@ type Response struct {
 	TotalReported int                `json:"totalReported"`
 	SuspendStatus string             `json:"suspendStatus,omitempty"`
 	SuspendDetail string             `json:"suspendDetail,omitempty"`
+	Tags          []string           `json:"tags"`
+	IsPinned      bool               `json:"isPinned"`
+	IsTopicPinned bool               `json:"isTopicPinned"`
+	IsLocked      bool               `json:"isLocked"`
+	IsDraft       bool               `json:"isDraft,omitempty"`
+	PublishAt     primitive.DateTime `json:"publishAt,omitempty"`
+	IsEdited      bool               `json:"isEdited"`
+	EditedAt      primitive.DateTime `json:"editedAt,omitempty"`
+	DeletedAt     primitive.DateTime `json:"deletedAt,omitempty"`
 	CreatedAt     primitive.DateTime `json:"createdAt"`
 	UpdatedAt     primitive.DateTime `json:"updatedAt"`
 }
 
 type Like struct {
-	User      users.Domain       `json:"user"`
+	User      dtoUser.Public     `json:"user"`
 	Timestamp primitive.DateTime `json:"timestamp" bson:"timestamp"`
 }
@@ -0,0 +1,34 @@
+package users
+
+import (
+	"charum/business/users"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Public is the representation of a user shown to other users, private fields such as the email are left out
+type Public struct {
+	Id                primitive.ObjectID `json:"_id"`
+	UserName          string             `json:"userName"`
+	DisplayName       string             `json:"displayName"`
+	Biodata           string             `json:"biodata"`
+	ProfilePictureURL string             `json:"profilePictureURL"`
+}
+
+func FromDomain(domain users.Domain) Public {
+	return Public{
+		Id:                domain.Id,
+		UserName:          domain.UserName,
+		DisplayName:       domain.DisplayName,
+		Biodata:           domain.Biodata,
+		ProfilePictureURL: domain.ProfilePictureURL,
+	}
+}
+
+func FromDomainArray(domains []users.Domain) []Public {
+	array := []Public{}
+	for _, domain := range domains {
+		array = append(array, FromDomain(domain))
+	}
+	return array
+}
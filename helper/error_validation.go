@@ -33,6 +33,8 @@ func MessageForTag(tag string) string {
 		return "This field must be [PARAM] characters"
 	case "gte":
 		return "This field must be greater than or equal to [PARAM]"
+	case "datetime":
+		return "This field must be a date and time in [PARAM] format"
 	default:
 		return "Invalid field " + tag
 	}
@@ -11,6 +11,10 @@ import (
 
 type Function interface {
 	SendMail(email string, token string) (string, error)
+	SendVerificationMail(email string, token string) (string, error)
+	SendMagicLinkMail(email string, token string) (string, error)
+	SendDataExportMail(email string, token string) (string, error)
+	SendAppealResultMail(email string, status string, reply string) (string, error)
 }
 
 type Mailgun struct {
@@ -48,3 +52,92 @@ func (mg *Mailgun) SendMail(email string, token string) (string, error) {
 	_, id, err := mg.Mailgun.Send(ctx, m)
 	return id, err
 }
+
+func (mg *Mailgun) SendVerificationMail(email string, token string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	m := mg.Mailgun.NewMessage(fmt.Sprintf("Charum No-Reply <noreply@%s>", mg.EmailDomain), "Verify Your Email Address", "")
+	m.SetTemplate("charum-verification")
+	if err := m.AddRecipient(email); err != nil {
+		return "", err
+	}
+
+	vars, err := json.Marshal(map[string]string{
+		"token": token,
+	})
+	if err != nil {
+		return "", err
+	}
+	m.AddHeader("X-Mailgun-Template-Variables", string(vars))
+
+	_, id, err := mg.Mailgun.Send(ctx, m)
+	return id, err
+}
+
+func (mg *Mailgun) SendMagicLinkMail(email string, token string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	m := mg.Mailgun.NewMessage(fmt.Sprintf("Charum No-Reply <noreply@%s>", mg.EmailDomain), "Your Login Link", "")
+	m.SetTemplate("charum-magic-link")
+	if err := m.AddRecipient(email); err != nil {
+		return "", err
+	}
+
+	vars, err := json.Marshal(map[string]string{
+		"token": token,
+	})
+	if err != nil {
+		return "", err
+	}
+	m.AddHeader("X-Mailgun-Template-Variables", string(vars))
+
+	_, id, err := mg.Mailgun.Send(ctx, m)
+	return id, err
+}
+
+func (mg *Mailgun) SendDataExportMail(email string, token string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	m := mg.Mailgun.NewMessage(fmt.Sprintf("Charum No-Reply <noreply@%s>", mg.EmailDomain), "Your Data Export Is Ready", "")
+	m.SetTemplate("charum-data-export")
+	if err := m.AddRecipient(email); err != nil {
+		return "", err
+	}
+
+	vars, err := json.Marshal(map[string]string{
+		"token": token,
+	})
+	if err != nil {
+		return "", err
+	}
+	m.AddHeader("X-Mailgun-Template-Variables", string(vars))
+
+	_, id, err := mg.Mailgun.Send(ctx, m)
+	return id, err
+}
+
+func (mg *Mailgun) SendAppealResultMail(email string, status string, reply string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	m := mg.Mailgun.NewMessage(fmt.Sprintf("Charum No-Reply <noreply@%s>", mg.EmailDomain), "Your Suspension Appeal Has Been Reviewed", "")
+	m.SetTemplate("charum-appeal-result")
+	if err := m.AddRecipient(email); err != nil {
+		return "", err
+	}
+
+	vars, err := json.Marshal(map[string]string{
+		"status": status,
+		"reply":  reply,
+	})
+	if err != nil {
+		return "", err
+	}
+	m.AddHeader("X-Mailgun-Template-Variables", string(vars))
+
+	_, id, err := mg.Mailgun.Send(ctx, m)
+	return id, err
+}
@@ -9,6 +9,69 @@ type Function struct {
 	mock.Mock
 }
 
+// SendAppealResultMail provides a mock function with given fields: email, status, reply
+func (_m *Function) SendAppealResultMail(email string, status string, reply string) (string, error) {
+	ret := _m.Called(email, status, reply)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, string, string) string); ok {
+		r0 = rf(email, status, reply)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(email, status, reply)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SendDataExportMail provides a mock function with given fields: email, token
+func (_m *Function) SendDataExportMail(email string, token string) (string, error) {
+	ret := _m.Called(email, token)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, string) string); ok {
+		r0 = rf(email, token)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(email, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SendMagicLinkMail provides a mock function with given fields: email, token
+func (_m *Function) SendMagicLinkMail(email string, token string) (string, error) {
+	ret := _m.Called(email, token)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, string) string); ok {
+		r0 = rf(email, token)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(email, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SendMail provides a mock function with given fields: email, token
 func (_m *Function) SendMail(email string, token string) (string, error) {
 	ret := _m.Called(email, token)
@@ -30,6 +93,27 @@ func (_m *Function) SendMail(email string, token string) (string, error) {
 	return r0, r1
 }
 
+// SendVerificationMail provides a mock function with given fields: email, token
+func (_m *Function) SendVerificationMail(email string, token string) (string, error) {
+	ret := _m.Called(email, token)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, string) string); ok {
+		r0 = rf(email, token)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(email, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewFunction interface {
 	mock.TestingT
 	Cleanup(func())
@@ -0,0 +1,287 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+type Function interface {
+	AuthCodeURL(state string, nonce string, codeChallenge string) (string, error)
+	Exchange(code string, codeVerifier string, nonce string) (Claims, error)
+	Issuer() string
+}
+
+type Claims struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+}
+
+type OIDC struct {
+	issuer       string
+	clientID     string
+	clientSecret string
+	redirectURL  string
+	httpClient   *http.Client
+
+	mu        sync.Mutex
+	discovery *discoveryDocument
+	keys      map[string]*rsa.PublicKey
+}
+
+type discoveryDocument struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+type idTokenClaims struct {
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"email_verified"`
+	Name          string `json:"name"`
+	Nonce         string `json:"nonce"`
+	jwt.RegisteredClaims
+}
+
+func Init(issuer string, clientID string, clientSecret string, redirectURL string) Function {
+	return &OIDC{
+		issuer:       strings.TrimSuffix(issuer, "/"),
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		redirectURL:  redirectURL,
+		httpClient:   &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+func (o *OIDC) Issuer() string {
+	return o.issuer
+}
+
+func (o *OIDC) AuthCodeURL(state string, nonce string, codeChallenge string) (string, error) {
+	discovery, err := o.getDiscovery()
+	if err != nil {
+		return "", err
+	}
+
+	params := url.Values{}
+	params.Set("response_type", "code")
+	params.Set("client_id", o.clientID)
+	params.Set("redirect_uri", o.redirectURL)
+	params.Set("scope", "openid email profile")
+	params.Set("state", state)
+	params.Set("nonce", nonce)
+	params.Set("code_challenge", codeChallenge)
+	params.Set("code_challenge_method", "S256")
+
+	separator := "?"
+	if strings.Contains(discovery.AuthorizationEndpoint, "?") {
+		separator = "&"
+	}
+
+	return discovery.AuthorizationEndpoint + separator + params.Encode(), nil
+}
+
+func (o *OIDC) Exchange(code string, codeVerifier string, nonce string) (Claims, error) {
+	discovery, err := o.getDiscovery()
+	if err != nil {
+		return Claims{}, err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", o.redirectURL)
+	form.Set("client_id", o.clientID)
+	form.Set("client_secret", o.clientSecret)
+	form.Set("code_verifier", codeVerifier)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, discovery.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return Claims{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	res, err := o.httpClient.Do(req)
+	if err != nil {
+		return Claims{}, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return Claims{}, fmt.Errorf("token endpoint returned status %d", res.StatusCode)
+	}
+
+	var tokenResponse struct {
+		IDToken string `json:"id_token"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&tokenResponse); err != nil {
+		return Claims{}, err
+	}
+
+	if tokenResponse.IDToken == "" {
+		return Claims{}, errors.New("token response does not contain an id token")
+	}
+
+	return o.verifyIDToken(tokenResponse.IDToken, nonce)
+}
+
+func (o *OIDC) verifyIDToken(rawIDToken string, nonce string) (Claims, error) {
+	claims := idTokenClaims{}
+	token, err := jwt.ParseWithClaims(rawIDToken, &claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
+			return nil, errors.New("unexpected signing method")
+		}
+
+		kid, _ := token.Header["kid"].(string)
+		return o.getKey(kid)
+	})
+	if err != nil {
+		return Claims{}, err
+	}
+
+	if !token.Valid {
+		return Claims{}, errors.New("invalid id token")
+	}
+
+	if !claims.VerifyIssuer(o.issuer, true) {
+		return Claims{}, errors.New("invalid id token issuer")
+	}
+
+	if !claims.VerifyAudience(o.clientID, true) {
+		return Claims{}, errors.New("invalid id token audience")
+	}
+
+	if claims.Nonce != nonce {
+		return Claims{}, errors.New("invalid id token nonce")
+	}
+
+	if claims.Subject == "" {
+		return Claims{}, errors.New("id token does not contain a subject")
+	}
+
+	return Claims{
+		Subject:       claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: claims.EmailVerified,
+		Name:          claims.Name,
+	}, nil
+}
+
+func (o *OIDC) getDiscovery() (*discoveryDocument, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.discovery != nil {
+		return o.discovery, nil
+	}
+
+	discovery := discoveryDocument{}
+	if err := o.getJSON(o.issuer+"/.well-known/openid-configuration", &discovery); err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSuffix(discovery.Issuer, "/") != o.issuer {
+		return nil, errors.New("discovery document issuer does not match")
+	}
+
+	o.discovery = &discovery
+	return o.discovery, nil
+}
+
+// getKey looks the key up in the cached key set and refetches the set once when the provider has rotated its keys
+func (o *OIDC) getKey(kid string) (*rsa.PublicKey, error) {
+	discovery, err := o.getDiscovery()
+	if err != nil {
+		return nil, err
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if key, ok := o.keys[kid]; ok {
+		return key, nil
+	}
+
+	var jwks struct {
+		Keys []struct {
+			Kid string `json:"kid"`
+			Kty string `json:"kty"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+		} `json:"keys"`
+	}
+	if err := o.getJSON(discovery.JWKSURI, &jwks); err != nil {
+		return nil, err
+	}
+
+	keys := map[string]*rsa.PublicKey{}
+	for _, k := range jwks.Keys {
+		if k.Kty != "RSA" {
+			continue
+		}
+
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			continue
+		}
+
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			continue
+		}
+
+		keys[k.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+	o.keys = keys
+
+	key, ok := o.keys[kid]
+	if !ok {
+		return nil, errors.New("signing key not found")
+	}
+
+	return key, nil
+}
+
+func (o *OIDC) getJSON(endpoint string, target interface{}) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	res, err := o.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned status %d", endpoint, res.StatusCode)
+	}
+
+	return json.NewDecoder(res.Body).Decode(target)
+}
@@ -0,0 +1,227 @@
+package oidc_test
+
+import (
+	"charum/helper/oidc"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	clientID     = "charum-client"
+	clientSecret = "charum-secret"
+	redirectURL  = "http://localhost/api/v1/user/oidc/callback"
+)
+
+type authorization struct {
+	codeChallenge string
+	nonce         string
+}
+
+// provider is a minimal stand-in OpenID Connect server for the authorization code flow with PKCE
+type provider struct {
+	server     *httptest.Server
+	signingKey *rsa.PrivateKey
+	publicKey  *rsa.PublicKey
+	kid        string
+	audience   string
+	subject    string
+	email      string
+
+	mu    sync.Mutex
+	codes map[string]authorization
+}
+
+func newProvider(t *testing.T) *provider {
+	signingKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := &provider{
+		signingKey: signingKey,
+		publicKey:  &signingKey.PublicKey,
+		kid:        "test-key",
+		audience:   clientID,
+		subject:    "external-subject",
+		email:      "test@charum.com",
+		codes:      map[string]authorization{},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 p.server.URL,
+			"authorization_endpoint": p.server.URL + "/authorize",
+			"token_endpoint":         p.server.URL + "/token",
+			"jwks_uri":               p.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{{
+				"kid": p.kid,
+				"kty": "RSA",
+				"alg": "RS256",
+				"n":   base64.RawURLEncoding.EncodeToString(p.publicKey.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(p.publicKey.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("/authorize", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		code := "code-" + query.Get("state")
+
+		p.mu.Lock()
+		p.codes[code] = authorization{
+			codeChallenge: query.Get("code_challenge"),
+			nonce:         query.Get("nonce"),
+		}
+		p.mu.Unlock()
+
+		http.Redirect(w, r, query.Get("redirect_uri")+"?code="+code+"&state="+query.Get("state"), http.StatusFound)
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+
+		p.mu.Lock()
+		auth, ok := p.codes[r.PostForm.Get("code")]
+		delete(p.codes, r.PostForm.Get("code"))
+		p.mu.Unlock()
+
+		verifierHash := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+		if !ok || base64.RawURLEncoding.EncodeToString(verifierHash[:]) != auth.codeChallenge || r.PostForm.Get("client_secret") != clientSecret {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+			"iss":            p.server.URL,
+			"sub":            p.subject,
+			"aud":            p.audience,
+			"email":          p.email,
+			"email_verified": true,
+			"nonce":          auth.nonce,
+			"iat":            time.Now().Unix(),
+			"exp":            time.Now().Add(5 * time.Minute).Unix(),
+		})
+		token.Header["kid"] = p.kid
+		idToken, _ := token.SignedString(p.signingKey)
+
+		json.NewEncoder(w).Encode(map[string]string{
+			"access_token": "access-token",
+			"token_type":   "Bearer",
+			"id_token":     idToken,
+		})
+	})
+	p.server = httptest.NewServer(mux)
+
+	return p
+}
+
+// authorize follows the authorization URL like a browser would and returns the code sent to the redirect URL
+func authorize(t *testing.T, authURL string) string {
+	client := &http.Client{
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	res, err := client.Get(authURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	location, err := url.Parse(res.Header.Get("Location"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return location.Query().Get("code")
+}
+
+func codeChallenge(verifier string) string {
+	hash := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+func TestExchange(t *testing.T) {
+	p := newProvider(t)
+	defer p.server.Close()
+
+	t.Run("Test Case 1 | Valid Exchange", func(t *testing.T) {
+		client := oidc.Init(p.server.URL, clientID, clientSecret, redirectURL)
+		authURL, err := client.AuthCodeURL("state1", "nonce1", codeChallenge("verifier1"))
+		assert.Nil(t, err)
+
+		claims, err := client.Exchange(authorize(t, authURL), "verifier1", "nonce1")
+
+		assert.Equal(t, "external-subject", claims.Subject)
+		assert.Equal(t, "test@charum.com", claims.Email)
+		assert.True(t, claims.EmailVerified)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test Case 2 | Invalid Exchange | Wrong code verifier", func(t *testing.T) {
+		client := oidc.Init(p.server.URL, clientID, clientSecret, redirectURL)
+		authURL, _ := client.AuthCodeURL("state2", "nonce2", codeChallenge("verifier2"))
+
+		claims, err := client.Exchange(authorize(t, authURL), "another-verifier", "nonce2")
+
+		assert.Equal(t, oidc.Claims{}, claims)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("Test Case 3 | Invalid Exchange | Nonce does not match", func(t *testing.T) {
+		client := oidc.Init(p.server.URL, clientID, clientSecret, redirectURL)
+		authURL, _ := client.AuthCodeURL("state3", "nonce3", codeChallenge("verifier3"))
+
+		claims, err := client.Exchange(authorize(t, authURL), "verifier3", "another-nonce")
+
+		assert.Equal(t, oidc.Claims{}, claims)
+		assert.EqualError(t, err, "invalid id token nonce")
+	})
+
+	t.Run("Test Case 4 | Invalid Exchange | Token issued for another client", func(t *testing.T) {
+		p.audience = "another-client"
+		defer func() { p.audience = clientID }()
+
+		client := oidc.Init(p.server.URL, clientID, clientSecret, redirectURL)
+		authURL, _ := client.AuthCodeURL("state4", "nonce4", codeChallenge("verifier4"))
+
+		claims, err := client.Exchange(authorize(t, authURL), "verifier4", "nonce4")
+
+		assert.Equal(t, oidc.Claims{}, claims)
+		assert.EqualError(t, err, "invalid id token audience")
+	})
+
+	t.Run("Test Case 5 | Invalid Exchange | Token signed with an unpublished key", func(t *testing.T) {
+		client := oidc.Init(p.server.URL, clientID, clientSecret, redirectURL)
+		authURL, _ := client.AuthCodeURL("state5", "nonce5", codeChallenge("verifier5"))
+		code := authorize(t, authURL)
+
+		// the key set still publishes the original key, so the signature must be rejected
+		originalKey := p.signingKey
+		p.signingKey, _ = rsa.GenerateKey(rand.Reader, 2048)
+		defer func() { p.signingKey = originalKey }()
+
+		claims, err := client.Exchange(code, "verifier5", "nonce5")
+
+		assert.Equal(t, oidc.Claims{}, claims)
+		assert.NotNil(t, err)
+	})
+}
@@ -11,6 +11,7 @@ import (
 	_mongo "charum/driver/mongo"
 	_cloudinary "charum/helper/cloudinary"
 	_mailgun "charum/helper/mailgun"
+	_oidc "charum/helper/oidc"
 	_util "charum/util"
 
 	_userUseCase "charum/business/users"
@@ -28,6 +29,12 @@ import (
 	_followThreadUseCase "charum/business/follow_threads"
 	_followThreadController "charum/controller/follow_threads"
 
+	_followUserUseCase "charum/business/follow_users"
+	_followUserController "charum/controller/follow_users"
+
+	_userBlockUseCase "charum/business/user_blocks"
+	_userBlockController "charum/controller/user_blocks"
+
 	_bookmarkUseCase "charum/business/bookmarks"
 	_bookmarkController "charum/controller/bookmarks"
 
@@ -37,6 +44,21 @@ import (
 	_reportUseCase "charum/business/reports"
 	_reportController "charum/controller/reports"
 
+	_permissionUseCase "charum/business/permissions"
+	_permissionController "charum/controller/permissions"
+
+	_dataExportUseCase "charum/business/data_exports"
+	_dataExportController "charum/controller/data_exports"
+
+	_warningUseCase "charum/business/warnings"
+	_warningController "charum/controller/warnings"
+
+	_appealUseCase "charum/business/appeals"
+	_appealController "charum/controller/appeals"
+
+	_auditLogUseCase "charum/business/audit_logs"
+	_auditLogController "charum/controller/audit_logs"
+
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 )
@@ -44,47 +66,99 @@ import (
 func main() {
 	e := echo.New()
 
+	ipExtractor, err := _util.NewIPExtractor(_util.GetConfig("TRUSTED_PROXIES"))
+	if err != nil {
+		e.Logger.Fatal(err)
+	}
+	e.IPExtractor = ipExtractor
+
 	database := _mongo.Init(_util.GetConfig("DB_NAME"))
 	cloudinary := _cloudinary.Init(_util.GetConfig("CLOUDINARY_UPLOAD_FOLDER"))
 	mailgun := _mailgun.Init(_util.GetConfig("MAILGUN_DOMAIN"), _util.GetConfig("MAILGUN_API_KEY"))
+	oidc := _oidc.Init(_util.GetConfig("OIDC_ISSUER"), _util.GetConfig("OIDC_CLIENT_ID"), _util.GetConfig("OIDC_CLIENT_SECRET"), _util.GetConfig("OIDC_REDIRECT_URL"))
 
 	userRepository := _driver.NewUserRepository(database)
 	topicRepository := _driver.NewTopicRepository(database)
 	threadRepository := _driver.NewThreadRepository(database)
 	commentRepository := _driver.NewCommentRepository(database)
 	followThreadRepository := _driver.NewFollowThreadRepository(database)
+	followUserRepository := _driver.NewFollowUserRepository(database)
+	userBlockRepository := _driver.NewUserBlockRepository(database)
 	bookmarkRepository := _driver.NewBookmarkRepository(database)
 	forgotPasswordRepository := _driver.NewForgotPasswordRepository(database)
 	reportRepository := _driver.NewReportRepository(database)
+	refreshTokenRepository := _driver.NewRefreshTokenRepository(database)
+	oidcStateRepository := _driver.NewOIDCStateRepository(database)
+	loginAttemptRepository := _driver.NewLoginAttemptRepository(database)
+	permissionRepository := _driver.NewPermissionRepository(database)
+	dataExportRepository := _driver.NewDataExportRepository(database)
+	suspensionRepository := _driver.NewSuspensionRepository(database)
+	warningRepository := _driver.NewWarningRepository(database)
+	appealRepository := _driver.NewAppealRepository(database)
+	auditLogRepository := _driver.NewAuditLogRepository(database)
+	threadRevisionRepository := _driver.NewThreadRevisionRepository(database)
+	bannedTagRepository := _driver.NewBannedTagRepository(database)
+
+	permissionUseCase := _permissionUseCase.NewPermissionUseCase(permissionRepository)
+	if err := permissionUseCase.SeedDefaults(); err != nil {
+		e.Logger.Fatal(err)
+	}
 
-	userUsecase := _userUseCase.NewUserUseCase(userRepository, cloudinary)
-	topicUsecase := _topicUseCase.NewTopicUseCase(topicRepository, cloudinary)
-	threadUsecase := _threadUseCase.NewThreadUseCase(threadRepository, topicRepository, userRepository, cloudinary)
-	commentUsecase := _commentUseCase.NewCommentUseCase(commentRepository, threadRepository, userRepository, cloudinary)
+	userUsecase := _userUseCase.NewUserUseCase(userRepository, refreshTokenRepository, oidcStateRepository, loginAttemptRepository, suspensionRepository, cloudinary, mailgun, oidc)
+	topicUsecase := _topicUseCase.NewTopicUseCase(topicRepository, userRepository, cloudinary)
+	threadUsecase := _threadUseCase.NewThreadUseCase(threadRepository, threadRevisionRepository, bannedTagRepository, topicRepository, userRepository, permissionRepository, commentRepository, followThreadRepository, bookmarkRepository, cloudinary)
+	commentUsecase := _commentUseCase.NewCommentUseCase(commentRepository, threadRepository, topicRepository, userRepository, userBlockRepository, permissionRepository, cloudinary)
 	followThreadUsecase := _followThreadUseCase.NewFollowThreadUseCase(followThreadRepository, userRepository, threadRepository, commentRepository, threadUsecase)
+	followUserUsecase := _followUserUseCase.NewFollowUserUseCase(followUserRepository, userRepository, userBlockRepository, threadRepository)
+	userBlockUsecase := _userBlockUseCase.NewUserBlockUseCase(userBlockRepository, userRepository)
+	warningConfig, err := _warningUseCase.ParseConfig(_util.GetConfig("WARNING_STRIKE_DURATION"), _util.GetConfig("WARNING_ESCALATIONS"))
+	if err != nil {
+		e.Logger.Fatal(err)
+	}
+	warningUsecase := _warningUseCase.NewWarningUseCase(warningRepository, userRepository, threadRepository, commentRepository, topicRepository, permissionRepository, userUsecase, warningConfig)
+	auditLogUsecase := _auditLogUseCase.NewAuditLogUseCase(auditLogRepository)
+	appealUsecase := _appealUseCase.NewAppealUseCase(appealRepository, userRepository, suspensionRepository, userUsecase, threadUsecase, mailgun)
 	bookmarkUsecase := _bookmarkUseCase.NewBookmarkUseCase(bookmarkRepository, threadRepository, userRepository, topicRepository, threadUsecase)
-	forgotPasswordUseCase := _forgotPasswordUseCase.NewForgotPasswordUseCase(forgotPasswordRepository, userRepository, mailgun)
-	reportUseCase := _reportUseCase.NewReportUseCase(reportRepository, userRepository, threadRepository)
+	forgotPasswordUseCase := _forgotPasswordUseCase.NewForgotPasswordUseCase(forgotPasswordRepository, userRepository, refreshTokenRepository, mailgun)
+	reportUseCase := _reportUseCase.NewReportUseCase(reportRepository, userRepository, userBlockRepository, threadRepository, topicRepository, permissionRepository)
+	dataExportUseCase := _dataExportUseCase.NewDataExportUseCase(dataExportRepository, userRepository, threadRepository, commentRepository, bookmarkRepository, followThreadRepository, reportRepository, mailgun)
 
-	userController := _userController.NewUserController(userUsecase, threadUsecase, commentUsecase, followThreadUsecase, bookmarkUsecase)
-	topicController := _topicController.NewTopicController(topicUsecase, threadUsecase, commentUsecase, followThreadUsecase, bookmarkUsecase)
-	threadController := _threadController.NewThreadController(threadUsecase, commentUsecase, followThreadUsecase, userUsecase, bookmarkUsecase, reportUseCase)
+	userController := _userController.NewUserController(userUsecase, threadUsecase, commentUsecase, followThreadUsecase, followUserUsecase, userBlockUsecase, warningUsecase, bookmarkUsecase, forgotPasswordUseCase, auditLogUsecase, dataExportUseCase)
+	topicController := _topicController.NewTopicController(topicUsecase, threadUsecase, commentUsecase, followThreadUsecase, bookmarkUsecase, auditLogUsecase)
+	threadController := _threadController.NewThreadController(threadUsecase, commentUsecase, followThreadUsecase, followUserUsecase, userUsecase, userBlockUsecase, bookmarkUsecase, reportUseCase, auditLogUsecase)
 	commentController := _commentController.NewCommentController(commentUsecase, followThreadUsecase)
 	forgotPasswordController := _forgotPasswordController.NewForgotPasswordController(forgotPasswordUseCase, userUsecase)
 	followThreadController := _followThreadController.NewFollowThreadController(followThreadUsecase, bookmarkUsecase)
+	followUserController := _followUserController.NewFollowUserController(followUserUsecase)
+	userBlockController := _userBlockController.NewUserBlockController(userBlockUsecase, followUserUsecase)
 	bookmarkController := _bookmarkController.NewBookmarkController(bookmarkUsecase, followThreadUsecase, commentUsecase)
 	reportController := _reportController.NewReportController(reportUseCase, userUsecase, threadUsecase)
+	permissionController := _permissionController.NewPermissionController(permissionUseCase, auditLogUsecase)
+	dataExportController := _dataExportController.NewDataExportController(dataExportUseCase)
+	warningController := _warningController.NewWarningController(warningUsecase, threadUsecase)
+	appealController := _appealController.NewAppealController(appealUsecase, auditLogUsecase)
+	auditLogController := _auditLogController.NewAuditLogController(auditLogUsecase)
 
 	routeController := _route.ControllerList{
 		UserRepository:           userRepository,
+		RefreshTokenRepository:   refreshTokenRepository,
+		PermissionRepository:     permissionRepository,
+		AdminTwoFactorRequired:   _util.GetConfig("ADMIN_2FA_REQUIRED") == "true",
 		UserController:           userController,
 		TopicController:          topicController,
 		ThreadController:         threadController,
 		CommentController:        commentController,
 		FollowThreadController:   followThreadController,
+		FollowUserController:     followUserController,
+		UserBlockController:      userBlockController,
 		BookmarkController:       bookmarkController,
 		ForgotPasswordController: forgotPasswordController,
 		ReportController:         reportController,
+		PermissionController:     permissionController,
+		DataExportController:     dataExportController,
+		WarningController:        warningController,
+		AppealController:         appealController,
+		AuditLogController:       auditLogController,
 	}
 
 	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
@@ -94,6 +168,41 @@ func main() {
 
 	routeController.Init(e)
 
+	// accounts are deleted once the grace period after a self-deletion request is over
+	stopAccountDeletion := _util.RunPeriodically(time.Hour, func() {
+		if err := userController.PurgePendingDeletions(); err != nil {
+			e.Logger.Error(err)
+		}
+	})
+
+	// queued data exports are built and emailed in the background
+	stopDataExport := _util.RunPeriodically(time.Minute, func() {
+		if err := dataExportUseCase.ProcessPending(); err != nil {
+			e.Logger.Error(err)
+		}
+	})
+
+	// timed suspensions are lifted once their end time has passed
+	stopSuspensionExpiry := _util.RunPeriodically(time.Minute, func() {
+		if err := userController.LiftExpiredSuspensions(); err != nil {
+			e.Logger.Error(err)
+		}
+	})
+
+	// scheduled drafts are published once their publish time has passed
+	stopDraftPublishing := _util.RunPeriodically(time.Minute, func() {
+		if _, err := threadUsecase.PublishScheduled(); err != nil {
+			e.Logger.Error(err)
+		}
+	})
+
+	// threads that stayed in the trash past the retention period are deleted for good
+	stopThreadTrashPurge := _util.RunPeriodically(time.Hour, func() {
+		if err := threadController.PurgeTrash(); err != nil {
+			e.Logger.Error(err)
+		}
+	})
+
 	appPort := fmt.Sprintf(":%s", _util.GetConfig("APP_PORT"))
 
 	go func() {
@@ -109,6 +218,26 @@ func main() {
 		"http-server": func(ctx context.Context) error {
 			return e.Shutdown(context.Background())
 		},
+		"account-deletion": func(ctx context.Context) error {
+			stopAccountDeletion()
+			return nil
+		},
+		"data-export": func(ctx context.Context) error {
+			stopDataExport()
+			return nil
+		},
+		"suspension-expiry": func(ctx context.Context) error {
+			stopSuspensionExpiry()
+			return nil
+		},
+		"draft-publishing": func(ctx context.Context) error {
+			stopDraftPublishing()
+			return nil
+		},
+		"thread-trash-purge": func(ctx context.Context) error {
+			stopThreadTrashPurge()
+			return nil
+		},
 	})
 
 	<-wait
@@ -0,0 +1,33 @@
+package util
+
+import (
+	"net"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// NewIPExtractor returns how the client IP is read from a request, X-Forwarded-For is only trusted when
+// the request comes from one of the comma separated trustedProxies CIDR ranges, otherwise the peer address is used
+func NewIPExtractor(trustedProxies string) (echo.IPExtractor, error) {
+	if strings.TrimSpace(trustedProxies) == "" {
+		return echo.ExtractIPDirect(), nil
+	}
+
+	options := []echo.TrustOption{
+		echo.TrustLoopback(false),
+		echo.TrustLinkLocal(false),
+		echo.TrustPrivateNet(false),
+	}
+
+	for _, proxy := range strings.Split(trustedProxies, ",") {
+		_, ipRange, err := net.ParseCIDR(strings.TrimSpace(proxy))
+		if err != nil {
+			return nil, err
+		}
+
+		options = append(options, echo.TrustIPRange(ipRange))
+	}
+
+	return echo.ExtractIPFromXFFHeader(options...), nil
+}
@@ -0,0 +1,52 @@
+package util_test
+
+import (
+	"charum/util"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewIPExtractor(t *testing.T) {
+	t.Run("Test case 1 | Valid extract ip | Spoofed header is ignored without trusted proxies", func(t *testing.T) {
+		extractIP, err := util.NewIPExtractor("")
+		assert.Nil(t, err)
+
+		req := httptest.NewRequest("POST", "/api/v1/user/login", nil)
+		req.RemoteAddr = "203.0.113.7:51234"
+		req.Header.Set("X-Forwarded-For", "198.51.100.1")
+		req.Header.Set("X-Real-IP", "198.51.100.2")
+
+		assert.Equal(t, "203.0.113.7", extractIP(req))
+	})
+
+	t.Run("Test case 2 | Valid extract ip | Spoofed header is ignored from an untrusted peer", func(t *testing.T) {
+		extractIP, err := util.NewIPExtractor("10.0.0.0/8")
+		assert.Nil(t, err)
+
+		req := httptest.NewRequest("POST", "/api/v1/user/login", nil)
+		req.RemoteAddr = "192.168.1.20:51234"
+		req.Header.Set("X-Forwarded-For", "198.51.100.1")
+
+		assert.Equal(t, "192.168.1.20", extractIP(req))
+	})
+
+	t.Run("Test case 3 | Valid extract ip | Header is used from a trusted proxy", func(t *testing.T) {
+		extractIP, err := util.NewIPExtractor("10.0.0.0/8, 172.16.0.0/12")
+		assert.Nil(t, err)
+
+		req := httptest.NewRequest("POST", "/api/v1/user/login", nil)
+		req.RemoteAddr = "10.0.0.5:51234"
+		req.Header.Set("X-Forwarded-For", "198.51.100.1")
+
+		assert.Equal(t, "198.51.100.1", extractIP(req))
+	})
+
+	t.Run("Test case 4 | Invalid extract ip | Invalid trusted proxy range", func(t *testing.T) {
+		extractIP, err := util.NewIPExtractor("not-a-cidr")
+
+		assert.Nil(t, extractIP)
+		assert.NotNil(t, err)
+	})
+}
@@ -15,12 +15,25 @@ type JWTCustomClaims struct {
 	jwt.RegisteredClaims
 }
 
+type EmailVerificationClaims struct {
+	UID   string `json:"uid"`
+	Email string `json:"email"`
+	jwt.RegisteredClaims
+}
+
 var JWTSecretKey = GetConfig("JWT_SECRET_KEY")
 
-func GenerateToken(uid string, role string) string {
+const (
+	emailVerificationAudience  = "email-verification"
+	twoFactorChallengeAudience = "two-factor-challenge"
+	appealAudience             = "suspension-appeal"
+)
+
+func GenerateToken(uid string, role string, tokenID string) string {
 	claims := JWTCustomClaims{
 		uid,
 		jwt.RegisteredClaims{
+			ID:        tokenID,
 			Issuer:    "charum",
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 			NotBefore: jwt.NewNumericDate(time.Now()),
@@ -53,11 +66,123 @@ func GetPayloadToken(token string) (JWTCustomClaims, error) {
 	return claims, nil
 }
 
-func GetUIDFromToken(c echo.Context) (primitive.ObjectID, error) {
+func GenerateEmailVerificationToken(uid string, email string) string {
+	claims := EmailVerificationClaims{
+		uid,
+		email,
+		jwt.RegisteredClaims{
+			Issuer:    "charum",
+			Audience:  jwt.ClaimStrings{emailVerificationAudience},
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			NotBefore: jwt.NewNumericDate(time.Now()),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(24 * time.Hour)),
+		},
+	}
+
+	token, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(JWTSecretKey))
+	return token
+}
+
+func GetEmailVerificationPayload(token string) (EmailVerificationClaims, error) {
+	claims := EmailVerificationClaims{}
+	if err := parseToken(token, &claims); err != nil {
+		return EmailVerificationClaims{}, err
+	}
+
+	if !claims.VerifyAudience(emailVerificationAudience, true) {
+		return EmailVerificationClaims{}, errors.New("invalid token")
+	}
+
+	return claims, nil
+}
+
+func GenerateTwoFactorChallengeToken(uid string) string {
+	claims := JWTCustomClaims{
+		uid,
+		jwt.RegisteredClaims{
+			Issuer:    "charum",
+			Audience:  jwt.ClaimStrings{twoFactorChallengeAudience},
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			NotBefore: jwt.NewNumericDate(time.Now()),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(5 * time.Minute)),
+		},
+	}
+
+	token, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(JWTSecretKey))
+	return token
+}
+
+func GetTwoFactorChallengePayload(token string) (JWTCustomClaims, error) {
+	claims := JWTCustomClaims{}
+	if err := parseToken(token, &claims); err != nil {
+		return JWTCustomClaims{}, err
+	}
+
+	if !claims.VerifyAudience(twoFactorChallengeAudience, true) {
+		return JWTCustomClaims{}, errors.New("invalid token")
+	}
+
+	return claims, nil
+}
+
+// GenerateAppealToken lets a suspended user do nothing but submit an appeal against the suspension
+func GenerateAppealToken(uid string) string {
+	claims := JWTCustomClaims{
+		uid,
+		jwt.RegisteredClaims{
+			Issuer:    "charum",
+			Audience:  jwt.ClaimStrings{appealAudience},
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			NotBefore: jwt.NewNumericDate(time.Now()),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}
+
+	token, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(JWTSecretKey))
+	return token
+}
+
+func GetAppealPayload(token string) (JWTCustomClaims, error) {
+	claims := JWTCustomClaims{}
+	if err := parseToken(token, &claims); err != nil {
+		return JWTCustomClaims{}, err
+	}
+
+	if !claims.VerifyAudience(appealAudience, true) {
+		return JWTCustomClaims{}, errors.New("invalid token")
+	}
+
+	return claims, nil
+}
+
+func parseToken(token string, claims jwt.Claims) error {
+	tkn, err := jwt.ParseWithClaims(token, claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errors.New("unexpected signing method")
+		}
+		return []byte(JWTSecretKey), nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if !tkn.Valid {
+		return errors.New("invalid token")
+	}
+
+	return nil
+}
+
+func GetClaimsFromToken(c echo.Context) (JWTCustomClaims, error) {
 	authHeader := c.Request().Header.Get("Authorization")
 	token := strings.Replace(authHeader, "Bearer ", "", -1)
 
-	claims, err := GetPayloadToken(token)
+	return GetPayloadToken(token)
+}
+
+func GetUIDFromToken(c echo.Context) (primitive.ObjectID, error) {
+	claims, err := GetClaimsFromToken(c)
 	if err != nil {
 		return primitive.NilObjectID, err
 	}
@@ -0,0 +1,27 @@
+package util
+
+import (
+	"context"
+	"time"
+)
+
+// RunPeriodically calls task every interval in the background until the returned stop function is called
+func RunPeriodically(interval time.Duration, task func()) context.CancelFunc {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				task()
+			}
+		}
+	}()
+
+	return cancel
+}
@@ -0,0 +1,83 @@
+package util
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	totpDigits = 6
+	totpPeriod = 30
+	totpSkew   = 1
+)
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+func GenerateTOTPSecret() (string, error) {
+	secret := make([]byte, 20)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+
+	return totpEncoding.EncodeToString(secret), nil
+}
+
+func GenerateTOTPURI(secret string, accountName string) string {
+	label := url.PathEscape("Charum:" + accountName)
+	params := url.Values{}
+	params.Set("secret", secret)
+	params.Set("issuer", "Charum")
+	params.Set("algorithm", "SHA1")
+	params.Set("digits", fmt.Sprint(totpDigits))
+	params.Set("period", fmt.Sprint(totpPeriod))
+
+	return "otpauth://totp/" + label + "?" + params.Encode()
+}
+
+func GenerateTOTPCode(secret string, t time.Time) (string, error) {
+	return generateTOTPCodeAtStep(secret, t.Unix()/totpPeriod)
+}
+
+// ValidateTOTPCode returns the time step the code belongs to so callers can reject a code that was already used
+func ValidateTOTPCode(secret string, code string, t time.Time) (int64, bool) {
+	currentStep := t.Unix() / totpPeriod
+	for step := currentStep - totpSkew; step <= currentStep+totpSkew; step++ {
+		expected, err := generateTOTPCodeAtStep(secret, step)
+		if err != nil {
+			return 0, false
+		}
+
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+
+	return 0, false
+}
+
+func generateTOTPCodeAtStep(secret string, step int64) (string, error) {
+	key, err := totpEncoding.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return "", err
+	}
+
+	message := make([]byte, 8)
+	binary.BigEndian.PutUint64(message, uint64(step))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(message)
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	return fmt.Sprintf("%0*d", totpDigits, value%1000000), nil
+}